@@ -77,6 +77,11 @@ type AuditLogConfig interface {
 
 	// WithParts configures the parts of the request/response to be logged.
 	WithParts(parts types.AuditLogParts) AuditLogConfig
+
+	// WithRedaction masks the values selected by the given targets in every
+	// audit log, e.g. "REQUEST_HEADERS:Authorization" or "ARGS:/^pass/".
+	// It behaves like the SecAuditLogRedact directive.
+	WithRedaction(targets ...string) AuditLogConfig
 }
 
 // NewAuditLogConfig returns a new AuditLogConfig with the default settings.
@@ -197,6 +202,7 @@ type auditLogConfig struct {
 	relevantOnly bool
 	parts        types.AuditLogParts
 	writer       plugintypes.AuditLogWriter
+	redactions   []string
 }
 
 func (c *auditLogConfig) LogRelevantOnly() AuditLogConfig {
@@ -211,6 +217,12 @@ func (c *auditLogConfig) WithParts(parts types.AuditLogParts) AuditLogConfig {
 	return ret
 }
 
+func (c *auditLogConfig) WithRedaction(targets ...string) AuditLogConfig {
+	ret := c.clone()
+	ret.redactions = append(append([]string{}, c.redactions...), targets...)
+	return ret
+}
+
 func (c *auditLogConfig) clone() *auditLogConfig {
 	ret := *c // copy
 	return &ret
@@ -3,7 +3,11 @@
 
 package plugintypes
 
-import "io/fs"
+import (
+	"io"
+	"io/fs"
+	"time"
+)
 
 // OperatorOptions is used to store the options for a rule operator
 type OperatorOptions struct {
@@ -18,6 +22,87 @@ type OperatorOptions struct {
 
 	// Datasets contains input datasets or dictionaries
 	Datasets map[string][]string
+
+	// GeoLookup is the geolocation database configured with SecGeoLookupDb,
+	// nil if none has been configured.
+	GeoLookup GeoLookup
+
+	// OpenAPI is the specification configured with SecOpenAPISpec, nil if
+	// none has been configured.
+	OpenAPI OpenAPISpec
+
+	// RBL configures the DNS lookups of the @rbl operator
+	RBL RBLOptions
+
+	// ReloadInterval is how often operators loading data from files, such as
+	// @ipMatchFromFile, check whether the file changed and reload it. It is
+	// configured with SecDataFileReloadInterval, zero disables reloading.
+	ReloadInterval time.Duration
+
+	// InspectFile configures the @inspectFile operator
+	InspectFile InspectFileOptions
+
+	// RegexEngine is the name of the engine compiling the expression of @rx,
+	// selected with SecRegexEngine or the regexEngine action. Empty means
+	// RE2.
+	RegexEngine string
+
+	// Regex configures the matches of regular expressions
+	Regex RegexOptions
+}
+
+// InspectFileOptions configures the @inspectFile operator
+type InspectFileOptions struct {
+	// Timeout is the timeout of an inspection configured with
+	// SecInspectFileTimeout, zero means the default.
+	Timeout time.Duration
+}
+
+// RBLOptions configures the DNS lookups of the @rbl operator
+type RBLOptions struct {
+	// HTTPBLKey is the Project Honeypot http:BL access key configured with
+	// SecHttpBlKey.
+	HTTPBLKey string
+
+	// Timeout is the timeout of a lookup configured with SecRblTimeout, zero
+	// means the default.
+	Timeout time.Duration
+
+	// CacheTTL is the time lookup results are cached for, configured with
+	// SecRblCacheTTL. Zero means the default, a negative value disables
+	// caching.
+	CacheTTL time.Duration
+}
+
+// GeoLookup resolves the location of IP addresses
+type GeoLookup interface {
+	// Lookup returns the GEO collection fields (e.g. COUNTRY_CODE) for the
+	// address, or false if the location of the address is unknown.
+	Lookup(addr string) (map[string]string, bool)
+}
+
+// OpenAPIRequest is a request validated against an OpenAPI specification
+type OpenAPIRequest struct {
+	// Method is the HTTP method of the request, e.g. GET
+	Method string
+	// Path is the request path, without query string
+	Path string
+	// Query, Header and Cookie return the values of a parameter
+	Query  func(name string) []string
+	Header func(name string) []string
+	Cookie func(name string) []string
+	// ContentType is the value of the Content-Type header
+	ContentType string
+	// Body is the request body, nil if it is not available (e.g. in phase 1).
+	Body io.Reader
+}
+
+// OpenAPISpec validates requests against an OpenAPI specification
+type OpenAPISpec interface {
+	// ValidateRequest returns the path parameters of the operation matching
+	// the request and an error describing the first violation of the
+	// specification, nil if the request is valid.
+	ValidateRequest(r *OpenAPIRequest) (map[string]string, error)
 }
 
 // Operator interface is used to define rule @operators
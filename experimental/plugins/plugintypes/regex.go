@@ -0,0 +1,32 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugintypes
+
+// Regexp is a regular expression compiled by a RegexEngine
+type Regexp interface {
+	// MatchString reports whether the string contains a match. It returns
+	// an error when the match could not complete, e.g. because it exceeded
+	// the limits of the engine.
+	MatchString(s string) (bool, error)
+
+	// FindStringSubmatch returns the text of the leftmost match and of its
+	// groups, nil if there is no match.
+	FindStringSubmatch(s string) ([]string, error)
+}
+
+// RegexOptions configures the matches of regular expressions. Engines not
+// backtracking, such as RE2, ignore the limits.
+type RegexOptions struct {
+	// MatchLimit bounds the backtracking steps of a match, configured with
+	// SecPcreMatchLimit. Zero means the default of the engine.
+	MatchLimit int
+
+	// MatchLimitRecursion bounds the backtracking points a match keeps at
+	// once, configured with SecPcreMatchLimitRecursion. Zero means the
+	// default of the engine.
+	MatchLimitRecursion int
+}
+
+// RegexEngine compiles the regular expressions of operators such as @rx
+type RegexEngine = func(expr string, options RegexOptions) (Regexp, error)
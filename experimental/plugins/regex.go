@@ -0,0 +1,16 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugins
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/regex"
+)
+
+// RegisterRegexEngine registers a regular expression engine, which rules
+// select with SecRegexEngine or the regexEngine action.
+// If the engine already exists it will be overwritten
+func RegisterRegexEngine(name string, engine plugintypes.RegexEngine) {
+	regex.Register(name, engine)
+}
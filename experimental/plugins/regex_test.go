@@ -0,0 +1,43 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugins_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins"
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/regex"
+)
+
+type prefixRegexp string
+
+func (p prefixRegexp) MatchString(s string) (bool, error) {
+	return strings.HasPrefix(s, string(p)), nil
+}
+
+func (p prefixRegexp) FindStringSubmatch(s string) ([]string, error) {
+	if !strings.HasPrefix(s, string(p)) {
+		return nil, nil
+	}
+	return []string{string(p)}, nil
+}
+
+func TestRegisterRegexEngine(t *testing.T) {
+	plugins.RegisterRegexEngine("Prefix", func(expr string, _ plugintypes.RegexOptions) (plugintypes.Regexp, error) {
+		return prefixRegexp(expr), nil
+	})
+	engine, err := regex.Get("prefix")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	re, err := engine("abc", plugintypes.RegexOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok, _ := re.MatchString("abcdef"); !ok {
+		t.Error("expected a match")
+	}
+}
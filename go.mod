@@ -29,6 +29,7 @@ require (
 	github.com/valllabh/ocsf-schema-golang v1.0.3
 	golang.org/x/net v0.35.0
 	golang.org/x/sync v0.11.0
+	golang.org/x/text v0.22.0
 	rsc.io/binaryregexp v0.2.0
 )
 
@@ -8,10 +8,12 @@
 package http
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/corazawaf/coraza/v3/types"
 )
@@ -43,10 +45,7 @@ func (i *rwInterceptor) WriteHeader(statusCode int) {
 
 	i.statusCode = statusCode
 	if it := i.tx.ProcessResponseHeaders(statusCode, i.proto); it != nil {
-		i.cleanHeaders()
-		i.Header().Set("Content-Length", "0")
-		i.statusCode = obtainStatusCodeFromInterruptionOrDefault(it, i.statusCode)
-		i.flushWriteHeader()
+		i.writeInterruption(it)
 		return
 	}
 
@@ -58,14 +57,36 @@ func (i *rwInterceptor) overrideWriteHeader(statusCode int) {
 	i.statusCode = statusCode
 }
 
-// flushWriteHeader sends the status code to the delegate writers
+// flushWriteHeader applies the header mutations queued by the rules and
+// sends the status code to the delegate writers
 func (i *rwInterceptor) flushWriteHeader() {
 	if !i.isWriteHeaderFlush {
+		applyHeaderMutations(i.w.Header(), i.tx.ResponseHeaderMutations())
 		i.w.WriteHeader(i.statusCode)
 		i.isWriteHeaderFlush = true
 	}
 }
 
+// writeInterruption replaces the response with the one carried by the interruption:
+// it cleans the headers, overrides the status code and writes the interruption body.
+// Drop interruptions close the connection instead.
+func (i *rwInterceptor) writeInterruption(it *types.Interruption) {
+	if it.Action == "drop" {
+		// nothing must be sent to the client
+		i.isWriteHeaderFlush = true
+		dropConnection(i.w)
+		return
+	}
+
+	i.cleanHeaders()
+	setInterruptionHeaders(i.Header(), it)
+	i.overrideWriteHeader(obtainStatusCodeFromInterruptionOrDefault(it, i.statusCode))
+	i.flushWriteHeader()
+	if it.Body != "" {
+		_, _ = io.WriteString(i.w, it.Body)
+	}
+}
+
 // cleanHeaders removes all headers from the response
 func (i *rwInterceptor) cleanHeaders() {
 	for k := range i.w.Header() {
@@ -101,11 +122,8 @@ func (i *rwInterceptor) Write(b []byte) (int, error) {
 		it, n, err := i.tx.WriteResponseBody(b)
 		if it != nil {
 			// if there is an interruption we must clean the headers and override the status code
-			i.cleanHeaders()
-			i.Header().Set("Content-Length", "0")
-			i.overrideWriteHeader(obtainStatusCodeFromInterruptionOrDefault(it, i.statusCode))
 			// We only flush the status code after an interruption.
-			i.flushWriteHeader()
+			i.writeInterruption(it)
 			// We return the number of bytes as according to the interface io.Writer
 			// if we don't return an error, the number of bytes written is len(p).
 			// See https://pkg.go.dev/io#Writer
@@ -172,10 +190,7 @@ func wrap(w http.ResponseWriter, r *http.Request, tx types.Transaction) (
 				return err
 			} else if it != nil {
 				// if there is an interruption we must clean the headers and override the status code
-				i.cleanHeaders()
-				i.Header().Set("Content-Length", "0")
-				i.overrideWriteHeader(obtainStatusCodeFromInterruptionOrDefault(it, i.statusCode))
-				i.flushWriteHeader()
+				i.writeInterruption(it)
 				return nil
 			}
 
@@ -187,6 +202,20 @@ func wrap(w http.ResponseWriter, r *http.Request, tx types.Transaction) (
 				return fmt.Errorf("failed to release the response body reader: %v", err)
 			}
 
+			// content might have been injected by rules (e.g. append and prepend actions),
+			// hence the declared length has to be recomputed. HEAD responses have no body
+			// and keep the length declared by the handler.
+			if r.Method != http.MethodHead && i.Header().Get("Content-Length") != "" {
+				body, err := io.ReadAll(reader)
+				if err != nil {
+					i.overrideWriteHeader(http.StatusInternalServerError)
+					i.flushWriteHeader()
+					return fmt.Errorf("failed to read the response body: %v", err)
+				}
+				i.Header().Set("Content-Length", strconv.Itoa(len(body)))
+				reader = bytes.NewReader(body)
+			}
+
 			// this is the last opportunity we have to report the resolved status code
 			// as next step is write into the response writer (triggering a 200 in the
 			// response status code.)
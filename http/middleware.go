@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httputil"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -75,10 +77,6 @@ func processRequest(tx types.Transaction, req *http.Request) (*types.Interruptio
 				return nil, fmt.Errorf("failed to append request body: %s", err.Error())
 			}
 
-			if it != nil {
-				return it, nil
-			}
-
 			rbr, err := tx.RequestBodyReader()
 			if err != nil {
 				return nil, fmt.Errorf("failed to get the request body: %s", err.Error())
@@ -88,8 +86,13 @@ func processRequest(tx types.Transaction, req *http.Request) (*types.Interruptio
 			// It happens when the partial body has been processed and it did not trigger an interruption
 			bodyReader := io.MultiReader(rbr, req.Body)
 			// req.Body is transparently reinizialied with a new io.ReadCloser.
-			// The http handler will be able to read it.
+			// The http handler will be able to read it. It is done also on interruptions
+			// as the proxy action forwards the request including its body.
 			req.Body = io.NopCloser(bodyReader)
+
+			if it != nil {
+				return it, nil
+			}
 		}
 	}
 
@@ -139,10 +142,17 @@ func WrapHandler(waf coraza.WAF, h http.Handler) http.Handler {
 			tx.DebugLogger().Error().Err(err).Msg("Failed to process request")
 			return
 		} else if it != nil {
-			w.WriteHeader(obtainStatusCodeFromInterruptionOrDefault(it, http.StatusOK))
+			if it.Action == "proxy" {
+				proxyRequest(w, r, tx, it)
+				return
+			}
+			applyHeaderMutations(w.Header(), tx.ResponseHeaderMutations())
+			writeInterruption(w, it, http.StatusOK)
 			return
 		}
 
+		applyRequestHeaderMutations(r, tx.RequestHeaderMutations())
+
 		ww, processResponse := wrap(w, r, tx)
 
 		// We continue with the other middlewares by catching the response
@@ -157,15 +167,121 @@ func WrapHandler(waf coraza.WAF, h http.Handler) http.Handler {
 	return http.HandlerFunc(fn)
 }
 
+// applyHeaderMutations applies in order the header changes queued by the rules.
+func applyHeaderMutations(h http.Header, mutations []types.HeaderMutation) {
+	for _, m := range mutations {
+		switch m.Type {
+		case types.HeaderMutationSet:
+			h.Set(m.Name, m.Value)
+		case types.HeaderMutationRemove:
+			h.Del(m.Name)
+		}
+	}
+}
+
+// applyRequestHeaderMutations applies the request header changes to the request
+// passed to the next handler. As Go promotes the Host header to the Request.Host
+// field, changes to it are applied there.
+func applyRequestHeaderMutations(r *http.Request, mutations []types.HeaderMutation) {
+	for _, m := range mutations {
+		if !strings.EqualFold(m.Name, "host") {
+			applyHeaderMutations(r.Header, []types.HeaderMutation{m})
+			continue
+		}
+
+		switch m.Type {
+		case types.HeaderMutationSet:
+			r.Host = m.Value
+		case types.HeaderMutationRemove:
+			r.Host = ""
+		}
+	}
+}
+
+// proxyRequest forwards the request, including the body already buffered by the
+// transaction, to the target of a proxy interruption and copies back the response.
+func proxyRequest(w http.ResponseWriter, r *http.Request, tx types.Transaction, it *types.Interruption) {
+	target, err := url.Parse(it.Data)
+	if err != nil {
+		tx.DebugLogger().Error().Err(err).Str("target", it.Data).Msg("Invalid proxy target")
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	applyRequestHeaderMutations(r, tx.RequestHeaderMutations())
+
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetURL(target)
+			pr.SetXForwarded()
+		},
+		ModifyResponse: func(res *http.Response) error {
+			applyHeaderMutations(res.Header, tx.ResponseHeaderMutations())
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			tx.DebugLogger().Error().Err(err).Str("target", it.Data).Msg("Failed to proxy the request")
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	rp.ServeHTTP(w, r)
+}
+
+// writeInterruption writes the status code, headers and body carried by the interruption.
+// Drop interruptions close the connection instead.
+func writeInterruption(w http.ResponseWriter, it *types.Interruption, defaultStatusCode int) {
+	if it.Action == "drop" {
+		dropConnection(w)
+		return
+	}
+
+	setInterruptionHeaders(w.Header(), it)
+	w.WriteHeader(obtainStatusCodeFromInterruptionOrDefault(it, defaultStatusCode))
+	if it.Body != "" {
+		_, _ = io.WriteString(w, it.Body)
+	}
+}
+
+// dropConnection closes the client connection without sending a response. When the
+// connection can't be hijacked (e.g. HTTP/2) the handler is aborted, which makes the
+// server reset the stream.
+//
+// Note: aborting the handler panics with http.ErrAbortHandler, middlewares recovering
+// from panics should re-panic with it.
+func dropConnection(w http.ResponseWriter) {
+	conn, _, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		panic(http.ErrAbortHandler)
+	}
+	_ = conn.Close()
+}
+
+// setInterruptionHeaders sets the headers carried by the interruption along with the
+// length of its body.
+func setInterruptionHeaders(h http.Header, it *types.Interruption) {
+	for k, v := range it.Headers {
+		h.Set(k, v)
+	}
+	h.Set("Content-Length", strconv.Itoa(len(it.Body)))
+}
+
 // obtainStatusCodeFromInterruptionOrDefault returns the desired status code derived from the interruption
-// on a "deny" action or a default value.
+// on a "deny" or "redirect" action or a default value.
 func obtainStatusCodeFromInterruptionOrDefault(it *types.Interruption, defaultStatusCode int) int {
-	if it.Action == "deny" {
+	switch it.Action {
+	case "deny":
 		statusCode := it.Status
 		if statusCode == 0 {
 			statusCode = 403
 		}
 
+		return statusCode
+	case "redirect":
+		statusCode := it.Status
+		if statusCode == 0 {
+			statusCode = 302
+		}
+
 		return statusCode
 	}
 	return defaultStatusCode
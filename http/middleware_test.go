@@ -16,6 +16,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/textproto"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -643,3 +645,300 @@ func TestHandlerAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerHeaderMutations(t *testing.T) {
+	waf, err := coraza.NewWAF(coraza.NewWAFConfig().WithDirectives(`
+SecRuleEngine On
+SecServerSignature "Coraza"
+SecAction "id:1,phase:1,pass,nolog,setRequestHeader:'X-WAF-Score=%{request_method}',removeHeader:request:X-Debug"
+SecAction "id:2,phase:1,pass,nolog,setResponseHeader:'X-Content-Type-Options=nosniff',removeHeader:X-Powered-By"
+SecRule REQUEST_URI "@streq /deny" "id:3,phase:1,deny,status:403"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error while creating the WAF: %s", err.Error())
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "GET", r.Header.Get("X-WAF-Score"); want != have {
+			t.Errorf("unexpected X-WAF-Score request header, want %q, have %q", want, have)
+		}
+		if have := r.Header.Get("X-Debug"); have != "" {
+			t.Errorf("unexpected X-Debug request header %q", have)
+		}
+		w.Header().Set("Server", "nginx")
+		w.Header().Set("X-Powered-By", "PHP")
+		_, _ = w.Write([]byte("hello"))
+	}
+
+	srv := httptest.NewServer(WrapHandler(waf, http.HandlerFunc(handler)))
+	defer srv.Close()
+
+	for _, path := range []string{"/", "/deny"} {
+		t.Run(path, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", srv.URL+path, nil)
+			req.Header.Set("X-Debug", "1")
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error while performing the request: %s", err.Error())
+			}
+			defer res.Body.Close()
+
+			if want, have := "Coraza", res.Header.Get("Server"); want != have {
+				t.Errorf("unexpected Server header, want %q, have %q", want, have)
+			}
+			if want, have := "nosniff", res.Header.Get("X-Content-Type-Options"); want != have {
+				t.Errorf("unexpected X-Content-Type-Options header, want %q, have %q", want, have)
+			}
+			if have := res.Header.Get("X-Powered-By"); have != "" {
+				t.Errorf("unexpected X-Powered-By header %q", have)
+			}
+		})
+	}
+}
+
+func TestHandlerResponseBodyInjection(t *testing.T) {
+	waf, err := coraza.NewWAF(coraza.NewWAFConfig().WithDirectives(`
+SecRuleEngine On
+SecResponseBodyAccess On
+SecResponseBodyMimeType text/html
+SecAction "id:1,phase:3,pass,nolog,prepend:'<div>%{request_method}</div>',append:'<script></script>'"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error while creating the WAF: %s", err.Error())
+	}
+
+	testCases := map[string]struct {
+		contentType  string
+		expectedBody string
+	}{
+		"matching mime type": {
+			contentType:  "text/html",
+			expectedBody: "<div>GET</div><p>hello</p><script></script>",
+		},
+		"non matching mime type": {
+			contentType:  "application/json",
+			expectedBody: "<p>hello</p>",
+		},
+	}
+
+	for name, tCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tCase.contentType)
+				w.Header().Set("Content-Length", "12")
+				_, _ = w.Write([]byte("<p>hello</p>"))
+			}
+
+			srv := httptest.NewServer(WrapHandler(waf, http.HandlerFunc(handler)))
+			defer srv.Close()
+
+			res, err := http.Get(srv.URL)
+			if err != nil {
+				t.Fatalf("unexpected error while performing the request: %s", err.Error())
+			}
+			defer res.Body.Close()
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error while reading the body: %s", err.Error())
+			}
+
+			if want, have := tCase.expectedBody, string(body); want != have {
+				t.Errorf("unexpected response body, want %q, have %q", want, have)
+			}
+			if want, have := int64(len(tCase.expectedBody)), res.ContentLength; want != have {
+				t.Errorf("unexpected content length, want %d, have %d", want, have)
+			}
+		})
+	}
+}
+
+func TestHandlerErrorDocument(t *testing.T) {
+	tmpl := filepath.Join(t.TempDir(), "403.html")
+	if err := os.WriteFile(tmpl, []byte("<p>Blocked, reference %{unique_id}</p>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	waf, err := coraza.NewWAF(coraza.NewWAFConfig().WithDirectives(`
+SecRuleEngine On
+SecResponseBodyAccess On
+SecResponseBodyMimeType text/plain
+SecErrorDocument 403 ` + tmpl + `
+SecRule REQUEST_URI "@streq /deny" "id:1,phase:1,deny,status:403"
+SecRule REQUEST_URI "@streq /redirect" "id:2,phase:1,redirect:https://example.com/blocked"
+SecRule RESPONSE_BODY "@contains secret" "id:3,phase:4,deny,status:403"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error while creating the WAF: %s", err.Error())
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("the secret"))
+	}
+
+	srv := httptest.NewServer(WrapHandler(waf, http.HandlerFunc(handler)))
+	defer srv.Close()
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	testCases := map[string]struct {
+		expectedStatus   int
+		expectedHeaders  map[string]string
+		expectedBodyPart string
+	}{
+		"/deny": {
+			expectedStatus:   403,
+			expectedHeaders:  map[string]string{"Content-Type": "text/html; charset=utf-8"},
+			expectedBodyPart: "<p>Blocked, reference ",
+		},
+		"/redirect": {
+			expectedStatus:  302,
+			expectedHeaders: map[string]string{"Location": "https://example.com/blocked"},
+		},
+		"/response": {
+			expectedStatus:   403,
+			expectedHeaders:  map[string]string{"Content-Type": "text/html; charset=utf-8"},
+			expectedBodyPart: "<p>Blocked, reference ",
+		},
+	}
+
+	for path, tCase := range testCases {
+		t.Run(path, func(t *testing.T) {
+			res, err := client.Get(srv.URL + path)
+			if err != nil {
+				t.Fatalf("unexpected error while performing the request: %s", err.Error())
+			}
+			defer res.Body.Close()
+
+			if want, have := tCase.expectedStatus, res.StatusCode; want != have {
+				t.Errorf("unexpected status code, want %d, have %d", want, have)
+			}
+			for k, want := range tCase.expectedHeaders {
+				if have := res.Header.Get(k); want != have {
+					t.Errorf("unexpected %s header, want %q, have %q", k, want, have)
+				}
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error while reading the body: %s", err.Error())
+			}
+			if !strings.Contains(string(body), tCase.expectedBodyPart) {
+				t.Errorf("unexpected body %q", string(body))
+			}
+			if strings.Contains(string(body), "secret") {
+				t.Errorf("response body leaked %q", string(body))
+			}
+		})
+	}
+}
+
+func TestHandlerDrop(t *testing.T) {
+	waf, err := coraza.NewWAF(coraza.NewWAFConfig().WithDirectives(`
+SecRuleEngine On
+SecRule REQUEST_URI "@streq /request" "id:1,phase:1,drop"
+SecRule RESPONSE_STATUS "@streq 201" "id:2,phase:3,drop"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error while creating the WAF: %s", err.Error())
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte("hello"))
+	}
+
+	for _, http2 := range []bool{false, true} {
+		for _, path := range []string{"/request", "/response"} {
+			t.Run(fmt.Sprintf("%s http2=%t", path, http2), func(t *testing.T) {
+				srv := httptest.NewUnstartedServer(WrapHandler(waf, http.HandlerFunc(handler)))
+				if http2 {
+					srv.EnableHTTP2 = true
+					srv.StartTLS()
+				} else {
+					srv.Start()
+				}
+				defer srv.Close()
+
+				res, err := srv.Client().Get(srv.URL + path)
+				if err == nil {
+					res.Body.Close()
+					t.Fatalf("expected the connection to be dropped, got status %d", res.StatusCode)
+				}
+			})
+		}
+	}
+}
+
+func TestHandlerProxy(t *testing.T) {
+	honeypot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Honeypot", "true")
+		fmt.Fprintf(w, "%s %s %s", r.Method, r.URL.RequestURI(), string(b))
+	}))
+	defer honeypot.Close()
+
+	waf, err := coraza.NewWAF(coraza.NewWAFConfig().WithDirectives(`
+SecRuleEngine On
+SecRequestBodyAccess On
+SecRequestBodyLimit 8
+SecRequestBodyLimitAction ProcessPartial
+SecRule REQUEST_URI "@beginsWith /admin" "id:1,phase:1,proxy:` + honeypot.URL + `"
+SecRule REQUEST_BODY "@contains drop" "id:2,phase:2,proxy:` + honeypot.URL + `"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error while creating the WAF: %s", err.Error())
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("application"))
+	}
+
+	srv := httptest.NewServer(WrapHandler(waf, http.HandlerFunc(handler)))
+	defer srv.Close()
+
+	testCases := map[string]struct {
+		path         string
+		body         string
+		expectedBody string
+	}{
+		"not proxied": {
+			path:         "/",
+			body:         "q=hello",
+			expectedBody: "application",
+		},
+		"proxied on request headers": {
+			path:         "/admin?id=1",
+			body:         "q=hello",
+			expectedBody: "POST /admin?id=1 q=hello",
+		},
+		"proxied on request body": {
+			path:         "/",
+			body:         "q=drop table users",
+			expectedBody: "POST / q=drop table users",
+		},
+	}
+
+	for name, tCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res, err := http.Post(srv.URL+tCase.path, "application/x-www-form-urlencoded", strings.NewReader(tCase.body))
+			if err != nil {
+				t.Fatalf("unexpected error while performing the request: %s", err.Error())
+			}
+			defer res.Body.Close()
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error while reading the body: %s", err.Error())
+			}
+			if want, have := tCase.expectedBody, string(body); want != have {
+				t.Errorf("unexpected body, want %q, have %q", want, have)
+			}
+		})
+	}
+}
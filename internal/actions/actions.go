@@ -34,6 +34,7 @@ func Register(name string, a func() plugintypes.Action) {
 
 func init() {
 	Register("allow", allow)
+	Register("append", appendAction)
 	Register("auditlog", auditlog)
 	Register("block", block)
 	Register("capture", capture)
@@ -41,6 +42,7 @@ func init() {
 	Register("ctl", ctl)
 	Register("deny", deny)
 	Register("drop", drop)
+	Register("errorDocument", errorDocument)
 	Register("exec", exec)
 	Register("expirevar", expirevar)
 	Register("id", id)
@@ -53,10 +55,17 @@ func init() {
 	Register("noauditlog", noauditlog)
 	Register("nolog", nolog)
 	Register("pass", pass)
+	Register("pause", pause)
 	Register("phase", phase)
+	Register("prepend", prepend)
+	Register("proxy", proxy)
 	Register("redirect", redirect)
+	Register("regexEngine", regexEngine)
+	Register("removeHeader", removeHeader)
 	Register("rev", rev)
 	Register("setenv", setenv)
+	Register("setRequestHeader", setRequestHeader)
+	Register("setResponseHeader", setResponseHeader)
 	Register("setvar", setvar)
 	Register("severity", severity)
 	Register("skip", skip)
@@ -0,0 +1,66 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"fmt"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/macro"
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+// Action Group: Non-disruptive
+//
+// Description:
+// Appends text given as parameter to the end of the response body. The text supports macro expansion.
+// Content is only injected when the response body is accessible (`SecResponseBodyAccess On`) and its
+// content type is one of `SecResponseBodyMimeType`. The connector is in charge of sending the modified
+// body, which it does when copying it from the transaction's response body reader. Content is not
+// injected into responses with a `Content-Encoding` other than identity. As the response body is only
+// available then, rules with this action must run in phase 3 or 4.
+//
+// Example:
+// ```
+// SecAction "id:175,phase:3,pass,nolog,append:'<script src=\"/monitoring.js\"></script>'"
+// ```
+type appendFn struct {
+	data macro.Macro
+}
+
+func (a *appendFn) Init(r plugintypes.RuleMetadata, data string) error {
+	if len(data) == 0 {
+		return ErrMissingArguments
+	}
+	if rule, ok := r.(*corazawaf.Rule); ok &&
+		(rule.Phase_ < types.PhaseResponseHeaders || rule.Phase_ > types.PhaseResponseBody) {
+		return fmt.Errorf("append can only be used in phases 3 and 4, not in phase %d", rule.Phase_)
+	}
+
+	m, err := macro.NewMacro(data)
+	if err != nil {
+		return err
+	}
+	a.data = m
+	return nil
+}
+
+func (a *appendFn) Evaluate(_ plugintypes.RuleMetadata, txS plugintypes.TransactionState) {
+	tx := txS.(*corazawaf.Transaction)
+	tx.AppendResponseBody(a.data.Expand(tx))
+}
+
+func (a *appendFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeNondisruptive
+}
+
+func appendAction() plugintypes.Action {
+	return &appendFn{}
+}
+
+var (
+	_ plugintypes.Action = &appendFn{}
+	_ ruleActionWrapper  = appendAction
+)
@@ -0,0 +1,88 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"io"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+func TestAppendPrependInit(t *testing.T) {
+	for _, name := range []string{"append", "prepend"} {
+		t.Run(name, func(t *testing.T) {
+			a, err := Get(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := a.Init(&md{}, ""); err != ErrMissingArguments {
+				t.Errorf("expected error ErrMissingArguments, got %v", err)
+			}
+			if err := a.Init(&md{}, "<p>%{unique_id}</p>"); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+
+			for phase, valid := range map[types.RulePhase]bool{
+				types.PhaseRequestHeaders:  false,
+				types.PhaseRequestBody:     false,
+				types.PhaseResponseHeaders: true,
+				types.PhaseResponseBody:    true,
+				types.PhaseLogging:         false,
+			} {
+				r := corazawaf.NewRule()
+				r.Phase_ = phase
+				err := a.Init(r, "<footer/>")
+				if valid && err != nil {
+					t.Errorf("unexpected error in phase %d: %s", phase, err.Error())
+				}
+				if !valid && err == nil {
+					t.Errorf("expected error in phase %d", phase)
+				}
+			}
+		})
+	}
+}
+
+func TestAppendPrependEvaluate(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	waf.ResponseBodyAccess = true
+	waf.ResponseBodyMimeTypes = []string{"text/html"}
+	tx := waf.NewTransaction()
+	defer tx.Close()
+
+	tx.ProcessURI("/", "GET", "HTTP/1.1")
+	tx.AddResponseHeader("Content-Type", "text/html")
+	tx.ProcessResponseHeaders(200, "HTTP/1.1")
+	if _, _, err := tx.WriteResponseBody([]byte("<p>body</p>")); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range map[string]string{
+		"prepend": "<header>%{request_method}</header>",
+		"append":  "<footer/>",
+	} {
+		a, err := Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := a.Init(&md{}, data); err != nil {
+			t.Fatal(err)
+		}
+		a.Evaluate(&md{}, tx)
+	}
+
+	reader, err := tx.ResponseBodyReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "<header>GET</header><p>body</p><footer/>", string(body); want != have {
+		t.Errorf("unexpected response body, want %q, have %q", want, have)
+	}
+}
@@ -56,6 +56,8 @@ const (
 // - `auditLogParts`
 // - `debugLogLevel`
 // - `forceRequestBodyVariable`
+// - `hashEngine`
+// - `hashEnforcement`
 // - `requestBodyAccess`
 // - `requestBodyLimit`
 // - `requestBodyProcessor`
@@ -68,8 +70,6 @@ const (
 // - `ruleRemoveTargetById`
 // - `ruleRemoveTargetByMsg`
 // - `ruleRemoveTargetByTag`
-// - `hashEngine` (**Not Supported in Coraza (TBI)**)
-// - `hashEnforcement` (**Not supported in Coraza (TBI)**)
 //
 // Here are some notes about the options:
 //
@@ -89,6 +89,9 @@ const (
 //  4. Option `forceRequestBodyVariable“ allows you to configure the `REQUEST_BODY` variable to be set when there is no request body processor configured.
 //     This allows for inspection of request bodies of unknown types.
 //
+//  5. Option `hashEngine` toggles the signing of the links of the response, and `hashEnforcement` the verification of
+//     signatures by the `@validateHash` operator. Both are enabled by `SecHashEngine On`.
+//
 // Example:
 // ```
 // # Parse requests with Content-Type "text/xml" as XML
@@ -353,9 +356,25 @@ func (a *ctlFn) Evaluate(_ plugintypes.RuleMetadata, txS plugintypes.Transaction
 			return
 		}
 	case ctlHashEngine:
-		// Not supported yet
+		val, ok := parseOnOff(a.value)
+		if !ok {
+			tx.DebugLogger().Error().
+				Str("ctl", "HashEngine").
+				Str("value", a.value).
+				Msg("Unknown toggle")
+			return
+		}
+		tx.HashEngine = val
 	case ctlHashEnforcement:
-		// Not supported yet
+		val, ok := parseOnOff(a.value)
+		if !ok {
+			tx.DebugLogger().Error().
+				Str("ctl", "HashEnforcement").
+				Str("value", a.value).
+				Msg("Unknown toggle")
+			return
+		}
+		tx.HashEnforcement = val
 	case ctlDebugLogLevel:
 		lvl, err := strconv.ParseInt(a.value, 10, 8)
 		if err != nil {
@@ -70,6 +70,33 @@ func TestCtl(t *testing.T) {
 				}
 			},
 		},
+		"hashEngine incorrect": {
+			input: "hashEngine=X",
+			checkTX: func(t *testing.T, tx *corazawaf.Transaction, logEntry string) {
+				if wantToContain, have := "[ERROR] Unknown toggle", logEntry; !strings.Contains(have, wantToContain) {
+					t.Errorf("Failed to log entry, want to contain %q, have %q", wantToContain, have)
+				}
+			},
+		},
+		"hashEngine successfully": {
+			input: "hashEngine=On",
+			checkTX: func(t *testing.T, tx *corazawaf.Transaction, logEntry string) {
+				if want, have := true, tx.HashEngine; want != have {
+					t.Errorf("Failed to set hashEngine, want %t, have %t", want, have)
+				}
+			},
+		},
+		"hashEnforcement successfully": {
+			prepareTX: func(tx *corazawaf.Transaction) {
+				tx.HashEnforcement = true
+			},
+			input: "hashEnforcement=Off",
+			checkTX: func(t *testing.T, tx *corazawaf.Transaction, logEntry string) {
+				if want, have := false, tx.HashEnforcement; want != have {
+					t.Errorf("Failed to set hashEnforcement, want %t, have %t", want, have)
+				}
+			},
+		},
 		"requestBodyAccess incorrect": {
 			input: "requestBodyAccess=X",
 			checkTX: func(t *testing.T, tx *corazawaf.Transaction, logEntry string) {
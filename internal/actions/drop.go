@@ -18,6 +18,9 @@ import (
 // which you may want to minimize the network bandwidth and the data returned to the client.
 // This action causes error message to appear in the log `(9)Bad file descriptor: core_output_filter: writing data to the network`
 //
+// The net/http middleware closes the connection without sending any response. For HTTP/2, where the
+// connection is shared, the stream is reset instead.
+//
 // Example:
 // ```
 // # The following example initiates an IP collection for tracking Basic Authentication attempts.
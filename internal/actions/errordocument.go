@@ -0,0 +1,47 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+// Action Group: Data
+//
+// Description:
+// Selects the error document, defined with `SecErrorDocument`, rendered into the interruptions
+// raised by the rule with actions deny and redirect. If no error document has been defined with
+// that name, the one configured for the interruption status is used.
+//
+// Example:
+// ```
+// SecErrorDocument ratelimited /etc/coraza/429.html
+// SecRule IP:REQUESTS "@gt 100" "id:181,phase:1,deny,status:429,errorDocument:ratelimited"
+// ```
+type errorDocumentFn struct{}
+
+func (a *errorDocumentFn) Init(r plugintypes.RuleMetadata, data string) error {
+	if len(data) == 0 {
+		return ErrMissingArguments
+	}
+
+	r.(*corazawaf.Rule).ErrorDocument = data
+	return nil
+}
+
+func (a *errorDocumentFn) Evaluate(_ plugintypes.RuleMetadata, _ plugintypes.TransactionState) {}
+
+func (a *errorDocumentFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeData
+}
+
+func errorDocument() plugintypes.Action {
+	return &errorDocumentFn{}
+}
+
+var (
+	_ plugintypes.Action = &errorDocumentFn{}
+	_ ruleActionWrapper  = errorDocument
+)
@@ -0,0 +1,70 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+// Action Group: Disruptive
+//
+// Description:
+// Pauses transaction processing for the specified number of milliseconds.
+// It can be used to slow down clients flagged as abusive (tarpitting) without blocking them.
+//
+// The pause ends earlier if the transaction context is done, e.g. when the client disconnects or
+// the request deadline is reached. To prevent it from becoming a denial of service vector, the
+// number of transactions paused at the same time is capped by `SecPauseConcurrencyLimit`, once
+// the cap is reached the pause is skipped.
+//
+// Example:
+// ```
+// SecRule IP:BF_COUNTER "@gt 5" "phase:2,id:160,pause:5000,log,msg:'Slowing down brute force client'"
+// ```
+type pauseFn struct {
+	duration time.Duration
+}
+
+func (a *pauseFn) Init(_ plugintypes.RuleMetadata, data string) error {
+	if len(data) == 0 {
+		return ErrMissingArguments
+	}
+
+	ms, err := strconv.Atoi(data)
+	if err != nil {
+		return fmt.Errorf("invalid argument: %s", err.Error())
+	}
+	if ms <= 0 {
+		return fmt.Errorf("invalid pause duration %d, it should be bigger than 0", ms)
+	}
+	a.duration = time.Duration(ms) * time.Millisecond
+	return nil
+}
+
+func (a *pauseFn) Evaluate(r plugintypes.RuleMetadata, txS plugintypes.TransactionState) {
+	tx := txS.(*corazawaf.Transaction)
+	tx.DebugLogger().Debug().
+		Int("rule_id", r.ID()).
+		Str("duration", a.duration.String()).
+		Msg("Pausing transaction")
+	tx.Pause(a.duration)
+}
+
+func (a *pauseFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeDisruptive
+}
+
+func pause() plugintypes.Action {
+	return &pauseFn{}
+}
+
+var (
+	_ plugintypes.Action = &pauseFn{}
+	_ ruleActionWrapper  = pause
+)
@@ -0,0 +1,59 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+func TestPauseInit(t *testing.T) {
+	t.Run("no arguments", func(t *testing.T) {
+		a := pause()
+		if err := a.Init(nil, ""); err == nil || err != ErrMissingArguments {
+			t.Error("expected error ErrMissingArguments")
+		}
+	})
+
+	for _, data := range []string{"abc", "0", "-10"} {
+		t.Run("invalid argument "+data, func(t *testing.T) {
+			a := pause()
+			if err := a.Init(nil, data); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	t.Run("valid argument", func(t *testing.T) {
+		a := pause()
+		if err := a.Init(nil, "1500"); err != nil {
+			t.Error(err)
+		}
+		if want, have := 1500*time.Millisecond, a.(*pauseFn).duration; want != have {
+			t.Errorf("unexpected duration, want %s, have %s", want, have)
+		}
+	})
+}
+
+func TestPauseEvaluate(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+
+	a := pause()
+	if err := a.Init(nil, "20"); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	a.Evaluate(&md{}, tx)
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("expected the transaction to be paused, elapsed %s", elapsed)
+	}
+	if tx.IsInterrupted() {
+		t.Error("unexpected interruption")
+	}
+}
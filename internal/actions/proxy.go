@@ -0,0 +1,79 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+// Action Group: Disruptive
+//
+// Description:
+// Intercepts the current transaction by forwarding the request to another web server.
+// The target must be an absolute http or https URL, the path and query of the original
+// request are appended to it.
+// > This action depends on each implementation, the server is instructed to forward the request.
+//
+// It can be used to divert suspicious requests to an isolated honeypot backend instead of
+// blocking them. The net/http middleware forwards the request, including the body already
+// buffered by Coraza, and sends the honeypot response to the client. As the request has already
+// been served afterwards, rules with this action must run in phase 1 or 2.
+//
+// Example:
+// ```
+// SecRule REQUEST_HEADERS:User-Agent "@pm sqlmap nikto" "id:108,phase:1,log,proxy:http://honeypot.internal:8080"
+// ```
+type proxyFn struct {
+	target string
+}
+
+func (a *proxyFn) Init(r plugintypes.RuleMetadata, data string) error {
+	if len(data) == 0 {
+		return ErrMissingArguments
+	}
+	if rule, ok := r.(*corazawaf.Rule); ok && rule.Phase_ > types.PhaseRequestBody {
+		return fmt.Errorf("proxy can only be used in phases 1 and 2, not in phase %d", rule.Phase_)
+	}
+
+	u, err := url.Parse(data)
+	if err != nil {
+		return fmt.Errorf("invalid proxy target: %s", err.Error())
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid proxy target %q, expected an absolute http or https URL", data)
+	}
+
+	a.target = data
+	return nil
+}
+
+func (a *proxyFn) Evaluate(r plugintypes.RuleMetadata, tx plugintypes.TransactionState) {
+	rid := r.ID()
+	if rid == noID {
+		rid = r.ParentID()
+	}
+	tx.Interrupt(&types.Interruption{
+		RuleID: rid,
+		Action: "proxy",
+		Data:   a.target,
+	})
+}
+
+func (a *proxyFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeDisruptive
+}
+
+func proxy() plugintypes.Action {
+	return &proxyFn{}
+}
+
+var (
+	_ plugintypes.Action = &proxyFn{}
+	_ ruleActionWrapper  = proxy
+)
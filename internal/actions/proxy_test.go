@@ -0,0 +1,73 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+func TestProxyInit(t *testing.T) {
+	t.Run("no arguments", func(t *testing.T) {
+		a := proxy()
+		if err := a.Init(nil, ""); err == nil || err != ErrMissingArguments {
+			t.Error("expected error ErrMissingArguments")
+		}
+	})
+
+	for _, target := range []string{"honeypot:8080", "/honeypot", "ftp://honeypot", "http://"} {
+		t.Run("invalid target "+target, func(t *testing.T) {
+			a := proxy()
+			if err := a.Init(nil, target); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	for _, phase := range []types.RulePhase{types.PhaseRequestHeaders, types.PhaseRequestBody} {
+		t.Run("phase "+strconv.Itoa(int(phase)), func(t *testing.T) {
+			r := corazawaf.NewRule()
+			r.Phase_ = phase
+			if err := proxy().Init(r, "http://honeypot:8080"); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+
+	for _, phase := range []types.RulePhase{types.PhaseResponseHeaders, types.PhaseResponseBody, types.PhaseLogging} {
+		t.Run("response phase "+strconv.Itoa(int(phase)), func(t *testing.T) {
+			r := corazawaf.NewRule()
+			r.Phase_ = phase
+			if err := proxy().Init(r, "http://honeypot:8080"); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestProxyEvaluate(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+
+	a := proxy()
+	if err := a.Init(nil, "http://honeypot:8080"); err != nil {
+		t.Fatal(err)
+	}
+	a.Evaluate(&md{}, tx)
+
+	it := tx.Interruption()
+	if it == nil {
+		t.Fatal("expected interruption")
+	}
+	if want, have := "proxy", it.Action; want != have {
+		t.Errorf("unexpected action, want %q, have %q", want, have)
+	}
+	if want, have := "http://honeypot:8080", it.Data; want != have {
+		t.Errorf("unexpected data, want %q, have %q", want, have)
+	}
+}
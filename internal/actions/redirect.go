@@ -48,6 +48,9 @@ func (a *redirectFn) Evaluate(r plugintypes.RuleMetadata, tx plugintypes.Transac
 		RuleID: rid,
 		Action: "redirect",
 		Data:   a.target,
+		Headers: map[string]string{
+			"Location": a.target,
+		},
 	})
 }
 
@@ -0,0 +1,47 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/regex"
+)
+
+// Action Group: Metadata
+//
+// Description:
+// Selects the engine compiling the regular expression of the `@rx` operator of the rule,
+// overriding the one configured with `SecRegexEngine`.
+// `re2` matches in linear time, while `backtrack` supports the PCRE syntax RE2 rejects,
+// such as lookarounds and backreferences, bounding matches with `SecPcreMatchLimit` and `SecPcreMatchLimitRecursion`.
+//
+// Example:
+// ```
+// SecRule ARGS "@rx (?<![\w.])alert\s*\(" "id:191,phase:2,deny,regexEngine:backtrack"
+// ```
+type regexEngineFn struct{}
+
+func (a *regexEngineFn) Init(_ plugintypes.RuleMetadata, data string) error {
+	if len(data) == 0 {
+		return ErrMissingArguments
+	}
+	// the engine is applied when parsing the operator, preceding the actions
+	_, err := regex.Get(data)
+	return err
+}
+
+func (a *regexEngineFn) Evaluate(_ plugintypes.RuleMetadata, _ plugintypes.TransactionState) {}
+
+func (a *regexEngineFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeMetadata
+}
+
+func regexEngine() plugintypes.Action {
+	return &regexEngineFn{}
+}
+
+var (
+	_ plugintypes.Action = &regexEngineFn{}
+	_ ruleActionWrapper  = regexEngine
+)
@@ -0,0 +1,19 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import "testing"
+
+func TestRegexEngineInit(t *testing.T) {
+	a := regexEngine()
+	if err := a.Init(nil, ""); err != ErrMissingArguments {
+		t.Errorf("expected error ErrMissingArguments, got %v", err)
+	}
+	if err := a.Init(nil, "backtrack"); err != nil {
+		t.Error(err)
+	}
+	if err := a.Init(nil, "unknown"); err == nil {
+		t.Error("expected an error for an unknown engine")
+	}
+}
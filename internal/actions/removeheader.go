@@ -0,0 +1,78 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+// Action Group: Non-disruptive
+//
+// Description:
+// Queues the removal of a header. By default the header is removed from the response sent
+// to the client; prefix the name with `request:` to remove it from the request forwarded
+// upstream instead. Coraza variables are not modified, the header is removed by the connector.
+//
+// Example:
+// ```
+// SecAction "id:173,phase:1,pass,nolog,removeHeader:Server,removeHeader:X-Powered-By"
+// SecAction "id:174,phase:1,pass,nolog,removeHeader:request:X-Debug"
+// ```
+type removeHeaderFn struct {
+	name    string
+	request bool
+}
+
+func (a *removeHeaderFn) Init(_ plugintypes.RuleMetadata, data string) error {
+	if len(data) == 0 {
+		return ErrMissingArguments
+	}
+
+	name := data
+	if target, rest, ok := strings.Cut(data, ":"); ok {
+		switch strings.ToLower(target) {
+		case "request":
+			a.request = true
+		case "response":
+		default:
+			return errors.New("invalid header target, expected request or response")
+		}
+		name = rest
+	}
+
+	name = strings.TrimSpace(name)
+	if !isValidHeaderName(name) {
+		return errors.New("invalid header name")
+	}
+	a.name = name
+	return nil
+}
+
+func (a *removeHeaderFn) Evaluate(_ plugintypes.RuleMetadata, txS plugintypes.TransactionState) {
+	tx := txS.(*corazawaf.Transaction)
+	m := types.HeaderMutation{Type: types.HeaderMutationRemove, Name: a.name}
+	if a.request {
+		tx.AddRequestHeaderMutation(m)
+	} else {
+		tx.AddResponseHeaderMutation(m)
+	}
+}
+
+func (a *removeHeaderFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeNondisruptive
+}
+
+func removeHeader() plugintypes.Action {
+	return &removeHeaderFn{}
+}
+
+var (
+	_ plugintypes.Action = &removeHeaderFn{}
+	_ ruleActionWrapper  = removeHeader
+)
@@ -0,0 +1,101 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/macro"
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+// Action Group: Non-disruptive
+//
+// Description:
+// Queues a header to be set on the request forwarded upstream, replacing any existing value.
+// The value supports macro expansion. Coraza does not modify `REQUEST_HEADERS`, the header
+// is set by the connector once the request phases have been processed.
+//
+// Example:
+// ```
+// SecRule TX:ANOMALY_SCORE "@gt 0" "id:170,phase:2,pass,nolog,setRequestHeader:'X-WAF-Score=%{tx.anomaly_score}'"
+// ```
+type setRequestHeaderFn struct {
+	name  string
+	value macro.Macro
+}
+
+func (a *setRequestHeaderFn) Init(_ plugintypes.RuleMetadata, data string) error {
+	name, value, err := parseHeaderArguments(data)
+	if err != nil {
+		return err
+	}
+	a.name = name
+	a.value = value
+	return nil
+}
+
+func (a *setRequestHeaderFn) Evaluate(_ plugintypes.RuleMetadata, txS plugintypes.TransactionState) {
+	tx := txS.(*corazawaf.Transaction)
+	tx.AddRequestHeaderMutation(types.HeaderMutation{
+		Type:  types.HeaderMutationSet,
+		Name:  a.name,
+		Value: a.value.Expand(tx),
+	})
+}
+
+func (a *setRequestHeaderFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeNondisruptive
+}
+
+func setRequestHeader() plugintypes.Action {
+	return &setRequestHeaderFn{}
+}
+
+// parseHeaderArguments parses the {name}={value} arguments shared by the
+// header actions.
+func parseHeaderArguments(data string) (string, macro.Macro, error) {
+	if len(data) == 0 {
+		return "", nil, ErrMissingArguments
+	}
+
+	name, value, ok := strings.Cut(data, "=")
+	if !ok {
+		return "", nil, ErrInvalidKVArguments
+	}
+
+	name = strings.TrimSpace(name)
+	if !isValidHeaderName(name) {
+		return "", nil, errors.New("invalid header name")
+	}
+
+	m, err := macro.NewMacro(strings.TrimSpace(value))
+	if err != nil {
+		return "", nil, err
+	}
+	return name, m, nil
+}
+
+// isValidHeaderName reports whether name is a valid HTTP header field name
+// as defined by the token rule in RFC 7230.
+func isValidHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c <= ' ' || c >= 0x7f || strings.IndexByte("\"(),/:;<=>?@[\\]{}", c) != -1 {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	_ plugintypes.Action = &setRequestHeaderFn{}
+	_ ruleActionWrapper  = setRequestHeader
+)
@@ -0,0 +1,60 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/macro"
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+// Action Group: Non-disruptive
+//
+// Description:
+// Queues a header to be set on the response sent to the client, replacing any existing value.
+// The value supports macro expansion. The header is set by the connector right before the
+// response headers are sent, so it can be used from any phase, including blocked transactions.
+//
+// Example:
+// ```
+// SecAction "id:171,phase:1,pass,nolog,setResponseHeader:'Strict-Transport-Security=max-age=31536000; includeSubDomains'"
+// SecAction "id:172,phase:1,pass,nolog,setResponseHeader:'X-Content-Type-Options=nosniff'"
+// ```
+type setResponseHeaderFn struct {
+	name  string
+	value macro.Macro
+}
+
+func (a *setResponseHeaderFn) Init(_ plugintypes.RuleMetadata, data string) error {
+	name, value, err := parseHeaderArguments(data)
+	if err != nil {
+		return err
+	}
+	a.name = name
+	a.value = value
+	return nil
+}
+
+func (a *setResponseHeaderFn) Evaluate(_ plugintypes.RuleMetadata, txS plugintypes.TransactionState) {
+	tx := txS.(*corazawaf.Transaction)
+	tx.AddResponseHeaderMutation(types.HeaderMutation{
+		Type:  types.HeaderMutationSet,
+		Name:  a.name,
+		Value: a.value.Expand(tx),
+	})
+}
+
+func (a *setResponseHeaderFn) Type() plugintypes.ActionType {
+	return plugintypes.ActionTypeNondisruptive
+}
+
+func setResponseHeader() plugintypes.Action {
+	return &setResponseHeaderFn{}
+}
+
+var (
+	_ plugintypes.Action = &setResponseHeaderFn{}
+	_ ruleActionWrapper  = setResponseHeader
+)
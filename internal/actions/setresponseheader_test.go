@@ -0,0 +1,91 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+func TestHeaderActionsInit(t *testing.T) {
+	tests := []struct {
+		action  string
+		data    string
+		wantErr bool
+	}{
+		{"setRequestHeader", "", true},
+		{"setRequestHeader", "X-Score", true},
+		{"setRequestHeader", "X Score=1", true},
+		{"setRequestHeader", "X-Score=", true},
+		{"setRequestHeader", "X-Score=%{tx.score}", false},
+		{"setResponseHeader", "X-Frame-Options=DENY", false},
+		{"setResponseHeader", ":path=/", true},
+		{"removeHeader", "", true},
+		{"removeHeader", "Server", false},
+		{"removeHeader", "request:X-Debug", false},
+		{"removeHeader", "response:X-Powered-By", false},
+		{"removeHeader", "upstream:X-Debug", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action+":"+tt.data, func(t *testing.T) {
+			a, err := Get(tt.action)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = a.Init(&md{}, tt.data)
+			if tt.wantErr && err == nil {
+				t.Error("expected error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestHeaderActionsEvaluate(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+
+	tx.ProcessURI("/", "POST", "HTTP/1.1")
+
+	for _, a := range []struct {
+		name string
+		data string
+	}{
+		{"setRequestHeader", "X-Method=%{request_method}"},
+		{"removeHeader", "request:X-Debug"},
+		{"setResponseHeader", "X-Frame-Options=DENY"},
+		{"removeHeader", "Server"},
+	} {
+		action, err := Get(a.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := action.Init(&md{}, a.data); err != nil {
+			t.Fatal(err)
+		}
+		action.Evaluate(&md{}, tx)
+	}
+
+	wantRequest := []types.HeaderMutation{
+		{Type: types.HeaderMutationSet, Name: "X-Method", Value: "POST"},
+		{Type: types.HeaderMutationRemove, Name: "X-Debug"},
+	}
+	wantResponse := []types.HeaderMutation{
+		{Type: types.HeaderMutationSet, Name: "X-Frame-Options", Value: "DENY"},
+		{Type: types.HeaderMutationRemove, Name: "Server"},
+	}
+
+	if want, have := wantRequest, tx.RequestHeaderMutations(); len(want) != len(have) || want[0] != have[0] || want[1] != have[1] {
+		t.Errorf("unexpected request header mutations, want %v, have %v", want, have)
+	}
+	if want, have := wantResponse, tx.ResponseHeaderMutations(); len(want) != len(have) || want[0] != have[0] || want[1] != have[1] {
+		t.Errorf("unexpected response header mutations, want %v, have %v", want, have)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/internal/collections"
+	"github.com/corazawaf/coraza/v3/internal/cookies"
+	"github.com/corazawaf/coraza/v3/types/variables"
+)
+
+// RedactionPolicy describes which values must be masked before an audit
+// log is handed to a formatter. The zero value redacts nothing.
+//
+// Targets use the same VARIABLE:key notation as rules, where key is either
+// a case-insensitive name or a /regex/ matched against the key, e.g.
+// REQUEST_HEADERS:Authorization or ARGS:/^pass/.
+//
+// Besides masking the targeted value itself, targeted cookies and arguments
+// are masked where they were sent: the cookie header, the query string and
+// urlencoded request bodies. Redacted values of at least
+// minScrubbedSecretLength bytes are also scrubbed from the places they may
+// have leaked to: the request URI, the request and response headers and
+// bodies and the rule messages. Shorter values are not, as masking every
+// occurrence of them would mangle the whole entry.
+type RedactionPolicy struct {
+	targets []redactionTarget
+}
+
+// minScrubbedSecretLength is the length below which redacted values are only
+// masked where they were targeted.
+const minScrubbedSecretLength = 4
+
+type redactionTarget struct {
+	variable variables.RuleVariable
+	key      string
+	keyRx    *regexp.Regexp
+}
+
+func (t redactionTarget) matches(key string) bool {
+	if t.keyRx != nil {
+		return t.keyRx.MatchString(key)
+	}
+	return strings.EqualFold(t.key, key)
+}
+
+// Add parses a VARIABLE:key target and adds it to the policy.
+// Supported variables are REQUEST_HEADERS, RESPONSE_HEADERS,
+// REQUEST_COOKIES and ARGS.
+func (p *RedactionPolicy) Add(target string) error {
+	name, key, ok := strings.Cut(target, ":")
+	if !ok || key == "" {
+		return fmt.Errorf("invalid redaction target %q, expected VARIABLE:key", target)
+	}
+
+	v, err := variables.Parse(name)
+	if err != nil {
+		return fmt.Errorf("invalid redaction target %q: %s", target, err.Error())
+	}
+	switch v {
+	case variables.RequestHeaders, variables.ResponseHeaders, variables.RequestCookies, variables.Args:
+	default:
+		return fmt.Errorf("invalid redaction target %q, variable %s is not supported", target, v.Name())
+	}
+
+	t := redactionTarget{variable: v}
+	if len(key) > 2 && key[0] == '/' && key[len(key)-1] == '/' {
+		rx, err := regexp.Compile(key[1 : len(key)-1])
+		if err != nil {
+			return fmt.Errorf("invalid redaction target %q: %s", target, err.Error())
+		}
+		t.keyRx = rx
+	} else {
+		t.key = key
+	}
+	p.targets = append(p.targets, t)
+	return nil
+}
+
+// IsEmpty returns true if the policy has no targets.
+func (p *RedactionPolicy) IsEmpty() bool {
+	return len(p.targets) == 0
+}
+
+// Redact masks in place every value of l selected by the policy.
+// Header maps and the arguments collection are replaced by redacted
+// copies, so l must own its header maps.
+func (p *RedactionPolicy) Redact(l *Log) {
+	if len(p.targets) == 0 || l == nil {
+		return
+	}
+
+	var (
+		secrets       []string
+		cookieTargets []redactionTarget
+		argTargets    []redactionTarget
+	)
+	req := l.Transaction_.Request_
+	res := l.Transaction_.Response_
+
+	for _, t := range p.targets {
+		switch t.variable {
+		case variables.RequestHeaders:
+			if req != nil {
+				secrets = redactHeaders(req.Headers_, t, secrets)
+			}
+		case variables.ResponseHeaders:
+			if res != nil {
+				secrets = redactHeaders(res.Headers_, t, secrets)
+			}
+		case variables.RequestCookies:
+			if req == nil {
+				continue
+			}
+			cookieTargets = append(cookieTargets, t)
+			for k, vs := range req.Headers_ {
+				if !strings.EqualFold(k, "cookie") {
+					continue
+				}
+				for _, v := range vs {
+					for name, values := range cookies.ParseCookies(v) {
+						if t.matches(name) {
+							secrets = append(secrets, values...)
+						}
+					}
+				}
+			}
+		case variables.Args:
+			if req == nil {
+				continue
+			}
+			argTargets = append(argTargets, t)
+			if req.Args_ != nil {
+				secrets = redactArgs(req, t, secrets)
+			}
+		}
+	}
+
+	if req != nil {
+		redactRequest(req, cookieTargets, argTargets)
+	}
+
+	scrubber := newScrubber(secrets)
+	if scrubber == nil {
+		return
+	}
+
+	if req != nil {
+		req.URI_ = scrubber.Replace(req.URI_)
+		req.Body_ = scrubber.Replace(req.Body_)
+		scrubHeaders(req.Headers_, scrubber)
+	}
+	if res != nil {
+		res.Body_ = scrubber.Replace(res.Body_)
+		scrubHeaders(res.Headers_, scrubber)
+	}
+	for i, m := range l.Messages_ {
+		msg, ok := m.(Message)
+		if !ok {
+			continue
+		}
+		msg.Message_ = scrubber.Replace(msg.Message_)
+		if msg.Data_ != nil {
+			md := *msg.Data_
+			md.Msg_ = scrubber.Replace(md.Msg_)
+			md.Data_ = scrubber.Replace(md.Data_)
+			msg.Data_ = &md
+		}
+		l.Messages_[i] = msg
+	}
+}
+
+func redactHeaders(headers map[string][]string, t redactionTarget, secrets []string) []string {
+	for k, vs := range headers {
+		if !t.matches(k) {
+			continue
+		}
+		for i, v := range vs {
+			secrets = append(secrets, v)
+			vs[i] = mask(v)
+		}
+	}
+	return secrets
+}
+
+func redactArgs(req *TransactionRequest, t redactionTarget, secrets []string) []string {
+	redacted := collections.NewMap(variables.Args)
+	found := false
+	for _, md := range req.Args_.FindAll() {
+		v := md.Value()
+		if t.matches(md.Key()) {
+			found = true
+			secrets = append(secrets, v)
+			v = mask(v)
+		}
+		redacted.Add(md.Key(), v)
+	}
+	if found {
+		req.Args_ = collections.NewConcatKeyed(variables.Args, redacted)
+	}
+	return secrets
+}
+
+// redactRequest masks the targeted cookies in the cookie headers and the
+// targeted arguments in the query string and in urlencoded bodies.
+func redactRequest(req *TransactionRequest, cookieTargets, argTargets []redactionTarget) {
+	if len(cookieTargets) > 0 {
+		for k, vs := range req.Headers_ {
+			if !strings.EqualFold(k, "cookie") {
+				continue
+			}
+			for i, v := range vs {
+				vs[i] = redactPairs(v, ";", cookieTargets, strings.TrimSpace)
+			}
+		}
+	}
+	if len(argTargets) == 0 {
+		return
+	}
+	if path, query, ok := strings.Cut(req.URI_, "?"); ok {
+		req.URI_ = path + "?" + redactPairs(query, "&", argTargets, unescapeKey)
+	}
+	for k, vs := range req.Headers_ {
+		if !strings.EqualFold(k, "content-type") {
+			continue
+		}
+		for _, v := range vs {
+			if strings.HasPrefix(strings.ToLower(v), "application/x-www-form-urlencoded") {
+				req.Body_ = redactPairs(req.Body_, "&", argTargets, unescapeKey)
+				return
+			}
+		}
+	}
+}
+
+// redactPairs masks the values of the key=value pairs of s, separated by sep,
+// whose key, as returned by keyOf, matches any of targets.
+func redactPairs(s, sep string, targets []redactionTarget, keyOf func(string) string) string {
+	pairs := strings.Split(s, sep)
+	for i, pair := range pairs {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		key := keyOf(k)
+		for _, t := range targets {
+			if t.matches(key) {
+				pairs[i] = k + "=" + mask(v)
+				break
+			}
+		}
+	}
+	return strings.Join(pairs, sep)
+}
+
+func unescapeKey(k string) string {
+	if u, err := url.QueryUnescape(k); err == nil {
+		return u
+	}
+	return k
+}
+
+func scrubHeaders(headers map[string][]string, scrubber *strings.Replacer) {
+	for _, vs := range headers {
+		for i, v := range vs {
+			vs[i] = scrubber.Replace(v)
+		}
+	}
+}
+
+// newScrubber returns a replacer masking every secret long enough to be
+// scrubbed, both raw and in its URL encoded form. It returns nil if there is
+// nothing to scrub.
+func newScrubber(secrets []string) *strings.Replacer {
+	var oldnew []string
+	for _, s := range secrets {
+		if len(s) < minScrubbedSecretLength {
+			continue
+		}
+		oldnew = append(oldnew, s, mask(s))
+		if e := url.QueryEscape(s); e != s {
+			oldnew = append(oldnew, e, mask(e))
+		}
+	}
+	if len(oldnew) == 0 {
+		return nil
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
+// mask replaces every character of s with an asterisk, preserving
+// its length as ModSecurity does for sanitised values.
+func mask(s string) string {
+	return strings.Repeat("*", len(s))
+}
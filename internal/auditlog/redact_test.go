@@ -0,0 +1,209 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/collections"
+	"github.com/corazawaf/coraza/v3/types/variables"
+)
+
+func TestRedactionPolicyAdd(t *testing.T) {
+	tests := map[string]bool{
+		"REQUEST_HEADERS:Authorization": true,
+		"RESPONSE_HEADERS:Set-Cookie":   true,
+		"REQUEST_COOKIES:session":       true,
+		"ARGS:/^pass/":                  true,
+		"ARGS":                          false,
+		"ARGS:":                         false,
+		"ARGS:/(/":                      false,
+		"TX:secret":                     false,
+		"UNKNOWN:key":                   false,
+	}
+
+	for target, valid := range tests {
+		t.Run(target, func(t *testing.T) {
+			p := &RedactionPolicy{}
+			err := p.Add(target)
+			if valid && err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !valid && err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func newRedactionTestLog() *Log {
+	args := collections.NewMap(variables.ArgsGet)
+	args.Add("user", "admin")
+	args.Add("password", "hunter2")
+
+	return &Log{
+		Transaction_: Transaction{
+			Request_: &TransactionRequest{
+				URI_: "/login?user=admin&password=hunter2",
+				Headers_: map[string][]string{
+					"authorization": {"Bearer abc123"},
+					"cookie":        {"session=s3cr3t; theme=dark"},
+					"host":          {"example.com"},
+				},
+				Body_: "user=admin&password=hunter2",
+				Args_: collections.NewConcatKeyed(variables.Args, args),
+			},
+			Response_: &TransactionResponse{
+				Headers_: map[string][]string{
+					"set-cookie": {"id=xyz"},
+				},
+			},
+		},
+		Messages_: []plugintypes.AuditLogMessage{
+			Message{
+				Message_: "Matched hunter2",
+				Data_:    &MessageData{Msg_: "Matched hunter2", Data_: "s3cr3t"},
+			},
+		},
+	}
+}
+
+func TestRedactionPolicyRedact(t *testing.T) {
+	p := &RedactionPolicy{}
+	for _, target := range []string{
+		"REQUEST_HEADERS:Authorization",
+		"RESPONSE_HEADERS:/^set-/",
+		"REQUEST_COOKIES:session",
+		"ARGS:password",
+	} {
+		if err := p.Add(target); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	l := newRedactionTestLog()
+	p.Redact(l)
+	req := l.Transaction_.Request_
+
+	if want, have := "*************", req.Headers_["authorization"][0]; want != have {
+		t.Errorf("unexpected authorization header, want %q, have %q", want, have)
+	}
+	if want, have := "session=******; theme=dark", req.Headers_["cookie"][0]; want != have {
+		t.Errorf("unexpected cookie header, want %q, have %q", want, have)
+	}
+	if want, have := "example.com", req.Headers_["host"][0]; want != have {
+		t.Errorf("unexpected host header, want %q, have %q", want, have)
+	}
+	if want, have := "******", l.Transaction_.Response_.Headers_["set-cookie"][0]; want != have {
+		t.Errorf("unexpected set-cookie header, want %q, have %q", want, have)
+	}
+	if want, have := "/login?user=admin&password=*******", req.URI_; want != have {
+		t.Errorf("unexpected uri, want %q, have %q", want, have)
+	}
+	if want, have := "user=admin&password=*******", req.Body_; want != have {
+		t.Errorf("unexpected body, want %q, have %q", want, have)
+	}
+	if want, have := "*******", req.Args_.Get("password")[0]; want != have {
+		t.Errorf("unexpected password arg, want %q, have %q", want, have)
+	}
+	if want, have := "admin", req.Args_.Get("user")[0]; want != have {
+		t.Errorf("unexpected user arg, want %q, have %q", want, have)
+	}
+
+	m := l.Messages_[0]
+	for _, s := range []string{m.Message(), m.Data().Msg(), m.Data().Data()} {
+		if strings.Contains(s, "hunter2") || strings.Contains(s, "s3cr3t") {
+			t.Errorf("secret leaked in message %q", s)
+		}
+	}
+}
+
+func TestRedactionPolicyEmpty(t *testing.T) {
+	l := newRedactionTestLog()
+	(&RedactionPolicy{}).Redact(l)
+
+	if want, have := "Bearer abc123", l.Transaction_.Request_.Headers_["authorization"][0]; want != have {
+		t.Errorf("unexpected authorization header, want %q, have %q", want, have)
+	}
+}
+
+func TestRedactionPolicyShortSecret(t *testing.T) {
+	p := &RedactionPolicy{}
+	for _, target := range []string{"ARGS:pin", "REQUEST_COOKIES:k"} {
+		if err := p.Add(target); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	args := collections.NewMap(variables.ArgsGet)
+	args.Add("id", "1001")
+	args.Add("pin", "1")
+	l := &Log{
+		Transaction_: Transaction{
+			Request_: &TransactionRequest{
+				URI_: "/items?id=1001&pin=1",
+				Headers_: map[string][]string{
+					"content-type": {"application/x-www-form-urlencoded"},
+					"cookie":       {"k=1; v=11"},
+				},
+				Body_: "id=1001&p%69n=1",
+				Args_: collections.NewConcatKeyed(variables.Args, args),
+			},
+			Response_: &TransactionResponse{
+				Body_: "item 1001",
+			},
+		},
+		Messages_: []plugintypes.AuditLogMessage{
+			Message{Message_: "Rule 1001 matched"},
+		},
+	}
+	p.Redact(l)
+	req := l.Transaction_.Request_
+
+	if want, have := "/items?id=1001&pin=*", req.URI_; want != have {
+		t.Errorf("unexpected uri, want %q, have %q", want, have)
+	}
+	if want, have := "id=1001&p%69n=*", req.Body_; want != have {
+		t.Errorf("unexpected body, want %q, have %q", want, have)
+	}
+	if want, have := "k=*; v=11", req.Headers_["cookie"][0]; want != have {
+		t.Errorf("unexpected cookie header, want %q, have %q", want, have)
+	}
+	if want, have := "item 1001", l.Transaction_.Response_.Body_; want != have {
+		t.Errorf("unexpected response body, want %q, have %q", want, have)
+	}
+	if want, have := "Rule 1001 matched", l.Messages_[0].Message(); want != have {
+		t.Errorf("unexpected message, want %q, have %q", want, have)
+	}
+}
+
+func TestRedactionPolicyResponseHeaders(t *testing.T) {
+	p := &RedactionPolicy{}
+	if err := p.Add("ARGS:token"); err != nil {
+		t.Fatal(err)
+	}
+
+	args := collections.NewMap(variables.ArgsGet)
+	args.Add("token", "t0k3n")
+	l := &Log{
+		Transaction_: Transaction{
+			Request_: &TransactionRequest{
+				URI_:  "/login?token=t0k3n",
+				Args_: collections.NewConcatKeyed(variables.Args, args),
+			},
+			Response_: &TransactionResponse{
+				Headers_: map[string][]string{
+					"set-cookie": {"token=t0k3n; HttpOnly"},
+				},
+			},
+		},
+	}
+	p.Redact(l)
+
+	if want, have := "token=*****; HttpOnly", l.Transaction_.Response_.Headers_["set-cookie"][0]; want != have {
+		t.Errorf("unexpected set-cookie header, want %q, have %q", want, have)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	now := time.Now()
+	expires := now.Add(time.Minute)
+	c := NewLRU[string, int](2)
+
+	c.Put("a", 1, expires)
+	c.Put("b", 2, expires)
+	if v, ok := c.Get("a", now); !ok || v != 1 {
+		t.Errorf("Expected cached value 1 for a, have %d", v)
+	}
+	// b is the least recently used entry
+	c.Put("c", 3, expires)
+	if _, ok := c.Get("b", now); ok {
+		t.Error("Unexpected cached value for evicted b")
+	}
+	if _, ok := c.Get("a", now); !ok {
+		t.Error("Expected cached value for a")
+	}
+
+	c.Put("c", 4, now.Add(3*time.Minute))
+	if v, ok := c.Get("c", now); !ok || v != 4 {
+		t.Errorf("Expected replaced value 4 for c, have %d", v)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get("a", now); ok {
+		t.Error("Unexpected cached value for expired a")
+	}
+	if _, ok := c.Get("c", now); !ok {
+		t.Error("Expected cached value for c, whose expiration was extended")
+	}
+	if c.Len() != 1 {
+		t.Errorf("Unexpected number of entries %d", c.Len())
+	}
+}
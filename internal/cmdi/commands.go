@@ -0,0 +1,165 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmdi
+
+// commands are Unix commands, and cmd.exe builtins, commonly used to probe
+// or take over a host. Commands named like common words are weak.
+var commands = map[string]commandKind{
+	// weak
+	"cat":    kindWeak,
+	"cd":     kindWeak,
+	"copy":   kindWeak,
+	"cut":    kindWeak,
+	"date":   kindWeak,
+	"del":    kindWeak,
+	"dir":    kindWeak,
+	"echo":   kindWeak,
+	"expr":   kindWeak,
+	"file":   kindWeak,
+	"find":   kindWeak,
+	"head":   kindWeak,
+	"id":     kindWeak,
+	"kill":   kindWeak,
+	"less":   kindWeak,
+	"more":   kindWeak,
+	"ping":   kindWeak,
+	"read":   kindWeak,
+	"set":    kindWeak,
+	"sleep":  kindWeak,
+	"sort":   kindWeak,
+	"tail":   kindWeak,
+	"tee":    kindWeak,
+	"touch":  kindWeak,
+	"type":   kindWeak,
+	"wait":   kindWeak,
+	"who":    kindWeak,
+	"write":  kindWeak,
+	"export": kindWeak,
+	"fetch":  kindWeak,
+	"host":   kindWeak,
+	"ip":     kindWeak,
+	"java":   kindWeak,
+	"node":   kindWeak,
+
+	// strong
+	"awk":       kindStrong,
+	"base32":    kindStrong,
+	"base64":    kindStrong,
+	"bash":      kindStrong,
+	"busybox":   kindStrong,
+	"chmod":     kindStrong,
+	"chown":     kindStrong,
+	"crontab":   kindStrong,
+	"csh":       kindStrong,
+	"curl":      kindStrong,
+	"dash":      kindStrong,
+	"dig":       kindStrong,
+	"ftp":       kindStrong,
+	"gawk":      kindStrong,
+	"getent":    kindStrong,
+	"grep":      kindStrong,
+	"gcc":       kindStrong,
+	"hostname":  kindStrong,
+	"ifconfig":  kindStrong,
+	"ksh":       kindStrong,
+	"killall":   kindStrong,
+	"ls":        kindStrong,
+	"lsof":      kindStrong,
+	"lua":       kindStrong,
+	"mkfifo":    kindStrong,
+	"mknod":     kindStrong,
+	"nc":        kindStrong,
+	"ncat":      kindStrong,
+	"netcat":    kindStrong,
+	"netstat":   kindStrong,
+	"nmap":      kindStrong,
+	"nslookup":  kindStrong,
+	"openssl":   kindStrong,
+	"passwd":    kindStrong,
+	"perl":      kindStrong,
+	"php":       kindStrong,
+	"pkill":     kindStrong,
+	"printenv":  kindStrong,
+	"ps":        kindStrong,
+	"pwd":       kindStrong,
+	"python":    kindStrong,
+	"python2":   kindStrong,
+	"python3":   kindStrong,
+	"rm":        kindStrong,
+	"ruby":      kindStrong,
+	"scp":       kindStrong,
+	"sed":       kindStrong,
+	"sh":        kindStrong,
+	"socat":     kindStrong,
+	"ssh":       kindStrong,
+	"systemctl": kindStrong,
+	"tcsh":      kindStrong,
+	"telnet":    kindStrong,
+	"tftp":      kindStrong,
+	"uname":     kindStrong,
+	"useradd":   kindStrong,
+	"wget":      kindStrong,
+	"whoami":    kindStrong,
+	"xxd":       kindStrong,
+	"zsh":       kindStrong,
+}
+
+// windowsCommands are Windows programs, whose names are case insensitive
+var windowsCommands = map[string]bool{
+	"bitsadmin":  true,
+	"certutil":   true,
+	"cmd":        true,
+	"cscript":    true,
+	"ipconfig":   true,
+	"mshta":      true,
+	"net":        true,
+	"netsh":      true,
+	"powershell": true,
+	"pwsh":       true,
+	"reg":        true,
+	"regsvr32":   true,
+	"rundll32":   true,
+	"schtasks":   true,
+	"systeminfo": true,
+	"taskkill":   true,
+	"tasklist":   true,
+	"whoami":     true,
+	"wmic":       true,
+	"wscript":    true,
+}
+
+// prefixCommands run the command that follows them
+var prefixCommands = map[string]bool{
+	"!":       true,
+	"builtin": true,
+	"command": true,
+	"env":     true,
+	"exec":    true,
+	"nice":    true,
+	"nohup":   true,
+	"sudo":    true,
+	"time":    true,
+	"timeout": true,
+	"xargs":   true,
+}
+
+// numericCommands take numbers as arguments, like sleep 5
+var numericCommands = map[string]bool{
+	"kill":  true,
+	"ping":  true,
+	"sleep": true,
+}
+
+// executablePaths are directories whose files are commands
+var executablePaths = []string{
+	"/bin/",
+	"/sbin/",
+	"/usr/",
+	"/tmp/",
+	"/dev/",
+	"/var/tmp/",
+	"./",
+	"../",
+	"~/",
+}
@@ -0,0 +1,364 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cmdi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// tokenType is the kind of a token, also used as its fingerprint character
+type tokenType byte
+
+const (
+	tokenWord tokenType = 'w'
+	// tokenSep is ; or a newline
+	tokenSep tokenType = ';'
+	// tokenAnd is & or &&
+	tokenAnd tokenType = '&'
+	// tokenPipe is |, || or |&
+	tokenPipe tokenType = '|'
+	// tokenSubst opens a command substitution: $(, a backquote, <( or >(
+	tokenSubst tokenType = '('
+	// tokenGroup opens a subshell or a group: ( or {
+	tokenGroup tokenType = '{'
+	// tokenClose closes a substitution, a subshell or a group
+	tokenClose tokenType = ')'
+	// tokenRedirect is a redirection, e.g. >, >> or <<<
+	tokenRedirect tokenType = '>'
+)
+
+// maxDepth bounds the nesting of substitutions and subshells
+const maxDepth = 32
+
+type token struct {
+	typ tokenType
+	// value is the word once quotes, escapes and expansions are removed,
+	// unknown parameters expand to nothing.
+	value string
+	// obfuscated is set when the word is built with quotes, escapes or
+	// expansions
+	obfuscated bool
+	// expansion is set when the word contains parameter expansions
+	expansion bool
+}
+
+// lexer splits a shell command line into tokens the way a POSIX shell or
+// bash would, without executing expansions.
+type lexer struct {
+	s      string
+	pos    int
+	tokens []token
+
+	word       strings.Builder
+	inWord     bool
+	obfuscated bool
+	expansion  bool
+}
+
+func lex(s string) []token {
+	l := &lexer{s: s}
+	l.lexCommand(0, 0)
+	l.flushWord()
+	return l.tokens
+}
+
+func (l *lexer) emit(t tokenType) {
+	l.flushWord()
+	l.tokens = append(l.tokens, token{typ: t})
+}
+
+func (l *lexer) flushWord() {
+	if !l.inWord {
+		return
+	}
+	value := l.word.String()
+	l.word.Reset()
+	l.inWord = false
+	t := token{typ: tokenWord, value: value, obfuscated: l.obfuscated, expansion: l.expansion}
+	l.obfuscated, l.expansion = false, false
+
+	switch {
+	case !t.obfuscated && value == "{":
+		t = token{typ: tokenGroup}
+	case !t.obfuscated && value == "}":
+		t = token{typ: tokenClose}
+	case len(value) > 2 && value[0] == '{' && value[len(value)-1] == '}' && strings.Contains(value, ","):
+		// brace expansion, {cat,/etc/passwd} runs cat /etc/passwd
+		for _, w := range strings.Split(value[1:len(value)-1], ",") {
+			l.tokens = append(l.tokens, token{typ: tokenWord, value: w, obfuscated: true, expansion: t.expansion})
+		}
+		return
+	}
+	l.tokens = append(l.tokens, t)
+}
+
+func (l *lexer) appendWord(s string) {
+	l.word.WriteString(s)
+	l.inWord = true
+}
+
+// lexCommand lexes commands until the closer of the enclosing substitution
+// or subshell is consumed, or the end of the input.
+func (l *lexer) lexCommand(closer byte, depth int) {
+	for l.pos < len(l.s) {
+		c := l.s[l.pos]
+		switch c {
+		case ' ', '\t', '\r':
+			l.flushWord()
+			l.pos++
+		case '\n':
+			l.emit(tokenSep)
+			l.pos++
+		case ';':
+			l.emit(tokenSep)
+			l.pos++
+			if l.peek(';') {
+				l.pos++
+			}
+		case '&':
+			l.pos++
+			if l.peek('>') {
+				l.lexRedirect()
+				continue
+			}
+			l.emit(tokenAnd)
+			if l.peek('&') {
+				l.pos++
+			}
+		case '|':
+			l.emit(tokenPipe)
+			l.pos++
+			if l.peek('|') || l.peek('&') {
+				l.pos++
+			}
+		case '<', '>':
+			if l.pos+1 < len(l.s) && l.s[l.pos+1] == '(' {
+				l.pos += 2
+				l.open(tokenSubst, ')', depth)
+				continue
+			}
+			l.lexRedirect()
+		case '(':
+			l.pos++
+			l.open(tokenGroup, ')', depth)
+		case ')', '`':
+			l.pos++
+			if closer == c {
+				l.emit(tokenClose)
+				return
+			}
+			if c == ')' {
+				l.emit(tokenClose)
+				continue
+			}
+			l.open(tokenSubst, '`', depth)
+		case '#':
+			if l.inWord {
+				l.appendWord("#")
+				l.pos++
+				continue
+			}
+			// comments run to the end of the line
+			if i := strings.IndexByte(l.s[l.pos:], '\n'); i >= 0 {
+				l.pos += i
+			} else {
+				l.pos = len(l.s)
+			}
+		case '\\':
+			l.pos++
+			if l.pos == len(l.s) {
+				l.appendWord("\\")
+				continue
+			}
+			if l.s[l.pos] != '\n' {
+				l.appendWord(l.s[l.pos : l.pos+1])
+				l.obfuscated = true
+			}
+			l.pos++
+		case '\'':
+			l.pos++
+			end := strings.IndexByte(l.s[l.pos:], '\'')
+			if end < 0 {
+				end = len(l.s) - l.pos
+			}
+			l.appendWord(l.s[l.pos : l.pos+end])
+			l.obfuscated = true
+			l.pos += end + 1
+		case '"':
+			l.pos++
+			l.lexDoubleQuoted(depth)
+		case '$':
+			l.lexDollar(false, depth)
+		default:
+			l.appendWord(l.s[l.pos : l.pos+1])
+			l.pos++
+		}
+	}
+}
+
+// open emits the opening token of a substitution or subshell and lexes its
+// content
+func (l *lexer) open(t tokenType, closer byte, depth int) {
+	l.emit(t)
+	if depth < maxDepth {
+		l.lexCommand(closer, depth+1)
+	}
+}
+
+func (l *lexer) peek(c byte) bool {
+	return l.pos < len(l.s) && l.s[l.pos] == c
+}
+
+func (l *lexer) lexRedirect() {
+	l.emit(tokenRedirect)
+	for l.pos < len(l.s) && strings.IndexByte("<>&|", l.s[l.pos]) >= 0 {
+		if l.s[l.pos] == '|' && l.pos > 0 && l.s[l.pos-1] != '>' {
+			break
+		}
+		l.pos++
+	}
+}
+
+func (l *lexer) lexDoubleQuoted(depth int) {
+	l.inWord = true
+	l.obfuscated = true
+	for l.pos < len(l.s) {
+		c := l.s[l.pos]
+		switch c {
+		case '"':
+			l.pos++
+			return
+		case '\\':
+			if l.pos+1 < len(l.s) && strings.IndexByte("$`\"\\\n", l.s[l.pos+1]) >= 0 {
+				if l.s[l.pos+1] != '\n' {
+					l.appendWord(l.s[l.pos+1 : l.pos+2])
+				}
+				l.pos += 2
+				continue
+			}
+			l.appendWord("\\")
+			l.pos++
+		case '$':
+			l.lexDollar(true, depth)
+		case '`':
+			l.pos++
+			l.open(tokenSubst, '`', depth)
+			l.inWord = true
+		default:
+			l.appendWord(l.s[l.pos : l.pos+1])
+			l.pos++
+		}
+	}
+}
+
+// lexDollar lexes substitutions and parameter expansions. $IFS splits words
+// outside double quotes, other parameters are assumed to expand to nothing.
+func (l *lexer) lexDollar(quoted bool, depth int) {
+	l.pos++
+	if l.pos == len(l.s) {
+		l.appendWord("$")
+		return
+	}
+	switch c := l.s[l.pos]; {
+	case c == '(':
+		l.pos++
+		l.open(tokenSubst, ')', depth)
+		if quoted {
+			l.inWord = true
+		}
+	case c == '\'' && !quoted:
+		l.pos++
+		l.lexANSIC()
+	case c == '{':
+		end := strings.IndexByte(l.s[l.pos:], '}')
+		if end < 0 {
+			end = len(l.s) - l.pos
+		}
+		name := l.s[l.pos+1 : l.pos+end]
+		l.pos += end + 1
+		l.parameter(name, quoted)
+	case isNameStart(c):
+		start := l.pos
+		for l.pos < len(l.s) && isNameChar(l.s[l.pos]) {
+			l.pos++
+		}
+		l.parameter(l.s[start:l.pos], quoted)
+	case strings.IndexByte("@*#?$!-0123456789", c) >= 0:
+		l.pos++
+		l.parameter(string(c), quoted)
+	default:
+		l.appendWord("$")
+	}
+}
+
+func (l *lexer) parameter(name string, quoted bool) {
+	if !quoted && strings.HasPrefix(name, "IFS") {
+		l.flushWord()
+		return
+	}
+	l.inWord = true
+	l.obfuscated = true
+	l.expansion = true
+}
+
+// lexANSIC lexes $'...' strings, whose escapes like \x63 are decoded
+func (l *lexer) lexANSIC() {
+	l.inWord = true
+	l.obfuscated = true
+	for l.pos < len(l.s) {
+		c := l.s[l.pos]
+		if c == '\'' {
+			l.pos++
+			return
+		}
+		if c != '\\' || l.pos+1 == len(l.s) {
+			l.appendWord(l.s[l.pos : l.pos+1])
+			l.pos++
+			continue
+		}
+		l.pos++
+		switch e := l.s[l.pos]; e {
+		case 'x':
+			end := l.pos + 1
+			for end < len(l.s) && end < l.pos+3 && isHex(l.s[end]) {
+				end++
+			}
+			if v, err := strconv.ParseUint(l.s[l.pos+1:end], 16, 8); err == nil {
+				l.word.WriteByte(byte(v))
+			}
+			l.pos = end
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			end := l.pos
+			for end < len(l.s) && end < l.pos+3 && l.s[end] >= '0' && l.s[end] <= '7' {
+				end++
+			}
+			if v, err := strconv.ParseUint(l.s[l.pos:end], 8, 8); err == nil {
+				l.word.WriteByte(byte(v))
+			}
+			l.pos = end
+		case 'n':
+			l.word.WriteByte('\n')
+			l.pos++
+		case 't':
+			l.word.WriteByte('\t')
+			l.pos++
+		default:
+			l.word.WriteByte(e)
+			l.pos++
+		}
+	}
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || c >= '0' && c <= '9'
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
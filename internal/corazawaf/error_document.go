@@ -0,0 +1,130 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package corazawaf
+
+import (
+	"encoding/json"
+	"errors"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/macro"
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+// ErrorDocument is a response template sent to the client when a transaction
+// is interrupted. Templates support macro expansion, e.g. %{unique_id} expands
+// to the transaction ID. Expanded values are escaped according to the content
+// type of the document, so request data can be safely included.
+type ErrorDocument struct {
+	contentType string
+	escape      func(string) string
+	chunks      []errorDocumentChunk
+}
+
+type errorDocumentChunk struct {
+	text  string
+	macro macro.Macro
+}
+
+// NewErrorDocument compiles an error document template.
+func NewErrorDocument(template string, contentType string) (*ErrorDocument, error) {
+	if template == "" {
+		return nil, errors.New("empty error document")
+	}
+
+	d := &ErrorDocument{contentType: contentType}
+	switch ct := strings.ToLower(contentType); {
+	case strings.Contains(ct, "html"), strings.Contains(ct, "xml"):
+		d.escape = html.EscapeString
+	case strings.Contains(ct, "json"):
+		d.escape = escapeJSONString
+	}
+
+	for {
+		start := strings.Index(template, "%{")
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end == -1 {
+			return nil, errors.New("malformed macro: no closing braces")
+		}
+		end += start + 1
+
+		m, err := macro.NewMacro(template[start:end])
+		if err != nil {
+			return nil, err
+		}
+		if start > 0 {
+			d.chunks = append(d.chunks, errorDocumentChunk{text: template[:start]})
+		}
+		d.chunks = append(d.chunks, errorDocumentChunk{macro: m})
+		template = template[end:]
+	}
+
+	if template != "" {
+		d.chunks = append(d.chunks, errorDocumentChunk{text: template})
+	}
+	return d, nil
+}
+
+// ContentType returns the content type of the document.
+func (d *ErrorDocument) ContentType() string {
+	return d.contentType
+}
+
+// Render expands the document for the given transaction.
+func (d *ErrorDocument) Render(tx plugintypes.TransactionState) string {
+	var sb strings.Builder
+	for _, c := range d.chunks {
+		if c.macro == nil {
+			sb.WriteString(c.text)
+			continue
+		}
+
+		v := c.macro.Expand(tx)
+		if d.escape != nil {
+			v = d.escape(v)
+		}
+		sb.WriteString(v)
+	}
+	return sb.String()
+}
+
+func escapeJSONString(s string) string {
+	b, _ := json.Marshal(s)
+	// strip the surrounding quotes
+	return string(b[1 : len(b)-1])
+}
+
+// setInterruptionResponse renders into the interruption the error document
+// selected by the rule, falling back to the one configured for the interruption
+// status. Only deny and redirect interruptions carry a response.
+func (tx *Transaction) setInterruptionResponse(r *Rule) {
+	it := tx.interruption
+	if it == nil || it.Body != "" || (it.Action != "deny" && it.Action != "redirect") {
+		return
+	}
+
+	var doc *ErrorDocument
+	if r != nil && r.ErrorDocument != "" {
+		doc = tx.WAF.ErrorDocuments[r.ErrorDocument]
+	}
+	if doc == nil {
+		doc = tx.WAF.ErrorDocuments[strconv.Itoa(it.Status)]
+	}
+	if doc == nil {
+		return
+	}
+
+	it.Body = doc.Render(tx)
+	if doc.ContentType() != "" {
+		if it.Headers == nil {
+			it.Headers = map[string]string{}
+		}
+		it.Headers["Content-Type"] = doc.ContentType()
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package corazawaf
+
+import (
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/types"
+)
+
+func TestNewErrorDocument(t *testing.T) {
+	for _, template := range []string{"", "%{unique_id", "%{unknown_variable}"} {
+		if _, err := NewErrorDocument(template, "text/html"); err == nil {
+			t.Errorf("expected error for template %q", template)
+		}
+	}
+}
+
+func TestErrorDocumentRender(t *testing.T) {
+	tests := map[string]struct {
+		template    string
+		contentType string
+		expected    string
+	}{
+		"plain text": {
+			template:    "blocked %{request_headers.x-name}, reference %{unique_id}",
+			contentType: "text/plain",
+			expected:    `blocked <a>", reference abc`,
+		},
+		"html": {
+			template:    "<p>%{request_headers.x-name}</p><p>%{unique_id}</p>",
+			contentType: "text/html; charset=utf-8",
+			expected:    "<p>&lt;a&gt;&#34;</p><p>abc</p>",
+		},
+		"json": {
+			template:    `{"id":"%{unique_id}","uri":"%{request_headers.x-name}"}`,
+			contentType: "application/json",
+			expected:    `{"id":"abc","uri":"\u003ca\u003e\""}`,
+		},
+		"no macros": {
+			template:    "100% blocked",
+			contentType: "text/plain",
+			expected:    "100% blocked",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			doc, err := NewErrorDocument(tt.template, tt.contentType)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tx := NewWAF().NewTransactionWithOptions(Options{ID: "abc"})
+			defer tx.Close()
+			tx.AddRequestHeader("X-Name", `<a>"`)
+
+			if want, have := tt.expected, doc.Render(tx); want != have {
+				t.Errorf("unexpected document, want %q, have %q", want, have)
+			}
+		})
+	}
+}
+
+func TestSetInterruptionResponse(t *testing.T) {
+	waf := NewWAF()
+	byStatus, _ := NewErrorDocument("status %{unique_id}", "text/plain")
+	byName, _ := NewErrorDocument("named %{unique_id}", "text/html")
+	waf.ErrorDocuments = map[string]*ErrorDocument{"403": byStatus, "custom": byName}
+
+	tests := map[string]struct {
+		rule         *Rule
+		interruption *types.Interruption
+		expectedBody string
+		expectedCT   string
+	}{
+		"by status": {
+			rule:         NewRule(),
+			interruption: &types.Interruption{Action: "deny", Status: 403},
+			expectedBody: "status abc",
+			expectedCT:   "text/plain",
+		},
+		"by name": {
+			rule:         &Rule{ErrorDocument: "custom"},
+			interruption: &types.Interruption{Action: "deny", Status: 403},
+			expectedBody: "named abc",
+			expectedCT:   "text/html",
+		},
+		"unknown name falls back to status": {
+			rule:         &Rule{ErrorDocument: "unknown"},
+			interruption: &types.Interruption{Action: "deny", Status: 403},
+			expectedBody: "status abc",
+			expectedCT:   "text/plain",
+		},
+		"no document for status": {
+			rule:         NewRule(),
+			interruption: &types.Interruption{Action: "deny", Status: 401},
+		},
+		"drop": {
+			rule:         &Rule{ErrorDocument: "custom"},
+			interruption: &types.Interruption{Action: "drop", Status: 403},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tx := waf.NewTransactionWithOptions(Options{ID: "abc"})
+			defer tx.Close()
+
+			tx.Interrupt(tt.interruption)
+			tx.setInterruptionResponse(tt.rule)
+
+			it := tx.Interruption()
+			if want, have := tt.expectedBody, it.Body; want != have {
+				t.Errorf("unexpected body, want %q, have %q", want, have)
+			}
+			if want, have := tt.expectedCT, it.Headers["Content-Type"]; want != have {
+				t.Errorf("unexpected content type, want %q, have %q", want, have)
+			}
+		})
+	}
+}
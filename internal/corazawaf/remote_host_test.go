@@ -0,0 +1,59 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo
+// +build !tinygo
+
+package corazawaf
+
+import (
+	"net"
+	"testing"
+
+	"github.com/foxcpp/go-mockdns"
+
+	"github.com/corazawaf/coraza/v3/internal/rdns"
+)
+
+func TestProcessConnectionRemoteHost(t *testing.T) {
+	srv, err := mockdns.NewServer(map[string]mockdns.Zone{
+		"1.113.0.203.in-addr.arpa.": {
+			PTR: []string{"crawl-66-249-66-1.googlebot.com."},
+		},
+		"2.113.0.203.in-addr.arpa.": {
+			PTR: []string{"host.example.com."},
+		},
+		"host.example.com.": {
+			A: []string{"203.0.113.2"},
+		},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	srv.PatchNet(net.DefaultResolver)
+	defer mockdns.UnpatchNet(net.DefaultResolver)
+
+	tests := []struct {
+		mode rdns.Mode
+		addr string
+		want string
+	}{
+		{mode: rdns.ModeOff, addr: "203.0.113.2", want: ""},
+		{mode: rdns.ModeOn, addr: "203.0.113.2", want: "host.example.com"},
+		{mode: rdns.ModeOn, addr: "203.0.113.1", want: "crawl-66-249-66-1.googlebot.com"},
+		{mode: rdns.ModeDouble, addr: "203.0.113.2", want: "host.example.com"},
+		{mode: rdns.ModeDouble, addr: "203.0.113.1", want: ""},
+		{mode: rdns.ModeDouble, addr: "198.51.100.1", want: ""},
+	}
+	for _, tt := range tests {
+		waf := NewWAF()
+		waf.HostnameLookups = tt.mode
+		tx := waf.NewTransaction()
+		tx.ProcessConnection(tt.addr, 4321, "127.0.0.1", 80)
+		if have := tx.variables.remoteHost.Get(); have != tt.want {
+			t.Errorf("unexpected REMOTE_HOST of %s with mode %d: %q, want %q", tt.addr, tt.mode, have, tt.want)
+		}
+		tx.Close()
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
 	"github.com/corazawaf/coraza/v3/internal/corazarules"
 	"github.com/corazawaf/coraza/v3/internal/memoize"
+	"github.com/corazawaf/coraza/v3/internal/transformations"
 	"github.com/corazawaf/coraza/v3/types"
 	"github.com/corazawaf/coraza/v3/types/variables"
 )
@@ -96,6 +97,9 @@ type Rule struct {
 	// action itself, not sure yet
 	transformations []ruleTransformationParams
 
+	// transformationsPipeline runs the transformations fused together
+	transformationsPipeline transformations.Pipeline
+
 	transformationsID int
 
 	// Slice of initialized actions to be evaluated during
@@ -113,6 +117,10 @@ type Rule struct {
 	// by disruptive rules
 	DisruptiveStatus int
 
+	// ErrorDocument is the name of the error document rendered into the
+	// interruptions raised by this rule
+	ErrorDocument string
+
 	// Message text to be macro expanded and logged
 	// In future versions we might use a special type of string that
 	// supports cached macro expansions. For performance
@@ -380,6 +388,7 @@ func (r *Rule) doEvaluate(logger debuglog.Logger, phase types.RulePhase, tx *Tra
 				// The parser enforces that the disruptive action is just one per rule (if more than one, only the last one is kept)
 				logger.Debug().Str("action", a.Name).Msg("Executing disruptive action for rule")
 				a.Function.Evaluate(r, tx)
+				tx.setInterruptionResponse(r)
 			}
 		}
 		if r.ID_ != noID {
@@ -607,11 +616,13 @@ func transformationID(currentID int, transformationName string) int {
 
 // AddTransformation adds a transformation to the rule
 // it fails if the transformation cannot be found
+// name must be the name the transformation is registered with
 func (r *Rule) AddTransformation(name string, t plugintypes.Transformation) error {
 	if t == nil || name == "" {
 		return fmt.Errorf("invalid transformation %q not found", name)
 	}
 	r.transformations = append(r.transformations, ruleTransformationParams{Function: t})
+	r.transformationsPipeline.Add(name, t)
 	r.transformationsID = transformationID(r.transformationsID, name)
 	return nil
 }
@@ -620,6 +631,8 @@ func (r *Rule) AddTransformation(name string, t plugintypes.Transformation) erro
 // it is mostly used by the "none" transformation
 func (r *Rule) ClearTransformations() {
 	r.transformations = []ruleTransformationParams{}
+	r.transformationsPipeline.Reset()
+	r.transformationsID = 0
 }
 
 // SetOperator sets the operator of the rule
@@ -662,16 +675,7 @@ func (r *Rule) executeTransformationsMultimatch(value string) ([]string, []error
 }
 
 func (r *Rule) executeTransformations(value string) (string, []error) {
-	var errs []error
-	for _, t := range r.transformations {
-		v, _, err := t.Function(value)
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-		value = v
-	}
-	return value, errs
+	return r.transformationsPipeline.Transform(value)
 }
 
 // NewRule returns a new initialized rule
@@ -410,6 +410,12 @@ func TestClearTransformation(t *testing.T) {
 	if len(rule.transformations) > 0 {
 		t.Fatal("Expected empty transformations slice after ClearTransformations")
 	}
+	if rule.transformationsID != 0 {
+		t.Fatal("Expected transformations ID to be reset after ClearTransformations")
+	}
+	if transformedInput, _ := rule.executeTransformations("input"); transformedInput != "input" {
+		t.Fatalf("Expected cleared transformations not to be executed, got %q", transformedInput)
+	}
 }
 
 var transformationAppendA = func(input string) (string, bool, error) {
@@ -5,6 +5,7 @@ package corazawaf
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -28,6 +29,9 @@ import (
 	"github.com/corazawaf/coraza/v3/internal/corazarules"
 	"github.com/corazawaf/coraza/v3/internal/corazatypes"
 	"github.com/corazawaf/coraza/v3/internal/environment"
+	"github.com/corazawaf/coraza/v3/internal/hashengine"
+	"github.com/corazawaf/coraza/v3/internal/rdns"
+	"github.com/corazawaf/coraza/v3/internal/ssrf"
 	stringsutil "github.com/corazawaf/coraza/v3/internal/strings"
 	urlutil "github.com/corazawaf/coraza/v3/internal/url"
 	"github.com/corazawaf/coraza/v3/types"
@@ -54,6 +58,14 @@ type Transaction struct {
 	// True if the transaction has been disrupted by any rule
 	interruption *types.Interruption
 
+	// Header changes queued by rules to be applied by the connector
+	requestHeaderMutations  []types.HeaderMutation
+	responseHeaderMutations []types.HeaderMutation
+
+	// Content injected around the response body by the prepend and append actions
+	responseBodyPrepend string
+	responseBodyAppend  string
+
 	// This is used to store log messages
 	// Deprecated since Coraza 3.0.5: this variable is not used, logdata values are stored in the matched rules
 	Logdata string
@@ -311,6 +323,58 @@ func (tx *Transaction) Interrupt(interruption *types.Interruption) {
 	}
 }
 
+// Pause delays the transaction for the given duration. The pause ends earlier
+// if the transaction context is done, e.g. when the client disconnects or the
+// deadline is reached. If the WAF already has PauseConcurrencyLimit transactions
+// paused, the transaction is not paused. It returns true if the transaction
+// has been paused for the whole duration.
+func (tx *Transaction) Pause(d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+
+	if n := tx.WAF.pausedTransactions.Add(1); int(n) > tx.WAF.PauseConcurrencyLimit {
+		tx.WAF.pausedTransactions.Add(-1)
+		tx.debugLogger.Warn().
+			Int("limit", tx.WAF.PauseConcurrencyLimit).
+			Msg("Skipping pause, concurrency limit reached")
+		return false
+	}
+	defer tx.WAF.pausedTransactions.Add(-1)
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-tx.context.Done():
+		tx.debugLogger.Debug().Err(tx.context.Err()).Msg("Pause cancelled")
+		return false
+	}
+}
+
+// AcquireInspectFileSlot waits for one of the slots of the inspections the
+// WAF runs at the same time, until ctx is done. It returns a function
+// releasing the slot, or false if no slot was freed in time.
+func (tx *Transaction) AcquireInspectFileSlot(ctx context.Context) (func(), bool) {
+	slots := tx.WAF.inspectFileSlots
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, true
+	case <-ctx.Done():
+		tx.debugLogger.Warn().
+			Int("limit", cap(slots)).
+			Msg("Skipping file inspection, concurrency limit reached")
+		return nil, false
+	}
+}
+
+// Context returns the context associated to the transaction
+func (tx *Transaction) Context() context.Context {
+	return tx.context
+}
+
 func (tx *Transaction) DebugLogger() debuglog.Logger {
 	return tx.debugLogger
 }
@@ -319,8 +383,106 @@ func (tx *Transaction) SetDebugLogLevel(lvl debuglog.Level) {
 	tx.debugLogger = tx.debugLogger.WithLevel(lvl)
 }
 
+// ResponseBodyReader returns a reader for the buffered response body. If content
+// has been injected by the prepend or append actions and the response body is
+// processable and not encoded, it is included in the returned reader.
 func (tx *Transaction) ResponseBodyReader() (io.Reader, error) {
-	return tx.responseBodyBuffer.Reader()
+	reader, err := tx.responseBodyBuffer.Reader()
+	if err != nil {
+		return nil, err
+	}
+
+	if !tx.ResponseBodyAccess || !tx.IsResponseBodyProcessable() {
+		return reader, nil
+	}
+
+	if tx.responseBodyPrepend != "" || tx.responseBodyAppend != "" {
+		if encoding := tx.responseContentEncoding(); encoding != "" {
+			tx.debugLogger.Debug().
+				Str("content_encoding", encoding).
+				Msg("Skipping response body injection on encoded response")
+		} else {
+			reader = io.MultiReader(
+				strings.NewReader(tx.responseBodyPrepend),
+				reader,
+				strings.NewReader(tx.responseBodyAppend),
+			)
+		}
+	}
+
+	if tx.signsLinks() && isHTML(tx.variables.responseContentType.Get()) {
+		body, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(tx.WAF.HashSigner.RewriteHTML(body, tx.hashBaseURL(), tx.hashExtra())), nil
+	}
+	return reader, nil
+}
+
+// responseContentEncoding returns the content encoding of the response, or an
+// empty string if it is not encoded.
+func (tx *Transaction) responseContentEncoding() string {
+	for _, v := range tx.variables.responseHeaders.Get("content-encoding") {
+		if v = strings.TrimSpace(v); v != "" && !strings.EqualFold(v, "identity") {
+			return v
+		}
+	}
+	return ""
+}
+
+func isHTML(contentType string) bool {
+	ct := strings.ToLower(strings.TrimSpace(contentType))
+	return ct == "text/html" || ct == "application/xhtml+xml"
+}
+
+// signsLinks returns whether the hash engine signs the links of the response
+func (tx *Transaction) signsLinks() bool {
+	return tx.HashEngine && tx.WAF.HashSigner != nil
+}
+
+// hashBaseURL returns the URL relative links of the response are resolved
+// against.
+func (tx *Transaction) hashBaseURL() *url.URL {
+	u, err := url.ParseRequestURI(tx.variables.requestURI.Get())
+	if err != nil {
+		u = &url.URL{Path: "/"}
+	}
+	u.Scheme, u.Host = "", ""
+	if host := tx.variables.requestHeaders.Get("host"); len(host) > 0 {
+		u.Host = host[0]
+	}
+	return u
+}
+
+// hashExtra returns the value mixed with the key of the hash engine
+func (tx *Transaction) hashExtra() string {
+	if tx.WAF.HashSigner.KeyMode() == hashengine.KeyRemoteIP {
+		return tx.variables.remoteAddr.Get()
+	}
+	return ""
+}
+
+// VerifyHash verifies the signature of uri, a link signed by the hash engine.
+// enforced is false when the hash engine or its enforcement are disabled for
+// the transaction, in which case the signature is not verified.
+func (tx *Transaction) VerifyHash(uri string) (valid bool, enforced bool) {
+	if !tx.HashEnforcement || !tx.signsLinks() {
+		return false, false
+	}
+	return tx.WAF.HashSigner.Verify(uri, tx.hashExtra()), true
+}
+
+// PrependResponseBody queues content to be injected at the beginning of the
+// response body. See ResponseBodyReader.
+func (tx *Transaction) PrependResponseBody(content string) {
+	tx.responseBodyPrepend += content
+}
+
+// AppendResponseBody queues content to be injected at the end of the
+// response body. See ResponseBodyReader.
+func (tx *Transaction) AppendResponseBody(content string) {
+	tx.responseBodyAppend += content
 }
 
 func (tx *Transaction) RequestBodyReader() (io.Reader, error) {
@@ -677,18 +839,37 @@ func (tx *Transaction) ProcessConnection(client string, cPort int, server string
 	p := strconv.Itoa(cPort)
 	p2 := strconv.Itoa(sPort)
 
-	// Modsecurity removed this, so maybe we do the same, such a copycat
-	// addr, err := net.LookupAddr(client)
-	// if err == nil {
-	// 	tx.Variables.VARIABLE_REMOTE_HOST.Set(addr[0])
-	// }else{
-	// 	tx.Variables.VARIABLE_REMOTE_HOST.Set(client)
-	// }
-
 	tx.variables.remoteAddr.Set(client)
 	tx.variables.remotePort.Set(p)
 	tx.variables.serverAddr.Set(server)
 	tx.variables.serverPort.Set(p2)
+
+	if mode := tx.WAF.HostnameLookups; mode != rdns.ModeOff && tx.WAF.HostnameResolver != nil {
+		res := tx.WAF.HostnameResolver.Lookup(tx.context, client)
+		if res.Host != "" && (res.Confirmed || mode == rdns.ModeOn) {
+			tx.variables.remoteHost.Set(res.Host)
+		}
+	}
+}
+
+// ResolveHost returns the host name of addr resolved with reverse DNS and
+// whether it is forward-confirmed, i.e. the name resolves back to addr. The
+// lookup ends with the transaction.
+func (tx *Transaction) ResolveHost(addr string) (string, bool) {
+	if tx.WAF.HostnameResolver == nil {
+		return "", false
+	}
+	res := tx.WAF.HostnameResolver.Lookup(tx.context, addr)
+	return res.Host, res.Confirmed
+}
+
+// ResolveURL resolves the host names of a URL and returns the first one
+// resolving to an internal address. The lookups end with the transaction.
+func (tx *Transaction) ResolveURL(value string) (ssrf.Result, bool, error) {
+	if tx.WAF.SSRFResolver == nil {
+		return ssrf.Result{}, false, nil
+	}
+	return tx.WAF.SSRFResolver.Resolve(tx.context, value)
 }
 
 // ExtractGetArguments transforms an url encoded string to a map and creates ARGS_GET
@@ -1101,6 +1282,14 @@ func (tx *Transaction) ProcessResponseHeaders(code int, proto string) *types.Int
 	tx.variables.responseProtocol.Set(proto)
 
 	tx.WAF.Rules.Eval(types.PhaseResponseHeaders, tx)
+
+	if tx.interruption == nil && tx.signsLinks() && tx.WAF.HashSigner.Signs(hashengine.TargetLocation) {
+		if location := tx.variables.responseHeaders.Get("location"); len(location) > 0 {
+			if signed, ok := tx.WAF.HashSigner.SignLink(hashengine.TargetLocation, location[0], tx.hashBaseURL(), tx.hashExtra()); ok {
+				tx.AddResponseHeaderMutation(types.HeaderMutation{Type: types.HeaderMutationSet, Name: "Location", Value: signed})
+			}
+		}
+	}
 	return tx.interruption
 }
 
@@ -1388,6 +1577,26 @@ func (tx *Transaction) MatchedRules() []types.MatchedRule {
 	return tx.matchedRules
 }
 
+// AddRequestHeaderMutation queues a header change to be applied by the
+// connector to the request forwarded upstream.
+func (tx *Transaction) AddRequestHeaderMutation(m types.HeaderMutation) {
+	tx.requestHeaderMutations = append(tx.requestHeaderMutations, m)
+}
+
+// AddResponseHeaderMutation queues a header change to be applied by the
+// connector to the response sent to the client.
+func (tx *Transaction) AddResponseHeaderMutation(m types.HeaderMutation) {
+	tx.responseHeaderMutations = append(tx.responseHeaderMutations, m)
+}
+
+func (tx *Transaction) RequestHeaderMutations() []types.HeaderMutation {
+	return tx.requestHeaderMutations
+}
+
+func (tx *Transaction) ResponseHeaderMutations() []types.HeaderMutation {
+	return tx.responseHeaderMutations
+}
+
 func (tx *Transaction) LastPhase() types.RulePhase {
 	return tx.lastPhase
 }
@@ -1527,6 +1736,8 @@ func (tx *Transaction) AuditLog() *auditlog.Log {
 		}
 	}
 
+	tx.WAF.AuditLogRedaction.Redact(al)
+
 	return al
 }
 
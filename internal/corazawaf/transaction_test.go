@@ -5,6 +5,7 @@ package corazawaf
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"regexp"
@@ -486,6 +487,42 @@ func TestAuditLog(t *testing.T) {
 	}
 }
 
+func TestAuditLogRedaction(t *testing.T) {
+	waf := NewWAF()
+	if err := waf.AuditLogRedaction.Add("REQUEST_HEADERS:Authorization"); err != nil {
+		t.Fatal(err)
+	}
+	if err := waf.AuditLogRedaction.Add("ARGS:password"); err != nil {
+		t.Fatal(err)
+	}
+	tx := waf.NewTransaction()
+	defer tx.Close()
+
+	tx.AuditLogParts = types.AuditLogParts("ABZ")
+	tx.ProcessURI("/login?password=hunter2", "GET", "HTTP/1.1")
+	tx.AddRequestHeader("Authorization", "Bearer abc123")
+
+	al := tx.AuditLog()
+	req := al.Transaction().Request()
+	if want, have := "/login?password=*******", req.URI(); want != have {
+		t.Errorf("unexpected uri, want %q, have %q", want, have)
+	}
+	if want, have := "*************", req.Headers()["authorization"][0]; want != have {
+		t.Errorf("unexpected authorization header, want %q, have %q", want, have)
+	}
+	if want, have := "*******", req.Args().Get("password")[0]; want != have {
+		t.Errorf("unexpected password in audit log, want %q, have %q", want, have)
+	}
+
+	// the transaction itself must keep the original values
+	if want, have := "hunter2", tx.variables.args.Get("password")[0]; want != have {
+		t.Errorf("unexpected password in transaction, want %q, have %q", want, have)
+	}
+	if want, have := "Bearer abc123", tx.variables.requestHeaders.Get("authorization")[0]; want != have {
+		t.Errorf("unexpected authorization header in transaction, want %q, have %q", want, have)
+	}
+}
+
 var responseBodyWriters = map[string]func(tx *Transaction, body string) (*types.Interruption, int, error){
 	"WriteResponsequestBody": func(tx *Transaction, body string) (*types.Interruption, int, error) {
 		return tx.WriteResponseBody([]byte(body))
@@ -1162,6 +1199,100 @@ func TestTxPhase4Magic(t *testing.T) {
 	}
 }
 
+func TestResponseBodyInjection(t *testing.T) {
+	for _, tCase := range []struct {
+		contentType     string
+		contentEncoding string
+		expectedBody    string
+	}{
+		{"text/html", "", "<a>body</a>"},
+		{"text/html", "identity", "<a>body</a>"},
+		{"text/html", "gzip", "body"},
+		{"application/json", "", "body"},
+	} {
+		t.Run(tCase.contentType+" "+tCase.contentEncoding, func(t *testing.T) {
+			waf := NewWAF()
+			waf.ResponseBodyAccess = true
+			waf.ResponseBodyMimeTypes = []string{"text/html"}
+			tx := waf.NewTransaction()
+			defer tx.Close()
+
+			tx.AddResponseHeader("content-type", tCase.contentType)
+			if tCase.contentEncoding != "" {
+				tx.AddResponseHeader("content-encoding", tCase.contentEncoding)
+			}
+			tx.ProcessResponseHeaders(200, "HTTP/1.1")
+			if _, _, err := tx.WriteResponseBody([]byte("body")); err != nil {
+				t.Fatal(err)
+			}
+			tx.PrependResponseBody("<a>")
+			tx.AppendResponseBody("</a>")
+
+			reader, err := tx.ResponseBodyReader()
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want, have := tCase.expectedBody, string(body); want != have {
+				t.Errorf("unexpected response body, want %q, have %q", want, have)
+			}
+		})
+	}
+}
+
+func TestPause(t *testing.T) {
+	t.Run("context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		tx := NewWAF().NewTransactionWithOptions(Options{ID: "abc", Context: ctx})
+		defer tx.Close()
+
+		start := time.Now()
+		if tx.Pause(time.Minute) {
+			t.Error("expected the pause to be cancelled")
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("pause not cancelled on time, elapsed %s", elapsed)
+		}
+	})
+
+	t.Run("concurrency limit", func(t *testing.T) {
+		waf := NewWAF()
+		waf.PauseConcurrencyLimit = 1
+
+		ctx, cancel := context.WithCancel(context.Background())
+		paused := waf.NewTransactionWithOptions(Options{ID: "paused", Context: ctx})
+		defer paused.Close()
+
+		done := make(chan bool)
+		go func() {
+			done <- paused.Pause(time.Minute)
+		}()
+
+		for waf.pausedTransactions.Load() != 1 {
+			time.Sleep(time.Millisecond)
+		}
+
+		tx := waf.NewTransaction()
+		defer tx.Close()
+		if tx.Pause(time.Minute) {
+			t.Error("expected the pause to be skipped")
+		}
+
+		cancel()
+		if <-done {
+			t.Error("expected the pause to be cancelled")
+		}
+		if want, have := int32(0), waf.pausedTransactions.Load(); want != have {
+			t.Errorf("unexpected paused transactions, want %d, have %d", want, have)
+		}
+	})
+}
+
 func TestVariablesMatch(t *testing.T) {
 	waf := NewWAF()
 	tx := waf.NewTransaction()
@@ -11,13 +11,18 @@ import (
 	"io/fs"
 	"os"
 	"regexp"
+	"runtime"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/corazawaf/coraza/v3/debuglog"
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
 	"github.com/corazawaf/coraza/v3/internal/auditlog"
 	"github.com/corazawaf/coraza/v3/internal/environment"
+	"github.com/corazawaf/coraza/v3/internal/hashengine"
+	"github.com/corazawaf/coraza/v3/internal/rdns"
+	"github.com/corazawaf/coraza/v3/internal/ssrf"
 	stringutils "github.com/corazawaf/coraza/v3/internal/strings"
 	"github.com/corazawaf/coraza/v3/internal/sync"
 	"github.com/corazawaf/coraza/v3/types"
@@ -71,6 +76,40 @@ type WAF struct {
 	// Instructs the waf to change the Server response header
 	ServerSignature string
 
+	// PauseConcurrencyLimit is the maximum number of transactions paused at the
+	// same time by the pause action, further pauses are skipped
+	PauseConcurrencyLimit int
+
+	// pausedTransactions is the number of transactions currently paused
+	pausedTransactions atomic.Int32
+
+	// inspectFileSlots limits the inspections of the @inspectFile operator
+	// running at the same time, see SetInspectFileConcurrency
+	inspectFileSlots chan struct{}
+
+	// ErrorDocuments contains the templates rendered into interruptions, keyed
+	// by status code or by the name referenced from the errorDocument action
+	ErrorDocuments map[string]*ErrorDocument
+
+	// HashEngine enables the signing of links and the enforcement of their
+	// signature, see HashSigner
+	HashEngine bool
+
+	// HashSigner signs the links of responses and verifies the signature of
+	// requests, it is configured by the SecHash directives
+	HashSigner *hashengine.Signer
+
+	// HostnameLookups configures the resolution of REMOTE_HOST
+	HostnameLookups rdns.Mode
+
+	// HostnameResolver resolves the host name of clients, for REMOTE_HOST and
+	// the @verifyCrawler operator
+	HostnameResolver *rdns.Resolver
+
+	// SSRFResolver resolves the host names of URLs for the @detectSSRF
+	// operator
+	SSRFResolver *ssrf.Resolver
+
 	// This directory will be used to store page files
 	TmpDir string
 
@@ -125,6 +164,9 @@ type WAF struct {
 	// Contains the regular expression for relevant status audit logging
 	AuditLogRelevantStatus *regexp.Regexp
 
+	// AuditLogRedaction lists the values masked out of every audit log
+	AuditLogRedaction auditlog.RedactionPolicy
+
 	auditLogWriter plugintypes.AuditLogWriter
 
 	// AuditLogWriterConfig is configuration of audit logging, populated by multiple directives and consumed by
@@ -173,6 +215,15 @@ func (w *WAF) newTransaction(opts Options) *Transaction {
 	tx.context = opts.Context
 	tx.matchedRules = []types.MatchedRule{}
 	tx.interruption = nil
+	tx.requestHeaderMutations = nil
+	tx.responseHeaderMutations = nil
+	tx.responseBodyPrepend = ""
+	tx.responseBodyAppend = ""
+	if w.ServerSignature != "" {
+		tx.responseHeaderMutations = []types.HeaderMutation{
+			{Type: types.HeaderMutationSet, Name: "Server", Value: w.ServerSignature},
+		}
+	}
 	tx.Logdata = "" // Deprecated, this variable is not used. Logdata for each matched rule is stored in the MatchData field.
 	tx.SkipAfter = ""
 	tx.AuditEngine = w.AuditEngine
@@ -184,8 +235,8 @@ func (w *WAF) newTransaction(opts Options) *Transaction {
 	tx.ResponseBodyAccess = w.ResponseBodyAccess
 	tx.ResponseBodyLimit = int64(w.ResponseBodyLimit)
 	tx.RuleEngine = w.RuleEngine
-	tx.HashEngine = false
-	tx.HashEnforcement = false
+	tx.HashEngine = w.HashEngine
+	tx.HashEnforcement = w.HashEngine
 	tx.lastPhase = 0
 	tx.ruleRemoveByID = nil
 	tx.ruleRemoveTargetByID = map[int][]ruleVariableParams{}
@@ -310,10 +361,14 @@ func NewWAF() *WAF {
 			types.AuditLogPartResponseHeaders,
 			types.AuditLogPartAuditLogTrailer,
 		},
-		AuditLogFormat: "Native",
-		Logger:         logger,
-		ArgumentLimit:  1000,
+		AuditLogFormat:        "Native",
+		Logger:                logger,
+		ArgumentLimit:         1000,
+		PauseConcurrencyLimit: 100,
+		HostnameResolver:      rdns.NewResolver(),
+		SSRFResolver:          ssrf.NewResolver(),
 	}
+	waf.SetInspectFileConcurrency(runtime.NumCPU())
 
 	if environment.HasAccessToFS {
 		waf.TmpDir = os.TempDir()
@@ -386,6 +441,20 @@ func (w *WAF) RequestBodyInMemoryLimit() *int64 {
 	return w.requestBodyInMemoryLimit
 }
 
+// SetInspectFileConcurrency sets the maximum number of inspections of the
+// @inspectFile operator the WAF runs at the same time, across all rules.
+// It defaults to the number of CPUs and must be set before transactions
+// are created.
+func (w *WAF) SetInspectFileConcurrency(limit int) {
+	w.inspectFileSlots = make(chan struct{}, limit)
+}
+
+// InspectFileConcurrency returns the maximum number of inspections of the
+// @inspectFile operator the WAF runs at the same time
+func (w *WAF) InspectFileConcurrency() int {
+	return cap(w.inspectFileSlots)
+}
+
 // Validate validates the waf after all the settings have been set.
 func (w *WAF) Validate() error {
 	if w.RequestBodyLimit <= 0 {
@@ -418,5 +487,13 @@ func (w *WAF) Validate() error {
 		return errors.New("argument limit should be bigger than 0")
 	}
 
+	if w.PauseConcurrencyLimit < 0 {
+		return errors.New("pause concurrency limit should not be negative")
+	}
+
+	if w.InspectFileConcurrency() <= 0 {
+		return errors.New("inspect file concurrency should be bigger than 0")
+	}
+
 	return nil
 }
@@ -97,6 +97,10 @@ func TestValidate(t *testing.T) {
 			expectErr:  true,
 			customizer: func(w *WAF) { w.ResponseBodyLimit = _1gb + 1 },
 		},
+		"inspect file concurrency of 0": {
+			expectErr:  true,
+			customizer: func(w *WAF) { w.SetInspectFileConcurrency(0) },
+		},
 		"argument limit greater than 0": {
 			expectErr:  false,
 			customizer: func(w *WAF) { w.ArgumentLimit = 1000 },
@@ -0,0 +1,229 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// data field types as defined by the MaxMind DB format
+const (
+	typeExtended uint = iota
+	typePointer
+	typeString
+	typeDouble
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeArray
+	typeContainer
+	typeEndMarker
+	typeBool
+	typeFloat
+)
+
+// maxDecodeDepth limits the nesting of maps, arrays and pointers, so
+// malformed files cannot exhaust the stack.
+const maxDecodeDepth = 32
+
+var errOutOfBounds = errors.New("invalid MaxMind DB file: unexpected end of data")
+
+// decoder decodes the fields of the data section. Values are decoded into
+// string, float64, float32, []byte, uint64, int32, *big.Int, bool,
+// map[string]interface{} and []interface{}.
+type decoder struct {
+	buf []byte
+}
+
+// decode decodes the field at offset, returning its value and the offset
+// right after it.
+func (d decoder) decode(offset uint, depth int) (interface{}, uint, error) {
+	if depth > maxDecodeDepth {
+		return nil, 0, errors.New("invalid MaxMind DB file: maximum data structure depth exceeded")
+	}
+
+	typ, size, offset, err := d.decodeControl(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if typ == typePointer {
+		pointer, next, err := d.decodePointer(size, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, _, err := d.decode(pointer, depth+1)
+		return v, next, err
+	}
+
+	switch typ {
+	case typeMap:
+		return d.decodeMap(size, offset, depth)
+	case typeArray:
+		return d.decodeArray(size, offset, depth)
+	case typeBool:
+		if size > 1 {
+			return nil, 0, fmt.Errorf("invalid MaxMind DB file: invalid boolean size %d", size)
+		}
+		return size == 1, offset, nil
+	}
+
+	if offset+size > uint(len(d.buf)) {
+		return nil, 0, errOutOfBounds
+	}
+	b := d.buf[offset : offset+size]
+	next := offset + size
+
+	switch typ {
+	case typeString:
+		return string(b), next, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("invalid MaxMind DB file: invalid double size %d", size)
+		}
+		return math.Float64frombits(decodeUint(b)), next, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("invalid MaxMind DB file: invalid float size %d", size)
+		}
+		return math.Float32frombits(uint32(decodeUint(b))), next, nil
+	case typeBytes:
+		return append([]byte(nil), b...), next, nil
+	case typeUint16, typeUint32, typeUint64:
+		if (typ == typeUint16 && size > 2) || (typ == typeUint32 && size > 4) || size > 8 {
+			return nil, 0, fmt.Errorf("invalid MaxMind DB file: invalid integer size %d", size)
+		}
+		return decodeUint(b), next, nil
+	case typeInt32:
+		if size > 4 {
+			return nil, 0, fmt.Errorf("invalid MaxMind DB file: invalid integer size %d", size)
+		}
+		return int32(uint32(decodeUint(b))), next, nil
+	case typeUint128:
+		if size > 16 {
+			return nil, 0, fmt.Errorf("invalid MaxMind DB file: invalid integer size %d", size)
+		}
+		return new(big.Int).SetBytes(b), next, nil
+	default:
+		return nil, 0, fmt.Errorf("invalid MaxMind DB file: unexpected data type %d", typ)
+	}
+}
+
+// decodeControl decodes the control byte of a field, returning its type,
+// its size (the raw size bits for pointers) and the offset of the payload.
+func (d decoder) decodeControl(offset uint) (uint, uint, uint, error) {
+	if offset >= uint(len(d.buf)) {
+		return 0, 0, 0, errOutOfBounds
+	}
+	ctrl := d.buf[offset]
+	offset++
+
+	typ := uint(ctrl >> 5)
+	if typ == typeExtended {
+		if offset >= uint(len(d.buf)) {
+			return 0, 0, 0, errOutOfBounds
+		}
+		typ = 7 + uint(d.buf[offset])
+		offset++
+		if typ < typeInt32 {
+			return 0, 0, 0, fmt.Errorf("invalid MaxMind DB file: invalid extended type %d", typ)
+		}
+	}
+
+	size := uint(ctrl & 0x1f)
+	if typ == typePointer || size < 29 {
+		return typ, size, offset, nil
+	}
+
+	n := size - 28
+	if offset+n > uint(len(d.buf)) {
+		return 0, 0, 0, errOutOfBounds
+	}
+	ext := decodeUint(d.buf[offset : offset+n])
+	offset += n
+	switch n {
+	case 1:
+		size = 29 + uint(ext)
+	case 2:
+		size = 285 + uint(ext)
+	default:
+		size = 65821 + uint(ext)
+	}
+	return typ, size, offset, nil
+}
+
+// decodePointer decodes the pointer whose control byte had the size bits
+// sizeBits, returning the pointed offset and the offset right after it.
+func (d decoder) decodePointer(sizeBits uint, offset uint) (uint, uint, error) {
+	n := (sizeBits>>3)&0x3 + 1
+	if offset+n > uint(len(d.buf)) {
+		return 0, 0, errOutOfBounds
+	}
+	b := d.buf[offset : offset+n]
+
+	var pointer uint
+	switch n {
+	case 1:
+		pointer = (sizeBits&0x7)<<8 | uint(b[0])
+	case 2:
+		pointer = ((sizeBits&0x7)<<16 | uint(decodeUint(b))) + 2048
+	case 3:
+		pointer = ((sizeBits&0x7)<<24 | uint(decodeUint(b))) + 526336
+	default:
+		pointer = uint(decodeUint(b))
+	}
+	return pointer, offset + n, nil
+}
+
+func (d decoder) decodeMap(size uint, offset uint, depth int) (interface{}, uint, error) {
+	// the size is not trusted to preallocate, as it might be bogus
+	m := map[string]interface{}{}
+	for i := uint(0); i < size; i++ {
+		k, next, err := d.decode(offset, depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, 0, errors.New("invalid MaxMind DB file: map key is not a string")
+		}
+
+		v, next, err := d.decode(next, depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		m[key] = v
+		offset = next
+	}
+	return m, offset, nil
+}
+
+func (d decoder) decodeArray(size uint, offset uint, depth int) (interface{}, uint, error) {
+	var a []interface{}
+	for i := uint(0); i < size; i++ {
+		v, next, err := d.decode(offset, depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		a = append(a, v)
+		offset = next
+	}
+	return a, offset, nil
+}
+
+// decodeUint decodes a big endian unsigned integer of up to 8 bytes
+func decodeUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package geo implements the geographical lookups of the geoLookup operator
+// on top of MaxMind DB files, like GeoLite2, GeoIP2 or compatible databases.
+package geo
+
+import (
+	"net"
+	"strconv"
+)
+
+// Database resolves IP addresses into the fields of the GEO collection
+type Database struct {
+	reader *Reader
+}
+
+// NewDatabase creates a Database for the MMDB content in b
+func NewDatabase(b []byte) (*Database, error) {
+	r, err := NewReader(b)
+	if err != nil {
+		return nil, err
+	}
+	return &Database{reader: r}, nil
+}
+
+// Lookup returns the GEO collection fields for the address: COUNTRY_CODE,
+// COUNTRY_NAME, CONTINENT_CODE, REGION, CITY, LATITUDE, LONGITUDE, ASN and
+// ASN_ORGANIZATION. Fields the database does not provide are omitted. It returns
+// false if the address is invalid or the database has no record for it.
+func (db *Database) Lookup(addr string) (map[string]string, bool) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil, false
+	}
+	v, err := db.reader.Lookup(ip)
+	if err != nil || v == nil {
+		return nil, false
+	}
+	record, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	fields := map[string]string{}
+	set := func(key string, v interface{}) {
+		switch v := v.(type) {
+		case string:
+			if v != "" {
+				fields[key] = v
+			}
+		case float64:
+			fields[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		case float32:
+			fields[key] = strconv.FormatFloat(float64(v), 'f', -1, 32)
+		case uint64:
+			fields[key] = strconv.FormatUint(v, 10)
+		}
+	}
+
+	country := lookupPath(record, "country")
+	if country == nil {
+		// country databases only provide the registered country for some networks
+		country = lookupPath(record, "registered_country")
+	}
+	set("COUNTRY_CODE", lookupPath(country, "iso_code"))
+	set("COUNTRY_NAME", db.name(country))
+	set("CONTINENT_CODE", lookupPath(record, "continent", "code"))
+	if subdivisions, ok := lookupPath(record, "subdivisions").([]interface{}); ok && len(subdivisions) > 0 {
+		set("REGION", lookupPath(subdivisions[0], "iso_code"))
+	}
+	set("CITY", db.name(lookupPath(record, "city")))
+	set("LATITUDE", lookupPath(record, "location", "latitude"))
+	set("LONGITUDE", lookupPath(record, "location", "longitude"))
+	set("ASN", lookupPath(record, "autonomous_system_number"))
+	set("ASN_ORGANIZATION", lookupPath(record, "autonomous_system_organization"))
+
+	return fields, true
+}
+
+// name returns the English name of a place, falling back to the
+// first language provided by the database.
+func (db *Database) name(place interface{}) interface{} {
+	if n := lookupPath(place, "names", "en"); n != nil {
+		return n
+	}
+	if langs := db.reader.metadata.Languages; len(langs) > 0 {
+		return lookupPath(place, "names", langs[0])
+	}
+	return nil
+}
+
+// lookupPath returns the value under the nested map keys of v, or nil if any
+// of them is missing.
+func lookupPath(v interface{}, keys ...string) interface{} {
+	for _, k := range keys {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[k]
+	}
+	return v
+}
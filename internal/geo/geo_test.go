@@ -0,0 +1,106 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package geo
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// testdataNetworks are the networks of testdata/geo.mmdb, which combines
+// City and ASN records like commercial databases do.
+var testdataNetworks = []testNetwork{
+	{
+		cidr: "81.2.69.0/24",
+		record: map[string]interface{}{
+			"city":      map[string]interface{}{"names": map[string]interface{}{"en": "London", "es": "Londres"}},
+			"continent": map[string]interface{}{"code": "EU", "names": map[string]interface{}{"en": "Europe"}},
+			"country": map[string]interface{}{
+				"iso_code": "GB",
+				"names":    map[string]interface{}{"en": "United Kingdom", "es": "Reino Unido"},
+			},
+			"location": map[string]interface{}{"latitude": 51.5142, "longitude": -0.0931},
+			"subdivisions": []interface{}{
+				map[string]interface{}{"iso_code": "ENG", "names": map[string]interface{}{"en": "England"}},
+			},
+			"autonomous_system_number":       uint64(20712),
+			"autonomous_system_organization": "Andrews & Arnold Ltd",
+		},
+	},
+	{
+		cidr: "2a02:d380::/32",
+		record: map[string]interface{}{
+			"continent": map[string]interface{}{"code": "EU"},
+			"registered_country": map[string]interface{}{
+				"iso_code": "ES",
+				"names":    map[string]interface{}{"en": "Spain"},
+			},
+		},
+	},
+	{
+		cidr:   "192.0.2.0/24",
+		record: map[string]interface{}{"autonomous_system_number": uint64(64496)},
+	},
+}
+
+func TestTestdataIsUpToDate(t *testing.T) {
+	have, err := os.ReadFile("testdata/geo.mmdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := writeTestDB(6, 28, testdataNetworks); !bytes.Equal(want, have) {
+		t.Error("testdata/geo.mmdb is outdated, it must be regenerated with writeTestDB")
+	}
+}
+
+func TestDatabaseLookup(t *testing.T) {
+	b, err := os.ReadFile("testdata/geo.mmdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := NewDatabase(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]map[string]string{
+		"81.2.69.160": {
+			"COUNTRY_CODE":     "GB",
+			"COUNTRY_NAME":     "United Kingdom",
+			"CONTINENT_CODE":   "EU",
+			"REGION":           "ENG",
+			"CITY":             "London",
+			"LATITUDE":         "51.5142",
+			"LONGITUDE":        "-0.0931",
+			"ASN":              "20712",
+			"ASN_ORGANIZATION": "Andrews & Arnold Ltd",
+		},
+		"2a02:d380::1": {
+			"COUNTRY_CODE":   "ES",
+			"COUNTRY_NAME":   "Spain",
+			"CONTINENT_CODE": "EU",
+		},
+		"192.0.2.1": {
+			"ASN": "64496",
+		},
+	}
+	for addr, want := range tests {
+		have, ok := db.Lookup(addr)
+		if !ok {
+			t.Errorf("expected record for %s", addr)
+			continue
+		}
+		if !reflect.DeepEqual(want, have) {
+			t.Errorf("unexpected fields for %s, want %v, have %v", addr, want, have)
+		}
+	}
+
+	for _, addr := range []string{"127.0.0.1", "2001:db8::1", "not an address", ""} {
+		if _, ok := db.Lookup(addr); ok {
+			t.Errorf("unexpected record for %q", addr)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package geo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// metadataStartMarker precedes the metadata map at the end of the file
+var metadataStartMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+// metadataMaxSize is the maximum size of the metadata section, the marker
+// is only searched in the last bytes of the file.
+const metadataMaxSize = 128 * 1024
+
+// dataSectionSeparatorSize is the size of the zeroed separator between the
+// search tree and the data section.
+const dataSectionSeparatorSize = 16
+
+// Metadata describes the layout and the content of a MaxMind DB file
+type Metadata struct {
+	NodeCount                uint
+	RecordSize               uint
+	IPVersion                uint
+	DatabaseType             string
+	Languages                []string
+	BinaryFormatMajorVersion uint
+	BuildEpoch               uint
+}
+
+// Reader is a pure Go reader for the MaxMind DB (MMDB) file format as
+// described in https://maxmind.github.io/MaxMind-DB/. Readers are
+// immutable and safe for concurrent use.
+type Reader struct {
+	buf          []byte
+	data         decoder
+	metadata     Metadata
+	nodeByteSize uint
+	ipv4Start    uint
+}
+
+// NewReader creates a Reader for the MMDB content in b
+func NewReader(b []byte) (*Reader, error) {
+	searchStart := 0
+	if len(b) > metadataMaxSize {
+		searchStart = len(b) - metadataMaxSize
+	}
+	i := bytes.LastIndex(b[searchStart:], metadataStartMarker)
+	if i == -1 {
+		return nil, errors.New("invalid MaxMind DB file: metadata not found")
+	}
+	metadataStart := searchStart + i + len(metadataStartMarker)
+
+	m, _, err := decoder{buf: b[metadataStart:]}.decode(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MaxMind DB metadata: %w", err)
+	}
+	raw, ok := m.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid MaxMind DB metadata: not a map")
+	}
+
+	r := &Reader{buf: b}
+	if err := r.metadata.fill(raw); err != nil {
+		return nil, err
+	}
+
+	if r.metadata.BinaryFormatMajorVersion != 2 {
+		return nil, fmt.Errorf("unsupported MaxMind DB format version %d", r.metadata.BinaryFormatMajorVersion)
+	}
+	switch r.metadata.RecordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("unsupported MaxMind DB record size %d", r.metadata.RecordSize)
+	}
+	if r.metadata.IPVersion != 4 && r.metadata.IPVersion != 6 {
+		return nil, fmt.Errorf("unsupported MaxMind DB IP version %d", r.metadata.IPVersion)
+	}
+
+	r.nodeByteSize = r.metadata.RecordSize / 4
+	treeSize := r.metadata.NodeCount * r.nodeByteSize
+	dataStart := treeSize + dataSectionSeparatorSize
+	dataEnd := uint(searchStart + i)
+	if dataStart > dataEnd {
+		return nil, errors.New("invalid MaxMind DB file: search tree exceeds the file size")
+	}
+	r.data = decoder{buf: b[dataStart:dataEnd]}
+
+	if r.metadata.IPVersion == 6 {
+		// IPv4 addresses are stored in the ::/96 subtree
+		node := uint(0)
+		for i := 0; i < 96 && node < r.metadata.NodeCount; i++ {
+			if node, err = r.readNode(node, 0); err != nil {
+				return nil, err
+			}
+		}
+		r.ipv4Start = node
+	}
+
+	return r, nil
+}
+
+// Metadata returns the metadata of the database
+func (r *Reader) Metadata() Metadata {
+	return r.metadata
+}
+
+// Lookup returns the record for the IP address, or nil if the database
+// contains no record for it.
+func (r *Reader) Lookup(ip net.IP) (interface{}, error) {
+	var (
+		node uint
+		addr []byte
+	)
+	if ip4 := ip.To4(); ip4 != nil {
+		node = r.ipv4Start
+		addr = ip4
+	} else if ip6 := ip.To16(); ip6 != nil {
+		if r.metadata.IPVersion == 4 {
+			return nil, fmt.Errorf("cannot look up IPv6 address %s in an IPv4 database", ip)
+		}
+		addr = ip6
+	} else {
+		return nil, fmt.Errorf("invalid IP address %q", ip)
+	}
+
+	nodeCount := r.metadata.NodeCount
+	for i := 0; i < len(addr)*8 && node < nodeCount; i++ {
+		bit := uint(addr[i/8]>>(7-uint(i%8))) & 1
+		var err error
+		if node, err = r.readNode(node, bit); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case node == nodeCount:
+		// empty record
+		return nil, nil
+	case node < nodeCount:
+		return nil, errors.New("invalid MaxMind DB file: search tree is deeper than the address")
+	}
+
+	offset := node - nodeCount - dataSectionSeparatorSize
+	v, _, err := r.data.decode(offset, 0)
+	return v, err
+}
+
+// readNode returns the left (bit 0) or right (bit 1) record of a node
+func (r *Reader) readNode(node uint, bit uint) (uint, error) {
+	offset := node * r.nodeByteSize
+	if offset+r.nodeByteSize > uint(len(r.buf)) {
+		return 0, errors.New("invalid MaxMind DB file: node out of bounds")
+	}
+	b := r.buf[offset : offset+r.nodeByteSize]
+
+	switch r.metadata.RecordSize {
+	case 24:
+		b = b[bit*3:]
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2]), nil
+	case 28:
+		// the middle byte holds the most significant nibble of both records
+		if bit == 0 {
+			return uint(b[3]&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2]), nil
+		}
+		return uint(b[3]&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6]), nil
+	default:
+		b = b[bit*4:]
+		return uint(b[0])<<24 | uint(b[1])<<16 | uint(b[2])<<8 | uint(b[3]), nil
+	}
+}
+
+func (m *Metadata) fill(raw map[string]interface{}) error {
+	for _, f := range []struct {
+		key      string
+		dst      *uint
+		required bool
+	}{
+		{"node_count", &m.NodeCount, true},
+		{"record_size", &m.RecordSize, true},
+		{"ip_version", &m.IPVersion, true},
+		{"binary_format_major_version", &m.BinaryFormatMajorVersion, true},
+		{"build_epoch", &m.BuildEpoch, false},
+	} {
+		v, ok := raw[f.key].(uint64)
+		if !ok {
+			if f.required {
+				return fmt.Errorf("invalid MaxMind DB metadata: missing %s", f.key)
+			}
+			continue
+		}
+		*f.dst = uint(v)
+	}
+
+	m.DatabaseType, _ = raw["database_type"].(string)
+	if langs, ok := raw["languages"].([]interface{}); ok {
+		for _, l := range langs {
+			if s, ok := l.(string); ok {
+				m.Languages = append(m.Languages, s)
+			}
+		}
+	}
+	return nil
+}
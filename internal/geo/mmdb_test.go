@@ -0,0 +1,130 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package geo
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReaderLookup(t *testing.T) {
+	networks := []testNetwork{
+		{cidr: "1.2.3.0/24", record: map[string]interface{}{"name": "ipv4", "flag": true}},
+		{cidr: "10.0.0.0/8", record: map[string]interface{}{"name": "private", "values": []interface{}{uint64(1), 2.5}}},
+		{cidr: "2001:db8::/32", record: map[string]interface{}{"name": "ipv6", "long": strings.Repeat("a", 300)}},
+	}
+
+	for _, recordSize := range []uint{24, 28, 32} {
+		for _, ipVersion := range []uint{4, 6} {
+			nets := networks
+			if ipVersion == 4 {
+				nets = networks[:2]
+			}
+			r, err := NewReader(writeTestDB(ipVersion, recordSize, nets))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if want, have := recordSize, r.Metadata().RecordSize; want != have {
+				t.Errorf("unexpected record size, want %d, have %d", want, have)
+			}
+
+			tests := map[string]interface{}{
+				"1.2.3.4":   map[string]interface{}{"name": "ipv4", "flag": true},
+				"1.2.4.4":   nil,
+				"10.1.2.3":  map[string]interface{}{"name": "private", "values": []interface{}{uint64(1), 2.5}},
+				"127.0.0.1": nil,
+			}
+			if ipVersion == 6 {
+				tests["2001:db8::1"] = map[string]interface{}{"name": "ipv6", "long": strings.Repeat("a", 300)}
+				tests["2001:db9::1"] = nil
+			}
+
+			for addr, want := range tests {
+				have, err := r.Lookup(net.ParseIP(addr))
+				if err != nil {
+					t.Errorf("unexpected error for %s (IPv%d, %d bits): %s", addr, ipVersion, recordSize, err.Error())
+					continue
+				}
+				if want == nil && have != nil || want != nil && !reflect.DeepEqual(want, have) {
+					t.Errorf("unexpected record for %s (IPv%d, %d bits), want %v, have %v", addr, ipVersion, recordSize, want, have)
+				}
+			}
+		}
+	}
+}
+
+func TestReaderLookupIPv6InIPv4Database(t *testing.T) {
+	r, err := NewReader(writeTestDB(4, 24, []testNetwork{{cidr: "1.2.3.0/24", record: map[string]interface{}{}}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Lookup(net.ParseIP("2001:db8::1")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestReaderPointers(t *testing.T) {
+	// strings repeated far away from their first occurrence are written as 2 byte pointers
+	var networks []testNetwork
+	for i := 0; i < 200; i++ {
+		networks = append(networks, testNetwork{
+			cidr: net.IPv4(10, 0, byte(i), 0).String() + "/24",
+			record: map[string]interface{}{
+				"shared": "value",
+				"unique": strings.Repeat(string(rune('a'+i%26)), i+1),
+			},
+		})
+	}
+	r, err := NewReader(writeTestDB(6, 28, networks))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []int{0, 100, 199} {
+		v, err := r.Lookup(net.IPv4(10, 0, byte(i), 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want, have := networks[i].record, v; !reflect.DeepEqual(want, have) {
+			t.Errorf("unexpected record, want %v, have %v", want, have)
+		}
+	}
+}
+
+func TestNewReaderInvalid(t *testing.T) {
+	valid := writeTestDB(6, 24, []testNetwork{{cidr: "1.2.3.0/24", record: map[string]interface{}{"a": "b"}}})
+
+	tests := map[string][]byte{
+		"empty":            nil,
+		"no metadata":      []byte("not a database"),
+		"invalid metadata": append(append([]byte{}, metadataStartMarker...), 0xFF),
+		"truncated tree":   valid[strings.LastIndex(string(valid), string(metadataStartMarker)):],
+	}
+	for name, b := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewReader(b); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestDecoderInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"out of bounds string":  {0x45, 'a'},
+		"invalid double size":   {0x62, 0, 0},
+		"map key is not string": {0xE1, 0xA1, 0x01, 0x41, 'a'},
+		"pointer out of bounds": {0x20, 0xFF},
+		"pointer loop":          {0x20, 0x00},
+		"data cache container":  {0x00, 0x05},
+	}
+	for name, b := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := (decoder{buf: b}).decode(0, 0); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
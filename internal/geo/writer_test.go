@@ -0,0 +1,223 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net"
+	"sort"
+)
+
+// testNetwork is a network and its record, as written by the test writer
+type testNetwork struct {
+	cidr   string
+	record map[string]interface{}
+}
+
+type writerNode struct {
+	children [2]*writerNode
+	data     int
+	index    uint
+}
+
+// writeTestDB writes a MaxMind DB file with the networks, so the reader can
+// be tested without shipping third party databases. IPv4 networks are stored
+// in the ::/96 subtree of IPv6 databases. Repeated strings are deduplicated
+// through pointers, like the official writers do.
+func writeTestDB(ipVersion uint, recordSize uint, networks []testNetwork) []byte {
+	var (
+		data    = &testEncoder{strings: map[string]int{}}
+		offsets []int
+		root    = &writerNode{data: -1}
+	)
+
+	for i, n := range networks {
+		_, ipnet, err := net.ParseCIDR(n.cidr)
+		if err != nil {
+			panic(err)
+		}
+		ones, _ := ipnet.Mask.Size()
+		addr := ipnet.IP.To16()
+		if ip4 := ipnet.IP.To4(); ip4 != nil {
+			if ipVersion == 4 {
+				addr = ip4
+			} else {
+				addr = append(make([]byte, 12), ip4...)
+				ones += 96
+			}
+		}
+
+		node := root
+		for b := 0; b < ones; b++ {
+			bit := (addr[b/8] >> (7 - uint(b%8))) & 1
+			if node.children[bit] == nil {
+				node.children[bit] = &writerNode{data: -1}
+			}
+			node = node.children[bit]
+		}
+		node.data = i
+
+		offsets = append(offsets, data.buf.Len())
+		data.encode(n.record)
+	}
+
+	// nodes are numbered breadth first, leaves are not part of the tree
+	var nodes []*writerNode
+	queue := []*writerNode{root}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		n.index = uint(len(nodes))
+		nodes = append(nodes, n)
+		for _, c := range n.children {
+			if c != nil && c.data == -1 {
+				queue = append(queue, c)
+			}
+		}
+	}
+	nodeCount := uint(len(nodes))
+
+	var out bytes.Buffer
+	for _, n := range nodes {
+		var records [2]uint
+		for i, c := range n.children {
+			switch {
+			case c == nil:
+				records[i] = nodeCount
+			case c.data != -1:
+				records[i] = nodeCount + dataSectionSeparatorSize + uint(offsets[c.data])
+			default:
+				records[i] = c.index
+			}
+		}
+
+		switch recordSize {
+		case 24:
+			out.Write([]byte{byte(records[0] >> 16), byte(records[0] >> 8), byte(records[0])})
+			out.Write([]byte{byte(records[1] >> 16), byte(records[1] >> 8), byte(records[1])})
+		case 28:
+			out.Write([]byte{byte(records[0] >> 16), byte(records[0] >> 8), byte(records[0])})
+			out.WriteByte(byte((records[0]>>24)<<4) | byte(records[1]>>24&0x0F))
+			out.Write([]byte{byte(records[1] >> 16), byte(records[1] >> 8), byte(records[1])})
+		case 32:
+			out.Write(binary.BigEndian.AppendUint32(nil, uint32(records[0])))
+			out.Write(binary.BigEndian.AppendUint32(nil, uint32(records[1])))
+		}
+	}
+
+	out.Write(make([]byte, dataSectionSeparatorSize))
+	out.Write(data.buf.Bytes())
+
+	out.Write(metadataStartMarker)
+	metadata := &testEncoder{}
+	metadata.encode(map[string]interface{}{
+		"node_count":                  uint64(nodeCount),
+		"record_size":                 uint64(recordSize),
+		"ip_version":                  uint64(ipVersion),
+		"database_type":               "Coraza-Test",
+		"languages":                   []interface{}{"en"},
+		"binary_format_major_version": uint64(2),
+		"binary_format_minor_version": uint64(0),
+		"build_epoch":                 uint64(1700000000),
+	})
+	out.Write(metadata.buf.Bytes())
+
+	return out.Bytes()
+}
+
+type testEncoder struct {
+	buf bytes.Buffer
+	// strings holds the offset of the strings already written, nil disables pointers
+	strings map[string]int
+}
+
+func (e *testEncoder) control(typ uint, size int) {
+	var ext []byte
+	switch {
+	case size >= 65821:
+		ext = []byte{byte((size - 65821) >> 16), byte((size - 65821) >> 8), byte(size - 65821)}
+		size = 31
+	case size >= 285:
+		ext = []byte{byte((size - 285) >> 8), byte(size - 285)}
+		size = 30
+	case size >= 29:
+		ext = []byte{byte(size - 29)}
+		size = 29
+	}
+
+	if typ > 7 {
+		e.buf.WriteByte(byte(size))
+		e.buf.WriteByte(byte(typ - 7))
+	} else {
+		e.buf.WriteByte(byte(typ<<5) | byte(size))
+	}
+	e.buf.Write(ext)
+}
+
+func (e *testEncoder) encode(v interface{}) {
+	switch v := v.(type) {
+	case string:
+		if offset, ok := e.strings[v]; ok {
+			e.pointer(offset)
+			return
+		}
+		if e.strings != nil {
+			e.strings[v] = e.buf.Len()
+		}
+		e.control(typeString, len(v))
+		e.buf.WriteString(v)
+	case float64:
+		e.control(typeDouble, 8)
+		e.buf.Write(binary.BigEndian.AppendUint64(nil, math.Float64bits(v)))
+	case uint64:
+		b := binary.BigEndian.AppendUint64(nil, v)
+		b = bytes.TrimLeft(b, "\x00")
+		if v <= math.MaxUint32 {
+			e.control(typeUint32, len(b))
+		} else {
+			e.control(typeUint64, len(b))
+		}
+		e.buf.Write(b)
+	case bool:
+		size := 0
+		if v {
+			size = 1
+		}
+		e.control(typeBool, size)
+	case []interface{}:
+		e.control(typeArray, len(v))
+		for _, i := range v {
+			e.encode(i)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.control(typeMap, len(v))
+		for _, k := range keys {
+			e.encode(k)
+			e.encode(v[k])
+		}
+	default:
+		panic("unsupported type")
+	}
+}
+
+func (e *testEncoder) pointer(offset int) {
+	switch {
+	case offset < 2048:
+		e.buf.WriteByte(byte(typePointer<<5) | byte(offset>>8))
+		e.buf.WriteByte(byte(offset))
+	case offset < 526336:
+		offset -= 2048
+		e.buf.WriteByte(byte(typePointer<<5) | 1<<3 | byte(offset>>16))
+		e.buf.Write([]byte{byte(offset >> 8), byte(offset)})
+	default:
+		panic("unsupported pointer size")
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hashengine signs the links of responses with an HMAC and verifies
+// the signature of the requests following them, as configured by the
+// SecHashEngine family of directives.
+package hashengine
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DefaultParam is the name of the parameter carrying the signature when
+// SecHashParam is not set.
+const DefaultParam = "hmac"
+
+// Target is a kind of link signed by the hash engine
+type Target int
+
+const (
+	// TargetHref is the href attribute of a and area elements
+	TargetHref Target = iota
+	// TargetFormAction is the action attribute of form elements
+	TargetFormAction
+	// TargetIframeSrc is the src attribute of iframe elements
+	TargetIframeSrc
+	// TargetFrameSrc is the src attribute of frame elements
+	TargetFrameSrc
+	// TargetLocation is the Location response header
+	TargetLocation
+)
+
+// ParseTarget parses the targets of SecHashMethodRx and SecHashMethodPm,
+// e.g. HashHref.
+func ParseTarget(s string) (Target, error) {
+	switch strings.ToLower(s) {
+	case "hashhref":
+		return TargetHref, nil
+	case "hashformaction":
+		return TargetFormAction, nil
+	case "hashiframesrc":
+		return TargetIframeSrc, nil
+	case "hashframesrc":
+		return TargetFrameSrc, nil
+	case "hashlocation":
+		return TargetLocation, nil
+	}
+	return 0, fmt.Errorf("invalid hash method %q", s)
+}
+
+// KeyMode defines what is mixed with the key to compute signatures
+type KeyMode int
+
+const (
+	// KeyOnly signatures only depend on the key
+	KeyOnly KeyMode = iota
+	// KeyRemoteIP signatures are bound to the address of the client
+	KeyRemoteIP
+)
+
+// Matcher selects the links to sign
+type Matcher interface {
+	MatchString(s string) bool
+}
+
+// phraseMatcher matches links containing any of its phrases, case
+// insensitively
+type phraseMatcher []string
+
+// NewPhraseMatcher returns a Matcher selecting links containing any of the
+// phrases, like the @pm operator.
+func NewPhraseMatcher(phrases []string) Matcher {
+	m := make(phraseMatcher, len(phrases))
+	for i, p := range phrases {
+		m[i] = strings.ToLower(p)
+	}
+	return m
+}
+
+func (m phraseMatcher) MatchString(s string) bool {
+	s = strings.ToLower(s)
+	for _, p := range m {
+		if strings.Contains(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+type method struct {
+	target  Target
+	matcher Matcher
+}
+
+// Signer signs and verifies links. It is configured while parsing the rules
+// and safe for concurrent use afterwards.
+type Signer struct {
+	key     []byte
+	mode    KeyMode
+	param   string
+	methods []method
+}
+
+// NewSigner returns a Signer with a random key, until SetKey is called.
+func NewSigner() (*Signer, error) {
+	s := &Signer{param: DefaultParam}
+	if err := s.SetKey("rand", KeyOnly); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetKey sets the key of the signatures, "rand" generates a random key.
+func (s *Signer) SetKey(key string, mode KeyMode) error {
+	if strings.EqualFold(key, "rand") {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return fmt.Errorf("failed to generate the hash key: %s", err.Error())
+		}
+		s.key = b
+	} else {
+		s.key = []byte(key)
+	}
+	s.mode = mode
+	return nil
+}
+
+// KeyMode returns what is mixed with the key to compute signatures
+func (s *Signer) KeyMode() KeyMode {
+	return s.mode
+}
+
+// SetParam sets the name of the parameter carrying the signature
+func (s *Signer) SetParam(name string) {
+	s.param = name
+}
+
+// AddMethod signs the links of the target selected by m
+func (s *Signer) AddMethod(t Target, m Matcher) {
+	s.methods = append(s.methods, method{target: t, matcher: m})
+}
+
+// Signs returns whether links of the target can be signed
+func (s *Signer) Signs(t Target) bool {
+	for _, m := range s.methods {
+		if m.target == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Signer) selects(t Target, link string) bool {
+	for _, m := range s.methods {
+		if m.target == t && m.matcher.MatchString(link) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignLink signs link if it is selected by a method of the target. Relative
+// links are resolved against base, links to other hosts are not signed.
+// extra is the value mixed with the key, e.g. the client address.
+func (s *Signer) SignLink(t Target, link string, base *url.URL, extra string) (string, bool) {
+	if !s.selects(t, link) {
+		return "", false
+	}
+	ref, err := url.Parse(link)
+	if err != nil || (ref.Scheme != "" && ref.Scheme != "http" && ref.Scheme != "https") || ref.Opaque != "" {
+		return "", false
+	}
+	if ref.Scheme == "" && ref.Host == "" && ref.Path == "" && ref.RawQuery == "" {
+		// fragments only link to the current document
+		return "", false
+	}
+	resolved := base.ResolveReference(ref)
+	if resolved.Host != "" && !strings.EqualFold(resolved.Host, base.Host) {
+		return "", false
+	}
+
+	pairs := s.stripParam(resolved.RawQuery)
+	mac := s.mac(canonical(resolved.EscapedPath(), pairs), extra)
+
+	// the link keeps its form, only the query is rewritten
+	pairs = s.stripParam(ref.RawQuery)
+	pairs = append(pairs, url.QueryEscape(s.param)+"="+mac)
+	ref.RawQuery = strings.Join(pairs, "&")
+	ref.ForceQuery = false
+	return ref.String(), true
+}
+
+// Verify verifies the signature of uri, the path and query of a request.
+func (s *Signer) Verify(uri, extra string) bool {
+	u, err := url.ParseRequestURI(uri)
+	if err != nil {
+		return false
+	}
+	var signature string
+	found := 0
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		k, v, _ := strings.Cut(pair, "=")
+		if key, err := url.QueryUnescape(k); err == nil && key == s.param {
+			signature = v
+			found++
+		}
+	}
+	if found != 1 {
+		return false
+	}
+	mac := s.mac(canonical(u.EscapedPath(), s.stripParam(u.RawQuery)), extra)
+	return hmac.Equal([]byte(signature), []byte(mac))
+}
+
+// stripParam splits a query into its pairs, without the signature
+func (s *Signer) stripParam(query string) []string {
+	if query == "" {
+		return nil
+	}
+	var pairs []string
+	for _, pair := range strings.Split(query, "&") {
+		k, _, _ := strings.Cut(pair, "=")
+		if key, err := url.QueryUnescape(k); err == nil && key == s.param {
+			continue
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+func (s *Signer) mac(message, extra string) string {
+	h := hmac.New(sha256.New, s.key)
+	h.Write([]byte(extra))
+	h.Write([]byte{0})
+	h.Write([]byte(message))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+// canonical returns the signed form of a path and its query pairs, escaping
+// is normalized as browsers may escape links differently.
+func canonical(path string, pairs []string) string {
+	var b strings.Builder
+	if p, err := url.PathUnescape(path); err == nil {
+		path = (&url.URL{Path: p}).EscapedPath()
+	}
+	if path == "" {
+		path = "/"
+	}
+	b.WriteString(path)
+	for i, pair := range pairs {
+		if i == 0 {
+			b.WriteByte('?')
+		} else {
+			b.WriteByte('&')
+		}
+		k, v, _ := strings.Cut(pair, "=")
+		if uk, err := url.QueryUnescape(k); err == nil {
+			k = url.QueryEscape(uk)
+		}
+		if uv, err := url.QueryUnescape(v); err == nil {
+			v = url.QueryEscape(uv)
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(v)
+	}
+	return b.String()
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package hashengine
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func newTestSigner(t testing.TB) *Signer {
+	t.Helper()
+	s, err := NewSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetKey("secret", KeyOnly); err != nil {
+		t.Fatal(err)
+	}
+	s.AddMethod(TargetHref, regexp.MustCompile(`product`))
+	s.AddMethod(TargetFormAction, regexp.MustCompile(`.`))
+	s.AddMethod(TargetLocation, regexp.MustCompile(`.`))
+	return s
+}
+
+func TestSignLink(t *testing.T) {
+	s := newTestSigner(t)
+	base, _ := url.Parse("http://shop.example.com/catalog/list.php?page=2")
+
+	tests := []struct {
+		link   string
+		target Target
+		signed bool
+	}{
+		{link: "/product.php?id=1", target: TargetHref, signed: true},
+		{link: "product.php?id=1&x=a%20b", target: TargetHref, signed: true},
+		{link: "../product.php", target: TargetHref, signed: true},
+		{link: "http://shop.example.com/product.php#top", target: TargetHref, signed: true},
+		{link: "/product.php?hmac=forged&id=1", target: TargetHref, signed: true},
+		{link: "/about.php", target: TargetHref},
+		{link: "/product.php", target: TargetIframeSrc},
+		{link: "http://evil.example.com/product.php", target: TargetHref},
+		{link: "javascript:product()", target: TargetHref},
+		{link: "#product", target: TargetHref},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			signed, ok := s.SignLink(tt.target, tt.link, base, "")
+			if ok != tt.signed {
+				t.Fatalf("unexpected signing %t, want %t", ok, tt.signed)
+			}
+			if !ok {
+				return
+			}
+			u, err := url.Parse(signed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.Query().Get("hmac") == "" || len(u.Query()["hmac"]) != 1 {
+				t.Fatalf("missing signature in %q", signed)
+			}
+			req := base.ResolveReference(u).RequestURI()
+			if !s.Verify(req, "") {
+				t.Errorf("signature of %q is not valid", req)
+			}
+			// parameters cannot be added to a signed link
+			forged := strings.Replace(req, "hmac=", "extra=1&hmac=", 1)
+			if s.Verify(forged, "") {
+				t.Errorf("forged %q is valid", forged)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	s := newTestSigner(t)
+	base, _ := url.Parse("http://example.com/")
+	signed, ok := s.SignLink(TargetHref, "/product.php?id=1&name=a%2Fb", base, "10.0.0.1")
+	if !ok {
+		t.Fatal("link not signed")
+	}
+
+	tests := map[string]bool{
+		signed: true,
+		strings.Replace(signed, "id=1", "id=2", 1):                   false,
+		"/product.php?id=1&name=a%2Fb":                               false,
+		signed + "&hmac=x":                                           false,
+		strings.Replace(signed, "/product.php", "/product%2Ephp", 1): true,
+		"not a uri": false,
+	}
+	for uri, want := range tests {
+		if have := s.Verify(uri, "10.0.0.1"); have != want {
+			t.Errorf("unexpected verification of %q: %t, want %t", uri, have, want)
+		}
+	}
+	if s.Verify(signed, "10.0.0.2") {
+		t.Error("signature bound to another address is valid")
+	}
+
+	other := newTestSigner(t)
+	if err := other.SetKey("other", KeyOnly); err != nil {
+		t.Fatal(err)
+	}
+	if other.Verify(signed, "10.0.0.1") {
+		t.Error("signature of another key is valid")
+	}
+}
+
+func TestRewriteHTML(t *testing.T) {
+	s := newTestSigner(t)
+	s.SetParam("sig")
+	base, _ := url.Parse("http://example.com/shop/")
+
+	body := `<!DOCTYPE html>
+<html><head><title><a href="/product">not a link</a></title>
+<script>var a = '<a href="/product.php">'; // </scripts> <a href="/product.php?raw"></SCRIPT></head>
+<body>
+<!-- <a href="/product.php?comment"> -->
+<a class=x HREF='/product.php?id=1&amp;c=2'>One</a>
+<a href=/product.php?id=2>Two</a>
+<a href="/about">About</a>
+<form method="post" action="/cart/add"><input name="q"></form>
+<iframe src="/product.php"></iframe>
+</body></html>`
+
+	out := string(s.RewriteHTML([]byte(body), base, ""))
+	for _, unchanged := range []string{
+		`<title><a href="/product">not a link</a></title>`,
+		`var a = '<a href="/product.php">'; // </scripts> <a href="/product.php?raw">`,
+		`<!-- <a href="/product.php?comment"> -->`,
+		`<a href="/about">`,
+		`<iframe src="/product.php">`,
+	} {
+		if !strings.Contains(out, unchanged) {
+			t.Errorf("expected %q to be unchanged in\n%s", unchanged, out)
+		}
+	}
+
+	for _, link := range regexp.MustCompile(`(?i)(?:href|action)=['"]?([^'" >]+)`).FindAllStringSubmatch(out, -1) {
+		if !strings.Contains(link[1], "sig=") {
+			continue
+		}
+		uri := strings.ReplaceAll(link[1], "&amp;", "&")
+		if !s.Verify(uri, "") {
+			t.Errorf("invalid signature of %q", uri)
+		}
+	}
+	if n := strings.Count(out, "sig="); n != 3 {
+		t.Errorf("unexpected number of signed links %d in\n%s", n, out)
+	}
+	if !strings.Contains(out, `HREF='/product.php?id=1&amp;c=2&amp;sig=`) {
+		t.Errorf("expected the signed link to be escaped in\n%s", out)
+	}
+
+	unsigned := newTestSigner(t)
+	unsigned.methods = nil
+	if string(unsigned.RewriteHTML([]byte(body), base, "")) != body {
+		t.Error("unexpected rewrite without methods")
+	}
+}
+
+func TestParseTarget(t *testing.T) {
+	for _, name := range []string{"HashHref", "HashFormAction", "HashIframeSrc", "HashframeSrc", "HashLocation"} {
+		if _, err := ParseTarget(name); err != nil {
+			t.Errorf("unexpected error for %q: %s", name, err.Error())
+		}
+	}
+	if _, err := ParseTarget("HashScript"); err == nil {
+		t.Error("expected error for unknown target")
+	}
+}
+
+func TestIndexClosingTag(t *testing.T) {
+	tests := []struct {
+		body string
+		want int
+	}{
+		{body: "var a;</script>", want: 6},
+		{body: "var a;</SCRIPT >", want: 6},
+		{body: "</scripts></ScRiPt>", want: 10},
+		{body: "a < b </ script", want: -1},
+		{body: "var a;</scr", want: -1},
+		{body: "var a;</script", want: 6},
+	}
+	for _, tt := range tests {
+		if have := indexClosingTag([]byte(tt.body), "script"); have != tt.want {
+			t.Errorf("indexClosingTag(%q): want %d, have %d", tt.body, tt.want, have)
+		}
+	}
+}
+
+func BenchmarkRewriteHTMLRawText(b *testing.B) {
+	s := newTestSigner(b)
+	s.SetParam("sig")
+	base, _ := url.Parse("http://example.com/")
+	body := []byte(strings.Repeat("<script>var a = 1;</script><a href=\"/product\">p</a>", 2000))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.RewriteHTML(body, base, "")
+	}
+}
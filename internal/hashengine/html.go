@@ -0,0 +1,183 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package hashengine
+
+import (
+	"bytes"
+	"html"
+	"net/url"
+)
+
+// linkAttributes are the attributes of the elements signed by each target
+var linkAttributes = map[string]struct {
+	attr   string
+	target Target
+}{
+	"a":      {"href", TargetHref},
+	"area":   {"href", TargetHref},
+	"form":   {"action", TargetFormAction},
+	"iframe": {"src", TargetIframeSrc},
+	"frame":  {"src", TargetFrameSrc},
+}
+
+// rawTextElements contain text that must not be parsed as markup
+var rawTextElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"textarea": true,
+	"title":    true,
+}
+
+type attribute struct {
+	name       string
+	start, end int
+}
+
+// RewriteHTML signs the links of an HTML document. Only the values of the
+// signed attributes are modified, the rest of the document is kept as is.
+func (s *Signer) RewriteHTML(body []byte, base *url.URL, extra string) []byte {
+	if !s.Signs(TargetHref) && !s.Signs(TargetFormAction) && !s.Signs(TargetIframeSrc) && !s.Signs(TargetFrameSrc) {
+		return body
+	}
+
+	var out bytes.Buffer
+	last := 0
+	for i := 0; i < len(body); {
+		lt := bytes.IndexByte(body[i:], '<')
+		if lt < 0 {
+			break
+		}
+		i += lt
+		if bytes.HasPrefix(body[i:], []byte("<!--")) {
+			end := bytes.Index(body[i+4:], []byte("-->"))
+			if end < 0 {
+				break
+			}
+			i += 4 + end + 3
+			continue
+		}
+
+		j := i + 1
+		for j < len(body) && isNameChar(body[j]) {
+			j++
+		}
+		name := string(bytes.ToLower(body[i+1 : j]))
+		if name == "" {
+			i++
+			continue
+		}
+		attrs, end := parseAttributes(body, j)
+		if end < 0 {
+			break
+		}
+
+		if la, ok := linkAttributes[name]; ok {
+			for _, a := range attrs {
+				if a.name != la.attr || a.start < 0 {
+					continue
+				}
+				link := html.UnescapeString(string(body[a.start:a.end]))
+				signed, ok := s.SignLink(la.target, link, base, extra)
+				if !ok {
+					continue
+				}
+				out.Write(body[last:a.start])
+				out.WriteString(html.EscapeString(signed))
+				last = a.end
+			}
+		}
+
+		i = end
+		if rawTextElements[name] {
+			// skip to the closing tag of the element
+			k := indexClosingTag(body[i:], name)
+			if k < 0 {
+				break
+			}
+			i += k
+		}
+	}
+
+	if last == 0 {
+		return body
+	}
+	out.Write(body[last:])
+	return out.Bytes()
+}
+
+// parseAttributes parses the attributes of a tag starting at i. It returns
+// the attributes, with the span of their value, and the position after the
+// end of the tag, -1 if the tag is not terminated.
+func parseAttributes(body []byte, i int) ([]attribute, int) {
+	var attrs []attribute
+	for {
+		for i < len(body) && (isSpace(body[i]) || body[i] == '/') {
+			i++
+		}
+		if i == len(body) {
+			return nil, -1
+		}
+		if body[i] == '>' {
+			return attrs, i + 1
+		}
+
+		start := i
+		for i < len(body) && !isSpace(body[i]) && body[i] != '=' && body[i] != '>' && body[i] != '/' {
+			i++
+		}
+		a := attribute{name: string(bytes.ToLower(body[start:i])), start: -1}
+		for i < len(body) && isSpace(body[i]) {
+			i++
+		}
+		if i < len(body) && body[i] == '=' {
+			i++
+			for i < len(body) && isSpace(body[i]) {
+				i++
+			}
+			if i == len(body) {
+				return nil, -1
+			}
+			if q := body[i]; q == '"' || q == '\'' {
+				end := bytes.IndexByte(body[i+1:], q)
+				if end < 0 {
+					return nil, -1
+				}
+				a.start, a.end = i+1, i+1+end
+				i += end + 2
+			} else {
+				a.start = i
+				for i < len(body) && !isSpace(body[i]) && body[i] != '>' {
+					i++
+				}
+				a.end = i
+			}
+		}
+		attrs = append(attrs, a)
+	}
+}
+
+// indexClosingTag returns the index of the first closing tag of the element
+// name in body, matched case-insensitively, or -1
+func indexClosingTag(body []byte, name string) int {
+	for i := 0; ; i += 2 {
+		k := bytes.Index(body[i:], []byte("</"))
+		if k < 0 {
+			return -1
+		}
+		i += k
+		end := i + 2 + len(name)
+		if end <= len(body) && bytes.EqualFold(body[i+2:end], []byte(name)) &&
+			(end == len(body) || !isNameChar(body[end])) {
+			return i
+		}
+	}
+}
+
+func isNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ipset implements sets of IP networks backed by path-compressed
+// binary tries, whose lookups only depend on the length of addresses rather
+// than on the number of networks.
+package ipset
+
+import (
+	"bufio"
+	"bytes"
+	"math/bits"
+	"net/netip"
+	"strings"
+)
+
+// Set is a set of IPv4 and IPv6 networks. It must not be modified once it
+// is used concurrently.
+type Set struct {
+	v4, v6 *node
+	len    int
+}
+
+// key is an address of up to 128 bits, most significant bits first
+type key struct {
+	hi, lo uint64
+}
+
+// node is a network of the trie, a leaf or a branch whose children extend
+// its prefix with a 0 or 1 bit.
+type node struct {
+	key      key
+	bits     int
+	terminal bool
+	child    [2]*node
+}
+
+// New returns an empty Set
+func New() *Set {
+	return &Set{}
+}
+
+// Len returns the number of networks inserted in the set, networks covered
+// by others included.
+func (s *Set) Len() int {
+	return s.len
+}
+
+// Insert adds a network to the set. IPv4-mapped IPv6 networks are inserted
+// as IPv4 networks.
+func (s *Set) Insert(p netip.Prefix) {
+	if !p.IsValid() {
+		return
+	}
+	addr, n := p.Addr(), p.Bits()
+	if addr.Is4In6() {
+		if n < 96 {
+			insert(&s.v6, keyOf(addr), n)
+			s.len++
+			return
+		}
+		addr, n = addr.Unmap(), n-96
+	}
+	if addr.Is4() {
+		insert(&s.v4, keyOf(addr), n)
+	} else {
+		insert(&s.v6, keyOf(addr), n)
+	}
+	s.len++
+}
+
+// Contains returns whether addr belongs to a network of the set. IPv4-mapped
+// IPv6 addresses are looked up as IPv4 addresses.
+func (s *Set) Contains(addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	addr = addr.Unmap()
+	n := s.v6
+	if addr.Is4() {
+		n = s.v4
+	}
+	k := keyOf(addr)
+	for n != nil {
+		if commonBits(n.key, k) < n.bits {
+			return false
+		}
+		if n.terminal {
+			return true
+		}
+		n = n.child[bitAt(k, n.bits)]
+	}
+	return false
+}
+
+func insert(root **node, k key, n int) {
+	k = mask(k, n)
+	for {
+		cur := *root
+		if cur == nil {
+			*root = &node{key: k, bits: n, terminal: true}
+			return
+		}
+		c := commonBits(cur.key, k)
+		if c > cur.bits {
+			c = cur.bits
+		}
+		if c > n {
+			c = n
+		}
+		if c < cur.bits {
+			// the network diverges from the node, or contains it
+			split := &node{key: mask(k, c), bits: c}
+			split.child[bitAt(cur.key, c)] = cur
+			if c == n {
+				split.terminal = true
+				split.child = [2]*node{}
+			} else {
+				split.child[bitAt(k, c)] = &node{key: k, bits: n, terminal: true}
+			}
+			*root = split
+			return
+		}
+		if cur.terminal {
+			// the network is already covered
+			return
+		}
+		if cur.bits == n {
+			// networks within the node are covered from now on
+			cur.terminal = true
+			cur.child = [2]*node{}
+			return
+		}
+		root = &cur.child[bitAt(k, cur.bits)]
+	}
+}
+
+func keyOf(addr netip.Addr) key {
+	if addr.Is4() {
+		b := addr.As4()
+		return key{hi: uint64(b[0])<<56 | uint64(b[1])<<48 | uint64(b[2])<<40 | uint64(b[3])<<32}
+	}
+	b := addr.As16()
+	var k key
+	for i := 0; i < 8; i++ {
+		k.hi = k.hi<<8 | uint64(b[i])
+		k.lo = k.lo<<8 | uint64(b[i+8])
+	}
+	return k
+}
+
+// commonBits returns the length of the common prefix of two keys
+func commonBits(a, b key) int {
+	if x := a.hi ^ b.hi; x != 0 {
+		return bits.LeadingZeros64(x)
+	}
+	return 64 + bits.LeadingZeros64(a.lo^b.lo)
+}
+
+// mask clears the bits of k after the first n
+func mask(k key, n int) key {
+	switch {
+	case n <= 0:
+		return key{}
+	case n < 64:
+		return key{hi: k.hi &^ (^uint64(0) >> n)}
+	case n < 128:
+		return key{hi: k.hi, lo: k.lo &^ (^uint64(0) >> (n - 64))}
+	}
+	return k
+}
+
+func bitAt(k key, i int) int {
+	if i < 64 {
+		return int(k.hi >> (63 - i) & 1)
+	}
+	return int(k.lo >> (127 - i) & 1)
+}
+
+// ParsePrefix parses an IP address or a network in CIDR notation, addresses
+// are parsed as single address networks.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return p.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// ParseList parses a list of networks, one per line, as published by
+// threat intelligence feeds. Comments start with # or ; and may follow the
+// network, e.g. "192.0.2.0/24 ; SBL000001" in Spamhaus DROP lists. Only the
+// first field of a line, separated by spaces, tabs or commas, is parsed.
+// Invalid entries are skipped and counted, an error is returned if the list
+// cannot be read, e.g. when a line is too long.
+func ParseList(data []byte) (*Set, int, error) {
+	s := New()
+	invalid := 0
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		l := sc.Text()
+		if i := strings.IndexAny(l, "#;"); i >= 0 {
+			l = l[:i]
+		}
+		fields := strings.FieldsFunc(l, func(r rune) bool { return r == ' ' || r == '\t' || r == ',' })
+		if len(fields) == 0 {
+			continue
+		}
+		p, err := ParsePrefix(fields[0])
+		if err != nil {
+			invalid++
+			continue
+		}
+		s.Insert(p)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, invalid, err
+	}
+	return s, invalid, nil
+}
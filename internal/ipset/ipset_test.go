@@ -0,0 +1,208 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipset
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	s := New()
+	for _, p := range []string{
+		"192.168.0.0/24",
+		"10.0.0.1",
+		"10.0.0.0/31",
+		"172.16.5.4/12",
+		"2001:db8::/32",
+		"2001:db8:1::1/128",
+		"::ffff:198.51.100.0/120",
+		"fe80::/10",
+	} {
+		prefix, err := ParsePrefix(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Insert(prefix)
+	}
+
+	tests := map[string]bool{
+		"192.168.0.1":             true,
+		"192.168.0.255":           true,
+		"192.168.1.0":             false,
+		"10.0.0.0":                true,
+		"10.0.0.1":                true,
+		"10.0.0.2":                false,
+		"172.31.255.255":          true,
+		"172.32.0.0":              false,
+		"198.51.100.7":            true,
+		"::ffff:192.168.0.1":      true,
+		"2001:db8:ffff::1":        true,
+		"2001:db9::1":             false,
+		"fe80::1%eth0":            true,
+		"::1":                     false,
+		"::c0a8:1":                false, // IPv4-compatible addresses are IPv6
+		"0.0.0.0":                 false,
+		"255.255.255.255":         false,
+		"2001:0db8:0000:0000::01": true,
+	}
+	for addr, want := range tests {
+		if have := s.Contains(netip.MustParseAddr(addr)); have != want {
+			t.Errorf("unexpected result for %s: %t, want %t", addr, have, want)
+		}
+	}
+	if s.Contains(netip.Addr{}) {
+		t.Error("unexpected result for invalid address")
+	}
+	if s.Len() != 8 {
+		t.Errorf("unexpected length %d", s.Len())
+	}
+}
+
+func TestInsertCovering(t *testing.T) {
+	s := New()
+	s.Insert(netip.MustParsePrefix("10.1.2.0/24"))
+	s.Insert(netip.MustParsePrefix("10.1.3.0/24"))
+	s.Insert(netip.MustParsePrefix("10.0.0.0/8"))
+	s.Insert(netip.MustParsePrefix("10.1.2.3/32"))
+	if !s.Contains(netip.MustParseAddr("10.200.0.1")) {
+		t.Error("expected the covering network to match")
+	}
+
+	s = New()
+	s.Insert(netip.MustParsePrefix("0.0.0.0/0"))
+	if !s.Contains(netip.MustParseAddr("203.0.113.1")) || s.Contains(netip.MustParseAddr("2001:db8::1")) {
+		t.Error("unexpected result for the default route")
+	}
+}
+
+func TestContainsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := New()
+	var nets []*net.IPNet
+	randomIP := func(v6 bool) net.IP {
+		if v6 {
+			ip := make(net.IP, 16)
+			r.Read(ip)
+			// narrow the address space so networks overlap
+			ip[0], ip[1] = 0x20, 0x01
+			return ip
+		}
+		ip := make(net.IP, 4)
+		r.Read(ip)
+		ip[0] = 10
+		return ip
+	}
+	for i := 0; i < 2000; i++ {
+		v6 := i%2 == 1
+		ip := randomIP(v6)
+		bits := 8 + r.Intn(25)
+		if v6 {
+			bits = 16 + r.Intn(113)
+		}
+		_, n, err := net.ParseCIDR(fmt.Sprintf("%s/%d", ip, bits))
+		if err != nil {
+			t.Fatal(err)
+		}
+		nets = append(nets, n)
+		s.Insert(netip.MustParsePrefix(n.String()))
+	}
+
+	for i := 0; i < 20000; i++ {
+		ip := randomIP(i%2 == 1)
+		want := false
+		for _, n := range nets {
+			if n.Contains(ip) {
+				want = true
+				break
+			}
+		}
+		addr, _ := netip.AddrFromSlice(ip)
+		if have := s.Contains(addr); have != want {
+			t.Fatalf("unexpected result for %s: %t, want %t", ip, have, want)
+		}
+	}
+}
+
+func TestParseList(t *testing.T) {
+	list := `; Spamhaus DROP List 2024/01/01
+; Last-Modified: Mon, 01 Jan 2024 00:00:00 GMT
+1.10.16.0/20 ; SBL256894
+# plain addresses and networks
+192.0.2.1
+2001:db8::/32	# documentation
+198.51.100.0/24,blocklist.example,malware
+not an address
+300.0.0.0/8
+`
+	s, invalid, err := ParseList([]byte(list))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Len() != 4 || invalid != 2 {
+		t.Errorf("unexpected number of networks %d and invalid entries %d", s.Len(), invalid)
+	}
+	for addr, want := range map[string]bool{
+		"1.10.31.255":   true,
+		"192.0.2.1":     true,
+		"192.0.2.2":     false,
+		"2001:db8::1":   true,
+		"198.51.100.20": true,
+	} {
+		if have := s.Contains(netip.MustParseAddr(addr)); have != want {
+			t.Errorf("unexpected result for %s: %t, want %t", addr, have, want)
+		}
+	}
+}
+
+func TestParseListTooLong(t *testing.T) {
+	list := "192.0.2.1\n# " + strings.Repeat("x", bufio.MaxScanTokenSize) + "\n198.51.100.1\n"
+	if _, _, err := ParseList([]byte(list)); err == nil {
+		t.Error("expected error for a line too long")
+	}
+}
+
+func TestParsePrefix(t *testing.T) {
+	for in, want := range map[string]string{
+		"192.168.0.1":    "192.168.0.1/32",
+		"192.168.0.1/24": "192.168.0.0/24",
+		"2001:db8::1":    "2001:db8::1/128",
+	} {
+		p, err := ParsePrefix(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.String() != want {
+			t.Errorf("unexpected prefix for %q: %s, want %s", in, p, want)
+		}
+	}
+	for _, in := range []string{"", "192.168.0", "192.168.0.1/33", "::1/129"} {
+		if _, err := ParsePrefix(in); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	var list strings.Builder
+	for i := 0; i < 300000; i++ {
+		fmt.Fprintf(&list, "%d.%d.%d.0/24\n", r.Intn(256), r.Intn(256), r.Intn(256))
+	}
+	s, _, err := ParseList([]byte(list.String()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	addr := netip.MustParseAddr("203.0.113.1")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(addr)
+	}
+}
@@ -0,0 +1,644 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxValidationDepth bounds the nesting of the schemas applied to a value,
+// as references can make schemas recursive.
+const maxValidationDepth = 256
+
+var uuidRe = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// schema is the subset of JSON Schema used by OpenAPI 3.0 and 3.1 to
+// describe parameters and bodies. Annotations and the keywords only
+// relevant to responses are ignored.
+type schema struct {
+	// never is set for the false schema
+	never bool
+
+	// types is empty when any type is allowed
+	types    []string
+	nullable bool
+	enum     []interface{}
+	constant *interface{}
+	format   string
+
+	minimum, maximum                   *float64
+	exclusiveMinimum, exclusiveMaximum bool
+	multipleOf                         *big.Rat
+
+	minLength, maxLength int
+	pattern              *regexp.Regexp
+
+	items              *schema
+	minItems, maxItems int
+	uniqueItems        bool
+
+	properties          map[string]*schema
+	required            []string
+	additional          *schema
+	minProperties       int
+	maxProperties       int
+	readOnly            bool
+	allOf, anyOf, oneOf []*schema
+	not                 *schema
+}
+
+// schemaCompiler compiles the schemas of a document, the referenced schemas
+// are compiled once so recursive schemas are supported.
+type schemaCompiler struct {
+	doc  *document
+	refs map[string]*schema
+}
+
+func (c *schemaCompiler) compile(v interface{}) (*schema, error) {
+	switch v := v.(type) {
+	case bool:
+		// OpenAPI 3.1 boolean schemas
+		return &schema{never: !v, minLength: -1, maxLength: -1, minItems: -1, maxItems: -1, minProperties: -1, maxProperties: -1}, nil
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			return c.compileRef(ref, v)
+		}
+		s := &schema{}
+		return s, c.fill(s, v)
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("invalid schema %v", v)
+}
+
+// compileRef compiles a referenced schema. Keywords next to $ref are
+// ignored by OpenAPI 3.0 but applied by 3.1, both are accepted.
+func (c *schemaCompiler) compileRef(ref string, m map[string]interface{}) (*schema, error) {
+	s, ok := c.refs[ref]
+	if !ok {
+		target, err := c.doc.resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+		s = &schema{}
+		// the schema is registered before being filled for recursive
+		// references.
+		c.refs[ref] = s
+		if b, ok := target.(bool); ok {
+			bs, _ := c.compile(b)
+			*s = *bs
+		} else {
+			tm, ok := target.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid schema %q", ref)
+			}
+			if nested, ok := tm["$ref"].(string); ok {
+				ns, err := c.compileRef(nested, tm)
+				if err != nil {
+					return nil, err
+				}
+				s.allOf = []*schema{ns}
+				s.minLength, s.maxLength, s.minItems, s.maxItems, s.minProperties, s.maxProperties = -1, -1, -1, -1, -1, -1
+			} else if err := c.fill(s, tm); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	siblings := map[string]interface{}{}
+	for k, v := range m {
+		if k != "$ref" {
+			siblings[k] = v
+		}
+	}
+	if len(siblings) == 0 {
+		return s, nil
+	}
+	combined := &schema{}
+	if err := c.fill(combined, siblings); err != nil {
+		return nil, err
+	}
+	combined.allOf = append(combined.allOf, s)
+	return combined, nil
+}
+
+func (c *schemaCompiler) fill(s *schema, m map[string]interface{}) error {
+	var err error
+	switch t := m["type"].(type) {
+	case string:
+		s.types = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			name, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("invalid schema type %v", v)
+			}
+			s.types = append(s.types, name)
+		}
+	case nil:
+	default:
+		return fmt.Errorf("invalid schema type %v", t)
+	}
+	for _, t := range s.types {
+		switch t {
+		case "string", "number", "integer", "boolean", "array", "object", "null":
+		default:
+			return fmt.Errorf("unknown schema type %q", t)
+		}
+	}
+
+	s.nullable, _ = m["nullable"].(bool)
+	s.readOnly, _ = m["readOnly"].(bool)
+	s.format, _ = m["format"].(string)
+	s.uniqueItems, _ = m["uniqueItems"].(bool)
+	if enum, ok := m["enum"].([]interface{}); ok {
+		s.enum = enum
+	}
+	if v, ok := m["const"]; ok {
+		s.constant = &v
+	}
+
+	if s.minimum, err = numberKeyword(m, "minimum"); err != nil {
+		return err
+	}
+	if s.maximum, err = numberKeyword(m, "maximum"); err != nil {
+		return err
+	}
+	// exclusiveMinimum is a boolean in OpenAPI 3.0 and a number in 3.1
+	switch v := m["exclusiveMinimum"].(type) {
+	case bool:
+		s.exclusiveMinimum = v
+	case float64:
+		s.minimum, s.exclusiveMinimum = &v, true
+	}
+	switch v := m["exclusiveMaximum"].(type) {
+	case bool:
+		s.exclusiveMaximum = v
+	case float64:
+		s.maximum, s.exclusiveMaximum = &v, true
+	}
+	if v, ok := m["multipleOf"].(float64); ok {
+		if v <= 0 {
+			return errors.New("multipleOf must be greater than 0")
+		}
+		s.multipleOf, _ = new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	}
+
+	if s.minLength, err = countKeyword(m, "minLength"); err != nil {
+		return err
+	}
+	if s.maxLength, err = countKeyword(m, "maxLength"); err != nil {
+		return err
+	}
+	if s.minItems, err = countKeyword(m, "minItems"); err != nil {
+		return err
+	}
+	if s.maxItems, err = countKeyword(m, "maxItems"); err != nil {
+		return err
+	}
+	if s.minProperties, err = countKeyword(m, "minProperties"); err != nil {
+		return err
+	}
+	if s.maxProperties, err = countKeyword(m, "maxProperties"); err != nil {
+		return err
+	}
+
+	if p, ok := m["pattern"].(string); ok {
+		// patterns are ECMA 262 regular expressions, the common subset with
+		// RE2 is supported.
+		if s.pattern, err = regexp.Compile(p); err != nil {
+			return fmt.Errorf("unsupported pattern %q: %s", p, err.Error())
+		}
+	}
+
+	if s.items, err = c.compile(m["items"]); err != nil {
+		return err
+	}
+	if props, ok := m["properties"].(map[string]interface{}); ok {
+		s.properties = make(map[string]*schema, len(props))
+		for name, v := range props {
+			if s.properties[name], err = c.compile(v); err != nil {
+				return err
+			}
+		}
+	}
+	if req, ok := m["required"].([]interface{}); ok {
+		for _, v := range req {
+			if name, ok := v.(string); ok {
+				s.required = append(s.required, name)
+			}
+		}
+	}
+	if s.additional, err = c.compile(m["additionalProperties"]); err != nil {
+		return err
+	}
+
+	for _, k := range []struct {
+		name string
+		dst  *[]*schema
+	}{{"allOf", &s.allOf}, {"anyOf", &s.anyOf}, {"oneOf", &s.oneOf}} {
+		list, ok := m[k.name].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, v := range list {
+			sub, err := c.compile(v)
+			if err != nil {
+				return err
+			}
+			*k.dst = append(*k.dst, sub)
+		}
+	}
+	if s.not, err = c.compile(m["not"]); err != nil {
+		return err
+	}
+	return nil
+}
+
+func numberKeyword(m map[string]interface{}, name string) (*float64, error) {
+	switch v := m[name].(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return &v, nil
+	}
+	return nil, fmt.Errorf("invalid %s %v", name, m[name])
+}
+
+// countKeyword returns the value of a keyword like minLength, -1 if not set
+func countKeyword(m map[string]interface{}, name string) (int, error) {
+	switch v := m[name].(type) {
+	case nil:
+		return -1, nil
+	case float64:
+		if v >= 0 && v == math.Trunc(v) {
+			return int(v), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s %v", name, m[name])
+}
+
+// validationError is a value not matching a schema, at the JSON pointer
+// path of the value.
+type validationError struct {
+	path string
+	msg  string
+}
+
+func (e *validationError) Error() string {
+	if e.path == "" {
+		return e.msg
+	}
+	return "at " + e.path + ": " + e.msg
+}
+
+func invalid(path, format string, args ...interface{}) error {
+	return &validationError{path: path, msg: fmt.Sprintf(format, args...)}
+}
+
+// validate validates v, a value decoded by encoding/json with UseNumber.
+func (s *schema) validate(v interface{}, path string, depth int) error {
+	if s == nil {
+		return nil
+	}
+	if depth > maxValidationDepth {
+		return invalid(path, "schemas nested too deeply")
+	}
+	if s.never {
+		return invalid(path, "no value is allowed")
+	}
+
+	if len(s.types) > 0 && !(v == nil && s.nullable) {
+		found := false
+		for _, t := range s.types {
+			if hasType(v, t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return invalid(path, "expected %s, got %s", strings.Join(s.types, " or "), describe(v))
+		}
+	}
+	if len(s.enum) > 0 {
+		found := false
+		for _, e := range s.enum {
+			if equal(v, e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return invalid(path, "%s is not one of the allowed values", describe(v))
+		}
+	}
+	if s.constant != nil && !equal(v, *s.constant) {
+		return invalid(path, "%s is not the allowed value", describe(v))
+	}
+
+	var err error
+	switch v := v.(type) {
+	case json.Number:
+		err = s.validateNumber(v, path)
+	case string:
+		err = s.validateString(v, path)
+	case []interface{}:
+		err = s.validateArray(v, path, depth)
+	case map[string]interface{}:
+		err = s.validateObject(v, path, depth)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range s.allOf {
+		if err := sub.validate(v, path, depth+1); err != nil {
+			return err
+		}
+	}
+	if len(s.anyOf) > 0 {
+		matched := false
+		for _, sub := range s.anyOf {
+			if sub.validate(v, path, depth+1) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return invalid(path, "value does not match any of the allowed schemas")
+		}
+	}
+	if len(s.oneOf) > 0 {
+		matches := 0
+		for _, sub := range s.oneOf {
+			if sub.validate(v, path, depth+1) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return invalid(path, "value matches %d schemas instead of exactly one", matches)
+		}
+	}
+	if s.not != nil && s.not.validate(v, path, depth+1) == nil {
+		return invalid(path, "value matches a disallowed schema")
+	}
+	return nil
+}
+
+func (s *schema) validateNumber(n json.Number, path string) error {
+	f, err := n.Float64()
+	if err != nil {
+		return invalid(path, "invalid number %s", n)
+	}
+	switch s.format {
+	case "int32":
+		if i, err := strconv.ParseInt(string(n), 10, 64); err != nil || i < math.MinInt32 || i > math.MaxInt32 {
+			return invalid(path, "%s is not a valid int32", n)
+		}
+	case "int64":
+		if _, err := strconv.ParseInt(string(n), 10, 64); err != nil {
+			return invalid(path, "%s is not a valid int64", n)
+		}
+	}
+	if s.minimum != nil {
+		if s.exclusiveMinimum && f <= *s.minimum {
+			return invalid(path, "%s must be greater than %v", n, *s.minimum)
+		}
+		if f < *s.minimum {
+			return invalid(path, "%s must be at least %v", n, *s.minimum)
+		}
+	}
+	if s.maximum != nil {
+		if s.exclusiveMaximum && f >= *s.maximum {
+			return invalid(path, "%s must be less than %v", n, *s.maximum)
+		}
+		if f > *s.maximum {
+			return invalid(path, "%s must be at most %v", n, *s.maximum)
+		}
+	}
+	if s.multipleOf != nil {
+		r, ok := new(big.Rat).SetString(string(n))
+		if !ok || !r.Quo(r, s.multipleOf).IsInt() {
+			return invalid(path, "%s is not a multiple of %s", n, s.multipleOf.RatString())
+		}
+	}
+	return nil
+}
+
+func (s *schema) validateString(v, path string) error {
+	if s.minLength >= 0 || s.maxLength >= 0 {
+		n := utf8.RuneCountInString(v)
+		if s.minLength >= 0 && n < s.minLength {
+			return invalid(path, "value must be at least %d characters long", s.minLength)
+		}
+		if s.maxLength >= 0 && n > s.maxLength {
+			return invalid(path, "value must be at most %d characters long", s.maxLength)
+		}
+	}
+	if s.pattern != nil && !s.pattern.MatchString(v) {
+		return invalid(path, "%s does not match pattern %q", describe(v), s.pattern.String())
+	}
+	if !validFormat(s.format, v) {
+		return invalid(path, "%s is not a valid %s", describe(v), s.format)
+	}
+	return nil
+}
+
+func (s *schema) validateArray(v []interface{}, path string, depth int) error {
+	if s.minItems >= 0 && len(v) < s.minItems {
+		return invalid(path, "array must have at least %d items", s.minItems)
+	}
+	if s.maxItems >= 0 && len(v) > s.maxItems {
+		return invalid(path, "array must have at most %d items", s.maxItems)
+	}
+	if s.uniqueItems {
+		seen := make(map[string]bool, len(v))
+		for _, item := range v {
+			b, _ := json.Marshal(item)
+			if seen[string(b)] {
+				return invalid(path, "array items must be unique")
+			}
+			seen[string(b)] = true
+		}
+	}
+	if s.items != nil {
+		for i, item := range v {
+			if err := s.items.validate(item, path+"/"+strconv.Itoa(i), depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *schema) validateObject(v map[string]interface{}, path string, depth int) error {
+	if s.minProperties >= 0 && len(v) < s.minProperties {
+		return invalid(path, "object must have at least %d properties", s.minProperties)
+	}
+	if s.maxProperties >= 0 && len(v) > s.maxProperties {
+		return invalid(path, "object must have at most %d properties", s.maxProperties)
+	}
+	for _, name := range s.required {
+		if _, ok := v[name]; ok {
+			continue
+		}
+		// read only properties are only required in responses
+		if p := s.properties[name]; p != nil && p.readOnly {
+			continue
+		}
+		return invalid(path, "missing required property %q", name)
+	}
+
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sub, ok := s.properties[name]
+		if !ok {
+			sub = s.additional
+			if sub != nil && sub.never {
+				return invalid(path, "property %q is not allowed", name)
+			}
+		}
+		if err := sub.validate(v[name], path+"/"+escapePointer(name), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func escapePointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+func hasType(v interface{}, t string) bool {
+	switch v := v.(type) {
+	case nil:
+		return t == "null"
+	case bool:
+		return t == "boolean"
+	case string:
+		return t == "string"
+	case []interface{}:
+		return t == "array"
+	case map[string]interface{}:
+		return t == "object"
+	case json.Number:
+		if t == "number" {
+			return true
+		}
+		if t == "integer" {
+			r, ok := new(big.Rat).SetString(string(v))
+			return ok && r.IsInt()
+		}
+	}
+	return false
+}
+
+// describe describes a value for error messages
+func describe(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool, json.Number, float64:
+		return fmt.Sprint(v)
+	case string:
+		if len(v) > 32 {
+			return strconv.Quote(v[:32] + "...")
+		}
+		return strconv.Quote(v)
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// equal compares a value with a value of the specification, where numbers
+// are float64.
+func equal(a, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		f, err := a.Float64()
+		switch b := b.(type) {
+		case float64:
+			return err == nil && f == b
+		case json.Number:
+			g, err2 := b.Float64()
+			return err == nil && err2 == nil && f == g
+		}
+		return false
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			w, ok := b[k]
+			if !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// validFormat checks the string formats defined by OpenAPI and JSON Schema,
+// unknown formats are always valid.
+func validFormat(format, v string) bool {
+	switch format {
+	case "date":
+		_, err := time.Parse("2006-01-02", v)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339Nano, v)
+		return err == nil
+	case "email":
+		addr, err := mail.ParseAddress(v)
+		return err == nil && addr.Address == v
+	case "uuid":
+		return uuidRe.MatchString(v)
+	case "ipv4":
+		ip := net.ParseIP(v)
+		return ip != nil && ip.To4() != nil && !strings.Contains(v, ":")
+	case "ipv6":
+		return net.ParseIP(v) != nil && strings.Contains(v, ":")
+	case "uri":
+		u, err := url.Parse(v)
+		return err == nil && u.IsAbs()
+	case "byte":
+		_, err := base64.StdEncoding.DecodeString(v)
+		return err == nil
+	}
+	return true
+}
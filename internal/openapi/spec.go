@@ -0,0 +1,595 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openapi implements a positive security model on top of OpenAPI 3
+// specifications: requests are rejected unless their path, method,
+// parameters and body are described by the specification.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+var pathParamRe = regexp.MustCompile(`\{([^{}/]+)\}`)
+
+// Spec is a compiled OpenAPI 3 specification
+type Spec struct {
+	// basePaths are the paths of the server URLs, the paths of the
+	// specification are relative to them.
+	basePaths []string
+	routes    []*route
+}
+
+var _ plugintypes.OpenAPISpec = (*Spec)(nil)
+
+// route is a path of the specification, e.g. /users/{id}
+type route struct {
+	template string
+	re       *regexp.Regexp
+	// names are the names of the path parameters, concrete paths are
+	// matched before templated ones.
+	names      []string
+	operations map[string]*operation
+}
+
+type operation struct {
+	method     string
+	route      *route
+	parameters []*parameter
+	body       *requestBody
+}
+
+type parameter struct {
+	name     string
+	in       string
+	required bool
+	schema   *schema
+	// explode is only used by query parameters, non exploded arrays are comma
+	// separated values.
+	explode bool
+	// json is set for parameters described by an application/json content
+	json bool
+}
+
+type requestBody struct {
+	required bool
+	// content maps the media types, maybe with wildcards, to their schema
+	content map[string]*schema
+}
+
+// document is a decoded OpenAPI document
+type document struct {
+	root map[string]interface{}
+}
+
+// Load compiles an OpenAPI 3.0 or 3.1 specification in JSON or YAML format.
+// Only local references are supported.
+func Load(data []byte) (*Spec, error) {
+	var v interface{}
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\ufeff")))
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, &v); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %s", err.Error())
+		}
+	} else {
+		var err error
+		if v, err = decodeYAML(data); err != nil {
+			return nil, err
+		}
+	}
+
+	root, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid OpenAPI document")
+	}
+	version, _ := root["openapi"].(string)
+	if !strings.HasPrefix(version, "3.") {
+		if _, ok := root["swagger"]; ok {
+			return nil, errors.New("swagger 2.0 documents are not supported")
+		}
+		return nil, fmt.Errorf("unsupported OpenAPI version %q", version)
+	}
+
+	doc := &document{root: root}
+	c := &schemaCompiler{doc: doc, refs: map[string]*schema{}}
+	spec := &Spec{basePaths: serverPaths(root["servers"])}
+
+	paths, _ := root["paths"].(map[string]interface{})
+	for template, item := range paths {
+		r, err := compileRoute(c, template, item)
+		if err != nil {
+			return nil, fmt.Errorf("path %q: %s", template, err.Error())
+		}
+		spec.routes = append(spec.routes, r)
+	}
+	sort.Slice(spec.routes, func(i, j int) bool {
+		a, b := spec.routes[i], spec.routes[j]
+		if len(a.names) != len(b.names) {
+			return len(a.names) < len(b.names)
+		}
+		return a.template < b.template
+	})
+	return spec, nil
+}
+
+// resolve resolves a local reference, e.g. #/components/schemas/User
+func (d *document) resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("unsupported reference %q, only local references are supported", ref)
+	}
+	pointer, err := url.PathUnescape(ref[1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference %q", ref)
+	}
+	var v interface{} = d.root
+	if pointer == "" {
+		return v, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("invalid reference %q", ref)
+	}
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		ok := false
+		switch cur := v.(type) {
+		case map[string]interface{}:
+			v, ok = cur[token]
+		case []interface{}:
+			if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(cur) {
+				v, ok = cur[i], true
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("unresolved reference %q", ref)
+		}
+	}
+	return v, nil
+}
+
+// deref returns the object v, following its reference if any. Chains of
+// references are bounded to detect cycles.
+func (d *document) deref(v interface{}) (map[string]interface{}, error) {
+	for i := 0; i < 32; i++ {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid object %v", v)
+		}
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m, nil
+		}
+		var err error
+		if v, err = d.resolve(ref); err != nil {
+			return nil, err
+		}
+	}
+	return nil, errors.New("circular reference")
+}
+
+// serverPaths returns the base paths of the server URLs, the server
+// variables are replaced by their default value.
+func serverPaths(v interface{}) []string {
+	servers, _ := v.([]interface{})
+	seen := map[string]bool{}
+	var paths []string
+	for _, s := range servers {
+		server, _ := s.(map[string]interface{})
+		u, _ := server["url"].(string)
+		vars, _ := server["variables"].(map[string]interface{})
+		u = pathParamRe.ReplaceAllStringFunc(u, func(m string) string {
+			variable, _ := vars[m[1:len(m)-1]].(map[string]interface{})
+			def, _ := variable["default"].(string)
+			return def
+		})
+		if i := strings.Index(u, "://"); i >= 0 {
+			u = u[i+3:]
+			if j := strings.IndexByte(u, '/'); j >= 0 {
+				u = u[j:]
+			} else {
+				u = ""
+			}
+		}
+		u = strings.TrimRight(u, "/")
+		if !seen[u] {
+			seen[u] = true
+			paths = append(paths, u)
+		}
+	}
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+	return paths
+}
+
+func compileRoute(c *schemaCompiler, template string, v interface{}) (*route, error) {
+	if !strings.HasPrefix(template, "/") {
+		return nil, errors.New("paths must start with a slash")
+	}
+	item, err := c.doc.deref(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// each path parameter matches a part of a segment, e.g.
+	// /files/{name}.{ext}
+	var expr strings.Builder
+	expr.WriteByte('^')
+	last := 0
+	var names []string
+	for _, loc := range pathParamRe.FindAllStringSubmatchIndex(template, -1) {
+		expr.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		expr.WriteString("([^/]+)")
+		names = append(names, template[loc[2]:loc[3]])
+		last = loc[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(template[last:]))
+	expr.WriteByte('$')
+	r := &route{
+		template:   template,
+		re:         regexp.MustCompile(expr.String()),
+		names:      names,
+		operations: map[string]*operation{},
+	}
+
+	common, err := compileParameters(c, item["parameters"])
+	if err != nil {
+		return nil, err
+	}
+	for _, method := range methods {
+		ov, ok := item[method]
+		if !ok {
+			continue
+		}
+		op, err := compileOperation(c, ov, common)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", strings.ToUpper(method), err.Error())
+		}
+		op.method = strings.ToUpper(method)
+		op.route = r
+		for _, name := range names {
+			if op.parameter("path", name) == nil {
+				// undocumented path parameters are accepted as strings
+				op.parameters = append(op.parameters, &parameter{name: name, in: "path", required: true})
+			}
+		}
+		r.operations[op.method] = op
+	}
+	return r, nil
+}
+
+func compileOperation(c *schemaCompiler, v interface{}, common []*parameter) (*operation, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid operation")
+	}
+	params, err := compileParameters(c, m["parameters"])
+	if err != nil {
+		return nil, err
+	}
+	op := &operation{parameters: params}
+	// the parameters of the operation override the ones of the path
+	for _, p := range common {
+		if op.parameter(p.in, p.name) == nil {
+			op.parameters = append(op.parameters, p)
+		}
+	}
+
+	if rb, ok := m["requestBody"]; ok {
+		rbm, err := c.doc.deref(rb)
+		if err != nil {
+			return nil, err
+		}
+		op.body = &requestBody{content: map[string]*schema{}}
+		op.body.required, _ = rbm["required"].(bool)
+		content, _ := rbm["content"].(map[string]interface{})
+		for mediaType, mt := range content {
+			mtm, _ := mt.(map[string]interface{})
+			s, err := c.compile(mtm["schema"])
+			if err != nil {
+				return nil, fmt.Errorf("request body %q: %s", mediaType, err.Error())
+			}
+			op.body.content[strings.ToLower(mediaType)] = s
+		}
+	}
+	return op, nil
+}
+
+func compileParameters(c *schemaCompiler, v interface{}) ([]*parameter, error) {
+	list, _ := v.([]interface{})
+	params := make([]*parameter, 0, len(list))
+	for _, pv := range list {
+		m, err := c.doc.deref(pv)
+		if err != nil {
+			return nil, err
+		}
+		p := &parameter{explode: true}
+		p.name, _ = m["name"].(string)
+		p.in, _ = m["in"].(string)
+		p.required, _ = m["required"].(bool)
+		if p.name == "" {
+			return nil, errors.New("parameters must have a name")
+		}
+		switch p.in {
+		case "path", "query", "header", "cookie":
+		default:
+			return nil, fmt.Errorf("parameter %q: invalid location %q", p.name, p.in)
+		}
+		if explode, ok := m["explode"].(bool); ok {
+			p.explode = explode
+		} else if style, ok := m["style"].(string); ok && style != "form" {
+			p.explode = false
+		}
+
+		sv := m["schema"]
+		if content, ok := m["content"].(map[string]interface{}); ok {
+			for mediaType, mt := range content {
+				mtm, _ := mt.(map[string]interface{})
+				sv = mtm["schema"]
+				p.json = isJSON(mediaType)
+			}
+		}
+		if p.schema, err = c.compile(sv); err != nil {
+			return nil, fmt.Errorf("parameter %q: %s", p.name, err.Error())
+		}
+		params = append(params, p)
+	}
+	return params, nil
+}
+
+func (op *operation) parameter(in, name string) *parameter {
+	for _, p := range op.parameters {
+		if p.in == in && p.name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// ValidateRequest validates a request against the specification. It returns
+// the values of the path parameters of the matching operation, even for
+// invalid requests, and the first violation of the specification.
+func (s *Spec) ValidateRequest(r *plugintypes.OpenAPIRequest) (map[string]string, error) {
+	path, ok := s.relativePath(r.Path)
+	if !ok {
+		return nil, fmt.Errorf("path %q is not under a server URL of the specification", r.Path)
+	}
+
+	var (
+		rt    *route
+		match []string
+	)
+	for _, candidate := range s.routes {
+		if match = candidate.re.FindStringSubmatch(path); match != nil {
+			rt = candidate
+			break
+		}
+	}
+	if rt == nil {
+		return nil, fmt.Errorf("no path of the specification matches %q", r.Path)
+	}
+
+	pathParams := map[string]string{}
+	for i, name := range rt.names {
+		value, err := url.PathUnescape(match[i+1])
+		if err != nil {
+			return pathParams, fmt.Errorf("path parameter %q: invalid escaping", name)
+		}
+		pathParams[name] = value
+	}
+
+	op, ok := rt.operations[strings.ToUpper(r.Method)]
+	if !ok {
+		return pathParams, fmt.Errorf("method %s is not allowed for path %q", r.Method, rt.template)
+	}
+	for _, p := range op.parameters {
+		if err := p.validate(r, pathParams); err != nil {
+			return pathParams, fmt.Errorf("%s parameter %q: %s", p.in, p.name, err.Error())
+		}
+	}
+	if err := op.validateBody(r); err != nil {
+		return pathParams, err
+	}
+	return pathParams, nil
+}
+
+// relativePath returns the path relative to a server URL
+func (s *Spec) relativePath(path string) (string, bool) {
+	for _, base := range s.basePaths {
+		if base == "" {
+			return path, true
+		}
+		if path == base {
+			return "/", true
+		}
+		if strings.HasPrefix(path, base+"/") {
+			return path[len(base):], true
+		}
+	}
+	return "", false
+}
+
+func (p *parameter) validate(r *plugintypes.OpenAPIRequest, pathParams map[string]string) error {
+	var values []string
+	switch p.in {
+	case "path":
+		values = []string{pathParams[p.name]}
+	case "query":
+		values = call(r.Query, p.name)
+	case "header":
+		switch strings.ToLower(p.name) {
+		case "accept", "content-type", "authorization":
+			// ignored by OpenAPI
+			return nil
+		}
+		values = call(r.Header, p.name)
+	case "cookie":
+		values = call(r.Cookie, p.name)
+	}
+	if len(values) == 0 {
+		if p.required {
+			return errors.New("missing required parameter")
+		}
+		return nil
+	}
+	if p.schema == nil {
+		return nil
+	}
+
+	if p.json {
+		var v interface{}
+		d := json.NewDecoder(strings.NewReader(values[0]))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return errors.New("value is not valid JSON")
+		}
+		return p.schema.validate(v, "", 0)
+	}
+
+	if !p.schema.is("array") {
+		return p.schema.validate(coerce(p.schema, values[0]), "", 0)
+	}
+	// non exploded arrays and the simple style of path and header parameters
+	// are comma separated values.
+	if p.in != "query" || !p.explode {
+		var split []string
+		for _, v := range values {
+			split = append(split, strings.Split(v, ",")...)
+		}
+		values = split
+	}
+	items := make([]interface{}, len(values))
+	for i, v := range values {
+		items[i] = coerce(p.schema.items, v)
+	}
+	return p.schema.validate(items, "", 0)
+}
+
+func call(f func(string) []string, name string) []string {
+	if f == nil {
+		return nil
+	}
+	return f(name)
+}
+
+// is returns whether the schema only allows the type t
+func (s *schema) is(t string) bool {
+	return s != nil && len(s.types) == 1 && s.types[0] == t
+}
+
+// coerce converts a parameter value into the type of the schema, values
+// that cannot be converted are left as strings and rejected by the schema.
+func coerce(s *schema, v string) interface{} {
+	if s == nil {
+		return v
+	}
+	for _, t := range s.types {
+		switch t {
+		case "integer", "number":
+			if _, err := strconv.ParseFloat(v, 64); err == nil && !strings.ContainsAny(v, "xXnN_") {
+				return json.Number(v)
+			}
+		case "boolean":
+			if v == "true" || v == "false" {
+				return v == "true"
+			}
+		}
+	}
+	return v
+}
+
+func isJSON(mediaType string) bool {
+	mediaType = strings.ToLower(mediaType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func (op *operation) validateBody(r *plugintypes.OpenAPIRequest) error {
+	if op.body == nil || r.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %s", err.Error())
+	}
+	if len(body) == 0 {
+		if op.body.required {
+			return errors.New("request body is required")
+		}
+		return nil
+	}
+	if len(op.body.content) == 0 {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.ContentType)
+	if err != nil {
+		return fmt.Errorf("invalid content type %q", r.ContentType)
+	}
+	s, ok := op.body.content[mediaType]
+	if !ok {
+		if s, ok = op.body.content[mediaType[:strings.IndexByte(mediaType, '/')+1]+"*"]; !ok {
+			if s, ok = op.body.content["*/*"]; !ok {
+				return fmt.Errorf("content type %q is not allowed for %s %s", mediaType, op.method, op.route.template)
+			}
+		}
+	}
+	if s == nil {
+		return nil
+	}
+
+	var v interface{}
+	switch {
+	case isJSON(mediaType):
+		d := json.NewDecoder(bytes.NewReader(body))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return errors.New("request body is not valid JSON")
+		}
+		if _, err := d.Token(); err != io.EOF {
+			return errors.New("request body is not valid JSON")
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return errors.New("request body is not a valid form")
+		}
+		obj := make(map[string]interface{}, len(form))
+		for name, values := range form {
+			prop := s.properties[name]
+			if prop.is("array") {
+				items := make([]interface{}, len(values))
+				for i, value := range values {
+					items[i] = coerce(prop.items, value)
+				}
+				obj[name] = items
+			} else {
+				obj[name] = coerce(prop, values[0])
+			}
+		}
+		v = obj
+	default:
+		// other media types are only checked against the allowed ones
+		return nil
+	}
+	if err := s.validate(v, "", 0); err != nil {
+		var ve *validationError
+		if errors.As(err, &ve) && ve.path == "" {
+			return errors.New("request body: " + ve.msg)
+		}
+		return errors.New("request body " + err.Error())
+	}
+	return nil
+}
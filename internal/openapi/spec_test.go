@@ -0,0 +1,324 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+  - url: https://{host}/api/{version}
+    variables:
+      host:
+        default: example.com
+      version:
+        default: v1
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            format: int32
+            minimum: 1
+            maximum: 100
+        - name: tags
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+              enum: [cat, dog]
+        - name: X-Request-ID
+          in: header
+          required: true
+          schema:
+            type: string
+            format: uuid
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+          application/x-www-form-urlencoded:
+            schema:
+              $ref: '#/components/schemas/Pet'
+  /pets/mine:
+    get: {}
+  /pets/{petId}:
+    parameters:
+      - $ref: '#/components/parameters/PetId'
+    get: {}
+    delete:
+      parameters:
+        - name: session
+          in: cookie
+          required: true
+          schema:
+            type: string
+            pattern: '^[a-f0-9]{8}$'
+  /files/{name}.{ext}:
+    get:
+      parameters:
+        - name: ext
+          in: path
+          required: true
+          schema:
+            type: string
+            enum: [png, jpg]
+components:
+  parameters:
+    PetId:
+      name: petId
+      in: path
+      required: true
+      schema:
+        type: integer
+        minimum: 1
+  schemas:
+    Pet:
+      type: object
+      additionalProperties: false
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+          readOnly: true
+        name:
+          type: string
+          minLength: 1
+          maxLength: 20
+        age:
+          type: number
+          exclusiveMinimum: true
+          minimum: 0
+        birthday:
+          type: string
+          format: date
+        owner:
+          $ref: '#/components/schemas/Owner'
+        children:
+          type: array
+          maxItems: 2
+          uniqueItems: true
+          items:
+            $ref: '#/components/schemas/Pet'
+        kind:
+          oneOf:
+            - type: string
+              enum: [cat]
+            - type: string
+              enum: [dog]
+    Owner:
+      type: object
+      nullable: true
+      properties:
+        email:
+          type: string
+          format: email
+`
+
+type request struct {
+	method, target string
+	header         http.Header
+	body           string
+}
+
+func (r request) openAPI() *plugintypes.OpenAPIRequest {
+	u, _ := url.Parse(r.target)
+	req := &plugintypes.OpenAPIRequest{
+		Method: r.method,
+		Path:   u.Path,
+		Query:  func(name string) []string { return u.Query()[name] },
+		Header: func(name string) []string { return r.header.Values(name) },
+		Cookie: func(name string) []string {
+			c, err := (&http.Request{Header: r.header}).Cookie(name)
+			if err != nil {
+				return nil
+			}
+			return []string{c.Value}
+		},
+		ContentType: r.header.Get("Content-Type"),
+		Body:        strings.NewReader(r.body),
+	}
+	return req
+}
+
+func TestValidateRequest(t *testing.T) {
+	spec, err := Load([]byte(petstore))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := http.Header{"X-Request-Id": {"4f0a2b8e-1c3d-4e5f-8a9b-0c1d2e3f4a5b"}}
+	jsonBody := http.Header{"Content-Type": {"application/json; charset=utf-8"}}
+	form := http.Header{"Content-Type": {"application/x-www-form-urlencoded"}}
+	tests := []struct {
+		name    string
+		request request
+		err     string
+		params  map[string]string
+	}{
+		{name: "valid query", request: request{"GET", "/api/v1/pets?limit=10&tags=cat&tags=dog", id, ""}},
+		{name: "concrete path first", request: request{"GET", "/api/v1/pets/mine", nil, ""}},
+		{
+			name:    "path parameter",
+			request: request{"GET", "/api/v1/pets/42", nil, ""},
+			params:  map[string]string{"petId": "42"},
+		},
+		{
+			name:    "partial segments",
+			request: request{"GET", "/api/v1/files/logo.png", nil, ""},
+			params:  map[string]string{"name": "logo", "ext": "png"},
+		},
+		{name: "unknown path", request: request{"GET", "/api/v1/users", nil, ""}, err: `no path of the specification matches "/api/v1/users"`},
+		{name: "outside of the servers", request: request{"GET", "/pets", nil, ""}, err: `path "/pets" is not under a server URL`},
+		{name: "unknown method", request: request{"PUT", "/api/v1/pets", nil, ""}, err: `method PUT is not allowed for path "/pets"`},
+		{
+			name:    "invalid path parameter",
+			request: request{"GET", "/api/v1/pets/abc", nil, ""},
+			err:     `path parameter "petId": expected integer, got "abc"`,
+			params:  map[string]string{"petId": "abc"},
+		},
+		{name: "path parameter range", request: request{"GET", "/api/v1/pets/0", nil, ""}, err: "0 must be at least 1"},
+		{name: "path parameter enum", request: request{"GET", "/api/v1/files/logo.gif", nil, ""}, err: `"gif" is not one of the allowed values`},
+		{name: "invalid query parameter", request: request{"GET", "/api/v1/pets?limit=1.5", id, ""}, err: `query parameter "limit": expected integer, got 1.5`},
+		{name: "query parameter range", request: request{"GET", "/api/v1/pets?limit=101", id, ""}, err: "101 must be at most 100"},
+		{name: "query parameter format", request: request{"GET", "/api/v1/pets?limit=9999999999", id, ""}, err: "is not a valid int32"},
+		{name: "query array item", request: request{"GET", "/api/v1/pets?tags=cat&tags=bird", id, ""}, err: `at /1: "bird" is not one of the allowed values`},
+		{name: "missing header", request: request{"GET", "/api/v1/pets", nil, ""}, err: `header parameter "X-Request-ID": missing required parameter`},
+		{name: "header format", request: request{"GET", "/api/v1/pets", http.Header{"X-Request-Id": {"1"}}, ""}, err: `"1" is not a valid uuid`},
+		{name: "cookie", request: request{"DELETE", "/api/v1/pets/1", http.Header{"Cookie": {"session=0123abcd"}}, ""}},
+		{name: "missing cookie", request: request{"DELETE", "/api/v1/pets/1", nil, ""}, err: `cookie parameter "session": missing required parameter`},
+		{name: "cookie pattern", request: request{"DELETE", "/api/v1/pets/1", http.Header{"Cookie": {"session=xyz"}}, ""}, err: "does not match pattern"},
+		{
+			name:    "valid body",
+			request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"Rex","age":3,"birthday":"2020-01-31","owner":{"email":"a@example.com"},"children":[{"name":"Bo"}],"kind":"dog"}`},
+		},
+		{name: "null owner", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"Rex","owner":null}`}},
+		{name: "missing body", request: request{"POST", "/api/v1/pets", jsonBody, ""}, err: "request body is required"},
+		{name: "invalid JSON", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":`}, err: "request body is not valid JSON"},
+		{name: "trailing data", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a"} {}`}, err: "request body is not valid JSON"},
+		{name: "wrong type", request: request{"POST", "/api/v1/pets", jsonBody, `[]`}, err: "request body: expected object, got array"},
+		{name: "missing property", request: request{"POST", "/api/v1/pets", jsonBody, `{"age":1}`}, err: `request body: missing required property "name"`},
+		{name: "unknown property", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","admin":true}`}, err: `property "admin" is not allowed`},
+		{name: "string length", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":""}`}, err: "request body at /name: value must be at least 1 characters long"},
+		{name: "exclusive minimum", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","age":0}`}, err: "at /age: 0 must be greater than 0"},
+		{name: "date", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","birthday":"2020-02-30"}`}, err: `"2020-02-30" is not a valid date`},
+		{name: "email", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","owner":{"email":"nope"}}`}, err: "at /owner/email:"},
+		{name: "recursive", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","children":[{"name":1}]}`}, err: "at /children/0/name: expected string, got 1"},
+		{name: "max items", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","children":[{"name":"b"},{"name":"c"},{"name":"d"}]}`}, err: "array must have at most 2 items"},
+		{name: "unique items", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","children":[{"name":"b"},{"name":"b"}]}`}, err: "array items must be unique"},
+		{name: "one of", request: request{"POST", "/api/v1/pets", jsonBody, `{"name":"a","kind":"bird"}`}, err: "matches 0 schemas instead of exactly one"},
+		{name: "form body", request: request{"POST", "/api/v1/pets", form, "name=Rex&age=2"}},
+		{name: "invalid form body", request: request{"POST", "/api/v1/pets", form, "name=Rex&age=old"}, err: `at /age: expected number, got "old"`},
+		{name: "content type", request: request{"POST", "/api/v1/pets", http.Header{"Content-Type": {"text/plain"}}, "x"}, err: `content type "text/plain" is not allowed for POST /pets`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := spec.ValidateRequest(tt.request.openAPI())
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("unexpected error %v, want %q", err, tt.err)
+			}
+			for k, v := range tt.params {
+				if params[k] != v {
+					t.Errorf("unexpected path parameter %s=%q, want %q", k, params[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRequestBodyUnavailable(t *testing.T) {
+	spec, err := Load([]byte(petstore))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := request{"POST", "/api/v1/pets", nil, ""}.openAPI()
+	r.Body = nil
+	if _, err := spec.ValidateRequest(r); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+}
+
+func TestLoadJSON(t *testing.T) {
+	spec, err := Load([]byte(`{
+  "openapi": "3.1.0",
+  "paths": {
+    "/items/{id}": {
+      "put": {
+        "parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string", "format": "uuid"}}],
+        "requestBody": {
+          "content": {
+            "application/*": {"schema": {"type": ["object", "null"], "properties": {"n": {"type": "integer", "exclusiveMaximum": 10}}}},
+            "text/plain": {}
+          }
+        }
+      }
+    }
+  }
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		contentType, body, err string
+	}{
+		{"application/json", `{"n": 9}`, ""},
+		{"application/json", `null`, ""},
+		{"application/vnd.api+json", `{"n": 10}`, "at /n: 10 must be less than 10"},
+		{"text/plain", "anything", ""},
+		{"application/octet-stream", "\x00", ""},
+	}
+	for _, tt := range tests {
+		r := request{"PUT", "/items/4f0a2b8e-1c3d-4e5f-8a9b-0c1d2e3f4a5b", http.Header{"Content-Type": {tt.contentType}}, tt.body}
+		_, err := spec.ValidateRequest(r.openAPI())
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("unexpected error for %s: %s", tt.contentType, err.Error())
+			}
+		} else if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("unexpected error %v, want %q", err, tt.err)
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := map[string]string{
+		`{"swagger": "2.0"}`: "swagger 2.0 documents are not supported",
+		"openapi: 4.0.0\n":   `unsupported OpenAPI version "4.0.0"`,
+		"- a\n":              "invalid OpenAPI document",
+		"openapi: 3.0.0\npaths:\n  /a:\n    get:\n      parameters:\n        - $ref: 'other.yaml#/p'\n":                                                           "only local references are supported",
+		"openapi: 3.0.0\npaths:\n  /a:\n    $ref: '#/paths/~1a'\n":                                                                                                "circular reference",
+		"openapi: 3.0.0\npaths:\n  /a:\n    get:\n      parameters:\n        - {name: a, in: body}\n":                                                             `invalid location "body"`,
+		"openapi: 3.0.0\npaths:\n  /a:\n    post:\n      requestBody:\n        content:\n          application/json:\n            schema: {pattern: '(?<=a)b'}\n": "unsupported pattern",
+		"openapi: 3.0.0\npaths:\n  a: {}\n": "paths must start with a slash",
+	}
+	for doc, want := range tests {
+		_, err := Load([]byte(doc))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("unexpected error %v, want %q", err, want)
+		}
+	}
+}
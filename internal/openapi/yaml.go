@@ -0,0 +1,652 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	yamlIntRe   = regexp.MustCompile(`^[-+]?(0|[1-9][0-9]*)$`)
+	yamlFloatRe = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+)
+
+// yamlLine is a line of a YAML document. text has no indentation and no
+// comment, raw is the line as is, for block scalars.
+type yamlLine struct {
+	num    int
+	indent int
+	text   string
+	raw    string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// decodeYAML decodes the subset of YAML used by OpenAPI documents into the
+// values produced by encoding/json: map[string]interface{}, []interface{},
+// string, float64, bool and nil. Block and flow collections, plain and quoted
+// scalars and block scalars are supported. Anchors, aliases, tags, complex
+// keys and multiple documents are not.
+func decodeYAML(data []byte) (interface{}, error) {
+	if !utf8.Valid(data) {
+		return nil, errors.New("invalid YAML: document is not valid UTF-8")
+	}
+	p := &yamlParser{}
+	started := false
+	for i, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimSuffix(raw, "\r")
+		if i == 0 {
+			raw = strings.TrimPrefix(raw, "\ufeff")
+		}
+		trimmed := strings.TrimLeft(raw, " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("invalid YAML: line %d: tabs cannot be used for indentation", i+1)
+		}
+		text := strings.TrimRight(stripYAMLComment(trimmed), " \t")
+		switch {
+		case strings.HasPrefix(raw, "%"):
+			continue
+		case text == "---" || strings.HasPrefix(text, "--- "):
+			if started {
+				return nil, fmt.Errorf("invalid YAML: line %d: multiple documents are not supported", i+1)
+			}
+			started = true
+			continue
+		case text == "...":
+			continue
+		}
+		if text != "" {
+			started = true
+		}
+		p.lines = append(p.lines, yamlLine{num: i + 1, indent: len(raw) - len(trimmed), text: text, raw: raw})
+	}
+
+	p.skipBlank()
+	if p.pos == len(p.lines) {
+		return nil, nil
+	}
+	v, err := p.parseNode(p.lines[p.pos].indent, -1)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlank()
+	if p.pos < len(p.lines) {
+		return nil, p.errorf("unexpected content")
+	}
+	return v, nil
+}
+
+// stripYAMLComment removes the comment of a line, a # at the start of the
+// line or preceded by a space, outside of quoted scalars.
+func stripYAMLComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				if i+1 < len(s) && s[i+1] == '\'' {
+					i++
+				} else {
+					quote = 0
+				}
+			}
+		case quote == '"':
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				quote = 0
+			}
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		case (c == '\'' || c == '"') && (i == 0 || strings.IndexByte(" \t[{,", s[i-1]) >= 0):
+			quote = c
+		}
+	}
+	return s
+}
+
+func (p *yamlParser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if p.pos < len(p.lines) {
+		line = p.lines[p.pos].num
+	} else if len(p.lines) > 0 {
+		line = p.lines[len(p.lines)-1].num
+	}
+	return fmt.Errorf("invalid YAML: line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *yamlParser) skipBlank() {
+	for p.pos < len(p.lines) && p.lines[p.pos].text == "" {
+		p.pos++
+	}
+}
+
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// parseNode parses the node starting at the current line, indented by indent.
+// parent is the indentation of the enclosing collection.
+func (p *yamlParser) parseNode(indent, parent int) (interface{}, error) {
+	l := p.lines[p.pos]
+	if isSequenceItem(l.text) {
+		return p.parseSequence(indent)
+	}
+	if _, _, ok, err := splitYAMLKey(l.text); err != nil {
+		return nil, p.errorf("%s", err.Error())
+	} else if ok {
+		return p.parseMapping(indent)
+	}
+	p.pos++
+	return p.parseValue(l.text, parent, false)
+}
+
+func (p *yamlParser) parseSequence(indent int) ([]interface{}, error) {
+	seq := []interface{}{}
+	for {
+		p.skipBlank()
+		if p.pos == len(p.lines) {
+			return seq, nil
+		}
+		l := p.lines[p.pos]
+		if l.indent < indent || (l.indent == indent && !isSequenceItem(l.text)) {
+			return seq, nil
+		}
+		if l.indent > indent {
+			return nil, p.errorf("bad indentation of a sequence entry")
+		}
+
+		rest := strings.TrimLeft(l.text[1:], " ")
+		if rest == "" {
+			p.pos++
+			v, err := p.parseValue("", indent, false)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, v)
+			continue
+		}
+		// the content of the entry is parsed as if it was on its own line, so
+		// "- key: value" starts a mapping at the column of key.
+		col := indent + len(l.text) - len(rest)
+		p.lines[p.pos].indent = col
+		p.lines[p.pos].text = rest
+		v, err := p.parseNode(col, indent)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, v)
+	}
+}
+
+func (p *yamlParser) parseMapping(indent int) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	for {
+		p.skipBlank()
+		if p.pos == len(p.lines) {
+			return m, nil
+		}
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			return m, nil
+		}
+		if l.indent > indent {
+			return nil, p.errorf("bad indentation of a mapping entry")
+		}
+		key, rest, ok, err := splitYAMLKey(l.text)
+		if err != nil {
+			return nil, p.errorf("%s", err.Error())
+		}
+		if !ok {
+			return nil, p.errorf("expected a mapping key")
+		}
+		if _, dup := m[key]; dup {
+			return nil, p.errorf("duplicated key %q", key)
+		}
+		p.pos++
+		v, err := p.parseValue(rest, indent, true)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+}
+
+// parseValue parses a value starting with text, continued by the lines
+// indented further than parent. A mapping value can be a sequence at the
+// indentation of its key when sameIndentSeq is set.
+func (p *yamlParser) parseValue(text string, parent int, sameIndentSeq bool) (interface{}, error) {
+	if text == "" {
+		p.skipBlank()
+		if p.pos == len(p.lines) {
+			return nil, nil
+		}
+		l := p.lines[p.pos]
+		if l.indent > parent {
+			return p.parseNode(l.indent, parent)
+		}
+		if sameIndentSeq && l.indent == parent && isSequenceItem(l.text) {
+			return p.parseSequence(parent)
+		}
+		return nil, nil
+	}
+
+	switch text[0] {
+	case '&', '*', '!':
+		return nil, p.errorf("anchors, aliases and tags are not supported")
+	case '|', '>':
+		return p.parseBlockScalar(text, parent)
+	case '[', '{':
+		for !flowBalanced(text) && p.pos < len(p.lines) && p.lines[p.pos].indent > parent {
+			text += " " + p.lines[p.pos].text
+			p.pos++
+		}
+		v, err := parseFlow(text)
+		if err != nil {
+			return nil, p.errorf("%s", err.Error())
+		}
+		return v, nil
+	case '"', '\'':
+		v, rest, ok := readQuoted(text)
+		for !ok && p.pos < len(p.lines) {
+			// multi-line quoted scalars are folded
+			text += " " + strings.TrimSpace(p.lines[p.pos].raw)
+			p.pos++
+			v, rest, ok = readQuoted(text)
+		}
+		if !ok {
+			return nil, p.errorf("unterminated quoted scalar")
+		}
+		if strings.TrimSpace(rest) != "" {
+			return nil, p.errorf("unexpected content after quoted scalar")
+		}
+		return v, nil
+	}
+
+	// multi-line plain scalars are folded
+	for p.pos < len(p.lines) && p.lines[p.pos].text != "" && p.lines[p.pos].indent > parent {
+		if _, _, ok, _ := splitYAMLKey(p.lines[p.pos].text); ok {
+			return nil, p.errorf("bad indentation of a mapping entry")
+		}
+		text += " " + p.lines[p.pos].text
+		p.pos++
+	}
+	return resolvePlain(text), nil
+}
+
+func (p *yamlParser) parseBlockScalar(header string, parent int) (string, error) {
+	folded := header[0] == '>'
+	chomp := byte(0)
+	indent := 0
+	for _, c := range header[1:] {
+		switch {
+		case (c == '-' || c == '+') && chomp == 0:
+			chomp = byte(c)
+		case c >= '1' && c <= '9' && indent == 0:
+			indent = parent + 1
+			if parent < 0 {
+				indent = 0
+			}
+			indent += int(c - '0')
+		default:
+			return "", p.errorf("invalid block scalar header %q", header)
+		}
+	}
+
+	var lines []string
+	for ; p.pos < len(p.lines); p.pos++ {
+		l := p.lines[p.pos]
+		if strings.TrimSpace(l.raw) == "" {
+			lines = append(lines, "")
+			continue
+		}
+		if indent == 0 {
+			if l.indent <= parent {
+				break
+			}
+			indent = l.indent
+		}
+		if l.indent < indent {
+			break
+		}
+		lines = append(lines, l.raw[indent:])
+	}
+	// trailing blank lines only matter for the chomping
+	trailing := 0
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+		trailing++
+	}
+	var b strings.Builder
+	for i, line := range lines {
+		if i > 0 {
+			prev := lines[i-1]
+			switch {
+			case !folded || prev == "" || prev[0] == ' ':
+				b.WriteByte('\n')
+			case line == "":
+				// the line break before blank lines is folded, each blank
+				// line is a line break
+			case line[0] == ' ':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(line)
+	}
+	s := b.String()
+	switch chomp {
+	case '-':
+	case '+':
+		if len(lines) > 0 {
+			s += "\n"
+		}
+		s += strings.Repeat("\n", trailing)
+	default:
+		if len(lines) > 0 {
+			s += "\n"
+		}
+	}
+	return s, nil
+}
+
+// splitYAMLKey splits a "key: value" line, ok is false if text is not a
+// mapping entry.
+func splitYAMLKey(text string) (key, rest string, ok bool, err error) {
+	if text == "" || text[0] == '[' || text[0] == '{' || isSequenceItem(text) {
+		return "", "", false, nil
+	}
+	if text == "?" || strings.HasPrefix(text, "? ") {
+		return "", "", false, errors.New("complex keys are not supported")
+	}
+	if text[0] == '"' || text[0] == '\'' {
+		k, after, closed := readQuoted(text)
+		if !closed {
+			return "", "", false, nil
+		}
+		after = strings.TrimLeft(after, " ")
+		if after == ":" || strings.HasPrefix(after, ": ") {
+			return k.(string), strings.TrimSpace(after[1:]), true, nil
+		}
+		return "", "", false, nil
+	}
+	i := strings.Index(text, ": ")
+	if i < 0 {
+		if !strings.HasSuffix(text, ":") {
+			return "", "", false, nil
+		}
+		i = len(text) - 1
+	}
+	return strings.TrimRight(text[:i], " "), strings.TrimSpace(text[i+1:]), true, nil
+}
+
+// readQuoted reads the quoted scalar at the start of s and returns the
+// remaining text, ok is false if the scalar is not terminated.
+func readQuoted(s string) (interface{}, string, bool) {
+	var b strings.Builder
+	if s[0] == '\'' {
+		for i := 1; i < len(s); i++ {
+			if s[i] == '\'' {
+				if i+1 < len(s) && s[i+1] == '\'' {
+					b.WriteByte('\'')
+					i++
+					continue
+				}
+				return b.String(), s[i+1:], true
+			}
+			b.WriteByte(s[i])
+		}
+		return nil, "", false
+	}
+
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return b.String(), s[i+1:], true
+		case '\\':
+			if i+1 == len(s) {
+				return nil, "", false
+			}
+			i++
+			switch c := s[i]; c {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '0':
+				b.WriteByte(0)
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'e':
+				b.WriteByte(0x1b)
+			case ' ':
+				b.WriteByte(' ')
+			case 'x', 'u', 'U':
+				n := map[byte]int{'x': 2, 'u': 4, 'U': 8}[c]
+				if i+n >= len(s) {
+					return nil, "", false
+				}
+				r, err := strconv.ParseUint(s[i+1:i+1+n], 16, 32)
+				if err != nil {
+					return nil, "", false
+				}
+				b.WriteRune(rune(r))
+				i += n
+			default:
+				// \\, \", \/ and \t are kept as the escaped character
+				b.WriteByte(c)
+			}
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return nil, "", false
+}
+
+// resolvePlain resolves a plain scalar into a null, a boolean, a number or a
+// string, following the YAML 1.2 core schema.
+func resolvePlain(s string) interface{} {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	case ".inf", ".Inf", ".INF", "+.inf", "+.Inf", "+.INF":
+		return math.Inf(1)
+	case "-.inf", "-.Inf", "-.INF":
+		return math.Inf(-1)
+	case ".nan", ".NaN", ".NAN":
+		return math.NaN()
+	}
+	if yamlIntRe.MatchString(s) || yamlFloatRe.MatchString(s) {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	}
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'o') {
+		base := 16
+		if s[1] == 'o' {
+			base = 8
+		}
+		if n, err := strconv.ParseUint(s[2:], base, 64); err == nil {
+			return float64(n)
+		}
+	}
+	return s
+}
+
+// flowBalanced returns whether the brackets of a flow collection are closed
+func flowBalanced(s string) bool {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		}
+	}
+	return depth <= 0 && quote == 0
+}
+
+// parseFlow parses a flow collection, e.g. [a, b] or {a: 1, b: [c]}
+func parseFlow(s string) (interface{}, error) {
+	f := &flowParser{s: s}
+	v, err := f.parseNode(0)
+	if err != nil {
+		return nil, err
+	}
+	f.skipSpaces()
+	if f.pos < len(f.s) {
+		return nil, fmt.Errorf("unexpected %q after flow collection", f.s[f.pos:])
+	}
+	return v, nil
+}
+
+// maxFlowDepth limits the nesting of flow collections
+const maxFlowDepth = 64
+
+type flowParser struct {
+	s   string
+	pos int
+}
+
+func (f *flowParser) skipSpaces() {
+	for f.pos < len(f.s) && (f.s[f.pos] == ' ' || f.s[f.pos] == '\t') {
+		f.pos++
+	}
+}
+
+func (f *flowParser) parseNode(depth int) (interface{}, error) {
+	if depth > maxFlowDepth {
+		return nil, errors.New("flow collections nested too deeply")
+	}
+	f.skipSpaces()
+	if f.pos == len(f.s) {
+		return nil, errors.New("unterminated flow collection")
+	}
+	switch f.s[f.pos] {
+	case '[':
+		f.pos++
+		seq := []interface{}{}
+		for {
+			f.skipSpaces()
+			if f.pos < len(f.s) && f.s[f.pos] == ']' {
+				f.pos++
+				return seq, nil
+			}
+			v, err := f.parseNode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, v)
+			if err := f.separator(']'); err != nil {
+				return nil, err
+			}
+		}
+	case '{':
+		f.pos++
+		m := map[string]interface{}{}
+		for {
+			f.skipSpaces()
+			if f.pos < len(f.s) && f.s[f.pos] == '}' {
+				f.pos++
+				return m, nil
+			}
+			k, err := f.parseScalar(true)
+			if err != nil {
+				return nil, err
+			}
+			key := fmt.Sprint(k)
+			if k == nil {
+				key = ""
+			}
+			f.skipSpaces()
+			var v interface{}
+			if f.pos < len(f.s) && f.s[f.pos] == ':' {
+				f.pos++
+				f.skipSpaces()
+				if f.pos < len(f.s) && f.s[f.pos] != ',' && f.s[f.pos] != '}' {
+					if v, err = f.parseNode(depth + 1); err != nil {
+						return nil, err
+					}
+				}
+			}
+			m[key] = v
+			if err := f.separator('}'); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return f.parseScalar(false)
+}
+
+// separator consumes the comma between entries, leaving the closing bracket
+func (f *flowParser) separator(end byte) error {
+	f.skipSpaces()
+	if f.pos == len(f.s) {
+		return errors.New("unterminated flow collection")
+	}
+	switch f.s[f.pos] {
+	case ',':
+		f.pos++
+		return nil
+	case end:
+		return nil
+	}
+	return fmt.Errorf("unexpected %q in flow collection", f.s[f.pos])
+}
+
+func (f *flowParser) parseScalar(key bool) (interface{}, error) {
+	if c := f.s[f.pos]; c == '"' || c == '\'' {
+		v, rest, ok := readQuoted(f.s[f.pos:])
+		if !ok {
+			return nil, errors.New("unterminated quoted scalar")
+		}
+		f.pos = len(f.s) - len(rest)
+		return v, nil
+	}
+	start := f.pos
+	for ; f.pos < len(f.s); f.pos++ {
+		c := f.s[f.pos]
+		if c == ',' || c == ']' || c == '}' || c == '[' || c == '{' {
+			break
+		}
+		if c == ':' && (key || f.pos+1 == len(f.s) || strings.IndexByte(" ,]}", f.s[f.pos+1]) >= 0) {
+			break
+		}
+	}
+	text := strings.TrimSpace(f.s[start:f.pos])
+	if key {
+		return text, nil
+	}
+	return resolvePlain(text), nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDecodeYAML(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{
+			name: "mapping",
+			yaml: "a: 1\nb: text # comment\nc: 'it''s'\nd: \"tab\\there\"\n",
+			want: `{"a":1,"b":"text","c":"it's","d":"tab\there"}`,
+		},
+		{
+			name: "scalars",
+			yaml: "n: ~\nt: true\nf: False\nx: 0x1f\ne: 1e3\nv: 3.0.3\nk: key: value\n",
+			want: `{"e":1000,"f":false,"k":"key: value","n":null,"t":true,"v":"3.0.3","x":31}`,
+		},
+		{
+			name: "nested",
+			yaml: `
+# leading comment
+---
+paths:
+  /users/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+        -   name: limit
+            in: query
+      tags: [a, "b", {c: d}]
+  "/quoted": {}
+`,
+			want: `{"paths":{"/quoted":{},"/users/{id}":{"get":{"parameters":[{"in":"path","name":"id"},{"in":"query","name":"limit"}],"tags":["a","b",{"c":"d"}]}}}}`,
+		},
+		{
+			name: "sequence at the key indentation",
+			yaml: "required:\n- a\n- b\nnext: c\n",
+			want: `{"next":"c","required":["a","b"]}`,
+		},
+		{
+			name: "nested sequences",
+			yaml: "- - a\n  - b\n- c\n-\n  d: e\n",
+			want: `[["a","b"],"c",{"d":"e"}]`,
+		},
+		{
+			name: "block scalars",
+			yaml: "literal: |\n  line 1\n    line 2\n\nfolded: >-\n  a\n  b\n\n  c\nkept: |+\n  x\n\nlast: 1\n",
+			want: `{"folded":"a b\nc","kept":"x\n\n","last":1,"literal":"line 1\n  line 2\n"}`,
+		},
+		{
+			name: "multi-line scalars",
+			yaml: "plain: a\n  b\nquoted: \"c\n  d\"\nflow: [1,\n  2]\n",
+			want: `{"flow":[1,2],"plain":"a b","quoted":"c d"}`,
+		},
+		{
+			name: "urls",
+			yaml: "url: http://example.com:8080/v1\nflow: [http://a/b, {u: http://c}]\n",
+			want: `{"flow":["http://a/b",{"u":"http://c"}],"url":"http://example.com:8080/v1"}`,
+		},
+		{
+			name: "hash in values",
+			yaml: "a: 'x # y'\nb: c#d\n",
+			want: `{"a":"x # y","b":"c#d"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := decodeYAML([]byte(tt.yaml))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("unexpected value\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeYAMLErrors(t *testing.T) {
+	tests := map[string]string{
+		"a: &anchor 1\nb: *anchor\n": "anchors, aliases and tags are not supported",
+		"a: 1\n---\nb: 2\n":          "multiple documents are not supported",
+		"a:\n\tb: 1\n":               "tabs cannot be used for indentation",
+		"a: 1\na: 2\n":               `line 2: duplicated key "a"`,
+		"a: 1\n  b: 2\n":             "bad indentation",
+		"a: \"open\n":                "unterminated quoted scalar",
+		"a: [1, 2\n":                 "unterminated flow collection",
+		"? complex\n":                "complex keys are not supported",
+		"a: 1\n- b\n":                "expected a mapping key",
+	}
+	for doc, want := range tests {
+		_, err := decodeYAML([]byte(doc))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("unexpected error %v for %q, want %q", err, doc, want)
+		}
+	}
+}
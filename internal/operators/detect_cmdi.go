@@ -0,0 +1,34 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.detectCmdi
+
+package operators
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/cmdi"
+)
+
+// detectCmdi detects shell command injection with a shell lexer, it
+// captures the fingerprint of the injected tokens.
+type detectCmdi struct{}
+
+var _ plugintypes.Operator = (*detectCmdi)(nil)
+
+func newDetectCmdi(plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	return &detectCmdi{}, nil
+}
+
+func (o *detectCmdi) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	res, fingerprint := cmdi.IsCmdi(value)
+	if !res {
+		return false
+	}
+	tx.CaptureField(0, fingerprint)
+	return true
+}
+
+func init() {
+	Register("detectCmdi", newDetectCmdi)
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+var cmdiTests = []string{
+	"",
+	"this is not a command",
+	"; cat /etc/passwd",
+	"$(id)",
+	"';{cat,/etc/passwd};'",
+	"\"`whoami`\"",
+}
+
+func TestDetectCmdiCapture(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	tx.Capture = true
+	cmdi := &detectCmdi{}
+	if !cmdi.Evaluate(tx, "x;cat /etc/passwd") {
+		t.Fatal("expected a match")
+	}
+	if have := tx.Variables().TX().Get("0"); len(have) != 1 || have[0] != ";cw" {
+		t.Errorf("unexpected capture %q", have)
+	}
+}
+
+func FuzzCmdi(f *testing.F) {
+	for _, tc := range cmdiTests {
+		f.Add(tc)
+	}
+	cmdi := &detectCmdi{}
+	waf := corazawaf.NewWAF()
+	f.Fuzz(func(t *testing.T, tc string) {
+		tx := waf.NewTransaction()
+		defer tx.Close()
+		_ = cmdi.Evaluate(tx, tc)
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.detectPathTraversal
+
+package operators
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/pathtraversal"
+)
+
+// detectPathTraversal detects paths climbing above their base directory
+// once decoded, it captures the fingerprint of the encodings used.
+type detectPathTraversal struct{}
+
+var _ plugintypes.Operator = (*detectPathTraversal)(nil)
+
+func newDetectPathTraversal(plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	return &detectPathTraversal{}, nil
+}
+
+func (o *detectPathTraversal) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	res, fingerprint := pathtraversal.IsPathTraversal(value)
+	if !res {
+		return false
+	}
+	tx.CaptureField(0, fingerprint)
+	return true
+}
+
+func init() {
+	Register("detectPathTraversal", newDetectPathTraversal)
+}
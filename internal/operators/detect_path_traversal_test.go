@@ -0,0 +1,46 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+var pathTraversalTests = []string{
+	"",
+	"/images/logo.png",
+	"../../etc/passwd",
+	"..%252f..%252fetc%252fpasswd",
+	"%c0%ae%c0%ae%c0%afwindows",
+	"．．／．．／etc",
+}
+
+func TestDetectPathTraversalCapture(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	tx.Capture = true
+	pt := &detectPathTraversal{}
+	if !pt.Evaluate(tx, "..%2f..%5cboot.ini") {
+		t.Fatal("expected a match")
+	}
+	if have := tx.Variables().TX().Get("0"); len(have) != 1 || have[0] != "EB2" {
+		t.Errorf("unexpected capture %q", have)
+	}
+}
+
+func FuzzPathTraversal(f *testing.F) {
+	for _, tc := range pathTraversalTests {
+		f.Add(tc)
+	}
+	pt := &detectPathTraversal{}
+	waf := corazawaf.NewWAF()
+	f.Fuzz(func(t *testing.T, tc string) {
+		tx := waf.NewTransaction()
+		defer tx.Close()
+		_ = pt.Evaluate(tx, tc)
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.detectSSRF
+
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/ssrf"
+)
+
+// detectSSRF parses the value as a URL and matches when it targets a
+// loopback, link-local, private, unspecified or cloud metadata address, e.g.
+// SecRule ARGS:url "@detectSSRF". With "@detectSSRF resolve", host names are
+// also resolved and match when they resolve to such an address, the lookups
+// are bounded by SecSSRFResolveTimeout and cached by the WAF. The range is
+// captured in TX.0 and the host in TX.1.
+type detectSSRF struct {
+	resolve bool
+}
+
+// urlResolver is implemented by transactions resolving the host names of URLs
+type urlResolver interface {
+	ResolveURL(value string) (ssrf.Result, bool, error)
+}
+
+var _ plugintypes.Operator = (*detectSSRF)(nil)
+
+func newDetectSSRF(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	o := &detectSSRF{}
+	switch arg := strings.TrimSpace(options.Arguments); arg {
+	case "":
+	case "resolve":
+		o.resolve = true
+	default:
+		return nil, fmt.Errorf("unknown argument %q, expected resolve", arg)
+	}
+	return o, nil
+}
+
+func (o *detectSSRF) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	res, ok := ssrf.Check(value)
+	if r, canResolve := tx.(urlResolver); !ok && o.resolve && canResolve {
+		var err error
+		res, ok, err = r.ResolveURL(value)
+		if err != nil {
+			tx.DebugLogger().Debug().
+				Str("operator", "detectSSRF").
+				Err(err).
+				Msg("Failed to resolve host")
+		}
+	}
+	if !ok {
+		return false
+	}
+	if tx.Capturing() {
+		tx.CaptureField(0, res.Range)
+		tx.CaptureField(1, res.Host)
+	}
+	return true
+}
+
+func init() {
+	Register("detectSSRF", newDetectSSRF)
+}
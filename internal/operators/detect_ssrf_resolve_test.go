@@ -0,0 +1,91 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo
+// +build !tinygo
+
+package operators
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/foxcpp/go-mockdns"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/internal/ssrf"
+)
+
+func TestDetectSSRFResolve(t *testing.T) {
+	srv, err := mockdns.NewServer(map[string]mockdns.Zone{
+		"internal.example.com.": {
+			A: []string{"10.0.0.5"},
+		},
+		"public.example.com.": {
+			A: []string{"203.0.113.1"},
+		},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	resolver := &net.Resolver{}
+	srv.PatchNet(resolver)
+
+	op, err := newDetectSSRF(plugintypes.OperatorOptions{Arguments: "resolve"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	waf.SSRFResolver = ssrf.NewNetResolver(resolver)
+	tests := map[string]bool{
+		"http://internal.example.com/": true,
+		"http://public.example.com/":   false,
+		"http://missing.example.com/":  false,
+	}
+	for value, want := range tests {
+		tx := waf.NewTransaction()
+		if have := op.Evaluate(tx, value); have != want {
+			t.Errorf("unexpected result for %q: %t, want %t", value, have, want)
+		}
+		tx.Close()
+	}
+
+	t.Run("Cached host", func(t *testing.T) {
+		srv.Close()
+		tx := waf.NewTransaction()
+		defer tx.Close()
+		if !op.Evaluate(tx, "http://internal.example.com/") {
+			t.Error("expected the resolved host to be cached by the WAF")
+		}
+	})
+
+	t.Run("Cancelled transaction", func(t *testing.T) {
+		// lookups only end with their context
+		waf := corazawaf.NewWAF()
+		waf.SSRFResolver = ssrf.NewNetResolver(&net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		})
+		waf.SSRFResolver.Timeout = time.Minute
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		tx := waf.NewTransactionWithOptions(corazawaf.Options{Context: ctx})
+		defer tx.Close()
+		start := time.Now()
+		if op.Evaluate(tx, "http://internal.example.com/") {
+			t.Error("expected no resolution once the transaction is cancelled")
+		}
+		if elapsed := time.Since(start); elapsed > 10*time.Second {
+			t.Errorf("lookup not bound to the transaction, took %s", elapsed)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+var ssrfTests = []string{
+	"",
+	"https://example.com/",
+	"http://169.254.169.254/latest/meta-data/",
+	"http://0x7f.1/",
+	"http://example.com#@[::ffff:127.0.0.1]/",
+}
+
+func TestDetectSSRFCapture(t *testing.T) {
+	op, err := newDetectSSRF(plugintypes.OperatorOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	tx.Capture = true
+	if !op.Evaluate(tx, "http://example.com@0251.0376.0251.0376/") {
+		t.Fatal("expected a match")
+	}
+	for i, want := range []string{"metadata", "0251.0376.0251.0376"} {
+		if have := tx.Variables().TX().Get(strconv.Itoa(i)); len(have) != 1 || have[0] != want {
+			t.Errorf("unexpected capture %d: %q, want %q", i, have, want)
+		}
+	}
+}
+
+func TestDetectSSRFArguments(t *testing.T) {
+	op, err := newDetectSSRF(plugintypes.OperatorOptions{Arguments: "resolve"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !op.(*detectSSRF).resolve {
+		t.Error("expected resolution to be enabled")
+	}
+	if _, err := newDetectSSRF(plugintypes.OperatorOptions{Arguments: "lookup"}); err == nil {
+		t.Error("expected error for unknown argument")
+	}
+}
+
+func FuzzSSRF(f *testing.F) {
+	for _, tc := range ssrfTests {
+		f.Add(tc)
+	}
+	op := &detectSSRF{}
+	waf := corazawaf.NewWAF()
+	f.Fuzz(func(t *testing.T, tc string) {
+		tx := waf.NewTransaction()
+		defer tx.Close()
+		_ = op.Evaluate(tx, tc)
+	})
+}
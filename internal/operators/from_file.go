@@ -8,17 +8,26 @@ import (
 	"io/fs"
 	"os"
 	"path"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
 )
 
 var errEmptyDirs = errors.New("empty dirs")
 
 func loadFromFile(filepath string, dirs []string, root fs.FS) ([]byte, error) {
+	content, _, err := readFromFile(filepath, dirs, root)
+	return content, err
+}
+
+// readFromFile is loadFromFile also returning the path the file was read from
+func readFromFile(filepath string, dirs []string, root fs.FS) ([]byte, string, error) {
 	if path.IsAbs(filepath) {
-		return fs.ReadFile(root, filepath)
+		content, err := fs.ReadFile(root, filepath)
+		return content, filepath, err
 	}
 
 	if len(dirs) == 0 {
-		return nil, errEmptyDirs
+		return nil, "", errEmptyDirs
 	}
 
 	// handling files by operators is hard because we must know the paths where we can
@@ -36,12 +45,23 @@ func loadFromFile(filepath string, dirs []string, root fs.FS) ([]byte, error) {
 			if os.IsNotExist(err) {
 				continue
 			} else {
-				return nil, err
+				return nil, "", err
 			}
 		}
 
-		return content, nil
+		return content, absFilepath, nil
 	}
 
-	return nil, err
+	return nil, "", err
+}
+
+// isUploadedFile returns true if the value is the path of a file uploaded in the
+// transaction. Other values are never read, as they might be controlled by the client.
+func isUploadedFile(tx plugintypes.TransactionState, value string) bool {
+	for _, md := range tx.Variables().FilesTmpNames().FindAll() {
+		if md.Value() == value {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,99 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.fuzzyHash
+
+package operators
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/environment"
+	"github.com/corazawaf/coraza/v3/internal/ssdeep"
+)
+
+// fuzzyHash computes the ssdeep hash of the input and matches if it is similar
+// enough to any of the hashes of the list, e.g. known malicious files.
+// When the input is the path of an uploaded file (FILES_TMPNAMES), the content
+// of the file is hashed instead.
+type fuzzyHash struct {
+	hashes    []ssdeep.Digest
+	threshold int
+}
+
+var _ plugintypes.Operator = (*fuzzyHash)(nil)
+
+func newFuzzyHash(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	args := strings.Fields(options.Arguments)
+	if len(args) != 2 {
+		return nil, errors.New("invalid @fuzzyHash argument, expected [FILE] [THRESHOLD]")
+	}
+	filepath := args[0]
+
+	threshold, err := strconv.Atoi(args[1])
+	if err != nil || threshold < 1 || threshold > 100 {
+		return nil, fmt.Errorf("invalid @fuzzyHash threshold %q, expected a value between 1 and 100", args[1])
+	}
+
+	data, err := loadFromFile(filepath, options.Path, options.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []ssdeep.Digest
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; sc.Scan(); n++ {
+		l := strings.TrimSpace(sc.Text())
+		// the header of the ssdeep output is skipped, e.g. ssdeep,1.1--blocksize:hash:hash,filename
+		if len(l) == 0 || l[0] == '#' || strings.HasPrefix(l, "ssdeep,") {
+			continue
+		}
+		d, err := ssdeep.Parse(l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hash at %s:%d: %s", filepath, n, err.Error())
+		}
+		hashes = append(hashes, d)
+	}
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("no hashes found in %s", filepath)
+	}
+
+	return &fuzzyHash{hashes: hashes, threshold: threshold}, nil
+}
+
+func (o *fuzzyHash) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	data := []byte(value)
+	if environment.HasAccessToFS && isUploadedFile(tx, value) {
+		content, err := os.ReadFile(value)
+		if err != nil {
+			tx.DebugLogger().Error().
+				Str("operator", "fuzzyHash").
+				Err(err).
+				Msg("Failed to read uploaded file")
+			return false
+		}
+		data = content
+	}
+
+	digest := ssdeep.Hash(data)
+	for _, h := range o.hashes {
+		if ssdeep.Compare(digest, h) >= o.threshold {
+			if tx.Capturing() {
+				tx.CaptureField(0, h.String())
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	Register("fuzzyHash", newFuzzyHash)
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/internal/ssdeep"
+)
+
+func TestFuzzyHash(t *testing.T) {
+	malware := make([]byte, 20000)
+	rand.New(rand.NewSource(1)).Read(malware)
+	variant := append([]byte{}, malware...)
+	copy(variant[5000:], "a small change in the middle of the file")
+	benign := make([]byte, 20000)
+	rand.New(rand.NewSource(2)).Read(benign)
+
+	root := fstest.MapFS{
+		"rules/hashes.txt": &fstest.MapFile{
+			Data: []byte("ssdeep,1.1--blocksize:hash:hash,filename\n" +
+				"# known malware\n" +
+				ssdeep.Hash(malware).String() + ",\"malware.exe\"\n"),
+		},
+		"rules/invalid.txt": &fstest.MapFile{Data: []byte("not a hash\n")},
+		"rules/empty.txt":   &fstest.MapFile{Data: []byte("# no hashes\n")},
+	}
+	newOp := func(args string) (plugintypes.Operator, error) {
+		return newFuzzyHash(plugintypes.OperatorOptions{
+			Arguments: args,
+			Path:      []string{"rules"},
+			Root:      root,
+		})
+	}
+
+	for _, args := range []string{"", "hashes.txt", "hashes.txt 0", "hashes.txt 101", "hashes.txt abc", "missing.txt 90", "invalid.txt 90", "empty.txt 90"} {
+		if _, err := newOp(args); err == nil {
+			t.Errorf("expected error for %q", args)
+		}
+	}
+
+	op, err := newOp("hashes.txt 90")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+
+	t.Run("content", func(t *testing.T) {
+		tx := waf.NewTransaction()
+		if !op.Evaluate(tx, string(variant)) {
+			t.Error("expected match for a variant of a known file")
+		}
+		if op.Evaluate(tx, string(benign)) {
+			t.Error("unexpected match for an unrelated file")
+		}
+	})
+
+	t.Run("uploaded file", func(t *testing.T) {
+		dir := t.TempDir()
+		uploaded := filepath.Join(dir, "crzmp123")
+		if err := os.WriteFile(uploaded, variant, 0600); err != nil {
+			t.Fatal(err)
+		}
+		notUploaded := filepath.Join(dir, "other")
+		if err := os.WriteFile(notUploaded, malware, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		tx := waf.NewTransaction()
+		tx.Variables().FilesTmpNames().Add("", uploaded)
+		if !op.Evaluate(tx, uploaded) {
+			t.Error("expected match for the uploaded file")
+		}
+		// paths that are not uploaded files are hashed as plain values
+		if op.Evaluate(tx, notUploaded) {
+			t.Error("unexpected match for a file that was not uploaded")
+		}
+	})
+}
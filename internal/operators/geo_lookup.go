@@ -9,8 +9,41 @@ import (
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
 )
 
-func newGeoLookup(plugintypes.OperatorOptions) (plugintypes.Operator, error) {
-	return &unconditionalMatch{}, nil
+// geoLookup resolves the location of the input address with the database
+// configured by SecGeoLookupDb and populates the GEO collection with it.
+// Without a database, as in ModSecurity, it never matches.
+type geoLookup struct {
+	db plugintypes.GeoLookup
+}
+
+var _ plugintypes.Operator = (*geoLookup)(nil)
+
+func newGeoLookup(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	return &geoLookup{db: options.GeoLookup}, nil
+}
+
+func (o *geoLookup) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	if o.db == nil {
+		tx.DebugLogger().Warn().
+			Str("operator", "geoLookup").
+			Msg("No geolocation database configured with SecGeoLookupDb")
+		return false
+	}
+
+	fields, ok := o.db.Lookup(value)
+	if !ok {
+		return false
+	}
+
+	geo := tx.Variables().Geo()
+	// fields from a previous lookup must not be mixed with the new ones
+	for _, md := range geo.FindAll() {
+		geo.Remove(md.Key())
+	}
+	for k, v := range fields {
+		geo.Set(k, []string{v})
+	}
+	return true
 }
 
 func init() {
@@ -0,0 +1,72 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+type geoLookupDB map[string]map[string]string
+
+func (db geoLookupDB) Lookup(addr string) (map[string]string, bool) {
+	fields, ok := db[addr]
+	return fields, ok
+}
+
+func TestGeoLookupWithoutDatabase(t *testing.T) {
+	op, err := newGeoLookup(plugintypes.OperatorOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	if op.Evaluate(tx, "1.1.1.1") {
+		t.Error("unexpected match without database")
+	}
+}
+
+func TestGeoLookup(t *testing.T) {
+	op, err := newGeoLookup(plugintypes.OperatorOptions{
+		GeoLookup: geoLookupDB{
+			"1.1.1.1": {"COUNTRY_CODE": "AU", "CITY": "Sydney"},
+			"2.2.2.2": {"COUNTRY_CODE": "FR"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	geo := tx.Variables().Geo()
+
+	if op.Evaluate(tx, "3.3.3.3") {
+		t.Error("unexpected match for unknown address")
+	}
+
+	if !op.Evaluate(tx, "1.1.1.1") {
+		t.Fatal("expected match")
+	}
+	if want, have := "AU", geo.Get("country_code"); len(have) != 1 || have[0] != want {
+		t.Errorf("unexpected GEO:COUNTRY_CODE, want %q, have %v", want, have)
+	}
+	if want, have := "Sydney", geo.Get("CITY"); len(have) != 1 || have[0] != want {
+		t.Errorf("unexpected GEO:CITY, want %q, have %v", want, have)
+	}
+
+	if !op.Evaluate(tx, "2.2.2.2") {
+		t.Fatal("expected match")
+	}
+	if want, have := "FR", geo.Get("COUNTRY_CODE"); len(have) != 1 || have[0] != want {
+		t.Errorf("unexpected GEO:COUNTRY_CODE, want %q, have %v", want, have)
+	}
+	if have := geo.Get("CITY"); len(have) != 0 {
+		t.Errorf("unexpected GEO:CITY from the previous lookup, have %v", have)
+	}
+}
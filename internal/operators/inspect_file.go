@@ -8,37 +8,149 @@ package operators
 
 import (
 	"context"
+	"io"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/environment"
 )
 
+const (
+	defaultInspectFileTimeout = 10 * time.Second
+	// inspectFileWaitDelay is how long the output of a killed script is
+	// waited for, as its children may keep it open
+	inspectFileWaitDelay = 100 * time.Millisecond
+)
+
+// inspectFile runs a script with the value as argument, usually the path of
+// an uploaded file, and matches unless the output starts with 1. Relative
+// scripts are resolved against the directory of the rules. With a clamd://
+// address, e.g. clamd://127.0.0.1:3310 or clamd:///var/run/clamav/clamd.ctl,
+// the content is scanned by clamd instead, and the operator matches when a
+// virus is found, capturing its name.
 type inspectFile struct {
-	path string
+	path    string
+	clamd   *clamdClient
+	timeout time.Duration
+}
+
+// inspectFileLimiter is implemented by transactions limiting the inspections
+// the WAF runs at the same time
+type inspectFileLimiter interface {
+	AcquireInspectFileSlot(ctx context.Context) (release func(), ok bool)
 }
 
 var _ plugintypes.Operator = (*inspectFile)(nil)
 
 func newInspectFile(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
-	return &inspectFile{path: options.Arguments}, nil
+	o := &inspectFile{timeout: options.InspectFile.Timeout}
+	if o.timeout <= 0 {
+		o.timeout = defaultInspectFileTimeout
+	}
+
+	arg := strings.TrimSpace(options.Arguments)
+	if strings.HasPrefix(arg, clamdScheme) {
+		c, err := newClamdClient(arg)
+		if err != nil {
+			return nil, err
+		}
+		o.clamd = c
+		return o, nil
+	}
+	o.path = resolveScript(arg, options.Path)
+	return o, nil
 }
 
 func (o *inspectFile) Evaluate(tx plugintypes.TransactionState, value string) bool {
-	// TODO parametrize timeout
-	// TODO add relative path capabilities
 	// TODO add lua special support
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
 	defer cancel()
-	// Add /bin/bash to context?
+
+	if l, ok := tx.(inspectFileLimiter); ok {
+		release, ok := l.AcquireInspectFileSlot(ctx)
+		if !ok {
+			return false
+		}
+		defer release()
+	}
+
+	if o.clamd != nil {
+		return o.scan(ctx, tx, value)
+	}
+
 	cmd := exec.CommandContext(ctx, o.path, value)
+	cmd.WaitDelay = inspectFileWaitDelay
 	output, err := cmd.CombinedOutput()
 	if ctx.Err() == context.DeadlineExceeded || err != nil {
+		if err == nil {
+			err = ctx.Err()
+		}
+		tx.DebugLogger().Warn().
+			Str("operator", "inspectFile").
+			Str("path", o.path).
+			Err(err).
+			Msg("Failed to inspect the file")
 		return false
 	}
 	return len(output) > 0 && output[0] != '1'
 }
 
+// scan scans the uploaded file, or the value itself, with clamd
+func (o *inspectFile) scan(ctx context.Context, tx plugintypes.TransactionState, value string) bool {
+	var r io.Reader = strings.NewReader(value)
+	if environment.HasAccessToFS && isUploadedFile(tx, value) {
+		f, err := os.Open(value)
+		if err != nil {
+			tx.DebugLogger().Error().
+				Str("operator", "inspectFile").
+				Err(err).
+				Msg("Failed to read uploaded file")
+			return false
+		}
+		defer f.Close()
+		r = f
+	}
+
+	virus, err := o.clamd.scan(ctx, r)
+	if err != nil {
+		tx.DebugLogger().Warn().
+			Str("operator", "inspectFile").
+			Str("clamd", o.clamd.address).
+			Err(err).
+			Msg("Failed to scan with clamd")
+		return false
+	}
+	if virus == "" {
+		return false
+	}
+	if tx.Capturing() {
+		tx.CaptureField(0, virus)
+	}
+	return true
+}
+
+// resolveScript resolves a relative script against the directories of the
+// rules, it is returned as is if it is not found in any of them.
+func resolveScript(script string, dirs []string) string {
+	if !environment.HasAccessToFS || filepath.IsAbs(script) {
+		return script
+	}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		p := filepath.Join(dir, script)
+		if info, err := os.Stat(p); err == nil && !info.IsDir() {
+			return p
+		}
+	}
+	return script
+}
+
 func init() {
 	Register("inspectFile", newInspectFile)
 }
@@ -0,0 +1,122 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo && !coraza.disabled_operators.inspectFile
+// +build !tinygo,!coraza.disabled_operators.inspectFile
+
+package operators
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	clamdScheme    = "clamd://"
+	clamdChunkSize = 64 * 1024
+	// clamdMaxReply bounds the reply read from clamd
+	clamdMaxReply = 4096
+)
+
+// clamdClient scans content with the INSTREAM command of clamd, see
+// https://docs.clamav.net/manual/Usage/Scanning.html#clamd
+type clamdClient struct {
+	network string
+	address string
+}
+
+// newClamdClient parses the address of clamd, clamd://host:port for TCP or
+// clamd:///path for a unix socket
+func newClamdClient(uri string) (*clamdClient, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clamd address %q: %s", uri, err.Error())
+	}
+	switch {
+	case u.Host != "" && (u.Path == "" || u.Path == "/"):
+		if _, _, err := net.SplitHostPort(u.Host); err != nil {
+			return nil, fmt.Errorf("invalid clamd address %q: %s", uri, err.Error())
+		}
+		return &clamdClient{network: "tcp", address: u.Host}, nil
+	case u.Host == "" && u.Path != "":
+		return &clamdClient{network: "unix", address: u.Path}, nil
+	}
+	return nil, fmt.Errorf("invalid clamd address %q, expected clamd://host:port or clamd:///path/to/socket", uri)
+}
+
+// scan streams r to clamd and returns the name of the virus found, empty if
+// the content is clean.
+func (c *clamdClient) scan(ctx context.Context, r io.Reader) (string, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return "", err
+		}
+	}
+
+	// clamd stops reading and replies with an error when the stream exceeds
+	// StreamMaxLength, so the reply is read even if the stream fails.
+	werr := writeInstream(conn, r)
+	reply, err := bufio.NewReader(io.LimitReader(conn, clamdMaxReply)).ReadString(0)
+	if err != nil && (err != io.EOF || reply == "") {
+		if werr != nil {
+			return "", werr
+		}
+		return "", err
+	}
+	return parseClamdReply(reply)
+}
+
+// writeInstream writes the INSTREAM command, the content in chunks prefixed
+// by their length and the terminating zero length chunk
+func writeInstream(w io.Writer, r io.Reader) error {
+	if _, err := io.WriteString(w, "zINSTREAM\x00"); err != nil {
+		return err
+	}
+	buf := make([]byte, 4+clamdChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf, uint32(n))
+			if _, err := w.Write(buf[:4+n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := w.Write([]byte{0, 0, 0, 0})
+	return err
+}
+
+// parseClamdReply parses replies like "stream: OK" and
+// "stream: Eicar-Signature FOUND"
+func parseClamdReply(reply string) (string, error) {
+	reply = strings.TrimRight(reply, "\x00\n")
+	result := strings.TrimPrefix(reply, "stream: ")
+	switch {
+	case result == "OK":
+		return "", nil
+	case strings.HasSuffix(result, " FOUND"):
+		return strings.TrimSuffix(result, " FOUND"), nil
+	case strings.HasSuffix(result, " ERROR"):
+		return "", fmt.Errorf("clamd error: %s", strings.TrimSuffix(result, " ERROR"))
+	}
+	return "", errors.New("unexpected clamd reply: " + reply)
+}
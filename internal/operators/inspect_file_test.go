@@ -7,12 +7,21 @@
 package operators
 
 import (
+	"bufio"
+	"context"
+	"encoding/binary"
 	_ "fmt"
+	"io"
+	"net"
+	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
 )
 
 func TestInspectFileExitCode(t *testing.T) {
@@ -42,7 +51,7 @@ func TestInspectFileExitCode(t *testing.T) {
 			if err != nil {
 				t.Error("cannot init inspectfile operator")
 			}
-			if want, have := tt.exists, ipf.Evaluate(nil, "/?"); want != have {
+			if want, have := tt.exists, ipf.Evaluate(corazawaf.NewWAF().NewTransaction(), "/?"); want != have {
 				t.Errorf("inspectfile path %s: want %v, have %v", tt.path, want, have)
 			}
 		})
@@ -77,9 +86,220 @@ func TestInspectFileOutput(t *testing.T) {
 	for _, tc := range tests {
 		tt := tc
 		t.Run(tt.output, func(t *testing.T) {
-			if want, have := tt.match, ipf.Evaluate(nil, tt.output); want != have {
+			if want, have := tt.match, ipf.Evaluate(corazawaf.NewWAF().NewTransaction(), tt.output); want != have {
 				t.Errorf("inspectfile output '%s': want %t, have %t", tt.output, want, have)
 			}
 		})
 	}
 }
+
+func TestInspectFileScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping test on Windows")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\nsleep \"$1\"\necho 0 infected\n"
+	if err := os.WriteFile(filepath.Join(dir, "scan.sh"), []byte(script), 0700); err != nil {
+		t.Fatal(err)
+	}
+	tx := corazawaf.NewWAF().NewTransaction()
+
+	t.Run("relative path", func(t *testing.T) {
+		ipf, err := newInspectFile(plugintypes.OperatorOptions{Arguments: "scan.sh", Path: []string{"", dir}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want, have := filepath.Join(dir, "scan.sh"), ipf.(*inspectFile).path; want != have {
+			t.Errorf("unexpected script path: want %q, have %q", want, have)
+		}
+		if !ipf.Evaluate(tx, "0") {
+			t.Error("expected the script to match")
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ipf, err := newInspectFile(plugintypes.OperatorOptions{
+			Arguments:   filepath.Join(dir, "scan.sh"),
+			InspectFile: plugintypes.InspectFileOptions{Timeout: 50 * time.Millisecond},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		start := time.Now()
+		if ipf.Evaluate(tx, "5") {
+			t.Error("unexpected match of a killed script")
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("the script was not killed, it ran for %s", elapsed)
+		}
+	})
+
+	t.Run("concurrency", func(t *testing.T) {
+		opts := plugintypes.OperatorOptions{
+			Arguments:   filepath.Join(dir, "scan.sh"),
+			InspectFile: plugintypes.InspectFileOptions{Timeout: 50 * time.Millisecond},
+		}
+		ipf, err := newInspectFile(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		other, err := newInspectFile(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		waf := corazawaf.NewWAF()
+		waf.SetInspectFileConcurrency(1)
+		tx := waf.NewTransaction()
+
+		// the only slot of the WAF is taken, by any rule
+		release, ok := tx.AcquireInspectFileSlot(context.Background())
+		if !ok {
+			t.Fatal("expected a free slot")
+		}
+		for _, o := range []plugintypes.Operator{ipf, other} {
+			if o.Evaluate(tx, "0") {
+				t.Error("unexpected match without free slot")
+			}
+		}
+		release()
+		if !ipf.Evaluate(tx, "0") {
+			t.Error("expected the script to match")
+		}
+	})
+}
+
+// fakeClamd serves the INSTREAM command, content containing "EICAR" is
+// infected and content larger than limit exceeds the stream limit.
+func fakeClamd(t *testing.T, l net.Listener, limit int) {
+	t.Helper()
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				cmd, err := r.ReadString(0)
+				if err != nil || cmd != "zINSTREAM\x00" {
+					_, _ = io.WriteString(conn, "UNKNOWN COMMAND\x00")
+					return
+				}
+				var content []byte
+				for {
+					var size uint32
+					if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+						return
+					}
+					if size == 0 {
+						break
+					}
+					if len(content)+int(size) > limit {
+						_, _ = io.WriteString(conn, "INSTREAM size limit exceeded. ERROR\x00")
+						return
+					}
+					chunk := make([]byte, size)
+					if _, err := io.ReadFull(r, chunk); err != nil {
+						return
+					}
+					content = append(content, chunk...)
+				}
+				if strings.Contains(string(content), "EICAR") {
+					_, _ = io.WriteString(conn, "stream: Eicar-Test-Signature FOUND\x00")
+					return
+				}
+				_, _ = io.WriteString(conn, "stream: OK\x00")
+			}(conn)
+		}
+	}()
+}
+
+func TestInspectFileClamd(t *testing.T) {
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fakeClamd(t, tcp, 1<<20)
+	addresses := []string{"clamd://" + tcp.Addr().String()}
+
+	if runtime.GOOS != "windows" {
+		sock := filepath.Join(t.TempDir(), "clamd.sock")
+		unix, err := net.Listen("unix", sock)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fakeClamd(t, unix, 1<<20)
+		addresses = append(addresses, "clamd://"+sock)
+	}
+
+	upload := filepath.Join(t.TempDir(), "upload")
+	if err := os.WriteFile(upload, []byte(strings.Repeat("x", 200000)+"EICAR"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, addr := range addresses {
+		t.Run(addr, func(t *testing.T) {
+			ipf, err := newInspectFile(plugintypes.OperatorOptions{Arguments: addr})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tx := corazawaf.NewWAF().NewTransaction()
+			tx.Capture = true
+			if ipf.Evaluate(tx, "clean content") {
+				t.Error("unexpected match of clean content")
+			}
+			if !ipf.Evaluate(tx, "X5O!P%@AP EICAR") {
+				t.Fatal("expected infected content to match")
+			}
+			if want, have := "Eicar-Test-Signature", tx.Variables().TX().Get("0"); len(have) != 1 || have[0] != want {
+				t.Errorf("unexpected capture: want %q, have %q", want, have)
+			}
+
+			// uploaded files are scanned, not their path
+			tx = corazawaf.NewWAF().NewTransaction()
+			if ipf.Evaluate(tx, upload) {
+				t.Error("unexpected match of a path which is not uploaded")
+			}
+			tx.Variables().FilesTmpNames().(interface{ Add(string, string) }).Add("", upload)
+			if !ipf.Evaluate(tx, upload) {
+				t.Error("expected infected upload to match")
+			}
+		})
+	}
+
+	t.Run("size limit", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		fakeClamd(t, l, 10)
+		c, err := newClamdClient("clamd://" + l.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if _, err := c.scan(ctx, strings.NewReader(strings.Repeat("x", 100))); err == nil || !strings.Contains(err.Error(), "size limit exceeded") {
+			t.Errorf("expected size limit error, have %v", err)
+		}
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		ipf, err := newInspectFile(plugintypes.OperatorOptions{Arguments: "clamd://" + filepath.Join(t.TempDir(), "missing.sock")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ipf.Evaluate(corazawaf.NewWAF().NewTransaction(), "X5O!P%@AP EICAR") {
+			t.Error("unexpected match without clamd")
+		}
+	})
+
+	for _, addr := range []string{"clamd://", "clamd://localhost", "clamd://host:3310/path"} {
+		if _, err := newInspectFile(plugintypes.OperatorOptions{Arguments: addr}); err == nil {
+			t.Errorf("expected error for %q", addr)
+		}
+	}
+}
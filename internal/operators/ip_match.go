@@ -6,14 +6,15 @@
 package operators
 
 import (
-	"net"
+	"net/netip"
 	"strings"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/ipset"
 )
 
 type ipMatch struct {
-	subnets []net.IPNet
+	set *ipset.Set
 }
 
 var _ plugintypes.Operator = (*ipMatch)(nil)
@@ -21,36 +22,31 @@ var _ plugintypes.Operator = (*ipMatch)(nil)
 func newIPMatch(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
 	data := options.Arguments
 
-	var subnets []net.IPNet
+	set := ipset.New()
 	for _, sb := range strings.Split(data, ",") {
 		sb = strings.TrimSpace(sb)
 		if sb == "" {
 			continue
 		}
-		if strings.Contains(sb, ":") && !strings.Contains(sb, "/") {
-			// ipv6
-			sb += "/128"
-		} else if strings.Contains(sb, ".") && !strings.Contains(sb, "/") {
-			// ipv4
-			sb += "/32"
-		}
-		_, subnet, err := net.ParseCIDR(sb)
+		subnet, err := ipset.ParsePrefix(sb)
 		if err != nil {
 			continue
 		}
-		subnets = append(subnets, *subnet)
+		set.Insert(subnet)
 	}
-	return &ipMatch{subnets: subnets}, nil
+	return &ipMatch{set: set}, nil
 }
 
 func (o *ipMatch) Evaluate(tx plugintypes.TransactionState, value string) bool {
-	ip := net.ParseIP(value)
-	for _, subnet := range o.subnets {
-		if subnet.Contains(ip) {
-			return true
-		}
+	return ipSetContains(o.set, value)
+}
+
+func ipSetContains(set *ipset.Set, value string) bool {
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return false
 	}
-	return false
+	return set.Contains(addr)
 }
 
 func init() {
@@ -6,40 +6,119 @@
 package operators
 
 import (
-	"bufio"
-	"bytes"
-	"strings"
+	"io/fs"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/ipset"
 )
 
-func newIPMatchFromFile(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
-	path := options.Arguments
+// ipMatchFromFile matches the networks listed in a file, see ipset.ParseList
+// for the supported formats. When a reload interval is configured, the file
+// is checked for changes by the first evaluation after each interval and
+// reloaded in place, the previous list is kept if the file cannot be read or
+// parsed.
+type ipMatchFromFile struct {
+	path     string
+	root     fs.FS
+	interval time.Duration
+
+	set       atomic.Pointer[ipset.Set]
+	nextCheck atomic.Int64
+
+	// mu guards the reload and the state of the loaded file
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+var _ plugintypes.Operator = (*ipMatchFromFile)(nil)
 
-	data, err := loadFromFile(path, options.Path, options.Root)
+func newIPMatchFromFile(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	data, path, err := readFromFile(options.Arguments, options.Path, options.Root)
 	if err != nil {
 		return nil, err
 	}
 
-	dataParsed := strings.Builder{}
-	sc := bufio.NewScanner(bytes.NewReader(data))
-	for sc.Scan() {
-		l := sc.Text()
-		l = strings.TrimSpace(l)
-		if len(l) == 0 {
-			continue
-		}
-		if l[0] == '#' {
-			continue
+	o := &ipMatchFromFile{
+		path:     path,
+		root:     options.Root,
+		interval: options.ReloadInterval,
+	}
+	set, _, err := ipset.ParseList(data)
+	if err != nil {
+		return nil, err
+	}
+	o.set.Store(set)
+	if o.interval > 0 {
+		if info, err := fs.Stat(o.root, o.path); err == nil {
+			o.modTime, o.size = info.ModTime(), info.Size()
 		}
-		dataParsed.WriteString(",")
-		dataParsed.WriteString(l)
+		o.nextCheck.Store(time.Now().Add(o.interval).UnixNano())
+	}
+	return o, nil
+}
+
+func (o *ipMatchFromFile) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	if o.interval > 0 {
+		o.reload(tx)
+	}
+	return ipSetContains(o.set.Load(), value)
+}
+
+// reload reloads the file if the interval elapsed and it changed since it
+// was last loaded
+func (o *ipMatchFromFile) reload(tx plugintypes.TransactionState) {
+	now := time.Now()
+	next := o.nextCheck.Load()
+	if now.UnixNano() < next || !o.nextCheck.CompareAndSwap(next, now.Add(o.interval).UnixNano()) {
+		return
+	}
+	if !o.mu.TryLock() {
+		return
 	}
+	defer o.mu.Unlock()
 
-	opts := plugintypes.OperatorOptions{
-		Arguments: dataParsed.String(),
+	info, err := fs.Stat(o.root, o.path)
+	if err != nil {
+		tx.DebugLogger().Warn().
+			Str("operator", "ipMatchFromFile").
+			Str("path", o.path).
+			Err(err).
+			Msg("Failed to check the list for changes")
+		return
+	}
+	if info.ModTime().Equal(o.modTime) && info.Size() == o.size {
+		return
+	}
+	data, err := fs.ReadFile(o.root, o.path)
+	if err != nil {
+		tx.DebugLogger().Warn().
+			Str("operator", "ipMatchFromFile").
+			Str("path", o.path).
+			Err(err).
+			Msg("Failed to reload the list")
+		return
+	}
+	set, invalid, err := ipset.ParseList(data)
+	if err != nil {
+		tx.DebugLogger().Warn().
+			Str("operator", "ipMatchFromFile").
+			Str("path", o.path).
+			Err(err).
+			Msg("Failed to parse the reloaded list")
+		return
 	}
-	return newIPMatch(opts)
+	o.set.Store(set)
+	o.modTime, o.size = info.ModTime(), info.Size()
+	tx.DebugLogger().Info().
+		Str("operator", "ipMatchFromFile").
+		Str("path", o.path).
+		Int("networks", set.Len()).
+		Int("invalid", invalid).
+		Msg("Reloaded the list")
 }
 
 func init() {
@@ -4,10 +4,15 @@
 package operators
 
 import (
+	"bufio"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
 	"github.com/corazawaf/coraza/v3/internal/io"
 )
 
@@ -40,3 +45,62 @@ func TestFromFile(t *testing.T) {
 		})
 	}
 }
+
+func TestFromFileReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drop.txt")
+	if err := os.WriteFile(path, []byte("192.0.2.1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := plugintypes.OperatorOptions{
+		Arguments:      path,
+		Root:           io.OSFS{},
+		ReloadInterval: time.Nanosecond,
+	}
+	ipm, err := newIPMatchFromFile(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.ReloadInterval = 0
+	static, err := newIPMatchFromFile(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := corazawaf.NewWAF().NewTransaction()
+	if !ipm.Evaluate(tx, "192.0.2.1") || ipm.Evaluate(tx, "198.51.100.7") {
+		t.Fatal("unexpected result before reload")
+	}
+
+	if err := os.WriteFile(path, []byte("; DROP list\n198.51.100.0/24 ; SBL000001\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+	if ipm.Evaluate(tx, "192.0.2.1") || !ipm.Evaluate(tx, "198.51.100.7") {
+		t.Error("unexpected result after reload")
+	}
+	if !static.Evaluate(tx, "192.0.2.1") || static.Evaluate(tx, "198.51.100.7") {
+		t.Error("unexpected reload without interval")
+	}
+
+	// the list is kept while the file is missing
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+	if !ipm.Evaluate(tx, "198.51.100.7") {
+		t.Error("unexpected result without file")
+	}
+
+	// and when the file cannot be parsed
+	long := "192.0.2.1\n# " + strings.Repeat("x", bufio.MaxScanTokenSize) + "\n"
+	if err := os.WriteFile(path, []byte(long), 0600); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+	if !ipm.Evaluate(tx, "198.51.100.7") || ipm.Evaluate(tx, "192.0.2.1") {
+		t.Error("unexpected result with an invalid file")
+	}
+	if _, err := newIPMatchFromFile(opts); err == nil {
+		t.Error("expected error for an invalid file")
+	}
+}
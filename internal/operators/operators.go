@@ -4,6 +4,7 @@
 package operators
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
@@ -24,3 +25,12 @@ func Get(name string, options plugintypes.OperatorOptions) (plugintypes.Operator
 func Register(name string, op plugintypes.OperatorFactory) {
 	operators[name] = op
 }
+
+// transactionContext returns the context of the transaction, which is done
+// when the transaction is cancelled, or the background context.
+func transactionContext(tx plugintypes.TransactionState) context.Context {
+	if t, ok := tx.(interface{ Context() context.Context }); ok && t.Context() != nil {
+		return t.Context()
+	}
+	return context.Background()
+}
@@ -49,9 +49,6 @@ func TestOperators(t *testing.T) {
 	notImplemented := []string{
 		"containsWord",
 		"strmatch",
-		"verifyCC",
-		"verifycpf",
-		"verifyssn",
 		"verifysvnr",
 	}
 
@@ -64,7 +61,7 @@ func TestOperators(t *testing.T) {
 	for _, f := range files {
 		cases := unmarshalTests(t, f)
 		for _, data := range cases {
-			if utils.InSlice("containsWord", notImplemented) {
+			if utils.InSlice(data.Name, notImplemented) {
 				continue
 			}
 			for capName, capVal := range captureMatrix {
@@ -97,6 +94,9 @@ func TestOperators(t *testing.T) {
 					}
 					op, err := Get(data.Name, opts)
 					if err != nil {
+						if data.Param == "" {
+							t.Skip("empty arguments are rejected when compiling the rule")
+						}
 						t.Error(err)
 						return
 					}
@@ -14,6 +14,8 @@ import (
 	"github.com/corazawaf/coraza/v3/internal/memoize"
 )
 
+// pm matches any of the space separated phrases, case insensitively. Phrases
+// may contain bytes in Snort content syntax, e.g. "A|42|C|0d 0a|".
 type pm struct {
 	matcher ahocorasick.AhoCorasick
 }
@@ -24,7 +26,10 @@ func newPM(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
 	data := options.Arguments
 
 	data = strings.ToLower(data)
-	dict := strings.Split(data, " ")
+	dict := splitPMContent(data)
+	for i, phrase := range dict {
+		dict[i] = parsePMContent(phrase)
+	}
 	builder := ahocorasick.NewAhoCorasickBuilder(ahocorasick.Opts{
 		AsciiCaseInsensitive: true,
 		MatchOnlyWholeWords:  false,
@@ -33,7 +38,6 @@ func newPM(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
 	})
 
 	m, _ := memoize.Do(data, func() (interface{}, error) { return builder.Build(dict), nil })
-	// TODO this operator is supposed to support snort data syntax: "@pm A|42|C|44|F"
 	return &pm{matcher: m.(ahocorasick.AhoCorasick)}, nil
 }
 
@@ -0,0 +1,93 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"strings"
+)
+
+// splitPMContent splits the argument of @pm into phrases on spaces, except
+// inside hex segments, e.g. "A|0d 0a|B C" returns "A|0d 0a|B" and "C".
+func splitPMContent(data string) []string {
+	var phrases []string
+	start := 0
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '|':
+			if end, ok := hexSegmentEnd(data, i); ok {
+				i = end
+			}
+		case ' ':
+			phrases = append(phrases, data[start:i])
+			start = i + 1
+		}
+	}
+	return append(phrases, data[start:])
+}
+
+// parsePMContent decodes the hex segments of a phrase written in Snort
+// content syntax, e.g. "A|42|C|44 45|F" returns "ABCDEF". Segments enclosed
+// in pipes are decoded when they only contain pairs of hex digits and
+// spaces, other pipes are kept literally.
+func parsePMContent(phrase string) string {
+	if !strings.Contains(phrase, "|") {
+		return phrase
+	}
+	var b strings.Builder
+	b.Grow(len(phrase))
+	for i := 0; i < len(phrase); i++ {
+		end, ok := hexSegmentEnd(phrase, i)
+		if !ok {
+			b.WriteByte(phrase[i])
+			continue
+		}
+		for j := i + 1; j < end; j++ {
+			if phrase[j] == ' ' {
+				continue
+			}
+			b.WriteByte(fromHexChar(phrase[j])<<4 | fromHexChar(phrase[j+1]))
+			j++
+		}
+		i = end
+	}
+	return b.String()
+}
+
+// hexSegmentEnd returns the position of the pipe closing the hex segment
+// opened at i, if any.
+func hexSegmentEnd(s string, i int) (int, bool) {
+	if s[i] != '|' {
+		return 0, false
+	}
+	digits := 0
+	for j := i + 1; j < len(s); j++ {
+		switch c := s[j]; {
+		case c == '|':
+			return j, digits > 0 && digits%2 == 0
+		case c == ' ':
+			if digits%2 != 0 {
+				return 0, false
+			}
+		case isHexChar(c):
+			digits++
+		default:
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+func isHexChar(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func fromHexChar(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	}
+	return c - 'A' + 10
+}
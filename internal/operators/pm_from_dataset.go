@@ -27,7 +27,13 @@ func newPMFromDataset(options plugintypes.OperatorOptions) (plugintypes.Operator
 		DFA:                  true,
 	})
 
-	m, _ := memoize.Do(data, func() (interface{}, error) { return builder.Build(dataset), nil })
+	m, _ := memoize.Do(data, func() (interface{}, error) {
+		phrases := make([]string, len(dataset))
+		for i, phrase := range dataset {
+			phrases[i] = parsePMContent(phrase)
+		}
+		return builder.Build(phrases), nil
+	})
 
 	return &pm{matcher: m.(ahocorasick.AhoCorasick)}, nil
 }
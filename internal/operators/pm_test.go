@@ -0,0 +1,108 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+func TestParsePMContent(t *testing.T) {
+	tests := map[string]string{
+		"abc":             "abc",
+		"A|42|C|44|F":     "ABCDF",
+		"|0d 0a|":         "\r\n",
+		"|0D0A|end":       "\r\nend",
+		"|00 ff|":         "\x00\xff",
+		"a|7c|b":          "a|b",
+		"string|int|x":    "string|int|x",
+		"<on|off>":        "<on|off>",
+		"a||b":            "a||b",
+		"a|4|b":           "a|4|b",
+		"a|4 2|b":         "a|4 2|b",
+		"unterminated|41": "unterminated|41",
+		"|41|42|43|":      "A42C",
+	}
+	for in, want := range tests {
+		if have := parsePMContent(in); have != want {
+			t.Errorf("unexpected content for %q: %q, want %q", in, have, want)
+		}
+	}
+}
+
+func TestSplitPMContent(t *testing.T) {
+	have := splitPMContent("a |0d 0a|b c|x y|")
+	want := []string{"a", "|0d 0a|b", "c|x", "y|"}
+	if len(have) != len(want) {
+		t.Fatalf("unexpected phrases %q, want %q", have, want)
+	}
+	for i := range want {
+		if have[i] != want[i] {
+			t.Errorf("unexpected phrase %d %q, want %q", i, have[i], want[i])
+		}
+	}
+}
+
+func TestPMBinaryContent(t *testing.T) {
+	// PDF with an embedded JavaScript action
+	body := "%PDF-1.4\n\x00\xff\xfe/JS (app.alert(1))\x00"
+	waf := corazawaf.NewWAF()
+
+	t.Run("pm", func(t *testing.T) {
+		op, err := newPM(plugintypes.OperatorOptions{Arguments: "|00 ff fe|/JS"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := waf.NewTransaction()
+		tx.Capture = true
+		if !op.Evaluate(tx, body) {
+			t.Error("expected binary pattern to match")
+		}
+		if have := tx.Variables().TX().Get("0"); len(have) != 1 || have[0] != "\x00\xff\xfe/JS" {
+			t.Errorf("unexpected capture %q", have)
+		}
+		if op.Evaluate(tx, "\x00\xfe\xff/JS") {
+			t.Error("unexpected match")
+		}
+	})
+
+	t.Run("pmFromFile", func(t *testing.T) {
+		op, err := newPMFromFile(plugintypes.OperatorOptions{
+			Arguments: "binary.data",
+			Path:      []string{"rules"},
+			Root: fstest.MapFS{
+				"rules/binary.data": {Data: []byte("# PDF JavaScript\n|ff fe|/js\n|89|PNG|0d 0a|\n")},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := waf.NewTransaction()
+		if !op.Evaluate(tx, body) {
+			t.Error("expected binary pattern to match")
+		}
+		if !op.Evaluate(tx, "\x89PNG\r\n\x1a\n") {
+			t.Error("expected PNG signature to match")
+		}
+		if op.Evaluate(tx, "|89|PNG|0d 0a|") {
+			t.Error("unexpected match of the encoded pattern")
+		}
+	})
+
+	t.Run("pmFromDataset", func(t *testing.T) {
+		op, err := newPMFromDataset(plugintypes.OperatorOptions{
+			Arguments: "binary",
+			Datasets:  map[string][]string{"binary": {"|00 FF FE|/JS"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !op.Evaluate(waf.NewTransaction(), body) {
+			t.Error("expected binary pattern to match")
+		}
+	})
+}
@@ -8,81 +8,225 @@ package operators
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/netip"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/cache"
 )
 
-const timeout = 500 * time.Millisecond
+const (
+	defaultRBLTimeout  = 500 * time.Millisecond
+	defaultRBLCacheTTL = 5 * time.Minute
+	rblCacheSize       = 4096
+)
+
+// httpBLTypes describes the visitor types of http:BL responses, by bit
+var httpBLTypes = []string{"Suspicious", "Harvester", "Comment Spammer"}
+
+// rblResult is the result of the lookup of an address
+type rblResult struct {
+	listed bool
+	// msg is the TXT record of the listed address or the description of the
+	// http:BL response
+	msg string
+	// httpBL is the response of http:BL, nil for other lists
+	httpBL *httpBLResponse
+}
 
+// httpBLResponse is a response of Project Honeypot http:BL, encoded as
+// 127.[days].[threat score].[type]
+type httpBLResponse struct {
+	days        int
+	threatScore int
+	visitorType int
+}
+
+// rbl looks up the address in a DNS block list, e.g. "@rbl zen.spamhaus.org".
+// Addresses are listed when the list resolves them to an address in
+// 127.0.0.0/8, results are cached for SecRblCacheTTL.
 type rbl struct {
 	service  string
+	key      string
+	httpBL   bool
+	timeout  time.Duration
+	cache    *cache.LRU[string, rblResult]
+	cacheTTL time.Duration
 	resolver *net.Resolver
 }
 
 var _ plugintypes.Operator = (*rbl)(nil)
 
 func newRBL(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
-	data := options.Arguments
-
-	return &rbl{
-		service:  data,
+	service := strings.TrimSuffix(strings.TrimSpace(options.Arguments), ".")
+	if service == "" {
+		return nil, errors.New("missing RBL service")
+	}
+	o := &rbl{
+		service:  service,
+		key:      options.RBL.HTTPBLKey,
+		httpBL:   strings.HasSuffix(strings.ToLower(service), "httpbl.org"),
+		timeout:  options.RBL.Timeout,
 		resolver: net.DefaultResolver,
-	}, nil
+	}
+	if o.httpBL && o.key == "" {
+		return nil, errors.New("missing http:BL key, it must be configured with SecHttpBlKey before the rule")
+	}
+	if o.timeout <= 0 {
+		o.timeout = defaultRBLTimeout
+	}
+	ttl := options.RBL.CacheTTL
+	if ttl == 0 {
+		ttl = defaultRBLCacheTTL
+	}
+	if ttl > 0 {
+		o.cache, o.cacheTTL = cache.NewLRU[string, rblResult](rblCacheSize), ttl
+	}
+	return o, nil
 }
 
 // https://github.com/mrichman/godnsbl
 // https://github.com/SpiderLabs/ModSecurity/blob/b66224853b4e9d30e0a44d16b29d5ed3842a6b11/src/operators/rbl.cc
 func (o *rbl) Evaluate(tx plugintypes.TransactionState, ipAddr string) bool {
-	// TODO validate address
-	resC := make(chan bool)
-	ctx, cancel := context.WithCancel(context.Background())
-
-	defer func() {
-		cancel()
-	}()
-
-	addr := fmt.Sprintf("%s.%s", ipAddr, o.service)
-	var captures []string
-	go func(ctx context.Context) {
-		defer func() {
-			close(resC)
-		}()
-		res, err := o.resolver.LookupHost(ctx, addr)
+	addr, err := netip.ParseAddr(ipAddr)
+	if err != nil || addr.Zone() != "" {
+		tx.DebugLogger().Debug().
+			Str("operator", "rbl").
+			Str("address", ipAddr).
+			Msg("Invalid IP address")
+		return false
+	}
+	addr = addr.Unmap()
+	if o.httpBL && !addr.Is4() {
+		// http:BL only lists IPv4 addresses
+		return false
+	}
 
+	name := o.queryName(addr)
+	res, cached := rblResult{}, false
+	if o.cache != nil {
+		res, cached = o.cache.Get(name, time.Now())
+	}
+	if !cached {
+		res, err = o.lookup(transactionContext(tx), name)
 		if err != nil {
-			resC <- false
-			return
+			tx.DebugLogger().Debug().
+				Str("operator", "rbl").
+				Str("address", ipAddr).
+				Err(err).
+				Msg("RBL lookup failed")
+			return false
 		}
-		// var status string
-		if len(res) > 0 {
-			txt, err := o.resolver.LookupTXT(ctx, addr)
-			if err != nil {
-				resC <- false
-				return
-			}
-
-			if len(txt) > 0 {
-				status := txt[0]
-				captures = append(captures, status)
-				tx.Variables().TX().Set("httpbl_msg", []string{status})
-			}
+		if o.cache != nil {
+			o.cache.Put(name, res, time.Now().Add(o.cacheTTL))
 		}
+	}
+	if !res.listed {
+		return false
+	}
 
-		resC <- true
-	}(ctx)
+	txVars := tx.Variables().TX()
+	if res.msg != "" {
+		txVars.Set("httpbl_msg", []string{res.msg})
+	}
+	if r := res.httpBL; r != nil {
+		txVars.Set("httpbl_days", []string{strconv.Itoa(r.days)})
+		txVars.Set("httpbl_threat_score", []string{strconv.Itoa(r.threatScore)})
+		txVars.Set("httpbl_type", []string{strconv.Itoa(r.visitorType)})
+	}
+	if res.msg != "" {
+		tx.CaptureField(0, res.msg)
+	}
+	return true
+}
 
-	select {
-	case res := <-resC:
-		if res && len(captures) > 0 {
-			tx.CaptureField(0, captures[0])
+// queryName returns the name to look up for addr, e.g. 4.3.2.1.service for
+// 1.2.3.4 and the reversed nibbles of IPv6 addresses.
+func (o *rbl) queryName(addr netip.Addr) string {
+	var b strings.Builder
+	if o.httpBL {
+		b.WriteString(o.key)
+		b.WriteByte('.')
+	}
+	ip := addr.AsSlice()
+	for i := len(ip) - 1; i >= 0; i-- {
+		if addr.Is4() {
+			b.WriteString(strconv.Itoa(int(ip[i])))
+			b.WriteByte('.')
+			continue
 		}
-		return res
-	case <-time.After(timeout):
-		return false
+		const hex = "0123456789abcdef"
+		b.WriteByte(hex[ip[i]&0x0f])
+		b.WriteByte('.')
+		b.WriteByte(hex[ip[i]>>4])
+		b.WriteByte('.')
+	}
+	b.WriteString(o.service)
+	return b.String()
+}
+
+// lookup resolves name, an error is returned when the result is not
+// conclusive and must not be cached. The lookup is abandoned after the
+// timeout or when ctx, the context of the transaction, is done.
+func (o *rbl) lookup(ctx context.Context, name string) (rblResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	addrs, err := o.resolver.LookupNetIP(ctx, "ip4", name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return rblResult{}, nil
+		}
+		return rblResult{}, err
+	}
+
+	var res rblResult
+	for _, a := range addrs {
+		a = a.Unmap()
+		ip := a.As4()
+		if ip[0] != 127 {
+			continue
+		}
+		if ip[1] == 255 && ip[2] == 255 {
+			// lists like Spamhaus use 127.255.255.0/24 to refuse queries
+			return rblResult{}, fmt.Errorf("query refused by the list with %s", a)
+		}
+		res.listed = true
+		if o.httpBL {
+			res.httpBL = &httpBLResponse{days: int(ip[1]), threatScore: int(ip[2]), visitorType: int(ip[3])}
+			res.msg = res.httpBL.String()
+			return res, nil
+		}
+	}
+	if !res.listed {
+		return res, nil
+	}
+
+	// the reason of the listing is informative, the address is listed anyway
+	if txt, err := o.resolver.LookupTXT(ctx, name); err == nil && len(txt) > 0 {
+		res.msg = txt[0]
+	}
+	return res, nil
+}
+
+func (r *httpBLResponse) String() string {
+	var kinds []string
+	for i, kind := range httpBLTypes {
+		if r.visitorType&(1<<i) != 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+	kind := "Search Engine"
+	if len(kinds) > 0 {
+		kind = strings.Join(kinds, ", ")
 	}
+	return fmt.Sprintf("%s: %d days since last activity, threat score %d", kind, r.days, r.threatScore)
 }
 
 func init() {
@@ -7,7 +7,10 @@
 package operators
 
 import (
+	"context"
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/foxcpp/go-mockdns"
 
@@ -30,20 +33,44 @@ func TestRbl(t *testing.T) {
 	if err != nil {
 		t.Fatal("Cannot init rbl operator")
 	}
+	opts.RBL.CacheTTL = -1
+	uncached, err := newRBL(opts)
+	if err != nil {
+		t.Fatal("Cannot init rbl operator")
+	}
+	httpBL, err := newRBL(plugintypes.OperatorOptions{
+		Arguments: "dnsbl.httpbl.org",
+		RBL:       plugintypes.RBLOptions{HTTPBLKey: "abcdefghijkl"},
+	})
+	if err != nil {
+		t.Fatal("Cannot init rbl operator")
+	}
 
 	logger := &testLogger{t}
 
 	srv, err := mockdns.NewServerWithLogger(map[string]mockdns.Zone{
-		"valid_no_txt.xbl.spamhaus.org.": {
+		"1.0.0.127.xbl.spamhaus.org.": {
+			A: []string{"127.0.0.4"},
+		},
+		"2.0.0.127.xbl.spamhaus.org.": {
+			A:   []string{"127.0.0.4"},
+			TXT: []string{"blocked"},
+		},
+		"3.0.0.127.xbl.spamhaus.org.": {
+			A: []string{"127.255.255.254"},
+		},
+		"4.0.0.127.xbl.spamhaus.org.": {
 			A: []string{"1.2.3.4"},
 		},
-		"valid_txt.xbl.spamhaus.org.": {
-			A:   []string{"1.2.3.5"},
-			TXT: []string{"not blocked"},
+		"1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.xbl.spamhaus.org.": {
+			A:   []string{"127.0.0.2"},
+			TXT: []string{"blocked v6"},
 		},
-		"blocked.xbl.spamhaus.org.": {
-			A:   []string{"1.2.3.6"},
-			TXT: []string{"blocked"},
+		"abcdefghijkl.5.0.0.127.dnsbl.httpbl.org.": {
+			A: []string{"127.3.25.5"},
+		},
+		"abcdefghijkl.6.0.0.127.dnsbl.httpbl.org.": {
+			A: []string{"127.1.0.0"},
 		},
 	}, logger, false)
 	if err != nil {
@@ -54,36 +81,131 @@ func TestRbl(t *testing.T) {
 	srv.PatchNet(op.(*rbl).resolver)
 	defer mockdns.UnpatchNet(op.(*rbl).resolver)
 
-	t.Run("Valid hostname with no TXT record", func(t *testing.T) {
-		if op.Evaluate(nil, "valid_no_txt") {
-			t.Errorf("Unexpected result for valid hostname with no TXT record")
+	waf := corazawaf.NewWAF()
+
+	t.Run("Invalid addresses", func(t *testing.T) {
+		for _, addr := range []string{"", "blocked", "127.0.0", "127.0.0.2.xbl.spamhaus.org", "fe80::1%eth0"} {
+			if op.Evaluate(waf.NewTransaction(), addr) {
+				t.Errorf("Unexpected result for invalid address %q", addr)
+			}
+		}
+	})
+
+	t.Run("Listed address with no TXT record", func(t *testing.T) {
+		tx := waf.NewTransaction()
+		if !op.Evaluate(tx, "127.0.0.1") {
+			t.Errorf("Unexpected result for listed address")
+		}
+		if have := tx.Variables().TX().Get("httpbl_msg"); len(have) != 0 {
+			t.Errorf("Unexpected message %q", have)
+		}
+	})
+
+	t.Run("Listed address with TXT record", func(t *testing.T) {
+		tx := waf.NewTransaction()
+		tx.Capture = true
+		if !op.Evaluate(tx, "127.0.0.2") {
+			t.Fatal("Unexpected result for listed address")
+		}
+		if want, have := "blocked", tx.Variables().TX().Get("httpbl_msg"); len(have) != 1 || have[0] != want {
+			t.Errorf("Unexpected message: want %q, have %q", want, have)
+		}
+		if want, have := "blocked", tx.Variables().TX().Get("0"); len(have) != 1 || have[0] != want {
+			t.Errorf("Unexpected capture: want %q, have %q", want, have)
+		}
+	})
+
+	t.Run("Unlisted addresses", func(t *testing.T) {
+		for _, addr := range []string{"127.0.0.10", "127.0.0.3", "127.0.0.4", "2001:db8::2"} {
+			if op.Evaluate(waf.NewTransaction(), addr) {
+				t.Errorf("Unexpected result for unlisted address %q", addr)
+			}
+		}
+	})
+
+	t.Run("IPv6 addresses", func(t *testing.T) {
+		for _, addr := range []string{"2001:db8::1", "2001:0DB8:0:0:0:0:0:1", "::ffff:127.0.0.2"} {
+			if !op.Evaluate(waf.NewTransaction(), addr) {
+				t.Errorf("Unexpected result for listed address %q", addr)
+			}
 		}
 	})
 
-	t.Run("Valid hostname with TXT record", func(t *testing.T) {
-		tx := corazawaf.NewWAF().NewTransaction()
-		if !op.Evaluate(tx, "valid_txt") {
-			t.Errorf("Unexpected result for valid hostname")
+	t.Run("http:BL", func(t *testing.T) {
+		tx := waf.NewTransaction()
+		if !httpBL.Evaluate(tx, "127.0.0.5") {
+			t.Fatal("Unexpected result for listed address")
 		}
-		if want, have := "not blocked", tx.Variables().TX().Get("httpbl_msg")[0]; want != have {
-			t.Errorf("Unexpected result for valid hostname: want %q, have %q", want, have)
+		for name, want := range map[string]string{
+			"httpbl_days":         "3",
+			"httpbl_threat_score": "25",
+			"httpbl_type":         "5",
+			"httpbl_msg":          "Suspicious, Comment Spammer: 3 days since last activity, threat score 25",
+		} {
+			if have := tx.Variables().TX().Get(name); len(have) != 1 || have[0] != want {
+				t.Errorf("Unexpected TX:%s: want %q, have %q", name, want, have)
+			}
+		}
+
+		tx = waf.NewTransaction()
+		if !httpBL.Evaluate(tx, "127.0.0.6") {
+			t.Fatal("Unexpected result for listed address")
+		}
+		if want, have := "Search Engine: 1 days since last activity, threat score 0", tx.Variables().TX().Get("httpbl_msg"); len(have) != 1 || have[0] != want {
+			t.Errorf("Unexpected message: want %q, have %q", want, have)
+		}
+
+		if httpBL.Evaluate(waf.NewTransaction(), "2001:db8::1") {
+			t.Error("Unexpected result for IPv6 address")
 		}
 	})
 
-	t.Run("Invalid hostname", func(t *testing.T) {
-		if op.Evaluate(nil, "invalid") {
-			t.Errorf("Unexpected result for invalid hostname")
+	t.Run("Cancelled transaction", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		tx := waf.NewTransactionWithOptions(corazawaf.Options{Context: ctx})
+		if uncached.Evaluate(tx, "127.0.0.1") {
+			t.Error("Unexpected result for cancelled transaction")
+		}
+		if !uncached.Evaluate(waf.NewTransaction(), "127.0.0.1") {
+			t.Error("Unexpected result for listed address")
 		}
 	})
 
-	t.Run("Blocked hostname", func(t *testing.T) {
-		tx := corazawaf.NewWAF().NewTransaction()
-		if !op.Evaluate(tx, "blocked") {
-			t.Fatal("Unexpected result for blocked hostname")
+	t.Run("Cached results", func(t *testing.T) {
+		if !uncached.Evaluate(waf.NewTransaction(), "127.0.0.2") {
+			t.Fatal("Unexpected result for listed address")
 		}
-		t.Log(tx.Variables().TX().Get("httpbl_msg"))
-		if want, have := "blocked", tx.Variables().TX().Get("httpbl_msg")[0]; want != have {
-			t.Errorf("Unexpected result for valid hostname: want %q, have %q", want, have)
+		srv.Close()
+		if !op.Evaluate(waf.NewTransaction(), "127.0.0.2") {
+			t.Error("Expected the cached result for listed address")
+		}
+		if uncached.Evaluate(waf.NewTransaction(), "127.0.0.2") {
+			t.Error("Unexpected result without cache")
 		}
 	})
 }
+
+func TestRblOptions(t *testing.T) {
+	if _, err := newRBL(plugintypes.OperatorOptions{}); err == nil {
+		t.Error("Expected error for missing service")
+	}
+	if _, err := newRBL(plugintypes.OperatorOptions{Arguments: "dnsbl.httpbl.org"}); err == nil {
+		t.Error("Expected error for missing http:BL key")
+	}
+
+	op, err := newRBL(plugintypes.OperatorOptions{
+		Arguments: "zen.spamhaus.org.",
+		RBL:       plugintypes.RBLOptions{Timeout: time.Second, CacheTTL: time.Minute},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := op.(*rbl)
+	if o.timeout != time.Second || o.cache == nil || o.cacheTTL != time.Minute {
+		t.Errorf("Unexpected options: timeout %s, cache %v", o.timeout, o.cache)
+	}
+	if want, have := "1.0.0.127.zen.spamhaus.org", o.queryName(netip.MustParseAddr("127.0.0.1")); want != have {
+		t.Errorf("Unexpected query name: want %q, have %q", want, have)
+	}
+}
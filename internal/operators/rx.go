@@ -7,18 +7,17 @@ package operators
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
-	"unicode/utf8"
+	"strings"
 
 	"rsc.io/binaryregexp"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
 	"github.com/corazawaf/coraza/v3/internal/memoize"
+	"github.com/corazawaf/coraza/v3/internal/regex"
 )
 
 type rx struct {
-	re *regexp.Regexp
+	re plugintypes.Regexp
 }
 
 var _ plugintypes.Operator = (*rx)(nil)
@@ -45,16 +44,28 @@ func newRX(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
 		return newBinaryRX(options)
 	}
 
-	re, err := memoize.Do(data, func() (interface{}, error) { return regexp.Compile(data) })
+	name := strings.ToLower(options.RegexEngine)
+	if name == "" {
+		name = regex.DefaultEngine
+	}
+	engine, err := regex.Get(name)
 	if err != nil {
 		return nil, err
 	}
-	return &rx{re: re.(*regexp.Regexp)}, nil
+	key := fmt.Sprintf("rx,%s,%d,%d,%s", name, options.Regex.MatchLimit, options.Regex.MatchLimitRecursion, data)
+	re, err := memoize.Do(key, func() (interface{}, error) { return engine(data, options.Regex) })
+	if err != nil {
+		return nil, err
+	}
+	return &rx{re: re.(plugintypes.Regexp)}, nil
 }
 
 func (o *rx) Evaluate(tx plugintypes.TransactionState, value string) bool {
 	if tx.Capturing() {
-		match := o.re.FindStringSubmatch(value)
+		match, err := o.re.FindStringSubmatch(value)
+		if err != nil {
+			return matchFailed(tx, err)
+		}
 		if len(match) == 0 {
 			return false
 		}
@@ -66,10 +77,26 @@ func (o *rx) Evaluate(tx plugintypes.TransactionState, value string) bool {
 		}
 		return true
 	} else {
-		return o.re.MatchString(value)
+		ok, err := o.re.MatchString(value)
+		if err != nil {
+			return matchFailed(tx, err)
+		}
+		return ok
 	}
 }
 
+// matchFailed handles a match which could not complete, e.g. exceeding the
+// limits of a backtracking engine. As in ModSecurity the operator does not
+// match and TX:MSC_PCRE_LIMITS_EXCEEDED is set.
+func matchFailed(tx plugintypes.TransactionState, err error) bool {
+	tx.DebugLogger().Warn().
+		Str("operator", "rx").
+		Err(err).
+		Msg("Failed to match regular expression")
+	tx.Variables().TX().Set("msc_pcre_limits_exceeded", []string{"1"})
+	return false
+}
+
 // binaryRx is exactly the same as rx, but using the binaryregexp package for matching
 // arbitrary bytes.
 type binaryRX struct {
@@ -109,36 +136,3 @@ func (o *binaryRX) Evaluate(tx plugintypes.TransactionState, value string) bool
 func init() {
 	Register("rx", newRX)
 }
-
-// matchesArbitraryBytes checks for control sequences for byte matches in the expression.
-// If the sequences are not valid utf8, it returns true.
-func matchesArbitraryBytes(expr string) bool {
-	decoded := make([]byte, 0, len(expr))
-	for i := 0; i < len(expr); i++ {
-		c := expr[i]
-		if c != '\\' {
-			decoded = append(decoded, c)
-			continue
-		}
-		if i+3 >= len(expr) {
-			decoded = append(decoded, expr[i:]...)
-			break
-		}
-		if expr[i+1] != 'x' {
-			decoded = append(decoded, expr[i])
-			continue
-		}
-
-		v, mb, _, err := strconv.UnquoteChar(expr[i:], 0)
-		if err != nil || mb {
-			// Wasn't a byte escape sequence, shouldn't happen in practice.
-			decoded = append(decoded, expr[i])
-			continue
-		}
-
-		decoded = append(decoded, byte(v))
-		i += 3
-	}
-
-	return !utf8.Valid(decoded)
-}
@@ -0,0 +1,43 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.rxFromDataset
+
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/memoize"
+)
+
+// newRXFromDataset matches the regular expressions of a dataset declared
+// with SecDataset, like @rxFromFile.
+func newRXFromDataset(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	data := options.Arguments
+	dataset, ok := options.Datasets[data]
+	if !ok {
+		return nil, fmt.Errorf("dataset %q not found", data)
+	}
+	var patterns []string
+	for _, p := range dataset {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("no patterns in dataset %q", data)
+	}
+
+	s, err := memoize.Do(rxSetKey(patterns), func() (interface{}, error) { return newRXSet(patterns) })
+	if err != nil {
+		return nil, err
+	}
+	return s.(*rxSet), nil
+}
+
+func init() {
+	Register("rxFromDataset", newRXFromDataset)
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.rxFromDataset
+
+package operators
+
+import (
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+func TestRXFromDataset(t *testing.T) {
+	opts := plugintypes.OperatorOptions{
+		Arguments: "agents",
+		Datasets: map[string][]string{
+			"agents": {`(?i)sqlmap`, ` `, `^nikto/\d`},
+			"empty":  {" "},
+		},
+	}
+	op, err := newRXFromDataset(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	if !op.Evaluate(tx, "SQLMap/1.0") || !op.Evaluate(tx, "nikto/2") || op.Evaluate(tx, "Mozilla/5.0") {
+		t.Error("unexpected result")
+	}
+
+	opts.Arguments = "empty"
+	if _, err := newRXFromDataset(opts); err == nil {
+		t.Error("expected error for empty dataset")
+	}
+	opts.Arguments = "missing"
+	if _, err := newRXFromDataset(opts); err == nil {
+		t.Error("expected error for missing dataset")
+	}
+}
+
+func TestRXFromDatasetSameNameDifferentPatterns(t *testing.T) {
+	first, err := newRXFromDataset(plugintypes.OperatorOptions{
+		Arguments: "agents",
+		Datasets:  map[string][]string{"agents": {`sqlmap`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := newRXFromDataset(plugintypes.OperatorOptions{
+		Arguments: "agents",
+		Datasets:  map[string][]string{"agents": {`nikto`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	if !first.Evaluate(tx, "sqlmap") || first.Evaluate(tx, "nikto") {
+		t.Error("unexpected result for the first dataset")
+	}
+	if !second.Evaluate(tx, "nikto") || second.Evaluate(tx, "sqlmap") {
+		t.Error("unexpected result for the second dataset")
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.rxFromFile
+
+package operators
+
+import (
+	"fmt"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/memoize"
+)
+
+// newRXFromFile matches the regular expressions of a file, one per line,
+// e.g. "@rxFromFile bad-agents.txt". Empty lines and lines starting with #
+// are ignored. The pattern matching is captured in TX.0 and
+// TX.matched_pattern, and its groups in TX.1 to TX.9.
+func newRXFromFile(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	filepath := options.Arguments
+
+	data, err := loadFromFile(filepath, options.Path, options.Root)
+	if err != nil {
+		return nil, err
+	}
+	patterns := parseRXList(string(data))
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("no patterns in %s", filepath)
+	}
+
+	s, err := memoize.Do(rxSetKey(patterns), func() (interface{}, error) { return newRXSet(patterns) })
+	if err != nil {
+		return nil, err
+	}
+	return s.(*rxSet), nil
+}
+
+func init() {
+	Register("rxFromFile", newRXFromFile)
+}
@@ -0,0 +1,356 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	ahocorasick "github.com/petar-dambovaliev/aho-corasick"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+	"github.com/corazawaf/coraza/v3/internal/sync"
+)
+
+// maxPrefilterLiterals bounds the number of literals prefiltering a pattern
+const maxPrefilterLiterals = 16
+
+// rxSet matches a list of regular expressions in one pass. Patterns are
+// prefiltered with literals any of their matches contains, found with a
+// single Aho-Corasick automaton, and only patterns whose literals are found
+// are evaluated. Patterns without such literals are combined into a single
+// expression. The pattern reported is the one matching leftmost in the value,
+// the first of the list for matches starting at the same position, as a
+// single alternation of the patterns would.
+type rxSet struct {
+	patterns []string
+	res      []*regexp.Regexp
+
+	// prefilter finds the literals of filtered patterns, literalPatterns
+	// lists the patterns of each literal
+	prefilter       ahocorasick.AhoCorasick
+	literalPatterns [][]int
+
+	// combined matches the other patterns, the group wrapping the n-th
+	// of them is combinedGroups[n]
+	combined         *regexp.Regexp
+	combinedPatterns []int
+	combinedGroups   []int
+
+	// candidatesPool holds the patternSets used by candidates
+	candidatesPool sync.Pool
+}
+
+var _ plugintypes.Operator = (*rxSet)(nil)
+
+// rxSetKey returns the memoize key of the set of patterns. Sets are keyed by
+// their patterns, as the same file or dataset name may hold different ones
+// in each WAF.
+func rxSetKey(patterns []string) string {
+	h := sha256.New()
+	for _, p := range patterns {
+		h.Write([]byte(p))
+		h.Write([]byte{'\n'})
+	}
+	return "rxSet," + hex.EncodeToString(h.Sum(nil))
+}
+
+// newRXSet compiles the patterns with the flags of @rx
+func newRXSet(patterns []string) (*rxSet, error) {
+	flags := "(?sm)"
+	if shouldNotUseMultilineRegexesOperatorByDefault {
+		flags = "(?s)"
+	}
+
+	s := &rxSet{patterns: patterns, res: make([]*regexp.Regexp, len(patterns))}
+	literalIndex := map[string]int{}
+	var literals []string
+	var combined strings.Builder
+	group := 1
+	for i, p := range patterns {
+		if matchesArbitraryBytes(p) {
+			return nil, fmt.Errorf("pattern %q matches arbitrary bytes, which is only supported by @rx", p)
+		}
+		re, err := regexp.Compile(flags + p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %s", p, err.Error())
+		}
+		s.res[i] = re
+
+		if lits := prefilterLiterals(flags + p); lits != nil {
+			for _, l := range lits {
+				idx, ok := literalIndex[l]
+				if !ok {
+					idx = len(literals)
+					literalIndex[l] = idx
+					literals = append(literals, l)
+					s.literalPatterns = append(s.literalPatterns, nil)
+				}
+				s.literalPatterns[idx] = append(s.literalPatterns[idx], i)
+			}
+			continue
+		}
+
+		if combined.Len() > 0 {
+			combined.WriteByte('|')
+		}
+		combined.WriteByte('(')
+		combined.WriteString(p)
+		combined.WriteByte(')')
+		s.combinedPatterns = append(s.combinedPatterns, i)
+		s.combinedGroups = append(s.combinedGroups, group)
+		group += 1 + re.NumSubexp()
+	}
+
+	if len(literals) > 0 {
+		words := (len(patterns) + 63) / 64
+		s.candidatesPool = sync.NewPool(func() interface{} {
+			set := make(patternSet, words)
+			return &set
+		})
+		builder := ahocorasick.NewAhoCorasickBuilder(ahocorasick.Opts{
+			AsciiCaseInsensitive: true,
+			MatchKind:            ahocorasick.StandardMatch,
+			DFA:                  true,
+		})
+		s.prefilter = builder.Build(literals)
+	}
+	if combined.Len() > 0 {
+		re, err := regexp.Compile(flags + combined.String())
+		if err != nil {
+			return nil, err
+		}
+		s.combined = re
+	}
+	return s, nil
+}
+
+func (o *rxSet) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	if !tx.Capturing() {
+		if o.combined != nil && o.combined.MatchString(value) {
+			return true
+		}
+		if len(o.literalPatterns) == 0 {
+			return false
+		}
+		found := o.candidatesPool.Get().(*patternSet)
+		defer o.candidatesPool.Put(found)
+		o.candidates(value, *found)
+		for i := found.next(0); i >= 0; i = found.next(i + 1) {
+			if o.res[i].MatchString(value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	pattern, match := o.find(value)
+	if pattern < 0 {
+		return false
+	}
+	tx.CaptureField(0, o.patterns[pattern])
+	for i := 1; i < len(match) && i < 10; i++ {
+		tx.CaptureField(i, match[i])
+	}
+	tx.Variables().TX().Set("matched_pattern", []string{o.patterns[pattern]})
+	return true
+}
+
+// find returns the index of the pattern matching leftmost in value, and its
+// submatches, or -1
+func (o *rxSet) find(value string) (int, []string) {
+	best, bestStart := -1, 0
+	var bestLoc []int
+	if o.combined != nil {
+		if loc := o.combined.FindStringSubmatchIndex(value); loc != nil {
+			for n, g := range o.combinedGroups {
+				if loc[2*g] < 0 {
+					continue
+				}
+				next := len(loc) / 2
+				if n+1 < len(o.combinedGroups) {
+					next = o.combinedGroups[n+1]
+				}
+				best, bestStart, bestLoc = o.combinedPatterns[n], loc[0], loc[2*g:2*next]
+				break
+			}
+		}
+	}
+	if len(o.literalPatterns) > 0 {
+		found := o.candidatesPool.Get().(*patternSet)
+		defer o.candidatesPool.Put(found)
+		o.candidates(value, *found)
+		for i := found.next(0); i >= 0; i = found.next(i + 1) {
+			if best >= 0 && i > best && bestStart == 0 {
+				break
+			}
+			loc := o.res[i].FindStringSubmatchIndex(value)
+			if loc == nil {
+				continue
+			}
+			if best < 0 || loc[0] < bestStart || loc[0] == bestStart && i < best {
+				best, bestStart, bestLoc = i, loc[0], loc
+			}
+		}
+	}
+	if best < 0 {
+		return -1, nil
+	}
+
+	match := make([]string, len(bestLoc)/2)
+	for i := range match {
+		if start := bestLoc[2*i]; start >= 0 {
+			match[i] = value[start:bestLoc[2*i+1]]
+		}
+	}
+	return best, match
+}
+
+// candidates sets found to the filtered patterns whose literals are found
+// in value
+func (o *rxSet) candidates(value string, found patternSet) {
+	clear(found)
+	// the prefilter only reads the haystack, so it doesn't need a copy
+	iter := o.prefilter.IterOverlappingByte(utils.UnwrapUnsafe(value))
+	for m := iter.Next(); m != nil; m = iter.Next() {
+		for _, i := range o.literalPatterns[m.Pattern()] {
+			found.add(i)
+		}
+	}
+}
+
+// patternSet is a bitset of pattern indexes
+type patternSet []uint64
+
+func (s patternSet) add(i int) {
+	s[i/64] |= 1 << (i % 64)
+}
+
+// next returns the lowest index of the set not lower than i, or -1
+func (s patternSet) next(i int) int {
+	for w := i / 64; w < len(s); w++ {
+		word := s[w]
+		if w == i/64 {
+			word &= ^uint64(0) << (i % 64)
+		}
+		if word != 0 {
+			return w*64 + bits.TrailingZeros64(word)
+		}
+	}
+	return -1
+}
+
+// prefilterLiterals returns literals one of which is contained in any match
+// of the pattern, or nil when there are none
+func prefilterLiterals(pattern string) []string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	return requiredLiterals(re.Simplify())
+}
+
+func requiredLiterals(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return foldedLiteral(re.Rune)
+		}
+		return []string{string(re.Rune)}
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return requiredLiterals(re.Sub[0])
+		}
+	case syntax.OpConcat:
+		// the literals of any required part will do, prefer the longest
+		var best []string
+		for _, sub := range re.Sub {
+			if lits := requiredLiterals(sub); lits != nil && shortest(lits) > shortest(best) {
+				best = lits
+			}
+		}
+		return best
+	case syntax.OpAlternate:
+		var lits []string
+		for _, sub := range re.Sub {
+			l := requiredLiterals(sub)
+			if l == nil || len(lits)+len(l) > maxPrefilterLiterals {
+				return nil
+			}
+			lits = append(lits, l...)
+		}
+		return lits
+	}
+	return nil
+}
+
+// shortest returns the length of the shortest literal, 0 for none
+func shortest(lits []string) int {
+	n := 0
+	for i, l := range lits {
+		if i == 0 || len(l) < n {
+			n = len(l)
+		}
+	}
+	return n
+}
+
+// foldedLiteral returns the longest part of a case folded literal whose runes
+// only fold to ASCII, lowercased, as the prefilter only folds ASCII letters.
+// Other runes, like s and k folding to U+017F and U+212A, would let values
+// using them skip the pattern.
+func foldedLiteral(runes []rune) []string {
+	best, start := "", 0
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && foldsToASCII(runes[i]) {
+			continue
+		}
+		if i-start > len(best) {
+			best = strings.ToLower(string(runes[start:i]))
+		}
+		start = i + 1
+	}
+	if best == "" {
+		return nil
+	}
+	return []string{best}
+}
+
+// foldsToASCII returns whether every rune of the simple fold orbit of r,
+// including itself, is ASCII
+func foldsToASCII(r rune) bool {
+	if r >= utf8.RuneSelf {
+		return false
+	}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// parseRXList parses a list of patterns, one per line. Empty lines and lines
+// starting with # are ignored.
+func parseRXList(data string) []string {
+	var patterns []string
+	for _, l := range strings.Split(data, "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" || l[0] == '#' {
+			continue
+		}
+		patterns = append(patterns, l)
+	}
+	return patterns
+}
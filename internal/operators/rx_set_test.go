@@ -0,0 +1,201 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+func TestPrefilterLiterals(t *testing.T) {
+	tests := map[string][]string{
+		"abc":                {"abc"},
+		"(?i)ABC":            {"abc"},
+		`foo\d+barbaz`:       {"barbaz"},
+		"(?:select|union)":   {"select", "union"},
+		"(?i)(?:sel|UNI)ect": {"ect"},
+		"(?i)(?:sela|UNI)b":  {"ela", "uni"},
+		"(?i)select":         {"elect"},
+		"(?i)kiss":           {"i"},
+		"(?i)sk":             nil,
+		"a(bc)+d":            {"bc"},
+		"(?:ab){2,}":         {"ab"},
+		"x(?:ab)*":           {"x"},
+		"(?i)é":              nil,
+		"é":                  {"é"},
+		"(?:abc|.*)":         nil,
+		`\d+`:                nil,
+		"":                   nil,
+	}
+	for pattern, want := range tests {
+		if have := prefilterLiterals(pattern); !reflect.DeepEqual(have, want) {
+			t.Errorf("unexpected literals for %q: %q, want %q", pattern, have, want)
+		}
+	}
+}
+
+func TestRXSet(t *testing.T) {
+	patterns := []string{
+		`(?i)union\s+select`,
+		`^\d{6,}$`,
+		`(a)(b)?c`,
+		`(?:sleep|benchmark)\(`,
+		`[xyz]{3}`,
+		`bc`,
+	}
+	s, err := newRXSet(patterns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		value   string
+		pattern int
+		match   []string
+	}{
+		{value: "1 UNION  SELECT 2", pattern: 0, match: []string{"UNION  SELECT"}},
+		{value: "1234567", pattern: 1, match: []string{"1234567"}},
+		{value: "xx abc", pattern: 2, match: []string{"abc", "a", "b"}},
+		{value: "xx ac", pattern: 2, match: []string{"ac", "a", ""}},
+		{value: "sleep(5)", pattern: 3, match: []string{"sleep("}},
+		{value: "xyz sleep(5)", pattern: 4, match: []string{"xyz"}},
+		// same start, the first pattern of the list wins
+		{value: "bcd abc", pattern: 5, match: []string{"bc"}},
+		{value: "nothing", pattern: -1},
+	}
+	for _, tt := range tests {
+		pattern, match := s.find(tt.value)
+		if pattern != tt.pattern || !reflect.DeepEqual(match, tt.match) {
+			t.Errorf("unexpected match for %q: %d %q, want %d %q", tt.value, pattern, match, tt.pattern, tt.match)
+		}
+	}
+
+	if _, err := newRXSet([]string{"valid", "(invalid"}); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+	if _, err := newRXSet([]string{`\xff`}); err == nil {
+		t.Error("expected error for binary pattern")
+	}
+}
+
+// TestRXSetRandom checks the set against a single alternation of the patterns
+func TestRXSetRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	atoms := []string{"a", "b", "ab", "ba", "(?i)AB", "a+", "b*", "[ab]", ".", "(a|b)", "(?:aa|bb)", "^", "$", `\b`}
+	randomPattern := func() string {
+		var b strings.Builder
+		for i := 0; i < 1+r.Intn(4); i++ {
+			b.WriteString(atoms[r.Intn(len(atoms))])
+		}
+		return b.String()
+	}
+
+	for i := 0; i < 200; i++ {
+		patterns := make([]string, 1+r.Intn(8))
+		groups := make([]int, len(patterns))
+		var alternation []string
+		group := 1
+		for j := range patterns {
+			patterns[j] = randomPattern()
+			groups[j] = group
+			group += 1 + regexp.MustCompile(patterns[j]).NumSubexp()
+			alternation = append(alternation, "("+patterns[j]+")")
+		}
+		s, err := newRXSet(patterns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := regexp.MustCompile("(?sm)" + strings.Join(alternation, "|"))
+
+		for j := 0; j < 20; j++ {
+			value := make([]byte, r.Intn(8))
+			for k := range value {
+				value[k] = "abAB "[r.Intn(5)]
+			}
+			wantPattern := -1
+			if loc := want.FindStringSubmatchIndex(string(value)); loc != nil {
+				for n, g := range groups {
+					if loc[2*g] >= 0 {
+						wantPattern = n
+						break
+					}
+				}
+			}
+			if have, _ := s.find(string(value)); have != wantPattern {
+				t.Fatalf("unexpected pattern for %q in %q: %d, want %d", value, patterns, have, wantPattern)
+			}
+		}
+	}
+}
+
+func TestRXSetCapture(t *testing.T) {
+	s, err := newRXSet([]string{`nothing`, `(?i)(curl|wget)/([0-9.]+)`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	tx.Capture = true
+	if !s.Evaluate(tx, "agent: Wget/1.21") {
+		t.Fatal("expected a match")
+	}
+	for key, want := range map[string]string{
+		"0":               `(?i)(curl|wget)/([0-9.]+)`,
+		"1":               "Wget",
+		"2":               "1.21",
+		"matched_pattern": `(?i)(curl|wget)/([0-9.]+)`,
+	} {
+		if have := tx.Variables().TX().Get(key); len(have) != 1 || have[0] != want {
+			t.Errorf("unexpected TX.%s %q, want %q", key, have, want)
+		}
+	}
+}
+
+func BenchmarkRXSet(b *testing.B) {
+	var patterns []string
+	for i := 0; i < 500; i++ {
+		patterns = append(patterns, fmt.Sprintf(`(?i)attack%d\s*=\s*\w+`, i))
+	}
+	value := strings.Repeat("benign request content with no attack markers ", 20)
+
+	b.Run("set", func(b *testing.B) {
+		s, err := newRXSet(patterns)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s.find(value)
+		}
+	})
+	b.Run("evaluate", func(b *testing.B) {
+		s, err := newRXSet(patterns)
+		if err != nil {
+			b.Fatal(err)
+		}
+		tx := corazawaf.NewWAF().NewTransaction()
+		defer tx.Close()
+		attack := value + "attack499 = x"
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s.Evaluate(tx, value)
+			s.Evaluate(tx, attack)
+		}
+	})
+	b.Run("alternation", func(b *testing.B) {
+		re := regexp.MustCompile("(?sm)(" + strings.Join(patterns, ")|(") + ")")
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			re.FindStringSubmatchIndex(value)
+		}
+	})
+}
@@ -8,6 +8,8 @@ package operators
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
@@ -108,6 +110,116 @@ func TestRx(t *testing.T) {
 	}
 }
 
+func TestRxBacktrack(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		want    bool
+		capture []string
+	}{
+		{pattern: `(?<=\$)\d+`, input: "price $42", want: true, capture: []string{"42"}},
+		{pattern: `(['"]).*?\1`, input: `x="a'b"`, want: true, capture: []string{`"a'b"`, `"`}},
+		{pattern: `^(?!admin$)\w+$`, input: "admin", want: false},
+		{pattern: `^hello.*world`, input: "test\nhello\nworld", want: true, capture: []string{"hello\nworld"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			rx, err := newRX(plugintypes.OperatorOptions{Arguments: tt.pattern, RegexEngine: "backtrack"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			waf := corazawaf.NewWAF()
+			tx := waf.NewTransaction()
+			if res := rx.Evaluate(tx, tt.input); res != tt.want {
+				t.Errorf("want %v, got %v", tt.want, res)
+			}
+			tx.Capture = true
+			if res := rx.Evaluate(tx, tt.input); res != tt.want {
+				t.Errorf("want %v, got %v when capturing", tt.want, res)
+			}
+			for i, want := range tt.capture {
+				if have := tx.Variables().TX().Get(strconv.Itoa(i)); len(have) != 1 || have[0] != want {
+					t.Errorf("unexpected capture %d: %q, want %q", i, have, want)
+				}
+			}
+		})
+	}
+
+	if _, err := newRX(plugintypes.OperatorOptions{Arguments: `(?<=\$)\d+`}); err == nil {
+		t.Error("expected RE2 to reject lookbehinds")
+	}
+	if _, err := newRX(plugintypes.OperatorOptions{Arguments: "a", RegexEngine: "unknown"}); err == nil {
+		t.Error("expected an error for an unknown engine")
+	}
+}
+
+func TestRxBacktrackLimits(t *testing.T) {
+	rx, err := newRX(plugintypes.OperatorOptions{
+		Arguments:   `^(a+)+$`,
+		RegexEngine: "backtrack",
+		Regex:       plugintypes.RegexOptions{MatchLimit: 1000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	if rx.Evaluate(tx, strings.Repeat("a", 30)+"!") {
+		t.Error("expected no match when exceeding the match limit")
+	}
+	if have := tx.Variables().TX().Get("msc_pcre_limits_exceeded"); len(have) != 1 || have[0] != "1" {
+		t.Errorf("expected TX:MSC_PCRE_LIMITS_EXCEEDED to be set, got %q", have)
+	}
+	if !rx.Evaluate(tx, "aaa") {
+		t.Error("expected a match within the limits")
+	}
+}
+
+// TestRxSetMatchesRx evaluates patterns with @rx and as a set, which must
+// agree, including on values only matching through Unicode case folding.
+func TestRxSetMatchesRx(t *testing.T) {
+	patterns := []string{
+		`(?i)select.+from`,
+		`(?i)kill\s+\d+`,
+		`(?i)(?:sel|uni)ect`,
+		`(?i)<script`,
+		`union\s+select`,
+	}
+	values := []string{
+		"select x from y",
+		"SELECT x FROM y",
+		"\u017felect x from y", // LATIN SMALL LETTER LONG S
+		"\u212aill 9",          // KELVIN SIGN
+		"kill 9",
+		"unielect",
+		"<\u017fcript>",
+		"<SCRIPT>",
+		"union select",
+		"UNION SELECT",
+		"nothing",
+	}
+	waf := corazawaf.NewWAF()
+	for _, p := range patterns {
+		rx, err := newRX(plugintypes.OperatorOptions{Arguments: p})
+		if err != nil {
+			t.Fatal(err)
+		}
+		set, err := newRXSet([]string{"nothing to see", p})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range values {
+			tx := waf.NewTransaction()
+			want := rx.Evaluate(tx, v)
+			if have := set.Evaluate(tx, v); have != want {
+				t.Errorf("unexpected result of %q for %q: %t, @rx %t", p, v, have, want)
+			}
+			tx.Close()
+		}
+	}
+}
+
 func BenchmarkRxSubstringVsMatch(b *testing.B) {
 	str := "hello world; heelloo Woorld; hello; heeeelloooo wooooooorld;hello world; heelloo Woorld; hello; heeeelloooo wooooooorld;hello world; heelloo Woorld; hello; heeeelloooo wooooooorld;hello world; heelloo Woorld; hello; heeeelloooo wooooooorld;hello world; heelloo Woorld; hello; heeeelloooo wooooooorld;hello world; heelloo Woorld; hello; heeeelloooo wooooooorld;hello world; heelloo Woorld; hello; heeeelloooo wooooooorld;"
 	rx := regexp.MustCompile(`((h.*e.*l.*l.*o.*)|\d+)`)
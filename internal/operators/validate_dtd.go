@@ -0,0 +1,61 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.validateDTD
+
+package operators
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/xmlvalidate"
+)
+
+// validateDTD validates the request body processed by the XML body processor
+// against a DTD. It matches when the document is not valid and sets the reason
+// in REQBODY_ERROR_MSG. The DOCTYPE of the document is ignored and external
+// entities are never resolved.
+type validateDTD struct {
+	dtd *xmlvalidate.DTD
+}
+
+var _ plugintypes.Operator = (*validateDTD)(nil)
+
+func newValidateDTD(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	filepath := strings.TrimSpace(options.Arguments)
+	if filepath == "" {
+		return nil, errors.New("invalid @validateDTD argument, expected the path of a DTD file")
+	}
+
+	data, err := loadFromFile(filepath, options.Path, options.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	dtd, err := xmlvalidate.NewDTD(data)
+	if err != nil {
+		return nil, err
+	}
+	return &validateDTD{dtd: dtd}, nil
+}
+
+func (o *validateDTD) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	doc, ok := xmlDocument(tx, value)
+	if !ok {
+		tx.DebugLogger().Debug().
+			Str("operator", "validateDTD").
+			Msg("No XML request body to validate")
+		return false
+	}
+	if err := o.dtd.Validate(doc); err != nil {
+		setXMLValidationError(tx, "XML: DTD validation failed: "+err.Error())
+		return true
+	}
+	return false
+}
+
+func init() {
+	Register("validateDTD", newValidateDTD)
+}
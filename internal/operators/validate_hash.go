@@ -0,0 +1,54 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.validateHash
+
+package operators
+
+import (
+	"regexp"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/memoize"
+)
+
+// hashVerifier is implemented by transactions supporting the hash engine
+type hashVerifier interface {
+	VerifyHash(uri string) (valid bool, enforced bool)
+}
+
+// validateHash verifies the signature added by the hash engine (see
+// SecHashEngine) to the links matching the regular expression, e.g.
+// SecRule REQUEST_URI "@validateHash product_info|product_list". It matches
+// when the signature of a matching link is missing or invalid. Nothing is
+// verified while the hash engine or its enforcement are disabled.
+type validateHash struct {
+	re *regexp.Regexp
+}
+
+var _ plugintypes.Operator = (*validateHash)(nil)
+
+func newValidateHash(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	data := options.Arguments
+	re, err := memoize.Do(data, func() (interface{}, error) { return regexp.Compile(data) })
+	if err != nil {
+		return nil, err
+	}
+	return &validateHash{re: re.(*regexp.Regexp)}, nil
+}
+
+func (o *validateHash) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	if !o.re.MatchString(value) {
+		return false
+	}
+	hv, ok := tx.(hashVerifier)
+	if !ok {
+		return false
+	}
+	valid, enforced := hv.VerifyHash(value)
+	return enforced && !valid
+}
+
+func init() {
+	Register("validateHash", newValidateHash)
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/internal/hashengine"
+)
+
+func TestValidateHash(t *testing.T) {
+	signer, err := hashengine.NewSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer.AddMethod(hashengine.TargetHref, regexp.MustCompile(`product`))
+	signed, ok := signer.SignLink(hashengine.TargetHref, "/product.php?id=1", &url.URL{Path: "/"}, "")
+	if !ok {
+		t.Fatal("link not signed")
+	}
+
+	op, err := newValidateHash(plugintypes.OperatorOptions{Arguments: "^/product"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	waf.HashSigner = signer
+	waf.HashEngine = true
+	tx := waf.NewTransaction()
+
+	tests := map[string]bool{
+		signed:              false,
+		"/product.php?id=1": true,
+		"/product.php?id=2&hmac=" + url.Values{"x": {signed}}.Encode(): true,
+		"/about.php": false,
+	}
+	for uri, want := range tests {
+		if have := op.Evaluate(tx, uri); have != want {
+			t.Errorf("unexpected result for %q: %t, want %t", uri, have, want)
+		}
+	}
+
+	tx.HashEnforcement = false
+	if op.Evaluate(tx, "/product.php?id=1") {
+		t.Error("unexpected match without enforcement")
+	}
+
+	if _, err := newValidateHash(plugintypes.OperatorOptions{Arguments: "("}); err == nil {
+		t.Error("expected error for invalid regular expression")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.validateOpenAPI
+
+package operators
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+// validateOpenAPI validates the request against the OpenAPI specification
+// configured with SecOpenAPISpec, the input is the request path, e.g.
+// SecRule REQUEST_FILENAME "@validateOpenAPI". It matches when the request
+// violates the specification and captures the violation in TX.0. Like
+// @restpath, the path parameters of the matching operation are set in
+// ARGS_PATH. The body is only validated once available, in phase 2.
+type validateOpenAPI struct {
+	spec plugintypes.OpenAPISpec
+}
+
+var _ plugintypes.Operator = (*validateOpenAPI)(nil)
+
+func newValidateOpenAPI(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	if options.OpenAPI == nil {
+		return nil, errors.New("@validateOpenAPI requires an OpenAPI specification, configure it with SecOpenAPISpec")
+	}
+	return &validateOpenAPI{spec: options.OpenAPI}, nil
+}
+
+func (o *validateOpenAPI) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	if i := strings.IndexByte(value, '?'); i >= 0 {
+		value = value[:i]
+	}
+	v := tx.Variables()
+	headers := v.RequestHeaders()
+	req := &plugintypes.OpenAPIRequest{
+		Method: v.RequestMethod().Get(),
+		Path:   value,
+		Query:  v.ArgsGet().Get,
+		Header: headers.Get,
+		Cookie: v.RequestCookies().Get,
+		Body:   openAPIBody(tx),
+	}
+	if ct := headers.Get("content-type"); len(ct) > 0 {
+		req.ContentType = ct[0]
+	}
+
+	params, err := o.spec.ValidateRequest(req)
+	for name, param := range params {
+		v.ArgsPath().SetIndex(name, 0, param)
+	}
+	if err == nil {
+		return false
+	}
+	if tx.Capturing() {
+		tx.CaptureField(0, err.Error())
+	}
+	tx.DebugLogger().Debug().
+		Str("operator", "validateOpenAPI").
+		Err(err).
+		Msg("Request violates the OpenAPI specification")
+	return true
+}
+
+// openAPIBody returns the request body, nil if it has not been read yet.
+func openAPIBody(tx plugintypes.TransactionState) io.Reader {
+	rbr, ok := tx.(requestBodyReader)
+	if !ok {
+		return nil
+	}
+	r, err := rbr.RequestBodyReader()
+	if err != nil {
+		return nil
+	}
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil
+	}
+	if len(body) == 0 {
+		// a body was sent but is not available, e.g. in phase 1 or without
+		// request body access.
+		headers := tx.Variables().RequestHeaders()
+		if cl := headers.Get("content-length"); len(cl) > 0 && cl[0] != "0" {
+			return nil
+		}
+		if len(headers.Get("transfer-encoding")) > 0 {
+			return nil
+		}
+	}
+	return bytes.NewReader(body)
+}
+
+func init() {
+	Register("validateOpenAPI", newValidateOpenAPI)
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/internal/openapi"
+)
+
+func TestValidateOpenAPI(t *testing.T) {
+	if _, err := newValidateOpenAPI(plugintypes.OperatorOptions{}); err == nil {
+		t.Error("expected error without specification")
+	}
+
+	spec, err := openapi.Load([]byte(`
+openapi: 3.1.0
+paths:
+  /orders/{id}:
+    put:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [qty]
+              properties:
+                qty: {type: integer}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, err := newValidateOpenAPI(plugintypes.OperatorOptions{OpenAPI: spec})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	waf.RequestBodyAccess = true
+	newTx := func(body string) *corazawaf.Transaction {
+		tx := waf.NewTransaction()
+		tx.Capture = true
+		tx.ProcessURI("/orders/7?debug=1", "PUT", "HTTP/1.1")
+		tx.AddRequestHeader("Content-Type", "application/json")
+		if body != "" {
+			tx.AddRequestHeader("Content-Length", "10")
+		}
+		return tx
+	}
+
+	// in phase 1 the body is not available yet
+	tx := newTx(`{"qty": 1}`)
+	if op.Evaluate(tx, "/orders/7?debug=1") {
+		t.Error("unexpected match before the body is read")
+	}
+	if have := tx.Variables().ArgsPath().Get("id"); len(have) != 1 || have[0] != "7" {
+		t.Errorf("unexpected ARGS_PATH:id %v", have)
+	}
+
+	if _, _, err := tx.WriteRequestBody([]byte(`{"qty": 1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if op.Evaluate(tx, "/orders/7") {
+		t.Error("unexpected match for a valid request")
+	}
+
+	tx = newTx(`{"qty": "1"}`)
+	if _, _, err := tx.WriteRequestBody([]byte(`{"qty": "1"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if !op.Evaluate(tx, "/orders/7") {
+		t.Fatal("expected match for an invalid body")
+	}
+	if want, have := `request body at /qty: expected integer, got "1"`, tx.Variables().TX().Get("0"); len(have) != 1 || have[0] != want {
+		t.Errorf("unexpected TX:0, want %q, have %v", want, have)
+	}
+
+	tx = newTx("")
+	if !op.Evaluate(tx, "/orders/7") {
+		t.Error("expected match for a missing body")
+	}
+	if !op.Evaluate(tx, "/orders") {
+		t.Error("expected match for an unknown path")
+	}
+}
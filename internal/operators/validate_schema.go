@@ -0,0 +1,62 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.validateSchema
+
+package operators
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/xmlvalidate"
+)
+
+// validateSchema validates the request body processed by the XML body
+// processor against an XML Schema, e.g. SecRule REQUEST_BODY "@validateSchema
+// soap.xsd". It matches when the document is not valid and sets the reason in
+// REQBODY_ERROR_MSG. Only the common subset of XML Schema 1.0 is supported and
+// remote schema locations are never loaded.
+type validateSchema struct {
+	schema *xmlvalidate.Schema
+}
+
+var _ plugintypes.Operator = (*validateSchema)(nil)
+
+func newValidateSchema(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	filepath := strings.TrimSpace(options.Arguments)
+	if filepath == "" {
+		return nil, errors.New("invalid @validateSchema argument, expected the path of a XSD file")
+	}
+
+	data, err := loadFromFile(filepath, options.Path, options.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := xmlvalidate.NewSchema(data, xmlLoader(filepath, options))
+	if err != nil {
+		return nil, err
+	}
+	return &validateSchema{schema: schema}, nil
+}
+
+func (o *validateSchema) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	doc, ok := xmlDocument(tx, value)
+	if !ok {
+		tx.DebugLogger().Debug().
+			Str("operator", "validateSchema").
+			Msg("No XML request body to validate")
+		return false
+	}
+	if err := o.schema.Validate(doc); err != nil {
+		setXMLValidationError(tx, "XML: schema validation failed: "+err.Error())
+		return true
+	}
+	return false
+}
+
+func init() {
+	Register("validateSchema", newValidateSchema)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"io"
+	"path"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/collections"
+	"github.com/corazawaf/coraza/v3/internal/xmlvalidate"
+)
+
+type requestBodyReader interface {
+	RequestBodyReader() (io.Reader, error)
+}
+
+// xmlDocument returns the XML document to validate. Like in ModSecurity, it is
+// the request body processed by the XML body processor, whatever the variable
+// of the rule. The value is only used for transactions without request body.
+func xmlDocument(tx plugintypes.TransactionState, value string) (io.Reader, bool) {
+	rbr, ok := tx.(requestBodyReader)
+	if !ok {
+		return strings.NewReader(value), true
+	}
+	if tx.Variables().RequestBodyProcessor().Get() != "XML" {
+		return nil, false
+	}
+	r, err := rbr.RequestBodyReader()
+	if err != nil {
+		return nil, false
+	}
+	return r, true
+}
+
+// xmlLoader loads the documents referenced by the file at filepath, relative
+// to its directory.
+func xmlLoader(filepath string, options plugintypes.OperatorOptions) xmlvalidate.Loader {
+	dirs := []string{path.Dir(filepath)}
+	if !path.IsAbs(filepath) {
+		dirs = dirs[:0]
+		for _, p := range options.Path {
+			dirs = append(dirs, path.Join(p, path.Dir(filepath)))
+		}
+	}
+	return func(location string) ([]byte, error) {
+		return loadFromFile(location, dirs, options.Root)
+	}
+}
+
+// setXMLValidationError sets the reason of a validation failure in
+// REQBODY_ERROR_MSG
+func setXMLValidationError(tx plugintypes.TransactionState, msg string) {
+	if col, ok := tx.Variables().RequestBodyErrorMsg().(*collections.Single); ok {
+		col.Set(msg)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/collections"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+func newXMLTransaction(t *testing.T, doc string) *corazawaf.Transaction {
+	t.Helper()
+	waf := corazawaf.NewWAF()
+	waf.RequestBodyAccess = true
+	tx := waf.NewTransaction()
+	tx.Variables().RequestBodyProcessor().(*collections.Single).Set("XML")
+	if _, _, err := tx.WriteRequestBody([]byte(doc)); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestValidateSchema(t *testing.T) {
+	root := fstest.MapFS{
+		"rules/schemas/order.xsd": &fstest.MapFile{Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:include schemaLocation="types.xsd"/>
+  <xs:element name="order">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element name="qty" type="Quantity"/>
+      </xs:sequence>
+    </xs:complexType>
+  </xs:element>
+</xs:schema>`)},
+		"rules/schemas/types.xsd": &fstest.MapFile{Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:simpleType name="Quantity">
+    <xs:restriction base="xs:positiveInteger">
+      <xs:maxInclusive value="10"/>
+    </xs:restriction>
+  </xs:simpleType>
+</xs:schema>`)},
+		"rules/invalid.xsd": &fstest.MapFile{Data: []byte(`<schema/>`)},
+	}
+	newOp := func(args string) (plugintypes.Operator, error) {
+		return newValidateSchema(plugintypes.OperatorOptions{
+			Arguments: args,
+			Path:      []string{"rules"},
+			Root:      root,
+		})
+	}
+
+	for _, args := range []string{"", "missing.xsd", "invalid.xsd"} {
+		if _, err := newOp(args); err == nil {
+			t.Errorf("expected error for %q", args)
+		}
+	}
+
+	op, err := newOp("schemas/order.xsd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := newXMLTransaction(t, `<order><qty>3</qty></order>`)
+	if op.Evaluate(tx, "") {
+		t.Error("unexpected match for a valid document")
+	}
+	if msg := tx.Variables().RequestBodyErrorMsg().Get(); msg != "" {
+		t.Errorf("unexpected REQBODY_ERROR_MSG %q", msg)
+	}
+
+	for _, doc := range []string{`<order><qty>11</qty></order>`, `<order><qty>1</qty><qty>2</qty></order>`, `<order>`, ``} {
+		tx := newXMLTransaction(t, doc)
+		if !op.Evaluate(tx, "") {
+			t.Errorf("expected match for %q", doc)
+		}
+		if msg := tx.Variables().RequestBodyErrorMsg().Get(); !strings.HasPrefix(msg, "XML: schema validation failed: ") {
+			t.Errorf("unexpected REQBODY_ERROR_MSG %q", msg)
+		}
+	}
+
+	// only the bodies processed by the XML body processor are validated
+	tx = corazawaf.NewWAF().NewTransaction()
+	if op.Evaluate(tx, `<order>`) {
+		t.Error("unexpected match without XML request body")
+	}
+}
+
+func TestValidateDTD(t *testing.T) {
+	root := fstest.MapFS{
+		"rules/order.dtd": &fstest.MapFile{Data: []byte(`<!ELEMENT order (qty)>
+<!ELEMENT qty (#PCDATA)>
+<!ENTITY passwd SYSTEM "file:///etc/passwd">`)},
+		"rules/invalid.dtd": &fstest.MapFile{Data: []byte(`<!ELEMENT order (qty>`)},
+	}
+	newOp := func(args string) (plugintypes.Operator, error) {
+		return newValidateDTD(plugintypes.OperatorOptions{
+			Arguments: args,
+			Path:      []string{"rules"},
+			Root:      root,
+		})
+	}
+
+	for _, args := range []string{"", "missing.dtd", "invalid.dtd"} {
+		if _, err := newOp(args); err == nil {
+			t.Errorf("expected error for %q", args)
+		}
+	}
+
+	op, err := newOp("order.dtd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := newXMLTransaction(t, `<order><qty>3</qty></order>`)
+	if op.Evaluate(tx, "") {
+		t.Error("unexpected match for a valid document")
+	}
+
+	for _, doc := range []string{`<order/>`, `<order><qty>&passwd;</qty></order>`, `<order><qty><x/></qty></order>`} {
+		tx := newXMLTransaction(t, doc)
+		if !op.Evaluate(tx, "") {
+			t.Errorf("expected match for %q", doc)
+		}
+		if msg := tx.Variables().RequestBodyErrorMsg().Get(); !strings.HasPrefix(msg, "XML: DTD validation failed: ") {
+			t.Errorf("unexpected REQBODY_ERROR_MSG %q", msg)
+		}
+	}
+}
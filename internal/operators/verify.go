@@ -0,0 +1,77 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"regexp"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/memoize"
+)
+
+// verifyFunction validates the checksum of a value matched by the expression
+type verifyFunction = func(input string) bool
+
+// verifyRX is the base of the data leakage operators: it looks for the candidates
+// matching the expression and validates them with fn, so values failing the checksum
+// do not produce false positives.
+type verifyRX struct {
+	fn verifyFunction
+	re *regexp.Regexp
+}
+
+var _ plugintypes.Operator = (*verifyRX)(nil)
+
+func newVerifyRX(expr string, fn verifyFunction) (plugintypes.Operator, error) {
+	re, err := memoize.Do(expr, func() (interface{}, error) { return regexp.Compile(expr) })
+	if err != nil {
+		return nil, err
+	}
+	return &verifyRX{fn: fn, re: re.(*regexp.Regexp)}, nil
+}
+
+// Evaluate returns true if any candidate is valid. Valid candidates are captured
+// (up to 10) so they can be referenced, e.g. for masking them in the logs.
+func (o *verifyRX) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	capturing := tx.Capturing()
+	res := false
+	i := 0
+	for _, m := range o.re.FindAllString(value, -1) {
+		if !o.fn(m) {
+			continue
+		}
+		if !capturing {
+			return true
+		}
+		res = true
+		tx.CaptureField(i, m)
+		i++
+		if i == 10 {
+			break
+		}
+	}
+	return res
+}
+
+// onlyDigits returns the digits of s, dropping separators like spaces, dots or dashes
+func onlyDigits(s string) []byte {
+	digits := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= '0' && c <= '9' {
+			digits = append(digits, c-'0')
+		}
+	}
+	return digits
+}
+
+// allEqual returns true if all the digits are the same, which is
+// a common placeholder for fake identifiers (e.g. 000.000.000-00)
+func allEqual(digits []byte) bool {
+	for _, d := range digits[1:] {
+		if d != digits[0] {
+			return false
+		}
+	}
+	return true
+}
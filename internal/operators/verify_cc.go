@@ -0,0 +1,44 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.verifyCC
+
+package operators
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+// newVerifyCC creates the verifyCC operator, which detects credit card numbers
+// matching the expression that also pass the Luhn check.
+func newVerifyCC(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	return newVerifyRX(options.Arguments, luhn)
+}
+
+// luhn validates a payment card number (ISO/IEC 7812), which has between 12
+// and 19 digits, the last one being the Luhn check digit.
+func luhn(input string) bool {
+	digits := onlyDigits(input)
+	if len(digits) < 12 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i])
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func init() {
+	Register("verifyCC", newVerifyCC)
+}
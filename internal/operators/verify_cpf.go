@@ -0,0 +1,44 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.verifyCPF
+
+package operators
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+// newVerifyCPF creates the verifyCPF operator, which detects Brazilian taxpayer
+// numbers (CPF) matching the expression that have valid check digits.
+func newVerifyCPF(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	return newVerifyRX(options.Arguments, cpf)
+}
+
+// cpf validates a CPF number, formed by 9 digits followed by two
+// modulo 11 check digits.
+func cpf(input string) bool {
+	digits := onlyDigits(input)
+	if len(digits) != 11 || allEqual(digits) {
+		return false
+	}
+
+	for n := 9; n <= 10; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += int(digits[i]) * (n + 1 - i)
+		}
+		dv := sum * 10 % 11
+		if dv == 10 {
+			dv = 0
+		}
+		if dv != int(digits[n]) {
+			return false
+		}
+	}
+	return true
+}
+
+func init() {
+	Register("verifyCPF", newVerifyCPF)
+}
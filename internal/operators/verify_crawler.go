@@ -0,0 +1,61 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.verifyCrawler
+
+package operators
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/rdns"
+)
+
+// hostResolver is implemented by transactions resolving host names
+type hostResolver interface {
+	ResolveHost(addr string) (host string, confirmed bool)
+}
+
+// verifyCrawler matches when the address resolves, with forward-confirmed
+// reverse DNS, to a host in one of the comma separated domains, e.g.
+// SecRule REMOTE_ADDR "!@verifyCrawler googlebot.com,google.com". It tells
+// search engine crawlers from clients only spoofing their User-Agent.
+type verifyCrawler struct {
+	domains []string
+}
+
+var _ plugintypes.Operator = (*verifyCrawler)(nil)
+
+func newVerifyCrawler(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	var domains []string
+	for _, d := range strings.FieldsFunc(options.Arguments, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if d = strings.Trim(d, "."); d != "" {
+			domains = append(domains, strings.ToLower(d))
+		}
+	}
+	if len(domains) == 0 {
+		return nil, errors.New("missing domains")
+	}
+	return &verifyCrawler{domains: domains}, nil
+}
+
+func (o *verifyCrawler) Evaluate(tx plugintypes.TransactionState, value string) bool {
+	hr, ok := tx.(hostResolver)
+	if !ok {
+		return false
+	}
+	host, confirmed := hr.ResolveHost(value)
+	if !confirmed || !rdns.InDomain(host, o.domains) {
+		return false
+	}
+	if tx.Capturing() {
+		tx.CaptureField(0, host)
+	}
+	return true
+}
+
+func init() {
+	Register("verifyCrawler", newVerifyCrawler)
+}
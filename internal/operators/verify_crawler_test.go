@@ -0,0 +1,75 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo
+// +build !tinygo
+
+package operators
+
+import (
+	"net"
+	"testing"
+
+	"github.com/foxcpp/go-mockdns"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+	"github.com/corazawaf/coraza/v3/internal/rdns"
+)
+
+func TestVerifyCrawler(t *testing.T) {
+	srv, err := mockdns.NewServer(map[string]mockdns.Zone{
+		"1.66.249.66.in-addr.arpa.": {
+			PTR: []string{"crawl-66-249-66-1.googlebot.com."},
+		},
+		"crawl-66-249-66-1.googlebot.com.": {
+			A: []string{"66.249.66.1"},
+		},
+		"1.113.0.203.in-addr.arpa.": {
+			PTR: []string{"crawl-66-249-66-1.googlebot.com."},
+		},
+		"2.113.0.203.in-addr.arpa.": {
+			PTR: []string{"host.example.com."},
+		},
+		"host.example.com.": {
+			A: []string{"203.0.113.2"},
+		},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	resolver := &net.Resolver{}
+	srv.PatchNet(resolver)
+
+	op, err := newVerifyCrawler(plugintypes.OperatorOptions{Arguments: "googlebot.com, .google.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	waf.HostnameResolver = rdns.NewNetResolver(resolver)
+	tests := map[string]bool{
+		"66.249.66.1":  true,
+		"203.0.113.1":  false, // spoofed reverse DNS
+		"203.0.113.2":  false, // another domain
+		"198.51.100.1": false, // no reverse DNS
+		"invalid":      false,
+	}
+	for addr, want := range tests {
+		tx := waf.NewTransaction()
+		tx.Capture = true
+		if have := op.Evaluate(tx, addr); have != want {
+			t.Errorf("unexpected result for %q: %t, want %t", addr, have, want)
+		}
+		if want {
+			if have := tx.Variables().TX().Get("0"); len(have) != 1 || have[0] != "crawl-66-249-66-1.googlebot.com" {
+				t.Errorf("unexpected capture %q", have)
+			}
+		}
+	}
+
+	if _, err := newVerifyCrawler(plugintypes.OperatorOptions{Arguments: " , "}); err == nil {
+		t.Error("expected error for missing domains")
+	}
+}
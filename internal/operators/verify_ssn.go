@@ -0,0 +1,51 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.verifySSN
+
+package operators
+
+import (
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+// newVerifySSN creates the verifySSN operator, which detects US social security
+// numbers matching the expression that follow the SSN numbering rules.
+func newVerifySSN(options plugintypes.OperatorOptions) (plugintypes.Operator, error) {
+	return newVerifyRX(options.Arguments, ssn)
+}
+
+// ssn validates a social security number, formed by a 3-digit area, a 2-digit
+// group and a 4-digit serial. None of them can be zero, areas 666 and above 740
+// were never assigned and the number cannot be a well-known sequence.
+func ssn(input string) bool {
+	digits := onlyDigits(input)
+	if len(digits) != 9 {
+		return false
+	}
+
+	area := int(digits[0])*100 + int(digits[1])*10 + int(digits[2])
+	group := int(digits[3])*10 + int(digits[4])
+	serial := int(digits[5])*1000 + int(digits[6])*100 + int(digits[7])*10 + int(digits[8])
+	if area == 0 || area == 666 || area >= 740 || group == 0 || serial == 0 {
+		return false
+	}
+
+	if allEqual(digits) {
+		return false
+	}
+
+	// 123456789, 234567890, ...
+	sequence := true
+	for i := 1; i < len(digits); i++ {
+		if digits[i] != (digits[i-1]+1)%10 {
+			sequence = false
+			break
+		}
+	}
+	return !sequence
+}
+
+func init() {
+	Register("verifySSN", newVerifySSN)
+}
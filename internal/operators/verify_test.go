@@ -0,0 +1,88 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package operators
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/corazawaf"
+)
+
+func TestLuhn(t *testing.T) {
+	ok := []string{"4532009746910413", "4532-0097-4691-0413", "4532 0097 4691 0413", "343918934573386", "4024007182237"}
+	nok := []string{"4532009746910414", "1234567890012345", "0", "00000000000", "45320097469104130000"}
+	for _, o := range ok {
+		if !luhn(o) {
+			t.Errorf("invalid card number %q", o)
+		}
+	}
+	for _, o := range nok {
+		if luhn(o) {
+			t.Errorf("valid card number %q", o)
+		}
+	}
+}
+
+func TestSSN(t *testing.T) {
+	ok := []string{"574-57-8065", "574578065", "001-01-0001"}
+	nok := []string{"000-57-8065", "666-57-8065", "800-57-8065", "574-00-8065", "574-57-0000", "123-45-6789", "111-11-1111", "574-57-806"}
+	for _, o := range ok {
+		if !ssn(o) {
+			t.Errorf("invalid SSN %q", o)
+		}
+	}
+	for _, o := range nok {
+		if ssn(o) {
+			t.Errorf("valid SSN %q", o)
+		}
+	}
+}
+
+func TestCPF(t *testing.T) {
+	ok := []string{"010.817.514-60", "01081751460", "529.982.247-25"}
+	nok := []string{"010.817.514-61", "010.817.514-06", "111.111.111-11", "000.000.000-00", "010.817.514"}
+	for _, o := range ok {
+		if !cpf(o) {
+			t.Errorf("invalid CPF %q", o)
+		}
+	}
+	for _, o := range nok {
+		if cpf(o) {
+			t.Errorf("valid CPF %q", o)
+		}
+	}
+}
+
+func TestVerifyCapture(t *testing.T) {
+	op, err := newVerifyCC(plugintypes.OperatorOptions{
+		Arguments: `\d{4}-?\d{4}-?\d{4}-?\d{1,4}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	tx := waf.NewTransaction()
+	tx.Capture = true
+	input := "invalid 4532-0097-4691-0414, valid 4532-0097-4691-0413 and 5484605089158216"
+	if !op.Evaluate(tx, input) {
+		t.Fatal("expected match")
+	}
+
+	want := []string{"4532-0097-4691-0413", "5484605089158216"}
+	for i, w := range want {
+		if have := tx.Variables().TX().Get(strconv.Itoa(i)); len(have) != 1 || have[0] != w {
+			t.Errorf("unexpected capture %d, want %q, have %v", i, w, have)
+		}
+	}
+	if have := tx.Variables().TX().Get("2"); len(have) != 0 && have[0] != "" {
+		t.Errorf("unexpected capture 2, have %v", have)
+	}
+
+	if op.Evaluate(tx, "4532-0097-4691-0414") {
+		t.Error("unexpected match for a number failing the Luhn check")
+	}
+}
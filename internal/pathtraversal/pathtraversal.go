@@ -0,0 +1,270 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pathtraversal detects paths climbing above their base directory.
+// Inputs are normalised first, undoing the encodings servers and operating
+// systems accept for dots and slashes, mixed or nested.
+package pathtraversal
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxRounds bounds the decoding of nested percent encodings
+const maxRounds = 4
+
+// encoding is a set of encodings found while normalising a path
+type encoding uint8
+
+const (
+	// encPercent is percent encoding, e.g. %2e%2e%2f
+	encPercent encoding = 1 << iota
+	// encDouble is nested percent encoding, e.g. %252e
+	encDouble
+	// encUnicode is IIS %u encoding, e.g. %u002e
+	encUnicode
+	// encOverlong is overlong UTF-8, e.g. %c0%ae
+	encOverlong
+	// encWide is Unicode dots and slashes, e.g. U+FF0E or U+2215
+	encWide
+	// encBackslash is Windows separators, e.g. ..\
+	encBackslash
+	// encNull is null bytes, e.g. ../../etc/passwd%00.png
+	encNull
+	// encStrip is sequences becoming ../ once ../ is removed, e.g. ....//
+	encStrip
+)
+
+// encodingNames are the fingerprint characters of encodings, in the order of
+// their bits
+const encodingNames = "EDUOWBNS"
+
+// stripEvasions are the sequences becoming ../ once filters remove ../ from
+// them, they are normalised to ../
+var stripEvasions = strings.NewReplacer("....//", "../", "..././", "../")
+
+// sensitiveRoots are the top-level directories whose access through an
+// absolute path climbing back to the root is a traversal, e.g.
+// /var/www/../../etc/passwd
+var sensitiveRoots = map[string]bool{
+	"bin":     true,
+	"boot":    true,
+	"dev":     true,
+	"etc":     true,
+	"home":    true,
+	"lib":     true,
+	"proc":    true,
+	"root":    true,
+	"sys":     true,
+	"usr":     true,
+	"var":     true,
+	"windows": true,
+	"winnt":   true,
+}
+
+// lookalikes are Unicode characters normalised to dots and slashes
+var lookalikes = map[rune]byte{
+	'․': '.',  // one dot leader
+	'﹒': '.',  // small full stop
+	'．': '.',  // fullwidth full stop
+	'⁄': '/',  // fraction slash
+	'∕': '/',  // division slash
+	'／': '/',  // fullwidth solidus
+	'∖': '\\', // set minus
+	'﹨': '\\', // small reverse solidus
+	'＼': '\\', // fullwidth reverse solidus
+}
+
+// IsPathTraversal returns whether the input is a path climbing above its
+// base directory, and a fingerprint made of the encodings used, P for none,
+// followed by the number of levels climbed, e.g. "EB2" for "..%5c..%5cx".
+func IsPathTraversal(input string) (bool, string) {
+	if len(input) < 3 {
+		return false, ""
+	}
+	p, enc := normalize(input)
+	if strings.IndexByte(p, '/') < 0 {
+		return false, ""
+	}
+	levels := climbs(p)
+	if levels == 0 {
+		return false, ""
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(encodingNames); i++ {
+		if enc&(1<<i) != 0 {
+			b.WriteByte(encodingNames[i])
+		}
+	}
+	if b.Len() == 0 {
+		b.WriteByte('P')
+	}
+	b.WriteString(strconv.Itoa(levels))
+	return true, b.String()
+}
+
+// normalize decodes the input and returns it with / separators, along with
+// the encodings found
+func normalize(input string) (string, encoding) {
+	var enc encoding
+	s := input
+	for round := 0; round < maxRounds && strings.IndexByte(s, '%') >= 0; round++ {
+		decoded, unicode := percentDecode(s)
+		if decoded == s {
+			break
+		}
+		enc |= encPercent
+		if round > 0 {
+			enc |= encDouble
+		}
+		if unicode {
+			enc |= encUnicode
+		}
+		s = decoded
+	}
+
+	s, e := decodeCharacters(s)
+	enc |= e
+	if strings.IndexByte(s, 0) >= 0 {
+		s = strings.ReplaceAll(s, "\x00", "")
+		enc |= encNull
+	}
+	if strings.IndexByte(s, '\\') >= 0 {
+		s = strings.ReplaceAll(s, "\\", "/")
+		enc |= encBackslash
+	}
+	if stripped := stripEvasions.Replace(s); stripped != s {
+		s = stripped
+		enc |= encStrip
+	}
+	return s, enc
+}
+
+// percentDecode decodes %XX and %uXXXX sequences, leaving invalid ones as is,
+// and reports whether %u sequences were found
+func percentDecode(s string) (string, bool) {
+	var b strings.Builder
+	b.Grow(len(s))
+	unicode := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '%' {
+			b.WriteByte(c)
+			continue
+		}
+		if i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			b.WriteByte(unhex(s[i+1])<<4 | unhex(s[i+2]))
+			i += 2
+			continue
+		}
+		if i+5 < len(s) && (s[i+1] == 'u' || s[i+1] == 'U') &&
+			isHex(s[i+2]) && isHex(s[i+3]) && isHex(s[i+4]) && isHex(s[i+5]) {
+			r := rune(unhex(s[i+2]))<<12 | rune(unhex(s[i+3]))<<8 | rune(unhex(s[i+4]))<<4 | rune(unhex(s[i+5]))
+			b.WriteRune(r)
+			unicode = true
+			i += 5
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), unicode
+}
+
+// decodeCharacters decodes overlong UTF-8 sequences of ASCII characters and
+// Unicode lookalikes of dots and slashes
+func decodeCharacters(s string) (string, encoding) {
+	if isASCII(s) {
+		return s, 0
+	}
+	var enc encoding
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case (c == 0xC0 || c == 0xC1) && i+1 < len(s) && isContinuation(s[i+1]):
+			b.WriteByte((c&0x1F)<<6 | s[i+1]&0x3F)
+			enc |= encOverlong
+			i += 2
+			continue
+		case c == 0xE0 && i+2 < len(s) && s[i+1] < 0x82 && isContinuation(s[i+1]) && isContinuation(s[i+2]):
+			b.WriteByte((s[i+1]&0x3F)<<6 | s[i+2]&0x3F)
+			enc |= encOverlong
+			i += 3
+			continue
+		case c < utf8.RuneSelf:
+			b.WriteByte(c)
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if l, ok := lookalikes[r]; ok && size > 1 {
+			b.WriteByte(l)
+			enc |= encWide
+		} else {
+			b.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	return b.String(), enc
+}
+
+// climbs returns the number of levels the path climbs above its base. The
+// base of absolute paths is the root, climbing back to it and into one of the
+// sensitiveRoots counts the levels climbed to reach it.
+func climbs(p string) int {
+	absolute := p[0] == '/'
+	depth, levels, up := 0, 0, 0
+	for _, segment := range strings.Split(p, "/") {
+		// path parameters, e.g. ..;/ accepted by Java servlet containers
+		if i := strings.IndexByte(segment, ';'); i >= 0 {
+			segment = segment[:i]
+		}
+		switch segment {
+		case "", ".":
+		case "..":
+			if depth == 0 {
+				levels++
+			} else {
+				depth--
+				up++
+			}
+		default:
+			if absolute && depth == 0 && levels == 0 && up > 0 && sensitiveRoots[strings.ToLower(segment)] {
+				levels = up
+			}
+			depth++
+		}
+	}
+	return levels
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func isContinuation(c byte) bool {
+	return c&0xC0 == 0x80
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	}
+	return c - '0'
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pathtraversal
+
+import "testing"
+
+func TestIsPathTraversal(t *testing.T) {
+	tests := map[string]string{
+		"../../etc/passwd":                         "P2",
+		"/../etc/passwd":                           "P1",
+		"foo/../../etc/passwd":                     "P1",
+		"./.././x":                                 "P1",
+		"..\\..\\windows\\win.ini":                 "B2",
+		"%2e%2e%2fetc%2fpasswd":                    "E1",
+		"%2E%2E/%2e%2e/etc":                        "E2",
+		"..%2f..%5cboot.ini":                       "EB2",
+		"%252e%252e%252fetc":                       "ED1",
+		"%25252e%25252e%25252fetc":                 "ED1",
+		"%u002e%u002e%u2215etc":                    "EUW1",
+		"%c0%ae%c0%ae%c0%afetc":                    "EO1",
+		"..%c0%af..%c1%9cetc":                      "EOB2",
+		"%e0%80%ae%e0%80%ae/etc":                   "EO1",
+		"．．／．．／etc":                                "W2",
+		"../../etc/passwd%00.png":                  "EN2",
+		"..;/..;/WEB-INF/web.xml":                  "P2",
+		"http://example.com/a/../../../../etc":     "P1",
+		"....//....//etc/passwd":                   "S2",
+		"..././..././etc/passwd":                   "S2",
+		"/var/www/../../etc/passwd":                "P2",
+		"/var/www/html/../../../proc/self/environ": "P3",
+		"%2fvar%2fwww%2f..%2f..%2fetc%2fpasswd":    "E2",
+		"/a/../../etc/passwd":                      "P1",
+		"..%252f..%252f..%252fetc%252fpasswd":      "ED3",
+	}
+	for input, want := range tests {
+		res, fingerprint := IsPathTraversal(input)
+		if want == "" {
+			if res {
+				t.Errorf("unexpected match for %q with fingerprint %q", input, fingerprint)
+			}
+			continue
+		}
+		if !res {
+			t.Errorf("expected a match for %q", input)
+			continue
+		}
+		if fingerprint != want {
+			t.Errorf("unexpected fingerprint for %q: %q, want %q", input, fingerprint, want)
+		}
+	}
+}
+
+func TestIsPathTraversalNegatives(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"..",
+		"...",
+		"wait... what?",
+		"a/../b",
+		"/var/www/html/index.php",
+		"images/./logo.png",
+		"1.2.3",
+		"100%",
+		"%zz%2",
+		"https://example.com/a/b/../c",
+		"/a/../b",
+		"/var/www/../../srv/app",
+		"Ünïcödé/text",
+	} {
+		if res, fingerprint := IsPathTraversal(input); res {
+			t.Errorf("unexpected match for %q with fingerprint %q", input, fingerprint)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"%2e%2e%2f":    "../",
+		"%u002E":       ".",
+		"%c0%ae":       ".",
+		"\xc0\xae":     ".",
+		"a\\b":         "a/b",
+		"％２ｅ":          "％２ｅ",
+		"%%32%65":      ".",
+		"%":            "%",
+		"%u12":         "%u12",
+		"\xe0\x80\xaf": "/",
+	}
+	for input, want := range tests {
+		if have, _ := normalize(input); have != want {
+			t.Errorf("unexpected normalisation of %q: %q, want %q", input, have, want)
+		}
+	}
+}
+
+func BenchmarkIsPathTraversal(b *testing.B) {
+	input := "/static/images/%E2%9C%93/logo.png?v=1.2.3&theme=dark"
+	for i := 0; i < b.N; i++ {
+		IsPathTraversal(input)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo
+// +build !tinygo
+
+package rdns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// NewNetResolver returns a Resolver looking up addresses with resolver
+// instead of net.DefaultResolver
+func NewNetResolver(resolver *net.Resolver) *Resolver {
+	r := NewResolver()
+	r.lookup = func(ctx context.Context, addr string) (Result, error) {
+		return resolve(ctx, resolver, addr)
+	}
+	return r
+}
+
+// systemLookup resolves addresses with net.DefaultResolver
+func systemLookup(ctx context.Context, addr string) (Result, error) {
+	return resolve(ctx, net.DefaultResolver, addr)
+}
+
+func resolve(ctx context.Context, resolver *net.Resolver, addr string) (Result, error) {
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return Result{}, err
+	}
+	ip = ip.Unmap().WithZone("")
+
+	names, err := resolver.LookupAddr(ctx, ip.String())
+	if err != nil {
+		if isNotFound(err) {
+			return Result{}, nil
+		}
+		return Result{}, err
+	}
+	if len(names) == 0 {
+		return Result{}, nil
+	}
+
+	// the first name is the host name, the others are only used to confirm
+	// the address
+	res := Result{Host: strings.TrimSuffix(names[0], ".")}
+	for _, name := range names {
+		ips, err := resolver.LookupNetIP(ctx, "ip", name)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return Result{}, err
+		}
+		for _, fwd := range ips {
+			if fwd.Unmap() == ip {
+				res.Host = strings.TrimSuffix(name, ".")
+				res.Confirmed = true
+				return res, nil
+			}
+		}
+	}
+	return res, nil
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,17 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tinygo
+// +build tinygo
+
+package rdns
+
+import (
+	"context"
+	"errors"
+)
+
+// systemLookup fails as DNS resolution is not available with TinyGo
+func systemLookup(context.Context, string) (Result, error) {
+	return Result{}, errors.New("reverse DNS is not supported")
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rdns resolves the host name of client addresses with
+// forward-confirmed reverse DNS, caching the results.
+package rdns
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/corazawaf/coraza/v3/internal/cache"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a lookup
+	DefaultTimeout = 1 * time.Second
+	// DefaultTTL is the default time results are cached for
+	DefaultTTL = 5 * time.Minute
+
+	cacheSize = 4096
+)
+
+// Mode configures the lookup of REMOTE_HOST
+type Mode int
+
+const (
+	// ModeOff does not resolve REMOTE_HOST
+	ModeOff Mode = iota
+	// ModeOn sets REMOTE_HOST to the name the address points to
+	ModeOn
+	// ModeDouble sets REMOTE_HOST only when the name resolves back to the
+	// address
+	ModeDouble
+)
+
+// Result is the result of the lookup of an address
+type Result struct {
+	// Host is the name the address points to, without trailing dot, empty if
+	// none.
+	Host string
+	// Confirmed is true when Host resolves back to the address
+	Confirmed bool
+}
+
+// lookupFunc resolves the host name of an address, an error is returned
+// when the result is not conclusive and must not be cached.
+type lookupFunc func(ctx context.Context, addr string) (Result, error)
+
+// Resolver looks up host names. It is safe for concurrent use.
+type Resolver struct {
+	// Timeout is the timeout of a lookup, reverse and forward resolutions
+	// included.
+	Timeout time.Duration
+	// TTL is the time results are cached for, results are not cached when
+	// it is not positive.
+	TTL time.Duration
+
+	lookup lookupFunc
+	cache  *cache.LRU[string, Result]
+	now    func() time.Time
+}
+
+// NewResolver returns a Resolver with the default timeout and TTL
+func NewResolver() *Resolver {
+	return &Resolver{
+		Timeout: DefaultTimeout,
+		TTL:     DefaultTTL,
+		lookup:  systemLookup,
+		cache:   cache.NewLRU[string, Result](cacheSize),
+		now:     time.Now,
+	}
+}
+
+// Lookup returns the host name of addr. The lookup ends with ctx or once the
+// timeout elapsed. Failed lookups are not cached.
+func (r *Resolver) Lookup(ctx context.Context, addr string) Result {
+	if res, ok := r.cache.Get(addr, r.now()); ok {
+		return res
+	}
+	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+	res, err := r.lookup(ctx, addr)
+	if err != nil {
+		return Result{}
+	}
+	if r.TTL > 0 {
+		r.cache.Put(addr, res, r.now().Add(r.TTL))
+	}
+	return res
+}
+
+// InDomain returns whether host is one of the domains or a subdomain of
+// them, e.g. crawl-66-249-66-1.googlebot.com is in googlebot.com.
+func InDomain(host string, domains []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "" {
+		return false
+	}
+	for _, d := range domains {
+		d = strings.ToLower(strings.Trim(d, "."))
+		if d == "" {
+			continue
+		}
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo
+// +build !tinygo
+
+package rdns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/foxcpp/go-mockdns"
+)
+
+func TestResolve(t *testing.T) {
+	srv, err := mockdns.NewServer(map[string]mockdns.Zone{
+		"1.66.249.66.in-addr.arpa.": {
+			PTR: []string{"crawl-66-249-66-1.googlebot.com."},
+		},
+		"crawl-66-249-66-1.googlebot.com.": {
+			A: []string{"66.249.66.1"},
+		},
+		// the owner of 203.0.113.1 claims to be googlebot
+		"1.113.0.203.in-addr.arpa.": {
+			PTR: []string{"crawl-66-249-66-1.googlebot.com."},
+		},
+		"2.113.0.203.in-addr.arpa.": {
+			PTR: []string{"unknown.example.com.", "host.example.com."},
+		},
+		"host.example.com.": {
+			A: []string{"203.0.113.2"},
+		},
+		"1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.": {
+			PTR: []string{"v6.example.com."},
+		},
+		"v6.example.com.": {
+			AAAA: []string{"2001:db8::1"},
+		},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	resolver := &net.Resolver{}
+	srv.PatchNet(resolver)
+
+	tests := []struct {
+		addr string
+		want Result
+	}{
+		{addr: "66.249.66.1", want: Result{Host: "crawl-66-249-66-1.googlebot.com", Confirmed: true}},
+		{addr: "::ffff:66.249.66.1", want: Result{Host: "crawl-66-249-66-1.googlebot.com", Confirmed: true}},
+		{addr: "203.0.113.1", want: Result{Host: "crawl-66-249-66-1.googlebot.com"}},
+		{addr: "203.0.113.2", want: Result{Host: "host.example.com", Confirmed: true}},
+		{addr: "2001:db8::1", want: Result{Host: "v6.example.com", Confirmed: true}},
+		{addr: "198.51.100.1", want: Result{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			have, err := resolve(context.Background(), resolver, tt.addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have != tt.want {
+				t.Errorf("unexpected result %+v, want %+v", have, tt.want)
+			}
+		})
+	}
+
+	if _, err := resolve(context.Background(), resolver, "not an address"); err == nil {
+		t.Error("expected error for invalid address")
+	}
+}
+
+func TestLookupCache(t *testing.T) {
+	now := time.Now()
+	lookups := 0
+	r := NewResolver()
+	r.now = func() time.Time { return now }
+	r.lookup = func(_ context.Context, addr string) (Result, error) {
+		lookups++
+		if addr == "198.51.100.1" {
+			return Result{}, errors.New("timeout")
+		}
+		return Result{Host: "host.example.com", Confirmed: true}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if res := r.Lookup(context.Background(), "203.0.113.2"); res.Host != "host.example.com" {
+			t.Errorf("unexpected result %+v", res)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("unexpected number of lookups %d", lookups)
+	}
+
+	now = now.Add(DefaultTTL + time.Second)
+	r.Lookup(context.Background(), "203.0.113.2")
+	if lookups != 2 {
+		t.Errorf("expected a lookup after the expiration, have %d", lookups)
+	}
+
+	// failures are not cached
+	r.Lookup(context.Background(), "198.51.100.1")
+	r.Lookup(context.Background(), "198.51.100.1")
+	if lookups != 4 {
+		t.Errorf("unexpected number of lookups %d", lookups)
+	}
+
+	r.TTL = 0
+	r.Lookup(context.Background(), "203.0.113.3")
+	r.Lookup(context.Background(), "203.0.113.3")
+	if lookups != 6 {
+		t.Errorf("unexpected number of lookups without cache %d", lookups)
+	}
+}
+
+func TestLookupContext(t *testing.T) {
+	r := NewResolver()
+	r.lookup = func(ctx context.Context, _ string) (Result, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("expected the lookup to have a deadline")
+		}
+		if err := ctx.Err(); err != nil {
+			return Result{}, err
+		}
+		return Result{Host: "host.example.com"}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if res := r.Lookup(ctx, "203.0.113.2"); res != (Result{}) {
+		t.Errorf("unexpected result with cancelled context %+v", res)
+	}
+	if res := r.Lookup(context.Background(), "203.0.113.2"); res.Host != "host.example.com" {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
+
+func TestInDomain(t *testing.T) {
+	domains := []string{"googlebot.com", ".google.com."}
+	tests := map[string]bool{
+		"crawl-66-249-66-1.googlebot.com":  true,
+		"crawl-66-249-66-1.googlebot.com.": true,
+		"GOOGLEBOT.COM":                    true,
+		"rate-limited-proxy.google.com":    true,
+		"googlebot.com.example.com":        false,
+		"evilgooglebot.com":                false,
+		"":                                 false,
+	}
+	for host, want := range tests {
+		if have := InDomain(host, domains); have != want {
+			t.Errorf("unexpected result for %q: %t, want %t", host, have, want)
+		}
+	}
+}
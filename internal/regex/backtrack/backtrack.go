@@ -0,0 +1,626 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package backtrack implements a backtracking regular expression engine
+// supporting the Perl and PCRE syntax RE2 rejects, such as lookarounds,
+// backreferences, atomic groups and possessive quantifiers.
+//
+// Backtracking takes exponential time on some patterns, so the work of a
+// match is bounded as with the match limits of PCRE, and a match exceeding
+// them fails with an error. Characters are matched as UTF-8, while the
+// classes of characters such as \w and \b are ASCII, as in PCRE without
+// Unicode properties.
+package backtrack
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultMatchLimit is the default of Limits.Match
+	DefaultMatchLimit = 1000000
+	// DefaultRecursionLimit is the default of Limits.Recursion
+	DefaultRecursionLimit = 100000
+)
+
+var (
+	// ErrMatchLimit is returned by matches exceeding Limits.Match
+	ErrMatchLimit = errors.New("regexp match limit exceeded")
+	// ErrRecursionLimit is returned by matches exceeding Limits.Recursion
+	ErrRecursionLimit = errors.New("regexp recursion limit exceeded")
+)
+
+// Limits bounds the work of a match, zero values mean the defaults
+type Limits struct {
+	// Match is the number of backtracking points a match can create and
+	// resume, as the match limit of PCRE.
+	Match int
+	// Recursion is the number of backtracking points a match can keep at
+	// once, as the recursion limit of PCRE.
+	Recursion int
+}
+
+// Regexp is a compiled regular expression, safe for concurrent use
+type Regexp struct {
+	expr   string
+	prog   *prog
+	ncap   int
+	names  []string
+	nslots int
+	limits Limits
+
+	// anchored is set for patterns only matching at the start of the text,
+	// prefix to the literal text all matches start with, and first to the
+	// bytes they start with
+	anchored bool
+	prefix   string
+	first    *[256]bool
+
+	machines sync.Pool
+}
+
+// Compile parses a regular expression
+func Compile(expr string, limits Limits) (*Regexp, error) {
+	p, root, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	c := &compiler{nslots: 2 * (p.ncap + 1)}
+	prog, err := c.compile(&node{kind: nodeCapture, subs: []*node{root}})
+	if err != nil {
+		return nil, err
+	}
+	if limits.Match <= 0 {
+		limits.Match = DefaultMatchLimit
+	}
+	if limits.Recursion <= 0 {
+		limits.Recursion = DefaultRecursionLimit
+	}
+	re := &Regexp{
+		expr:     expr,
+		prog:     prog,
+		ncap:     p.ncap,
+		names:    p.names,
+		nslots:   c.nslots,
+		limits:   limits,
+		anchored: anchored(root),
+		prefix:   literalPrefix(root),
+	}
+	if min, _ := root.width(); min > 0 {
+		first := &[256]bool{}
+		if firstBytes(root, first) {
+			re.first = first
+		}
+	}
+	re.machines.New = func() interface{} { return &machine{re: re, slots: make([]int, re.nslots)} }
+	return re, nil
+}
+
+// MustCompile is like Compile with the default limits but panics if the
+// expression cannot be parsed
+func MustCompile(expr string) *Regexp {
+	re, err := Compile(expr, Limits{})
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+// String returns the source text of the expression
+func (re *Regexp) String() string {
+	return re.expr
+}
+
+// NumSubexp returns the number of capturing groups
+func (re *Regexp) NumSubexp() int {
+	return re.ncap
+}
+
+// SubexpNames returns the names of the capturing groups, the name of the
+// i-th group is SubexpNames()[i], "" for unnamed groups and the whole match
+func (re *Regexp) SubexpNames() []string {
+	return re.names
+}
+
+// MatchString reports whether s contains a match
+func (re *Regexp) MatchString(s string) (bool, error) {
+	loc, err := re.FindStringSubmatchIndex(s)
+	return loc != nil, err
+}
+
+// FindStringSubmatch returns the text of the leftmost match and of its
+// groups, nil if there is no match
+func (re *Regexp) FindStringSubmatch(s string) ([]string, error) {
+	loc, err := re.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return nil, err
+	}
+	match := make([]string, len(loc)/2)
+	for i := range match {
+		if loc[2*i] >= 0 {
+			match[i] = s[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return match, nil
+}
+
+// FindStringSubmatchIndex returns the index pairs of the leftmost match and
+// of its groups, -1 for groups not taking part in the match, nil if there
+// is no match
+func (re *Regexp) FindStringSubmatchIndex(s string) ([]int, error) {
+	m := re.machines.Get().(*machine)
+	defer re.machines.Put(m)
+	m.input, m.steps, m.err = s, 0, nil
+	defer func() {
+		m.input = ""
+		m.stack = m.stack[:0]
+	}()
+
+	for pos := 0; pos <= len(s); {
+		if re.prefix != "" {
+			i := strings.Index(s[pos:], re.prefix)
+			if i < 0 {
+				return nil, nil
+			}
+			pos += i
+		}
+		if re.first != nil {
+			for pos < len(s) && !re.first[s[pos]] {
+				pos++
+			}
+			if pos == len(s) {
+				return nil, nil
+			}
+		}
+		m.start = pos
+		for i := range m.slots {
+			m.slots[i] = -1
+		}
+		if _, ok := m.run(re.prog, pos, -1); ok {
+			return append([]int(nil), m.slots[:2*(re.ncap+1)]...), nil
+		}
+		if m.err != nil {
+			return nil, m.err
+		}
+		if re.anchored || pos == len(s) {
+			break
+		}
+		_, w := m.decode(pos)
+		pos += w
+	}
+	return nil, nil
+}
+
+// anchored reports whether all the matches of the node start at the
+// beginning of the text
+func anchored(n *node) bool {
+	switch n.kind {
+	case nodeAssert:
+		return n.assert == assertBeginText
+	case nodeConcat:
+		return len(n.subs) > 0 && anchored(n.subs[0])
+	case nodeCapture, nodeAtomic:
+		return anchored(n.subs[0])
+	case nodeAlternate:
+		for _, sub := range n.subs {
+			if !anchored(sub) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// firstBytes adds the bytes the matches of the node start with to first,
+// and reports false when they can start with any byte. Matches of nodes
+// which may be empty start with the bytes of what follows them, so these are
+// only added to those of the node.
+func firstBytes(n *node, first *[256]bool) bool {
+	switch n.kind {
+	case nodeLiteral:
+		var buf [utf8.UTFMax]byte
+		r := n.r
+		for {
+			utf8.EncodeRune(buf[:], r)
+			first[buf[0]] = true
+			if !n.fold {
+				return true
+			}
+			if r = unicode.SimpleFold(r); r == n.r {
+				return true
+			}
+		}
+	case nodeClass:
+		for c := 0; c < utf8.RuneSelf; c++ {
+			if n.class.matches(rune(c)) {
+				first[c] = true
+			}
+		}
+		for c := utf8.RuneSelf; c < len(first); c++ {
+			first[c] = true
+		}
+		return true
+	case nodeConcat:
+		for _, sub := range n.subs {
+			if !firstBytes(sub, first) {
+				return false
+			}
+			if min, _ := sub.width(); min > 0 {
+				return true
+			}
+		}
+		return true
+	case nodeAlternate:
+		for _, sub := range n.subs {
+			if !firstBytes(sub, first) {
+				return false
+			}
+		}
+		return true
+	case nodeRepeat, nodeCapture, nodeAtomic:
+		return firstBytes(n.subs[0], first)
+	case nodeEmpty, nodeAssert, nodeLook:
+		return true
+	}
+	return false
+}
+
+// literalPrefix returns the case-sensitive literal text the matches of the
+// node start with
+func literalPrefix(n *node) string {
+	subs := []*node{n}
+	if n.kind == nodeConcat {
+		subs = n.subs
+	}
+	var b strings.Builder
+	for _, sub := range subs {
+		if sub.kind != nodeLiteral || sub.fold {
+			break
+		}
+		b.WriteRune(sub.r)
+	}
+	return b.String()
+}
+
+type entryKind uint8
+
+const (
+	// entryBranch resumes at pc and pos
+	entryBranch entryKind = iota
+	// entryRestore restores the value pos of slot n
+	entryRestore
+	// entryGreedy gives back a character of a repeat ending at pos, down
+	// to position n, and resumes at pc
+	entryGreedy
+	// entryLazy matches one more character of the repeat at pc ending at
+	// pos, up to n more or unbounded when negative
+	entryLazy
+)
+
+// entry is an entry of the backtracking stack
+type entry struct {
+	kind       entryKind
+	pc, pos, n int
+}
+
+// machine holds the state of a match
+type machine struct {
+	re    *Regexp
+	input string
+	slots []int
+	stack []entry
+	start int
+	steps int
+	err   error
+}
+
+// run matches the program at pos, ending at end unless it is negative, and
+// returns the end of the match. Backtracking points of the match are left
+// on the stack.
+func (m *machine) run(p *prog, pos, end int) (int, bool) {
+	base := len(m.stack)
+	pc := 0
+	for {
+		in := &p.insts[pc]
+		ok := true
+		switch in.op {
+		case opMatch:
+			if end < 0 || pos == end {
+				return pos, true
+			}
+			ok = false
+		case opRune, opAny, opAnyNotNL, opClass:
+			r, w := m.decode(pos)
+			if ok = w > 0 && in.matches(r); ok {
+				pos += w
+				pc++
+			}
+		case opSplit:
+			if !m.push(entry{kind: entryBranch, pc: in.y, pos: pos}) {
+				return 0, false
+			}
+			pc = in.x
+		case opJmp:
+			pc = in.x
+		case opSave:
+			m.set(in.x, pos)
+			pc++
+		case opCheck:
+			if m.slots[in.x] == pos {
+				pc = in.y
+			} else {
+				pc++
+			}
+		case opAssert:
+			if ok = m.assert(in.assert, pos); ok {
+				pc++
+			}
+		case opBackref:
+			var w int
+			if w, ok = m.backref(in, pos); ok {
+				pos += w
+				pc++
+			}
+		case opLook:
+			if ok = m.look(in, pos); ok {
+				pc++
+			}
+		case opAtomic:
+			mark := len(m.stack)
+			var e int
+			if e, ok = m.run(in.prog, pos, -1); ok {
+				m.cut(mark)
+				pos = e
+				pc++
+			}
+		case opRepeat:
+			if pos, ok = m.repeat(in, pc, pos); ok {
+				pc++
+			}
+		}
+		if m.err != nil {
+			return 0, false
+		}
+		if ok {
+			continue
+		}
+		if pc, pos, ok = m.backtrack(p, base); !ok {
+			return 0, false
+		}
+	}
+}
+
+// backtrack resumes at the last backtracking point above base
+func (m *machine) backtrack(p *prog, base int) (int, int, bool) {
+	for len(m.stack) > base {
+		top := len(m.stack) - 1
+		e := &m.stack[top]
+		switch e.kind {
+		case entryRestore:
+			m.slots[e.n] = e.pos
+			m.stack = m.stack[:top]
+			continue
+		case entryBranch:
+			pc, pos := e.pc, e.pos
+			m.stack = m.stack[:top]
+			return pc, pos, true
+		case entryGreedy:
+			_, w := utf8.DecodeLastRuneInString(m.input[e.n:e.pos])
+			e.pos -= w
+			pc, pos := e.pc, e.pos
+			if e.pos <= e.n {
+				m.stack = m.stack[:top]
+			}
+			return pc, pos, m.step()
+		case entryLazy:
+			r, w := m.decode(e.pos)
+			if w == 0 || !p.insts[e.pc].one.matches(r) {
+				m.stack = m.stack[:top]
+				continue
+			}
+			e.pos += w
+			if e.n > 0 {
+				e.n--
+			}
+			pc, pos := e.pc+1, e.pos
+			if e.n == 0 {
+				m.stack = m.stack[:top]
+			}
+			return pc, pos, m.step()
+		}
+	}
+	return 0, 0, false
+}
+
+// step counts a backtracking step against the match limit
+func (m *machine) step() bool {
+	if m.steps++; m.steps > m.re.limits.Match {
+		m.err = ErrMatchLimit
+		return false
+	}
+	return true
+}
+
+// push adds a backtracking point
+func (m *machine) push(e entry) bool {
+	if !m.step() {
+		return false
+	}
+	if len(m.stack) >= m.re.limits.Recursion {
+		m.err = ErrRecursionLimit
+		return false
+	}
+	m.stack = append(m.stack, e)
+	return true
+}
+
+// set stores pos in a slot, restoring its value when backtracking
+func (m *machine) set(slot, pos int) {
+	m.stack = append(m.stack, entry{kind: entryRestore, n: slot, pos: m.slots[slot]})
+	m.slots[slot] = pos
+}
+
+// cut drops the backtracking points above mark, keeping the restores of
+// slots so that backtracking further still undoes them
+func (m *machine) cut(mark int) {
+	n := mark
+	for _, e := range m.stack[mark:] {
+		if e.kind == entryRestore {
+			m.stack[n] = e
+			n++
+		}
+	}
+	m.stack = m.stack[:n]
+}
+
+// undo restores the slots set above mark and drops its backtracking points
+func (m *machine) undo(mark int) {
+	for i := len(m.stack) - 1; i >= mark; i-- {
+		if e := m.stack[i]; e.kind == entryRestore {
+			m.slots[e.n] = e.pos
+		}
+	}
+	m.stack = m.stack[:mark]
+}
+
+func (m *machine) decode(pos int) (rune, int) {
+	if pos >= len(m.input) {
+		return 0, 0
+	}
+	if c := m.input[pos]; c < utf8.RuneSelf {
+		return rune(c), 1
+	}
+	return utf8.DecodeRuneInString(m.input[pos:])
+}
+
+// repeat matches a repeated character and adds a backtracking point to
+// match fewer of them, or more when lazy
+func (m *machine) repeat(in *inst, pc, pos int) (int, bool) {
+	n := 0
+	for ; n < in.min; n++ {
+		r, w := m.decode(pos)
+		if w == 0 || !in.one.matches(r) {
+			return pos, false
+		}
+		pos += w
+	}
+
+	if !in.greedy {
+		if in.max < 0 || in.max > n {
+			more := -1
+			if in.max >= 0 {
+				more = in.max - n
+			}
+			if !m.push(entry{kind: entryLazy, pc: pc, pos: pos, n: more}) {
+				return pos, false
+			}
+		}
+		return pos, true
+	}
+
+	lower := pos
+	for ; in.max < 0 || n < in.max; n++ {
+		r, w := m.decode(pos)
+		if w == 0 || !in.one.matches(r) {
+			break
+		}
+		pos += w
+	}
+	if pos > lower && !in.possessive {
+		if !m.push(entry{kind: entryGreedy, pc: pc + 1, pos: pos, n: lower}) {
+			return pos, false
+		}
+	}
+	return pos, true
+}
+
+func (m *machine) assert(k assertKind, pos int) bool {
+	s := m.input
+	switch k {
+	case assertBeginText:
+		return pos == 0
+	case assertBeginLine:
+		return pos == 0 || pos < len(s) && s[pos-1] == '\n'
+	case assertEndText:
+		return pos == len(s)
+	case assertEndTextNewline:
+		return pos == len(s) || pos == len(s)-1 && s[pos] == '\n'
+	case assertEndLine:
+		return pos == len(s) || s[pos] == '\n'
+	case assertWordBoundary:
+		return m.isWordAt(pos-1) != m.isWordAt(pos)
+	case assertNotWordBoundary:
+		return m.isWordAt(pos-1) == m.isWordAt(pos)
+	case assertSearchStart:
+		return pos == m.start
+	}
+	return false
+}
+
+func (m *machine) isWordAt(i int) bool {
+	return i >= 0 && i < len(m.input) && isWordByte(m.input[i])
+}
+
+// backref matches the text of a group at pos and returns its length
+func (m *machine) backref(in *inst, pos int) (int, bool) {
+	start, end := m.slots[2*in.x], m.slots[2*in.x+1]
+	if start < 0 || end < start {
+		return 0, false
+	}
+	ref := m.input[start:end]
+	if !in.fold {
+		return len(ref), strings.HasPrefix(m.input[pos:], ref)
+	}
+	i := pos
+	for _, r := range ref {
+		c, w := m.decode(i)
+		if w == 0 || c != r && !equalFold(c, r) {
+			return 0, false
+		}
+		i += w
+	}
+	return i - pos, true
+}
+
+// look evaluates a lookaround at pos. Groups captured by positive
+// lookarounds are kept.
+func (m *machine) look(in *inst, pos int) bool {
+	mark := len(m.stack)
+	matched := false
+	if in.behind {
+		// try the starts at each possible width before pos
+		start := pos
+		for width := 0; width <= in.max; width++ {
+			if width >= in.min {
+				if _, matched = m.run(in.prog, start, pos); matched || m.err != nil {
+					break
+				}
+			}
+			if start == 0 {
+				break
+			}
+			_, w := utf8.DecodeLastRuneInString(m.input[:start])
+			start -= w
+		}
+	} else {
+		_, matched = m.run(in.prog, pos, -1)
+	}
+	if m.err != nil {
+		return false
+	}
+	if in.negate {
+		if matched {
+			m.undo(mark)
+		}
+		return !matched
+	}
+	if matched {
+		m.cut(mark)
+	}
+	return matched
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backtrack
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestFindStringSubmatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		want    []string
+	}{
+		// lookarounds
+		{`foo(?=bar)`, "foobaz foobar", []string{"foo"}},
+		{`foo(?!bar)`, "foobar foobaz", []string{"foo"}},
+		{`(?<=\$)\d+`, "cost: $42", []string{"42"}},
+		{`(?<!\$)\b\d+`, "$42 or 17", []string{"17"}},
+		{`(?<=ab|c)d`, "abd", []string{"d"}},
+		{`(?<=a\w{1,3})z`, "abbz", []string{"z"}},
+		{`(?<!^)x`, "xax", []string{"x"}},
+		{`^(?=.*\d)(?=.*[a-z]).{6,}$`, "abc123", []string{"abc123"}},
+		{`(?=(\w+))\w`, "abc", []string{"a", "abc"}},
+		// backreferences
+		{`(\w)\1`, "abccd", []string{"cc", "c"}},
+		{`(?i)(a)\1`, "aA", []string{"aA", "a"}},
+		{`(?<q>['"]).*?\k<q>`, `x='a"b' y`, []string{`'a"b'`, "'"}},
+		{`(?P<q>['"]).*?(?P=q)`, `"x"`, []string{`"x"`, `"`}},
+		{`(a)(b)\g{-1}\g1`, "abba", []string{"abba", "a", "b"}},
+		{`<(\w+)>.*</\1>`, "<b>x</i><i>y</i>", []string{"<i>y</i>", "i"}},
+		// atomic groups and possessive quantifiers
+		{`(?>a+)b`, "aaab", []string{"aaab"}},
+		{`(?>a+)ab`, "aaab", nil},
+		{`a++b`, "aab", []string{"aab"}},
+		{`a++a`, "aaa", nil},
+		{`(?:ab)*+ab`, "abab", nil},
+		{`"[^"]*+"`, `say "hi"`, []string{`"hi"`}},
+		// quantifiers
+		{`a{2,3}`, "aaaa", []string{"aaa"}},
+		{`a{2,3}?`, "aaaa", []string{"aa"}},
+		{`(ab){2}`, "ababab", []string{"abab", "ab"}},
+		{`<.+?>`, "<a><b>", []string{"<a>"}},
+		{`(?U)<.+>`, "<a><b>", []string{"<a>"}},
+		{`x{,2}`, "x{,2}", []string{"x{,2}"}},
+		{`(a|)*b`, "aab", []string{"aab", ""}},
+		{`(a*)*b`, "b", []string{"b", ""}},
+		{`(a?)+?c`, "aac", []string{"aac", "a"}},
+		// anchors and flags
+		{`^b`, "a\nb", nil},
+		{`(?m)^b$`, "a\nb\nc", []string{"b"}},
+		{`a$`, "a\n", []string{"a"}},
+		{`a\z`, "a\n", nil},
+		{`a\Z`, "a\n", []string{"a"}},
+		{`(?s)a.b`, "a\nb", []string{"a\nb"}},
+		{`a.b`, "a\nb", nil},
+		{`(?i)straße`, "STRAßE", []string{"STRAßE"}},
+		{`(?i:a)b`, "Ab AB", []string{"Ab"}},
+		{`a(?i)b|c`, "C", []string{"C"}},
+		{`(?x) a \d + # digits`, "a12", []string{"a12"}},
+		{`(?x)[ ]a`, " a", []string{" a"}},
+		{`\bfoo\b`, "foobar foo", []string{"foo"}},
+		{`\Bbar`, "foobar", []string{"bar"}},
+		// classes and escapes
+		{`[[:alpha:]]+`, "12ab3", []string{"ab"}},
+		{`[^[:^digit:]]+`, "ab12", []string{"12"}},
+		{`[\d-z]+`, "a1-z", []string{"1-z"}},
+		{`[]a]+`, "]a]", []string{"]a]"}},
+		{`[^]a]`, "]ab", []string{"b"}},
+		{`(?i)[a-c]+`, "xABC", []string{"ABC"}},
+		{`\p{Greek}+`, "abc αβγ", []string{"αβγ"}},
+		{`\P{L}+`, "ab12", []string{"12"}},
+		{`\x41\x{263a}\101\cA`, "A☺A\x01", []string{"A☺A\x01"}},
+		{`\Qa.b*\E+`, "a.b**", []string{"a.b**"}},
+		{`\h+\v`, "a \t\nb", []string{" \t\n"}},
+		{`\s`, "\v", []string{"\v"}},
+		{`\N+`, "ab\ncd", []string{"ab"}},
+		{`é+`, "café", []string{"é"}},
+		{`(?#comment)a`, "a", []string{"a"}},
+		{`\Ga`, "aab", []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			re := MustCompile(tt.pattern)
+			have, err := re.FindStringSubmatch(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(have, tt.want) {
+				t.Errorf("unexpected match of %q: %q, want %q", tt.input, have, tt.want)
+			}
+		})
+	}
+}
+
+// patterns supported by RE2, matching the same in both engines
+var compatPatterns = []string{
+	`a`, `ab|cd`, `a*`, `a+?b`, `(a|b)*c`, `(a*)(b*)`, `(a|ab)(c|bcd)(d*)`,
+	`[a-c]+`, `[^ab]+`, `(?i)ab`, `x(y|z)+?`, `(\w+)\s+(\w+)`, `\d{2,3}`,
+	`(?:a|b)+?c`, `.`, `(?s).+`, `^a|b\z`, `(?m)^b`, `\bab\b`, `a\Bb`,
+	`(a)|(b)|(c)`, `((a)|b)+`, `(a{1,2}){2}`, `[[:digit:]x]+`, `(?U)a+`,
+	`(?i)[^a]`, `(|a)+`, `(?:(a)|b)*`, `a{0,3}?b`,
+}
+
+func TestCompatibleWithRE2(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, pattern := range compatPatterns {
+		re := MustCompile(pattern)
+		re2 := regexp.MustCompile(pattern)
+		for i := 0; i < 200; i++ {
+			b := make([]byte, r.Intn(10))
+			for j := range b {
+				b[j] = "abcdxyz AB1\n"[r.Intn(12)]
+			}
+			input := string(b)
+			have, err := re.FindStringSubmatchIndex(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := re2.FindStringSubmatchIndex(input); !reflect.DeepEqual(have, want) {
+				t.Errorf("unexpected match of %q with %q: %v, want %v", input, pattern, have, want)
+			}
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, pattern := range []string{
+		`(`, `)`, `a)`, `[a`, `*a`, `a**`, `a{3,2}`, `a{1001}`, `\`, `(?<=a+)b`,
+		`(?<=a*)b`, `\1(a)(b)\3`, `\k<x>(?<y>a)`, `(?<n>a)(?<n>b)`, `(?R)`,
+		`(?1)`, `(?(1)a|b)`, `(*SKIP)`, `[z-a]`, `\p{Foo}`, `[[:foo:]]`,
+		`\y`, `(?z)`, `\x{110000}`, `\g{0}`, `a\K`, `(?#`, `\c`,
+		strings.Repeat("(", 300) + strings.Repeat(")", 300),
+	} {
+		if _, err := Compile(pattern, Limits{}); err == nil {
+			t.Errorf("expected an error compiling %q", pattern)
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+	input := strings.Repeat("a", 40) + "!"
+
+	re, err := Compile(`^(a+)+$`, Limits{Match: 10000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := re.MatchString(input); !errors.Is(err, ErrMatchLimit) {
+		t.Errorf("expected the match limit to be exceeded, got %v", err)
+	}
+	// the limit applies to each match
+	if ok, err := re.MatchString("aaa"); !ok || err != nil {
+		t.Errorf("unexpected match result %t, %v", ok, err)
+	}
+
+	re, err = Compile(`(a|b)*c`, Limits{Recursion: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := re.MatchString(strings.Repeat("ab", 100)); !errors.Is(err, ErrRecursionLimit) {
+		t.Errorf("expected the recursion limit to be exceeded, got %v", err)
+	}
+	if ok, err := re.MatchString(strings.Repeat("ab", 10) + "c"); !ok || err != nil {
+		t.Errorf("unexpected match result %t, %v", ok, err)
+	}
+}
+
+func TestConcurrentMatches(t *testing.T) {
+	re := MustCompile(`(\w+)@(\w+)\.com`)
+	done := make(chan bool)
+	for i := 0; i < 8; i++ {
+		go func() {
+			for j := 0; j < 100; j++ {
+				match, err := re.FindStringSubmatch("mail john@example.com now")
+				if err != nil || len(match) != 3 || match[2] != "example" {
+					t.Errorf("unexpected match %q, %v", match, err)
+				}
+			}
+			done <- true
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+}
+
+func BenchmarkMatchString(b *testing.B) {
+	re := MustCompile(`(?i)(?:union\s+(?:all\s+)?select|(?<=\W)sleep\s*\(\s*\d+\s*\))`)
+	input := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 20) + "1 union all select password"
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if ok, _ := re.MatchString(input); !ok {
+			b.Fatal("expected a match")
+		}
+	}
+}
+
+func FuzzFindStringSubmatch(f *testing.F) {
+	for _, pattern := range compatPatterns {
+		f.Add(pattern, "abcd")
+	}
+	f.Add(`(?<=a|bc)(?!d)(\w)\1++`, "bcxx")
+	f.Add(`(?i)(?>a|ab)*?[[:^alpha:]\p{Lu}]{2,}\Z`, "ABab12\n")
+	f.Fuzz(func(t *testing.T, pattern, input string) {
+		re, err := Compile(pattern, Limits{Match: 10000, Recursion: 1000})
+		if err != nil {
+			return
+		}
+		loc, err := re.FindStringSubmatchIndex(input)
+		if err != nil || loc == nil {
+			return
+		}
+		if len(loc) != 2*(re.NumSubexp()+1) || loc[0] < 0 || loc[0] > loc[1] || loc[1] > len(input) {
+			t.Errorf("invalid match %v of %q in %q", loc, pattern, input)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backtrack
+
+import "unicode"
+
+// class is a character class, such as [a-z\d]
+type class struct {
+	items  []classItem
+	negate bool
+	fold   bool
+}
+
+// classItem is a range of characters, or a predicate such as \d when fn is
+// set
+type classItem struct {
+	lo, hi rune
+	fn     func(rune) bool
+	negate bool
+}
+
+func (c *class) matches(r rune) bool {
+	in := c.contains(r)
+	if !in && c.fold {
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if c.contains(f) {
+				in = true
+				break
+			}
+		}
+	}
+	return in != c.negate
+}
+
+func (c *class) contains(r rune) bool {
+	for i := range c.items {
+		it := &c.items[i]
+		if it.fn != nil {
+			if it.fn(r) != it.negate {
+				return true
+			}
+		} else if r >= it.lo && r <= it.hi {
+			return true
+		}
+	}
+	return false
+}
+
+// equalFold reports whether the characters are equal under simple case
+// folding
+func equalFold(a, b rune) bool {
+	for f := unicode.SimpleFold(a); f != a; f = unicode.SimpleFold(f) {
+		if f == b {
+			return true
+		}
+	}
+	return false
+}
+
+// The classes of characters are ASCII only, as in PCRE without Unicode
+// properties.
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isWord(r rune) bool {
+	return r < 0x80 && isWordByte(byte(r))
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r >= '\t' && r <= '\r'
+}
+
+func isHorizontalSpace(r rune) bool {
+	switch r {
+	case ' ', '\t', 0xa0, 0x1680, 0x180e, 0x202f, 0x205f, 0x3000:
+		return true
+	}
+	return r >= 0x2000 && r <= 0x200a
+}
+
+func isVerticalSpace(r rune) bool {
+	return r >= '\n' && r <= '\r' || r == 0x85 || r == 0x2028 || r == 0x2029
+}
+
+var posixClasses = map[string]func(rune) bool{
+	"alnum":  func(r rune) bool { return r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' },
+	"alpha":  func(r rune) bool { return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' },
+	"ascii":  func(r rune) bool { return r < 0x80 },
+	"blank":  func(r rune) bool { return r == ' ' || r == '\t' },
+	"cntrl":  func(r rune) bool { return r < 0x20 || r == 0x7f },
+	"digit":  isDigit,
+	"graph":  func(r rune) bool { return r > ' ' && r < 0x7f },
+	"lower":  func(r rune) bool { return r >= 'a' && r <= 'z' },
+	"print":  func(r rune) bool { return r >= ' ' && r < 0x7f },
+	"punct":  func(r rune) bool { return r > ' ' && r < 0x7f && !isWord(r) || r == '_' },
+	"space":  isSpace,
+	"upper":  func(r rune) bool { return r >= 'A' && r <= 'Z' },
+	"word":   isWord,
+	"xdigit": func(r rune) bool { return r < 0x80 && isHex(byte(r)) },
+}
@@ -0,0 +1,244 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backtrack
+
+import "errors"
+
+// maxInsts bounds the size of compiled programs
+const maxInsts = 100000
+
+var errTooLarge = errors.New("error parsing regexp: pattern too large after expanding repetitions")
+
+type opcode uint8
+
+const (
+	opMatch opcode = iota
+	opRune
+	opAny
+	opAnyNotNL
+	opClass
+	// opSplit continues at x, and at y when backtracking
+	opSplit
+	opJmp
+	// opSave stores the position in slot x
+	opSave
+	// opCheck jumps to y when the position is the one stored in slot x,
+	// leaving loops whose iteration matched empty text
+	opCheck
+	opAssert
+	opBackref
+	opLook
+	opAtomic
+	// opRepeat matches the single character one between min and max times
+	opRepeat
+)
+
+// inst is an instruction of a program
+type inst struct {
+	op   opcode
+	x, y int
+
+	r     rune
+	fold  bool
+	class *class
+
+	assert         assertKind
+	behind, negate bool
+
+	min, max           int
+	greedy, possessive bool
+	one                *inst
+
+	// prog is the sub-program of lookarounds and atomic groups
+	prog *prog
+}
+
+type prog struct {
+	insts []inst
+}
+
+// compiler compiles syntax trees into programs. Lookarounds and atomic
+// groups are compiled into sub-programs, sharing the slots of captures and
+// loops with the main one.
+type compiler struct {
+	nslots int
+}
+
+func (c *compiler) compile(n *node) (*prog, error) {
+	p := &prog{}
+	if err := c.emit(p, n); err != nil {
+		return nil, err
+	}
+	p.insts = append(p.insts, inst{op: opMatch})
+	return p, nil
+}
+
+func (c *compiler) emit(p *prog, n *node) error {
+	switch n.kind {
+	case nodeLiteral, nodeAny, nodeClass:
+		p.insts = append(p.insts, single(n))
+	case nodeConcat:
+		for _, sub := range n.subs {
+			if err := c.emit(p, sub); err != nil {
+				return err
+			}
+		}
+	case nodeAlternate:
+		var jumps []int
+		for i, sub := range n.subs {
+			split := len(p.insts)
+			last := i == len(n.subs)-1
+			if !last {
+				p.insts = append(p.insts, inst{op: opSplit, x: split + 1})
+			}
+			if err := c.emit(p, sub); err != nil {
+				return err
+			}
+			if !last {
+				jumps = append(jumps, len(p.insts))
+				p.insts = append(p.insts, inst{op: opJmp})
+				p.insts[split].y = len(p.insts)
+			}
+		}
+		for _, j := range jumps {
+			p.insts[j].x = len(p.insts)
+		}
+	case nodeCapture:
+		p.insts = append(p.insts, inst{op: opSave, x: 2 * n.index})
+		if err := c.emit(p, n.subs[0]); err != nil {
+			return err
+		}
+		p.insts = append(p.insts, inst{op: opSave, x: 2*n.index + 1})
+	case nodeRepeat:
+		if err := c.emitRepeat(p, n); err != nil {
+			return err
+		}
+	case nodeLook:
+		sub, err := c.compile(n.subs[0])
+		if err != nil {
+			return err
+		}
+		min, max := n.subs[0].width()
+		p.insts = append(p.insts, inst{op: opLook, prog: sub, behind: n.behind, negate: n.negate, min: min, max: max})
+	case nodeAtomic:
+		sub, err := c.compile(n.subs[0])
+		if err != nil {
+			return err
+		}
+		p.insts = append(p.insts, inst{op: opAtomic, prog: sub})
+	case nodeBackref:
+		p.insts = append(p.insts, inst{op: opBackref, x: n.index, fold: n.fold})
+	case nodeAssert:
+		p.insts = append(p.insts, inst{op: opAssert, assert: n.assert})
+	}
+	if len(p.insts) > maxInsts {
+		return errTooLarge
+	}
+	return nil
+}
+
+func (c *compiler) emitRepeat(p *prog, n *node) error {
+	sub := n.subs[0]
+	switch sub.kind {
+	case nodeLiteral, nodeAny, nodeClass:
+		one := single(sub)
+		p.insts = append(p.insts, inst{op: opRepeat, one: &one, min: n.min, max: n.max, greedy: n.greedy, possessive: n.possessive})
+		return nil
+	}
+
+	if n.possessive {
+		// X*+ is (?>X*)
+		inner := *n
+		inner.possessive = false
+		sub, err := c.compile(&inner)
+		if err != nil {
+			return err
+		}
+		p.insts = append(p.insts, inst{op: opAtomic, prog: sub})
+		return nil
+	}
+
+	for i := 0; i < n.min; i++ {
+		if err := c.emit(p, sub); err != nil {
+			return err
+		}
+	}
+
+	if n.max < 0 {
+		slot := -1
+		if min, _ := sub.width(); min == 0 {
+			slot = c.nslots
+			c.nslots++
+		}
+		loop := len(p.insts)
+		p.insts = append(p.insts, inst{op: opSplit})
+		if slot >= 0 {
+			p.insts = append(p.insts, inst{op: opSave, x: slot})
+		}
+		if err := c.emit(p, sub); err != nil {
+			return err
+		}
+		check := len(p.insts)
+		if slot >= 0 {
+			p.insts = append(p.insts, inst{op: opCheck, x: slot})
+		}
+		p.insts = append(p.insts, inst{op: opJmp, x: loop})
+		if slot >= 0 {
+			p.insts[check].y = len(p.insts)
+		}
+		p.insts[loop].x, p.insts[loop].y = order(loop+1, len(p.insts), n.greedy)
+		return nil
+	}
+
+	// X{n,m} is n times X followed by m-n nested optional X
+	var splits []int
+	for i := n.min; i < n.max; i++ {
+		splits = append(splits, len(p.insts))
+		p.insts = append(p.insts, inst{op: opSplit})
+		if err := c.emit(p, sub); err != nil {
+			return err
+		}
+	}
+	for _, s := range splits {
+		p.insts[s].x, p.insts[s].y = order(s+1, len(p.insts), n.greedy)
+	}
+	return nil
+}
+
+// order returns the targets of a split entering a repeated node at body,
+// or skipping it to exit, in the order a greedy or lazy repeat tries them
+func order(body, exit int, greedy bool) (int, int) {
+	if greedy {
+		return body, exit
+	}
+	return exit, body
+}
+
+// single returns the instruction matching a single character node
+func single(n *node) inst {
+	switch n.kind {
+	case nodeLiteral:
+		return inst{op: opRune, r: n.r, fold: n.fold}
+	case nodeAny:
+		if n.dotNL {
+			return inst{op: opAny}
+		}
+		return inst{op: opAnyNotNL}
+	}
+	return inst{op: opClass, class: n.class}
+}
+
+func (in *inst) matches(r rune) bool {
+	switch in.op {
+	case opRune:
+		return r == in.r || in.fold && equalFold(r, in.r)
+	case opAny:
+		return true
+	case opAnyNotNL:
+		return r != '\n'
+	case opClass:
+		return in.class.matches(r)
+	}
+	return false
+}
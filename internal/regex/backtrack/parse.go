@@ -0,0 +1,998 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backtrack
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// maxRepeat bounds the counts of {n,m} quantifiers, as in RE2
+	maxRepeat = 1000
+	// maxDepth bounds the nesting of groups, as in PCRE
+	maxDepth = 250
+	// maxWidth caps the widths computed for nodes
+	maxWidth = 1 << 30
+)
+
+type nodeKind uint8
+
+const (
+	nodeEmpty nodeKind = iota
+	nodeLiteral
+	nodeAny
+	nodeClass
+	nodeConcat
+	nodeAlternate
+	nodeRepeat
+	nodeCapture
+	nodeLook
+	nodeAtomic
+	nodeBackref
+	nodeAssert
+)
+
+type assertKind uint8
+
+const (
+	// assertBeginText is \A, and ^ unless in multiline mode
+	assertBeginText assertKind = iota
+	// assertBeginLine is ^ in multiline mode
+	assertBeginLine
+	// assertEndText is \z
+	assertEndText
+	// assertEndTextNewline is \Z, and $ unless in multiline mode, matching
+	// at the end or before a final newline
+	assertEndTextNewline
+	// assertEndLine is $ in multiline mode
+	assertEndLine
+	assertWordBoundary
+	assertNotWordBoundary
+	// assertSearchStart is \G, the position the search started at
+	assertSearchStart
+)
+
+// node is a node of the syntax tree of a pattern
+type node struct {
+	kind nodeKind
+	subs []*node
+
+	// r is the character of literals, fold makes literals, classes and
+	// backreferences case-insensitive
+	r    rune
+	fold bool
+	// dotNL makes . match newlines
+	dotNL bool
+	class *class
+
+	// min and max are the counts of repeats, max is -1 when unbounded
+	min, max           int
+	greedy, possessive bool
+
+	// index is the group of captures and backreferences, name the group of
+	// backreferences by name until resolved
+	index int
+	name  string
+
+	behind, negate bool
+	assert         assertKind
+
+	// quoted marks the characters of a \Q...\E sequence
+	quoted bool
+}
+
+// flags are the options set with (?imsxU)
+type flags struct {
+	fold, multiline, dotNL, extended, ungreedy bool
+}
+
+type parser struct {
+	src   string
+	pos   int
+	depth int
+
+	// ncap is the number of groups parsed so far, names their names
+	ncap  int
+	names []string
+	// groups is the number of groups of the pattern, telling backreferences
+	// from octal escapes
+	groups   int
+	backrefs []*node
+}
+
+// parse returns the syntax tree of the pattern
+func parse(src string) (*parser, *node, error) {
+	p := &parser{src: src, names: []string{""}, groups: countGroups(src)}
+	n, err := p.parseAlternate(&flags{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if !p.done() {
+		return nil, nil, p.errorf("unmatched closing parenthesis")
+	}
+	for _, b := range p.backrefs {
+		if b.name != "" {
+			for i, name := range p.names {
+				if name == b.name {
+					b.index = i
+				}
+			}
+			if b.index == 0 {
+				return nil, nil, fmt.Errorf("error parsing regexp: reference to non-existent subpattern %q", b.name)
+			}
+		}
+		if b.index > p.ncap {
+			return nil, nil, fmt.Errorf("error parsing regexp: reference to non-existent subpattern %d", b.index)
+		}
+	}
+	return p, n, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("error parsing regexp: %s at offset %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() rune {
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return r
+}
+
+func (p *parser) next() rune {
+	r, w := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.pos += w
+	return r
+}
+
+// eat consumes the ASCII character c if it is next
+func (p *parser) eat(c byte) bool {
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// skipSpace skips the whitespace and comments ignored in extended mode
+func (p *parser) skipSpace() {
+	for !p.done() {
+		switch p.src[p.pos] {
+		case ' ', '\t', '\n', '\v', '\f', '\r':
+			p.pos++
+		case '#':
+			if i := strings.IndexByte(p.src[p.pos:], '\n'); i >= 0 {
+				p.pos += i + 1
+			} else {
+				p.pos = len(p.src)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) parseAlternate(f *flags) (*node, error) {
+	var alts []*node
+	for {
+		n, err := p.parseConcat(f)
+		if err != nil {
+			return nil, err
+		}
+		alts = append(alts, n)
+		if !p.eat('|') {
+			break
+		}
+	}
+	if len(alts) == 1 {
+		return alts[0], nil
+	}
+	return &node{kind: nodeAlternate, subs: alts}, nil
+}
+
+func (p *parser) parseConcat(f *flags) (*node, error) {
+	var subs []*node
+	for {
+		if f.extended {
+			p.skipSpace()
+		}
+		if p.done() || p.src[p.pos] == '|' || p.src[p.pos] == ')' {
+			break
+		}
+		atom, err := p.parseAtom(f)
+		if err != nil {
+			return nil, err
+		}
+		if atom == nil {
+			continue
+		}
+		if atom.quoted {
+			// a quantifier after \Q...\E applies to its last character
+			if len(atom.subs) == 0 {
+				continue
+			}
+			subs = append(subs, atom.subs[:len(atom.subs)-1]...)
+			atom = atom.subs[len(atom.subs)-1]
+		}
+		if atom, err = p.parseQuantifier(atom, f); err != nil {
+			return nil, err
+		}
+		subs = append(subs, atom)
+	}
+	switch len(subs) {
+	case 0:
+		return &node{kind: nodeEmpty}, nil
+	case 1:
+		return subs[0], nil
+	}
+	return &node{kind: nodeConcat, subs: subs}, nil
+}
+
+func (p *parser) parseQuantifier(atom *node, f *flags) (*node, error) {
+	if f.extended {
+		p.skipSpace()
+	}
+	if p.done() {
+		return atom, nil
+	}
+	start := p.pos
+	var min, max int
+	switch p.src[p.pos] {
+	case '*':
+		p.pos++
+		min, max = 0, -1
+	case '+':
+		p.pos++
+		min, max = 1, -1
+	case '?':
+		p.pos++
+		min, max = 0, 1
+	case '{':
+		var ok bool
+		if min, max, ok = p.parseBraces(); !ok {
+			return atom, nil
+		}
+	default:
+		return atom, nil
+	}
+	if atom.kind == nodeAssert {
+		p.pos = start
+		return nil, p.errorf("nothing to repeat")
+	}
+	if max >= 0 && min > max {
+		p.pos = start
+		return nil, p.errorf("numbers out of order in {} quantifier")
+	}
+	if min > maxRepeat || max > maxRepeat {
+		p.pos = start
+		return nil, p.errorf("number too big in {} quantifier")
+	}
+
+	n := &node{kind: nodeRepeat, subs: []*node{atom}, min: min, max: max, greedy: !f.ungreedy}
+	switch {
+	case p.eat('?'):
+		n.greedy = !n.greedy
+	case p.eat('+'):
+		n.greedy, n.possessive = true, true
+	}
+	return n, nil
+}
+
+// parseBraces parses a {n}, {n,} or {n,m} quantifier. It consumes nothing
+// and returns false when the brace is a literal.
+func (p *parser) parseBraces() (int, int, bool) {
+	min, i, ok := number(p.src, p.pos+1)
+	if !ok {
+		return 0, 0, false
+	}
+	max := min
+	if i < len(p.src) && p.src[i] == ',' {
+		i++
+		if i < len(p.src) && p.src[i] == '}' {
+			max = -1
+		} else if max, i, ok = number(p.src, i); !ok {
+			return 0, 0, false
+		}
+	}
+	if i >= len(p.src) || p.src[i] != '}' {
+		return 0, 0, false
+	}
+	p.pos = i + 1
+	return min, max, true
+}
+
+// number parses the decimal number at s[i:], capped above maxRepeat, and
+// returns the index following it
+func number(s string, i int) (int, int, bool) {
+	n, j := 0, i
+	for ; j < len(s) && s[j] >= '0' && s[j] <= '9'; j++ {
+		if n <= maxRepeat {
+			n = n*10 + int(s[j]-'0')
+		}
+	}
+	return n, j, j > i
+}
+
+func (p *parser) parseAtom(f *flags) (*node, error) {
+	start := p.pos
+	c := p.next()
+	switch c {
+	case '(':
+		return p.parseGroup(f)
+	case '[':
+		cls, err := p.parseClass(f)
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: nodeClass, class: cls}, nil
+	case '.':
+		return &node{kind: nodeAny, dotNL: f.dotNL}, nil
+	case '^':
+		if f.multiline {
+			return &node{kind: nodeAssert, assert: assertBeginLine}, nil
+		}
+		return &node{kind: nodeAssert, assert: assertBeginText}, nil
+	case '$':
+		if f.multiline {
+			return &node{kind: nodeAssert, assert: assertEndLine}, nil
+		}
+		return &node{kind: nodeAssert, assert: assertEndTextNewline}, nil
+	case '\\':
+		return p.parseEscape(f)
+	case '*', '+', '?':
+		p.pos = start
+		return nil, p.errorf("nothing to repeat")
+	case '{':
+		p.pos = start
+		if _, _, ok := p.parseBraces(); ok {
+			p.pos = start
+			return nil, p.errorf("nothing to repeat")
+		}
+		p.pos++
+	}
+	return literal(c, f.fold), nil
+}
+
+func literal(r rune, fold bool) *node {
+	return &node{kind: nodeLiteral, r: r, fold: fold && unicode.SimpleFold(r) != r}
+}
+
+func (p *parser) parseGroup(f *flags) (*node, error) {
+	if p.depth++; p.depth > maxDepth {
+		return nil, p.errorf("parentheses are too deeply nested")
+	}
+	defer func() { p.depth-- }()
+
+	if !p.eat('?') {
+		if p.eat('*') {
+			return nil, p.errorf("backtracking control verbs are not supported")
+		}
+		return p.parseCapture(f, "")
+	}
+	if p.done() {
+		return nil, p.errorf("missing closing parenthesis")
+	}
+	switch c := p.next(); c {
+	case ':':
+		return p.parseBody(f)
+	case '>':
+		return p.parseWrapped(f, &node{kind: nodeAtomic})
+	case '=', '!':
+		return p.parseWrapped(f, &node{kind: nodeLook, negate: c == '!'})
+	case '<':
+		switch {
+		case p.eat('='):
+			return p.parseLookbehind(f, false)
+		case p.eat('!'):
+			return p.parseLookbehind(f, true)
+		}
+		name, err := p.parseName('>')
+		if err != nil {
+			return nil, err
+		}
+		return p.parseCapture(f, name)
+	case '\'':
+		name, err := p.parseName('\'')
+		if err != nil {
+			return nil, err
+		}
+		return p.parseCapture(f, name)
+	case 'P':
+		switch {
+		case p.eat('<'):
+			name, err := p.parseName('>')
+			if err != nil {
+				return nil, err
+			}
+			return p.parseCapture(f, name)
+		case p.eat('='):
+			name, err := p.parseName(')')
+			if err != nil {
+				return nil, err
+			}
+			return p.backref(0, name, f), nil
+		}
+		return nil, p.errorf("recursion is not supported")
+	case '#':
+		i := strings.IndexByte(p.src[p.pos:], ')')
+		if i < 0 {
+			return nil, p.errorf("missing ) after comment")
+		}
+		p.pos += i + 1
+		return nil, nil
+	case '|':
+		return nil, p.errorf("branch reset groups are not supported")
+	case '(':
+		return nil, p.errorf("conditional groups are not supported")
+	case 'R', '&', '+', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return nil, p.errorf("recursion is not supported")
+	case '-':
+		if !p.done() && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+			return nil, p.errorf("recursion is not supported")
+		}
+	}
+	p.pos--
+	return p.parseFlags(f)
+}
+
+// parseFlags parses the flags of (?flags) and (?flags:...) groups. The
+// former changes the flags until the end of the enclosing group.
+func (p *parser) parseFlags(f *flags) (*node, error) {
+	g := *f
+	negate := false
+	for !p.done() {
+		switch c := p.next(); c {
+		case '-':
+			if negate {
+				return nil, p.errorf("unrecognized character after (? or (?-")
+			}
+			negate = true
+		case 'i':
+			g.fold = !negate
+		case 'm':
+			g.multiline = !negate
+		case 's':
+			g.dotNL = !negate
+		case 'x':
+			g.extended = !negate
+		case 'U':
+			g.ungreedy = !negate
+		case ')':
+			*f = g
+			return nil, nil
+		case ':':
+			return p.parseBody(&g)
+		default:
+			return nil, p.errorf("unrecognized character after (? or (?-")
+		}
+	}
+	return nil, p.errorf("missing closing parenthesis")
+}
+
+// parseBody parses the content of a group, up to its closing parenthesis.
+// Flags set inside the group do not apply after it.
+func (p *parser) parseBody(f *flags) (*node, error) {
+	g := *f
+	n, err := p.parseAlternate(&g)
+	if err != nil {
+		return nil, err
+	}
+	if !p.eat(')') {
+		return nil, p.errorf("missing closing parenthesis")
+	}
+	return n, nil
+}
+
+// parseWrapped parses the content of a group as the sub-node of n
+func (p *parser) parseWrapped(f *flags, n *node) (*node, error) {
+	sub, err := p.parseBody(f)
+	if err != nil {
+		return nil, err
+	}
+	n.subs = []*node{sub}
+	return n, nil
+}
+
+func (p *parser) parseLookbehind(f *flags, negate bool) (*node, error) {
+	start := p.pos
+	n, err := p.parseWrapped(f, &node{kind: nodeLook, behind: true, negate: negate})
+	if err != nil {
+		return nil, err
+	}
+	if _, max := n.subs[0].width(); max < 0 {
+		p.pos = start
+		return nil, p.errorf("lookbehind assertion is not fixed length")
+	}
+	return n, nil
+}
+
+func (p *parser) parseCapture(f *flags, name string) (*node, error) {
+	if name != "" {
+		for _, n := range p.names {
+			if n == name {
+				return nil, p.errorf("two named subpatterns have the same name")
+			}
+		}
+	}
+	p.ncap++
+	n := &node{kind: nodeCapture, index: p.ncap}
+	p.names = append(p.names, name)
+	return p.parseWrapped(f, n)
+}
+
+// parseName parses a group name terminated by end
+func (p *parser) parseName(end byte) (string, error) {
+	start := p.pos
+	for !p.done() && isWordByte(p.src[p.pos]) {
+		p.pos++
+	}
+	name := p.src[start:p.pos]
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		return "", p.errorf("group name must start with a non-digit")
+	}
+	if !p.eat(end) {
+		return "", p.errorf("syntax error in subpattern name (missing terminator)")
+	}
+	return name, nil
+}
+
+func (p *parser) backref(index int, name string, f *flags) *node {
+	n := &node{kind: nodeBackref, index: index, name: name, fold: f.fold}
+	p.backrefs = append(p.backrefs, n)
+	return n
+}
+
+func (p *parser) parseEscape(f *flags) (*node, error) {
+	if p.done() {
+		return nil, p.errorf("\\ at end of pattern")
+	}
+	c := p.next()
+	switch c {
+	case 'b':
+		return &node{kind: nodeAssert, assert: assertWordBoundary}, nil
+	case 'B':
+		return &node{kind: nodeAssert, assert: assertNotWordBoundary}, nil
+	case 'A':
+		return &node{kind: nodeAssert, assert: assertBeginText}, nil
+	case 'z':
+		return &node{kind: nodeAssert, assert: assertEndText}, nil
+	case 'Z':
+		return &node{kind: nodeAssert, assert: assertEndTextNewline}, nil
+	case 'G':
+		return &node{kind: nodeAssert, assert: assertSearchStart}, nil
+	case 'N':
+		return &node{kind: nodeAny}, nil
+	case 'Q':
+		quoted := p.src[p.pos:]
+		if i := strings.Index(quoted, `\E`); i >= 0 {
+			quoted = quoted[:i]
+			p.pos += i + 2
+		} else {
+			p.pos = len(p.src)
+		}
+		n := &node{kind: nodeConcat, quoted: true}
+		for _, r := range quoted {
+			n.subs = append(n.subs, literal(r, f.fold))
+		}
+		return n, nil
+	case 'E':
+		return nil, nil
+	case 'k':
+		var end byte
+		switch {
+		case p.eat('<'):
+			end = '>'
+		case p.eat('\''):
+			end = '\''
+		case p.eat('{'):
+			end = '}'
+		default:
+			return nil, p.errorf("\\k is not followed by a braced, angle-bracketed, or quoted name")
+		}
+		name, err := p.parseName(end)
+		if err != nil {
+			return nil, err
+		}
+		return p.backref(0, name, f), nil
+	case 'g':
+		return p.parseGReference(f)
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		n, i, _ := number(p.src, p.pos-1)
+		if n < 10 || n <= p.groups {
+			p.pos = i
+			return p.backref(n, "", f), nil
+		}
+	case 'K', 'R', 'X', 'C':
+		return nil, p.errorf("\\%c is not supported", c)
+	}
+
+	item, ok, err := p.parseClassEscape(c)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return &node{kind: nodeClass, class: &class{items: []classItem{item}}}, nil
+	}
+	r, err := p.parseCharEscape(c)
+	if err != nil {
+		return nil, err
+	}
+	return literal(r, f.fold), nil
+}
+
+// parseGReference parses the \g{n}, \g{-n}, \gn, \g-n and \g{name}
+// backreferences
+func (p *parser) parseGReference(f *flags) (*node, error) {
+	braced := p.eat('{')
+	if braced && !p.done() && p.src[p.pos] != '-' && (p.src[p.pos] < '0' || p.src[p.pos] > '9') {
+		name, err := p.parseName('}')
+		if err != nil {
+			return nil, err
+		}
+		return p.backref(0, name, f), nil
+	}
+	if !braced && (p.eat('<') || p.eat('\'')) {
+		return nil, p.errorf("subroutine calls are not supported")
+	}
+	relative := p.eat('-')
+	n, i, ok := number(p.src, p.pos)
+	if !ok || n == 0 {
+		return nil, p.errorf("a numbered reference must not be zero")
+	}
+	p.pos = i
+	if braced && !p.eat('}') {
+		return nil, p.errorf("\\g is not followed by a braced, angle-bracketed, or quoted name/number or by a plain number")
+	}
+	if relative {
+		if n = p.ncap + 1 - n; n <= 0 {
+			return nil, p.errorf("reference to non-existent subpattern")
+		}
+	}
+	return p.backref(n, "", f), nil
+}
+
+// parseCharEscape returns the character escaped by \c
+func (p *parser) parseCharEscape(c rune) (rune, error) {
+	switch c {
+	case 'a':
+		return '\a', nil
+	case 'e':
+		return 0x1b, nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		r := c - '0'
+		for i := 0; i < 2 && !p.done() && p.src[p.pos] >= '0' && p.src[p.pos] <= '7'; i++ {
+			r = r*8 + rune(p.src[p.pos]-'0')
+			p.pos++
+		}
+		return r, nil
+	case '8', '9':
+		return c, nil
+	case 'o':
+		if !p.eat('{') {
+			return 0, p.errorf("missing opening brace after \\o")
+		}
+		return p.parseCodePoint(8)
+	case 'x':
+		if p.eat('{') {
+			return p.parseCodePoint(16)
+		}
+		var r rune
+		for i := 0; i < 2 && !p.done() && isHex(p.src[p.pos]); i++ {
+			r = r*16 + rune(unhex(p.src[p.pos]))
+			p.pos++
+		}
+		return r, nil
+	case 'c':
+		if p.done() {
+			return 0, p.errorf("\\c at end of pattern")
+		}
+		r := p.next()
+		if r >= utf8.RuneSelf {
+			return 0, p.errorf("\\c must be followed by an ASCII character")
+		}
+		return unicode.ToUpper(r) ^ 0x40, nil
+	}
+	if c < utf8.RuneSelf && isWordByte(byte(c)) {
+		return 0, p.errorf("unrecognized character follows \\")
+	}
+	return c, nil
+}
+
+// parseCodePoint parses the digits of \x{...} and \o{...}
+func (p *parser) parseCodePoint(base rune) (rune, error) {
+	var r rune
+	start := p.pos
+	for !p.done() && p.src[p.pos] != '}' {
+		c := p.src[p.pos]
+		if !isHex(c) || base == 8 && (c < '0' || c > '7') {
+			return 0, p.errorf("non-hex character in \\x{} (closing brace missing?)")
+		}
+		if r = r*base + rune(unhex(c)); r > unicode.MaxRune {
+			return 0, p.errorf("character code point value in \\x{} or \\o{} is too large")
+		}
+		p.pos++
+	}
+	if p.pos == start || !p.eat('}') {
+		return 0, p.errorf("missing closing brace in \\x{} or \\o{}")
+	}
+	return r, nil
+}
+
+// parseClassEscape parses the escapes matching classes of characters, such
+// as \d, and reports false for others
+func (p *parser) parseClassEscape(c rune) (classItem, bool, error) {
+	var fn func(rune) bool
+	switch c {
+	case 'd', 'D':
+		fn = isDigit
+	case 'w', 'W':
+		fn = isWord
+	case 's', 'S':
+		fn = isSpace
+	case 'h', 'H':
+		fn = isHorizontalSpace
+	case 'v', 'V':
+		fn = isVerticalSpace
+	case 'p', 'P':
+		item, err := p.parseProperty(c == 'P')
+		return item, err == nil, err
+	default:
+		return classItem{}, false, nil
+	}
+	return classItem{fn: fn, negate: unicode.IsUpper(c)}, true, nil
+}
+
+// parseProperty parses the Unicode property of \p and \P
+func (p *parser) parseProperty(negate bool) (classItem, error) {
+	var name string
+	switch {
+	case p.eat('{'):
+		i := strings.IndexByte(p.src[p.pos:], '}')
+		if i < 0 {
+			return classItem{}, p.errorf("malformed \\P or \\p sequence")
+		}
+		name = p.src[p.pos : p.pos+i]
+		p.pos += i + 1
+	case p.done():
+		return classItem{}, p.errorf("malformed \\P or \\p sequence")
+	default:
+		name = string(p.next())
+	}
+	if strings.HasPrefix(name, "^") {
+		negate = !negate
+		name = name[1:]
+	}
+
+	var fn func(rune) bool
+	switch name {
+	case "Any":
+		fn = func(rune) bool { return true }
+	case "L&":
+		fn = func(r rune) bool { return unicode.In(r, unicode.Lu, unicode.Ll, unicode.Lt) }
+	default:
+		table := unicode.Categories[name]
+		if table == nil {
+			table = unicode.Scripts[name]
+		}
+		if table == nil {
+			return classItem{}, p.errorf("unknown property name after \\P or \\p")
+		}
+		fn = func(r rune) bool { return unicode.Is(table, r) }
+	}
+	return classItem{fn: fn, negate: negate}, nil
+}
+
+func (p *parser) parseClass(f *flags) (*class, error) {
+	start := p.pos - 1
+	cls := &class{fold: f.fold, negate: p.eat('^')}
+	for first := true; ; first = false {
+		if p.done() {
+			p.pos = start
+			return nil, p.errorf("missing terminating ] for character class")
+		}
+		c := p.next()
+		if c == ']' && !first {
+			return cls, nil
+		}
+		if c == '[' && !p.done() && p.src[p.pos] == ':' {
+			item, ok, err := p.parsePOSIXClass()
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				cls.items = append(cls.items, item)
+				continue
+			}
+		}
+
+		lo, item, ok, err := p.parseClassAtom(c)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			cls.items = append(cls.items, item)
+			continue
+		}
+		if p.pos+1 >= len(p.src) || p.src[p.pos] != '-' || p.src[p.pos+1] == ']' {
+			cls.items = append(cls.items, classItem{lo: lo, hi: lo})
+			continue
+		}
+		p.pos++
+		hi, item, ok, err := p.parseClassAtom(p.next())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			// [a-\d] is a, - and \d
+			cls.items = append(cls.items, classItem{lo: lo, hi: lo}, classItem{lo: '-', hi: '-'}, item)
+			continue
+		}
+		if hi < lo {
+			return nil, p.errorf("range out of order in character class")
+		}
+		cls.items = append(cls.items, classItem{lo: lo, hi: hi})
+	}
+}
+
+// parseClassAtom parses a character of a class, or an escape matching a
+// class of characters
+func (p *parser) parseClassAtom(c rune) (rune, classItem, bool, error) {
+	if c != '\\' {
+		return c, classItem{}, false, nil
+	}
+	if p.done() {
+		return 0, classItem{}, false, p.errorf("\\ at end of pattern")
+	}
+	c = p.next()
+	if item, ok, err := p.parseClassEscape(c); ok || err != nil {
+		return 0, item, ok, err
+	}
+	if c == 'b' {
+		return '\b', classItem{}, false, nil
+	}
+	r, err := p.parseCharEscape(c)
+	return r, classItem{}, false, err
+}
+
+// parsePOSIXClass parses a class such as [:alpha:] inside a class. It
+// reports false when the bracket does not start one.
+func (p *parser) parsePOSIXClass() (classItem, bool, error) {
+	end := strings.Index(p.src[p.pos:], ":]")
+	if end < 0 {
+		return classItem{}, false, nil
+	}
+	name := p.src[p.pos+1 : p.pos+end]
+	negate := strings.HasPrefix(name, "^")
+	if negate {
+		name = name[1:]
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] < 'a' || name[i] > 'z' {
+			return classItem{}, false, nil
+		}
+	}
+	fn, ok := posixClasses[name]
+	if !ok {
+		return classItem{}, false, p.errorf("unknown POSIX class name")
+	}
+	p.pos += end + 2
+	return classItem{fn: fn, negate: negate}, true, nil
+}
+
+// width returns the minimum and maximum numbers of characters matched by
+// the node, the maximum is -1 when unbounded
+func (n *node) width() (int, int) {
+	switch n.kind {
+	case nodeLiteral, nodeAny, nodeClass:
+		return 1, 1
+	case nodeConcat:
+		min, max := 0, 0
+		for _, sub := range n.subs {
+			a, b := sub.width()
+			min = capWidth(min + a)
+			if max >= 0 {
+				if b < 0 {
+					max = -1
+				} else {
+					max = capWidth(max + b)
+				}
+			}
+		}
+		return min, max
+	case nodeAlternate:
+		min, max := n.subs[0].width()
+		for _, sub := range n.subs[1:] {
+			a, b := sub.width()
+			if a < min {
+				min = a
+			}
+			if max >= 0 && (b < 0 || b > max) {
+				max = b
+			}
+		}
+		return min, max
+	case nodeRepeat:
+		a, b := n.subs[0].width()
+		min := capWidth(a * n.min)
+		switch {
+		case b == 0:
+			return min, 0
+		case b < 0 || n.max < 0:
+			return min, -1
+		}
+		return min, capWidth(b * n.max)
+	case nodeCapture, nodeAtomic:
+		return n.subs[0].width()
+	case nodeBackref:
+		return 0, -1
+	}
+	return 0, 0
+}
+
+func capWidth(n int) int {
+	if n > maxWidth {
+		return maxWidth
+	}
+	return n
+}
+
+// countGroups returns the number of capturing groups of the pattern
+func countGroups(src string) int {
+	n := 0
+	inClass := false
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+			if strings.HasPrefix(src[i+1:], "^]") {
+				i += 2
+			} else if strings.HasPrefix(src[i+1:], "]") {
+				i++
+			}
+		case c == '(':
+			rest := src[i+1:]
+			switch {
+			case strings.HasPrefix(rest, "?<=") || strings.HasPrefix(rest, "?<!"):
+			case strings.HasPrefix(rest, "?<") || strings.HasPrefix(rest, "?P<") || strings.HasPrefix(rest, "?'"):
+				n++
+			case strings.HasPrefix(rest, "?") || strings.HasPrefix(rest, "*"):
+			default:
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	}
+	return c - '0'
+}
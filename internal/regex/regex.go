@@ -0,0 +1,79 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package regex holds the regular expression engines operators such as @rx
+// compile their expressions with.
+package regex
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/regex/backtrack"
+)
+
+const (
+	// DefaultEngine is RE2, as implemented by the regexp package
+	DefaultEngine = "re2"
+	// BacktrackEngine supports the PCRE syntax RE2 rejects, such as
+	// lookarounds and backreferences, bounding matches with the limits of
+	// SecPcreMatchLimit and SecPcreMatchLimitRecursion.
+	BacktrackEngine = "backtrack"
+)
+
+var engines = map[string]plugintypes.RegexEngine{}
+
+// Get returns an engine by name, the default one for an empty name
+func Get(name string) (plugintypes.RegexEngine, error) {
+	if name == "" {
+		name = DefaultEngine
+	}
+	if e, ok := engines[strings.ToLower(name)]; ok {
+		return e, nil
+	}
+	return nil, fmt.Errorf("regex engine %s not found", name)
+}
+
+// Register registers a new engine
+// If the engine already exists it will be overwritten
+func Register(name string, engine plugintypes.RegexEngine) {
+	engines[strings.ToLower(name)] = engine
+}
+
+type re2 struct {
+	re *regexp.Regexp
+}
+
+func (r re2) MatchString(s string) (bool, error) {
+	return r.re.MatchString(s), nil
+}
+
+func (r re2) FindStringSubmatch(s string) ([]string, error) {
+	return r.re.FindStringSubmatch(s), nil
+}
+
+func compileRE2(expr string, _ plugintypes.RegexOptions) (plugintypes.Regexp, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return re2{re: re}, nil
+}
+
+func compileBacktrack(expr string, options plugintypes.RegexOptions) (plugintypes.Regexp, error) {
+	re, err := backtrack.Compile(expr, backtrack.Limits{
+		Match:     options.MatchLimit,
+		Recursion: options.MatchLimitRecursion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+func init() {
+	Register(DefaultEngine, compileRE2)
+	Register(BacktrackEngine, compileBacktrack)
+}
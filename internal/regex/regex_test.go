@@ -0,0 +1,51 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	"github.com/corazawaf/coraza/v3/internal/regex/backtrack"
+)
+
+func TestEngines(t *testing.T) {
+	for _, name := range []string{"", "re2", "RE2", "backtrack"} {
+		engine, err := Get(name)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", name, err)
+		}
+		re, err := engine(`(\w+)@example\.com`, plugintypes.RegexOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		match, err := re.FindStringSubmatch("mail admin@example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"admin@example.com", "admin"}; !reflect.DeepEqual(match, want) {
+			t.Errorf("unexpected match with %q: %q, want %q", name, match, want)
+		}
+		if _, err := engine(`(`, plugintypes.RegexOptions{}); err == nil {
+			t.Errorf("expected an error compiling an invalid expression with %q", name)
+		}
+	}
+	if _, err := Get("pcre"); err == nil {
+		t.Error("expected an error for an unknown engine")
+	}
+}
+
+func TestBacktrackLimits(t *testing.T) {
+	engine, _ := Get(BacktrackEngine)
+	re, err := engine(`^(\w+\s?)*$`, plugintypes.RegexOptions{MatchLimit: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := re.MatchString(strings.Repeat("word ", 20) + "!"); !errors.Is(err, backtrack.ErrMatchLimit) {
+		t.Errorf("expected the match limit to be exceeded, got %v", err)
+	}
+}
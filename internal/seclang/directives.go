@@ -9,15 +9,24 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"mime"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/corazawaf/coraza/v3/debuglog"
 	"github.com/corazawaf/coraza/v3/internal/auditlog"
 	"github.com/corazawaf/coraza/v3/internal/corazawaf"
 	"github.com/corazawaf/coraza/v3/internal/environment"
+	"github.com/corazawaf/coraza/v3/internal/geo"
+	"github.com/corazawaf/coraza/v3/internal/hashengine"
+	"github.com/corazawaf/coraza/v3/internal/io"
 	"github.com/corazawaf/coraza/v3/internal/memoize"
+	"github.com/corazawaf/coraza/v3/internal/openapi"
+	"github.com/corazawaf/coraza/v3/internal/rdns"
+	"github.com/corazawaf/coraza/v3/internal/regex"
 	utils "github.com/corazawaf/coraza/v3/internal/strings"
 	"github.com/corazawaf/coraza/v3/types"
 )
@@ -299,6 +308,163 @@ func directiveSecWebAppID(options *DirectiveOptions) error {
 	return nil
 }
 
+// Description: Configures the response sent to the client when a transaction is interrupted.
+// Syntax: SecErrorDocument [STATUS|NAME] [PATH]
+// ---
+// The template at PATH is rendered into `deny` and `redirect` interruptions (including the ones
+// raised by `block`) and sent by the connector as the response body. When the first argument is
+// a status code, the template is used for every interruption with that status. Otherwise it
+// defines a named template that rules select with the `errorDocument` action.
+//
+// Templates support macro expansion, e.g. `%{unique_id}` expands to the transaction ID that can
+// be shown as a support reference. Expanded values are escaped for HTML, XML and JSON documents.
+// The content type is derived from the file extension. Relative paths are resolved against the
+// directory of the configuration file.
+//
+// Example:
+// ```
+// SecErrorDocument 403 /etc/coraza/403.html
+// SecErrorDocument ratelimited /etc/coraza/429.json
+// SecRule IP:REQUESTS "@gt 100" "id:180,phase:1,deny,status:429,errorDocument:ratelimited"
+// ```
+func directiveSecErrorDocument(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+
+	key, path, ok := strings.Cut(options.Opts, " ")
+	path = utils.MaybeRemoveQuotes(strings.TrimSpace(path))
+	if !ok || path == "" {
+		return errors.New("syntax error: SecErrorDocument [STATUS|NAME] [PATH]")
+	}
+
+	content, err := readConfigFile(options, path)
+	if err != nil {
+		return fmt.Errorf("failed to read error document: %s", err.Error())
+	}
+
+	doc, err := corazawaf.NewErrorDocument(string(content), mime.TypeByExtension(filepath.Ext(path)))
+	if err != nil {
+		return fmt.Errorf("invalid error document %q: %s", path, err.Error())
+	}
+
+	if options.WAF.ErrorDocuments == nil {
+		options.WAF.ErrorDocuments = map[string]*corazawaf.ErrorDocument{}
+	}
+	options.WAF.ErrorDocuments[key] = doc
+	return nil
+}
+
+// readConfigFile reads a file referenced by a directive. Relative paths are
+// resolved against the directory of the configuration file and then the
+// working directory.
+func readConfigFile(options *DirectiveOptions, path string) ([]byte, error) {
+	root := options.Parser.Root
+	if root == nil {
+		root = io.OSFS{}
+	}
+
+	if filepath.IsAbs(path) {
+		return fs.ReadFile(root, path)
+	}
+
+	var (
+		content []byte
+		err     = fs.ErrNotExist
+	)
+	// like the operator data files, an empty config directory (rules loaded
+	// from a string) resolves paths against the root.
+	for i, dir := range []string{options.Parser.ConfigDir, options.Parser.WorkingDir} {
+		if i > 0 && dir == "" {
+			continue
+		}
+		content, err = fs.ReadFile(root, filepath.Join(dir, path))
+		if err == nil || !errors.Is(err, fs.ErrNotExist) {
+			return content, err
+		}
+	}
+	return nil, err
+}
+
+// Description: Configures the geolocation database used by the `@geoLookup` operator.
+// Syntax: SecGeoLookupDb [PATH]
+// ---
+// The database must be in the MaxMind DB format (e.g. GeoLite2-City.mmdb or any compatible
+// database). It is loaded in memory, so the file can be safely replaced once loaded. The
+// directive must precede the rules using `@geoLookup`, which never match without a database.
+// Relative paths are resolved against the directory of the configuration file.
+//
+// On a successful lookup the GEO collection is populated with the COUNTRY_CODE, COUNTRY_NAME,
+// CONTINENT_CODE, REGION, CITY, LATITUDE and LONGITUDE fields, plus ASN and ASN_ORGANIZATION
+// when the database provides autonomous system data.
+//
+// Example:
+// ```
+// SecGeoLookupDb /usr/share/GeoIP/GeoLite2-City.mmdb
+// SecRule REMOTE_ADDR "@geoLookup" "id:150,phase:1,chain,deny"
+// SecRule GEO:COUNTRY_CODE "@pm CN RU"
+// ```
+func directiveSecGeoLookupDb(options *DirectiveOptions) error {
+	path := utils.MaybeRemoveQuotes(options.Opts)
+	if len(path) == 0 {
+		return errEmptyOptions
+	}
+
+	content, err := readConfigFile(options, path)
+	if err != nil {
+		return fmt.Errorf("failed to read geolocation database: %s", err.Error())
+	}
+
+	db, err := geo.NewDatabase(content)
+	if err != nil {
+		return fmt.Errorf("invalid geolocation database %q: %s", path, err.Error())
+	}
+	options.Parser.GeoLookupDB = db
+	return nil
+}
+
+// Description: Configures the OpenAPI 3 specification enforced by the `@validateOpenAPI` operator.
+// Syntax: SecOpenAPISpec [PATH]
+// ---
+// The specification can be in JSON or YAML format, only local references (e.g.
+// `#/components/schemas/User`) are supported. The directive must precede the rules using
+// `@validateOpenAPI`. Relative paths are resolved against the directory of the configuration
+// file.
+//
+// Requests are only allowed if their path and method match an operation of the specification,
+// their path, query, header and cookie parameters match their schema and their JSON or
+// urlencoded body matches the schema of the operation.
+//
+// Example:
+// ```
+// SecOpenAPISpec /etc/coraza/openapi.yaml
+// SecRule REQUEST_FILENAME "@validateOpenAPI" "id:160,phase:2,deny,capture,msg:'%{TX.0}'"
+// ```
+func directiveSecOpenAPISpec(options *DirectiveOptions) error {
+	path := utils.MaybeRemoveQuotes(options.Opts)
+	if len(path) == 0 {
+		return errEmptyOptions
+	}
+
+	content, err := readConfigFile(options, path)
+	if err != nil {
+		return fmt.Errorf("failed to read OpenAPI specification: %s", err.Error())
+	}
+
+	spec, err := openapi.Load(content)
+	if err != nil {
+		return fmt.Errorf("invalid OpenAPI specification %q: %s", path, err.Error())
+	}
+	options.Parser.OpenAPISpec = spec
+	return nil
+}
+
+// Description: Instructs Coraza to change the data presented in the "Server" response header.
+// Syntax: SecServerSignature "WAF Server"
+// ---
+// The new value is queued as a response header mutation on every transaction, so it is
+// applied by the connector together with the `setResponseHeader` and `removeHeader` actions.
+// Rules can still override it, as mutations are applied in order.
 func directiveSecServerSignature(options *DirectiveOptions) error {
 	if len(options.Opts) == 0 {
 		return errEmptyOptions
@@ -521,15 +687,325 @@ func directiveSecConnReadStateLimit(options *DirectiveOptions) error {
 	return nil
 }
 
+// Description: Configures the recursion limit of the backtracking regular expression engine.
+// Syntax: SecPcreMatchLimitRecursion [LIMIT]
+// Default: 100000
+// ---
+// The limit bounds the backtracking points a match of `@rx` keeps at once, and so the memory it
+// uses. Matches exceeding it do not match and set `TX:MSC_PCRE_LIMITS_EXCEEDED`. It only
+// applies to rules using the `backtrack` engine, see `SecRegexEngine`, and must precede them.
+//
+// Example:
+// ```
+// SecPcreMatchLimitRecursion 10000
+// ```
 func directiveSecPcreMatchLimitRecursion(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	limit, err := strconv.Atoi(options.Opts)
+	if err != nil || limit <= 0 {
+		return errors.New("syntax error: SecPcreMatchLimitRecursion [LIMIT]")
+	}
+	options.Parser.Regex.MatchLimitRecursion = limit
 	return nil
 }
 
+// Description: Configures the match limit of the backtracking regular expression engine.
+// Syntax: SecPcreMatchLimit [LIMIT]
+// Default: 1000000
+// ---
+// The limit bounds the backtracking steps of a match of `@rx`, and so its time, protecting from
+// patterns backtracking catastrophically. Matches exceeding it do not match and set
+// `TX:MSC_PCRE_LIMITS_EXCEEDED`. It only applies to rules using the `backtrack` engine, see
+// `SecRegexEngine`, and must precede them.
+//
+// Example:
+// ```
+// SecPcreMatchLimit 100000
+// ```
 func directiveSecPcreMatchLimit(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	limit, err := strconv.Atoi(options.Opts)
+	if err != nil || limit <= 0 {
+		return errors.New("syntax error: SecPcreMatchLimit [LIMIT]")
+	}
+	options.Parser.Regex.MatchLimit = limit
 	return nil
 }
 
+// Description: Configures the engine compiling the regular expressions of `@rx`.
+// Syntax: SecRegexEngine re2|backtrack
+// Default: re2
+// ---
+// RE2 matches in linear time but rejects lookarounds and backreferences, which some legacy
+// ModSecurity rules need. The `backtrack` engine supports the PCRE syntax, bounding matches
+// with `SecPcreMatchLimit` and `SecPcreMatchLimitRecursion`. The engine applies to the rules
+// following the directive, so it can be set for the whole WAF or for a group of rules, while
+// the `regexEngine` action selects it for a single rule. Engines registered by plugins can be
+// selected too.
+//
+// Example:
+// ```
+// SecRegexEngine backtrack
+// SecRule ARGS "@rx (['\"]).*?\1" "id:190,phase:2,deny"
+// SecRegexEngine re2
+// ```
+func directiveSecRegexEngine(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	if _, err := regex.Get(options.Opts); err != nil {
+		return err
+	}
+	options.Parser.RegexEngine = strings.ToLower(options.Opts)
+	return nil
+}
+
+// Description: Configures the Project Honeypot http:BL access key used by the `@rbl` operator.
+// Syntax: SecHttpBlKey [KEY]
+// ---
+// The key is required to query dnsbl.httpbl.org and must precede the rules using it. Listed
+// addresses populate TX:HTTPBL_DAYS (days since the last activity), TX:HTTPBL_THREAT_SCORE,
+// TX:HTTPBL_TYPE (the visitor type bitmask, 0 for search engines) and TX:HTTPBL_MSG.
+//
+// Example:
+// ```
+// SecHttpBlKey whdkfieyhtnf
+// SecRule REMOTE_ADDR "@rbl dnsbl.httpbl.org" "id:160,phase:1,chain,deny"
+// SecRule TX:HTTPBL_THREAT_SCORE "@gt 25"
+// ```
 func directiveSecHTTPBlKey(options *DirectiveOptions) error {
+	key := utils.MaybeRemoveQuotes(options.Opts)
+	if len(key) == 0 {
+		return errEmptyOptions
+	}
+	options.Parser.RBL.HTTPBLKey = key
+	return nil
+}
+
+// Description: Configures the timeout of the DNS lookups of the `@rbl` operator.
+// Syntax: SecRblTimeout [MILLISECONDS]
+// Default: 500
+// ---
+// Lookups not answered in time do not match and their result is not cached. The directive
+// must precede the rules using `@rbl`.
+//
+// Example:
+// ```
+// SecRblTimeout 200
+// ```
+func directiveSecRblTimeout(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	ms, err := strconv.Atoi(options.Opts)
+	if err != nil || ms <= 0 {
+		return errors.New("syntax error: SecRblTimeout [MILLISECONDS]")
+	}
+	options.Parser.RBL.Timeout = time.Duration(ms) * time.Millisecond
+	return nil
+}
+
+// Description: Configures the time the results of the `@rbl` operator are cached for.
+// Syntax: SecRblCacheTTL [SECONDS]
+// Default: 300
+// ---
+// Results are cached by each rule, across transactions, both for listed and unlisted
+// addresses. 0 disables the cache. The directive must precede the rules using `@rbl`.
+//
+// Example:
+// ```
+// SecRblCacheTTL 3600
+// ```
+func directiveSecRblCacheTTL(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	sec, err := strconv.Atoi(options.Opts)
+	if err != nil || sec < 0 {
+		return errors.New("syntax error: SecRblCacheTTL [SECONDS]")
+	}
+	if sec == 0 {
+		options.Parser.RBL.CacheTTL = -1
+		return nil
+	}
+	options.Parser.RBL.CacheTTL = time.Duration(sec) * time.Second
+	return nil
+}
+
+// Description: Configures the resolution of REMOTE_HOST with reverse DNS.
+// Syntax: SecHostnameLookups On|Off|Double
+// Default: Off
+// ---
+// With `On`, REMOTE_HOST is set to the name the client address points to. As the owner of an
+// address controls its reverse DNS, the name is not trustworthy unless it resolves back to the
+// address, which `Double` requires before setting REMOTE_HOST. Lookups are performed when the
+// connection is processed, their results are cached for 5 minutes. See `SecHostnameLookupTimeout`
+// to configure their timeout.
+//
+// Example:
+// ```
+// SecHostnameLookups Double
+// SecRule REMOTE_HOST "@endsWith .example.com" "id:170,phase:1,pass,nolog,ctl:ruleEngine=DetectionOnly"
+// ```
+func directiveSecHostnameLookups(options *DirectiveOptions) error {
+	switch strings.ToLower(options.Opts) {
+	case "on":
+		options.WAF.HostnameLookups = rdns.ModeOn
+	case "off":
+		options.WAF.HostnameLookups = rdns.ModeOff
+	case "double":
+		options.WAF.HostnameLookups = rdns.ModeDouble
+	default:
+		return errors.New("syntax error: SecHostnameLookups [On|Off|Double]")
+	}
+	return nil
+}
+
+// Description: Configures the timeout of the reverse DNS lookups of REMOTE_HOST and
+// `@verifyCrawler`.
+// Syntax: SecHostnameLookupTimeout [MILLISECONDS]
+// Default: 1000
+// ---
+// The timeout includes the forward confirmation of the name. Lookups not answered in time leave
+// REMOTE_HOST empty and their result is not cached.
+//
+// Example:
+// ```
+// SecHostnameLookupTimeout 300
+// ```
+func directiveSecHostnameLookupTimeout(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	ms, err := strconv.Atoi(options.Opts)
+	if err != nil || ms <= 0 {
+		return errors.New("syntax error: SecHostnameLookupTimeout [MILLISECONDS]")
+	}
+	options.WAF.HostnameResolver.Timeout = time.Duration(ms) * time.Millisecond
+	return nil
+}
+
+// Description: Configures the timeout of the DNS lookups of `@detectSSRF resolve`.
+// Syntax: SecSSRFResolveTimeout [MILLISECONDS]
+// Default: 500
+// ---
+// The timeout bounds the resolution of all the hosts of a URL, lookups also end with the
+// transaction. Hosts not resolved in time do not match and are not cached.
+//
+// Example:
+// ```
+// SecSSRFResolveTimeout 200
+// ```
+func directiveSecSSRFResolveTimeout(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	ms, err := strconv.Atoi(options.Opts)
+	if err != nil || ms <= 0 {
+		return errors.New("syntax error: SecSSRFResolveTimeout [MILLISECONDS]")
+	}
+	options.WAF.SSRFResolver.Timeout = time.Duration(ms) * time.Millisecond
+	return nil
+}
+
+// Description: Configures the time the hosts resolved by `@detectSSRF resolve` are cached for.
+// Syntax: SecSSRFResolveCacheTTL [SECONDS]
+// Default: 30
+// ---
+// Resolved hosts are cached by the WAF, across rules and transactions. 0 disables the cache.
+//
+// Example:
+// ```
+// SecSSRFResolveCacheTTL 10
+// ```
+func directiveSecSSRFResolveCacheTTL(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	sec, err := strconv.Atoi(options.Opts)
+	if err != nil || sec < 0 {
+		return errors.New("syntax error: SecSSRFResolveCacheTTL [SECONDS]")
+	}
+	options.WAF.SSRFResolver.TTL = time.Duration(sec) * time.Second
+	return nil
+}
+
+// Description: Configures how often the lists loaded by `@ipMatchFromFile` are checked for changes.
+// Syntax: SecDataFileReloadInterval [SECONDS]
+// Default: 0
+// ---
+// Once the interval elapsed, the first evaluation of the operator checks whether the file changed
+// and reloads it in place, so threat intelligence feeds can be updated without reloading the
+// rules. The previous list is kept if the file cannot be read. 0 disables reloading. The directive
+// must precede the rules using the lists.
+//
+// Example:
+// ```
+// SecDataFileReloadInterval 60
+// SecRule REMOTE_ADDR "@ipMatchFromFile drop.txt" "id:180,phase:1,deny"
+// ```
+func directiveSecDataFileReloadInterval(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	sec, err := strconv.Atoi(options.Opts)
+	if err != nil || sec < 0 {
+		return errors.New("syntax error: SecDataFileReloadInterval [SECONDS]")
+	}
+	options.Parser.DataFileReloadInterval = time.Duration(sec) * time.Second
+	return nil
+}
+
+// Description: Configures the timeout of the inspections of the `@inspectFile` operator.
+// Syntax: SecInspectFileTimeout [MILLISECONDS]
+// Default: 10000
+// ---
+// Scripts still running once the timeout expires are killed, and clamd scans are aborted. The
+// time waiting for a slot when `SecInspectFileConcurrency` is reached is included. Inspections
+// that time out do not match. The directive must precede the rules using `@inspectFile`.
+//
+// Example:
+// ```
+// SecInspectFileTimeout 5000
+// ```
+func directiveSecInspectFileTimeout(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	ms, err := strconv.Atoi(options.Opts)
+	if err != nil || ms <= 0 {
+		return errors.New("syntax error: SecInspectFileTimeout [MILLISECONDS]")
+	}
+	options.Parser.InspectFile.Timeout = time.Duration(ms) * time.Millisecond
+	return nil
+}
+
+// Description: Configures the maximum number of concurrent inspections of the `@inspectFile` operator.
+// Syntax: SecInspectFileConcurrency [LIMIT]
+// Default: the number of CPUs
+// ---
+// The limit applies to all the `@inspectFile` rules of the WAF together. Inspections over the
+// limit wait for a slot until `SecInspectFileTimeout` expires, and do not match if none is freed
+// in time.
+//
+// Example:
+// ```
+// SecInspectFileConcurrency 4
+// SecRule FILES_TMPNAMES "@inspectFile clamd:///var/run/clamav/clamd.ctl" "id:190,phase:2,deny,msg:'Virus %{tx.0} found'"
+// ```
+func directiveSecInspectFileConcurrency(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+	n, err := strconv.Atoi(options.Opts)
+	if err != nil || n <= 0 {
+		return errors.New("syntax error: SecInspectFileConcurrency [LIMIT]")
+	}
+	options.WAF.SetInspectFileConcurrency(n)
 	return nil
 }
 
@@ -537,23 +1013,178 @@ func directiveSecGsbLookupDb(options *DirectiveOptions) error {
 	return nil
 }
 
+// hashSigner returns the signer of the hash engine, creating it on first use
+func hashSigner(options *DirectiveOptions) (*hashengine.Signer, error) {
+	if options.WAF.HashSigner == nil {
+		s, err := hashengine.NewSigner()
+		if err != nil {
+			return nil, err
+		}
+		options.WAF.HashSigner = s
+	}
+	return options.WAF.HashSigner, nil
+}
+
+// parseHashMethod parses the target and the argument of the SecHashMethod
+// directives, e.g. HashHref "product_info|product_list"
+func parseHashMethod(opts string) (hashengine.Target, string, error) {
+	name, arg, ok := strings.Cut(strings.TrimSpace(opts), " ")
+	arg = utils.MaybeRemoveQuotes(strings.TrimSpace(arg))
+	if !ok || arg == "" {
+		return 0, "", errors.New("syntax error: SecHashMethod[Rx|Pm] [HashHref|HashFormAction|HashIframeSrc|HashframeSrc|HashLocation] [ARGUMENT]")
+	}
+	t, err := hashengine.ParseTarget(name)
+	return t, arg, err
+}
+
+// Description: Configures the links signed by the hash engine using phrases.
+// Syntax: SecHashMethodPm [TARGET] "[PHRASES]"
+// ---
+// Links of the target containing any of the space separated phrases, case insensitively, are
+// signed. See `SecHashMethodRx` for the targets.
+//
+// Example:
+// ```
+// SecHashMethodPm HashHref "product_info product_list"
+// ```
 func directiveSecHashMethodPm(options *DirectiveOptions) error {
+	t, arg, err := parseHashMethod(options.Opts)
+	if err != nil {
+		return err
+	}
+	s, err := hashSigner(options)
+	if err != nil {
+		return err
+	}
+	s.AddMethod(t, hashengine.NewPhraseMatcher(strings.Fields(arg)))
 	return nil
 }
 
+// Description: Configures the links signed by the hash engine using a regular expression.
+// Syntax: SecHashMethodRx [TARGET] "[REGEX]"
+// ---
+// Links of the target matching the regular expression are signed. The targets are:
+// - HashHref: the href attribute of `a` and `area` elements
+// - HashFormAction: the action attribute of `form` elements
+// - HashIframeSrc: the src attribute of `iframe` elements
+// - HashframeSrc: the src attribute of `frame` elements
+// - HashLocation: the Location response header
+//
+// Links are only signed in HTML responses whose body is accessible (see `SecResponseBodyAccess`
+// and `SecResponseBodyMimeType`), and never when they point to another host.
+//
+// Example:
+// ```
+// SecHashMethodRx HashHref "product_info|product_list"
+// ```
 func directiveSecHashMethodRx(options *DirectiveOptions) error {
+	t, arg, err := parseHashMethod(options.Opts)
+	if err != nil {
+		return err
+	}
+	re, err := memoize.Do(arg, func() (interface{}, error) { return regexp.Compile(arg) })
+	if err != nil {
+		return err
+	}
+	s, err := hashSigner(options)
+	if err != nil {
+		return err
+	}
+	s.AddMethod(t, re.(*regexp.Regexp))
 	return nil
 }
 
+// Description: Configures the name of the parameter carrying the signature of the links.
+// Syntax: SecHashParam [NAME]
+// Default: hmac
+// ---
+// The signature is appended to the query of the signed links, any value of the parameter
+// already present is replaced.
+//
+// Example:
+// ```
+// SecHashParam "hmac"
+// ```
 func directiveSecHashParam(options *DirectiveOptions) error {
+	name := utils.MaybeRemoveQuotes(options.Opts)
+	if len(name) == 0 {
+		return errEmptyOptions
+	}
+	s, err := hashSigner(options)
+	if err != nil {
+		return err
+	}
+	s.SetParam(name)
 	return nil
 }
 
+// Description: Configures the key used to sign the links.
+// Syntax: SecHashKey [rand|TEXT] [KeyOnly|RemoteIP]
+// Default: rand KeyOnly
+// ---
+// `rand` generates a random key when the rules are loaded, hence signed links are only
+// valid for the lifetime of the WAF and not across instances. The signatures can be bound to
+// the client address with `RemoteIP`. `SessionID` is not supported as Coraza does not track
+// sessions.
+//
+// Example:
+// ```
+// SecHashKey "this_is_my_key" KeyOnly
+// ```
 func directiveSecHashKey(options *DirectiveOptions) error {
-	return nil
+	fields := strings.Fields(options.Opts)
+	if len(fields) == 0 || len(fields) > 2 {
+		return errors.New("syntax error: SecHashKey [rand|TEXT] [KeyOnly|RemoteIP]")
+	}
+	mode := hashengine.KeyOnly
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "keyonly":
+		case "remoteip":
+			mode = hashengine.KeyRemoteIP
+		case "sessionid":
+			return errors.New("SecHashKey: SessionID is not supported, use KeyOnly or RemoteIP")
+		default:
+			return fmt.Errorf("SecHashKey: invalid option %q", fields[1])
+		}
+	}
+	s, err := hashSigner(options)
+	if err != nil {
+		return err
+	}
+	return s.SetKey(utils.MaybeRemoveQuotes(fields[0]), mode)
 }
 
+// Description: Configures the hash engine, which signs the links of responses and verifies
+// their signature in subsequent requests.
+// Syntax: SecHashEngine On|Off
+// Default: Off
+// ---
+// Signed links carry an HMAC of their path and query in the parameter configured by
+// `SecHashParam`, which makes parameters tamper-proof and prevents cross-site request forgery
+// as links cannot be forged without the key. The links to sign are configured by
+// `SecHashMethodRx` and `SecHashMethodPm`, and signatures are verified by the `@validateHash`
+// operator. The engine and the enforcement of the signatures can be toggled per transaction
+// with `ctl:hashEngine` and `ctl:hashEnforcement`.
+//
+// Example:
+// ```
+// SecHashEngine On
+// SecHashKey "this_is_my_key" KeyOnly
+// SecHashMethodRx HashHref "product_info|product_list"
+// SecRule REQUEST_URI "@validateHash product_info|product_list" "id:180,phase:1,deny,msg:'Invalid signature'"
+// ```
 func directiveSecHashEngine(options *DirectiveOptions) error {
+	b, err := parseBoolean(options.Opts)
+	if err != nil {
+		return err
+	}
+	if b {
+		if _, err := hashSigner(options); err != nil {
+			return err
+		}
+	}
+	options.WAF.HashEngine = b
 	return nil
 }
 
@@ -812,6 +1443,39 @@ func directiveSecAuditLogParts(options *DirectiveOptions) error {
 	return err
 }
 
+// Description: Masks sensitive values before transactions are written to the audit log.
+// Syntax: SecAuditLogRedact [TARGET] [TARGET...]
+// ---
+// Each target uses the `VARIABLE:key` notation, where key is either a case-insensitive
+// name or a `/regex/` matched against the key. Supported variables are `REQUEST_HEADERS`,
+// `RESPONSE_HEADERS`, `REQUEST_COOKIES` and `ARGS`. The directive can be used multiple
+// times and targets accumulate.
+//
+// Matching values are replaced by asterisks of the same length, as are targeted cookies
+// and arguments in the cookie header, the query string and urlencoded request bodies.
+// Redacted values of at least 4 bytes are also scrubbed from the request URI, the request
+// and response headers and bodies and the matched rule messages, so a password reflected
+// elsewhere does not leak through part B or part H. Shorter values are only masked where
+// they were sent.
+//
+// Example:
+// ```
+// SecAuditLogRedact REQUEST_HEADERS:Authorization REQUEST_COOKIES:session
+// SecAuditLogRedact ARGS:/(?i)^(?:pass|token)/
+// ```
+func directiveSecAuditLogRedact(options *DirectiveOptions) error {
+	if len(options.Opts) == 0 {
+		return errEmptyOptions
+	}
+
+	for _, target := range strings.Fields(utils.MaybeRemoveQuotes(options.Opts)) {
+		if err := options.WAF.AuditLogRedaction.Add(target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Description: Configures the audit logging engine.
 // Syntax: SecAuditEngine RelevantOnly
 // Default: Off
@@ -1220,6 +1884,29 @@ func directiveSecArgumentsLimit(options *DirectiveOptions) error {
 	return nil
 }
 
+// Description: Configures the maximum number of transactions that can be paused at the same
+// time by the `pause` action.
+// Default: 100
+// Syntax: SecPauseConcurrencyLimit [LIMIT]
+// ---
+// Once the limit is reached, further pauses are skipped so tarpitting clients can't exhaust
+// the server resources. A limit of 0 disables the `pause` action.
+// Example:
+// ```apache
+// SecPauseConcurrencyLimit 50
+// ```
+func directiveSecPauseConcurrencyLimit(options *DirectiveOptions) error {
+	limit, err := strconv.Atoi(options.Opts)
+	if err != nil {
+		return err
+	}
+	if limit < 0 {
+		return errors.New("pause concurrency limit should not be negative")
+	}
+	options.WAF.PauseConcurrencyLimit = limit
+	return nil
+}
+
 func parseBoolean(data string) (bool, error) {
 	data = strings.ToLower(data)
 	switch data {
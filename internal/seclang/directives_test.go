@@ -4,13 +4,18 @@
 package seclang
 
 import (
+	"io"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
 	"github.com/corazawaf/coraza/v3/internal/corazawaf"
 	"github.com/corazawaf/coraza/v3/internal/environment"
+	"github.com/corazawaf/coraza/v3/internal/rdns"
 	"github.com/corazawaf/coraza/v3/types"
 )
 
@@ -20,12 +25,6 @@ func Test_NonImplementedDirective(t *testing.T) {
 		`SecConnReadStateLimit 50 "!@ipMatch 127.0.0.1"`,
 		`SecPcreMatchLimit 1500`,
 		`SecPcreMatchLimitRecursion 1500`,
-		`SecHttpBlKey whdkfieyhtnf`,
-		`SecHashMethodRx HashHref "product_info|list_product"`,
-		`SecHashMethodPm HashHref“product_info list_product”`,
-		`SecHashParam "hmac"`,
-		`SecHashKey "this_is_my_key" KeyOnly`,
-		`SecHashEngine On`,
 	}
 	w := corazawaf.NewWAF()
 	p := NewParser(w)
@@ -132,6 +131,337 @@ func TestSecDataset(t *testing.T) {
 var expectErrorOnDirective func(*corazawaf.WAF) bool = nil
 var expectNoErrorOnDirective func(*corazawaf.WAF) bool = func(*corazawaf.WAF) bool { return true }
 
+func TestSecErrorDocument(t *testing.T) {
+	if !environment.HasAccessToFS {
+		t.Skip("no access to FS")
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "403.html"), []byte("<p>%{unique_id}</p>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	conf := filepath.Join(dir, "rules.conf")
+	if err := os.WriteFile(conf, []byte("SecErrorDocument blocked 403.html"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	waf := corazawaf.NewWAF()
+	p := NewParser(waf)
+	if err := p.FromString("SecErrorDocument 403 " + filepath.Join(dir, "403.html")); err != nil {
+		t.Fatal(err)
+	}
+	// relative paths are resolved against the directory of the configuration file
+	if err := p.FromFile(conf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"403", "blocked"} {
+		doc, ok := waf.ErrorDocuments[key]
+		if !ok {
+			t.Fatalf("missing error document %q", key)
+		}
+		if want, have := "text/html; charset=utf-8", doc.ContentType(); want != have {
+			t.Errorf("unexpected content type, want %q, have %q", want, have)
+		}
+	}
+
+	for _, directive := range []string{
+		"SecErrorDocument 403",
+		"SecErrorDocument 403 /non-existing/403.html",
+		"SecErrorDocument 403 " + conf + ".missing",
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
+func TestGeoLookupWithoutSecGeoLookupDb(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	p := NewParser(waf)
+	if err := p.FromString(`SecRule REMOTE_ADDR "@geoLookup" "id:1,phase:1,deny,status:403"`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	tx.ProcessConnection("81.2.69.160", 12345, "127.0.0.1", 80)
+	if it := tx.ProcessRequestHeaders(); it != nil {
+		t.Errorf("unexpected interruption without geolocation database: %v", it)
+	}
+}
+
+func TestSecGeoLookupDb(t *testing.T) {
+	if !environment.HasAccessToFS {
+		t.Skip("no access to FS")
+	}
+
+	waf := corazawaf.NewWAF()
+	p := NewParser(waf)
+	if err := p.FromString(`
+SecGeoLookupDb ../geo/testdata/geo.mmdb
+SecRule REMOTE_ADDR "@geoLookup" "id:1,phase:1,pass,chain"
+SecRule GEO:COUNTRY_CODE "@streq GB" "setvar:tx.country=%{geo.country_code}-%{geo.city}"
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	tx.ProcessConnection("81.2.69.160", 12345, "127.0.0.1", 80)
+	tx.ProcessRequestHeaders()
+	if want, have := "GB-London", tx.Variables().TX().Get("country"); len(have) != 1 || have[0] != want {
+		t.Errorf("unexpected TX:country, want %q, have %v", want, have)
+	}
+
+	for _, directive := range []string{
+		"SecGeoLookupDb",
+		"SecGeoLookupDb /non-existing/geo.mmdb",
+		"SecGeoLookupDb ../../coraza.conf-recommended",
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
+func TestSecOpenAPISpec(t *testing.T) {
+	if !environment.HasAccessToFS {
+		t.Skip("no access to FS")
+	}
+
+	waf := corazawaf.NewWAF()
+	p := NewParser(waf)
+	if err := p.FromString(`SecRule REQUEST_FILENAME "@validateOpenAPI" "id:1,phase:1,pass"`); err == nil {
+		t.Error("expected error for @validateOpenAPI without specification")
+	}
+
+	if err := p.FromString(`
+SecOpenAPISpec ../../testing/testdata/openapi.yaml
+SecRule REQUEST_FILENAME "@validateOpenAPI" "id:1,phase:1,pass,capture,setvar:'tx.violation=%{tx.0}'"
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := waf.NewTransaction()
+	defer tx.Close()
+	tx.ProcessURI("/users/abc", "GET", "HTTP/1.1")
+	tx.ProcessRequestHeaders()
+	if want, have := `path parameter "id": expected integer, got "abc"`, tx.Variables().TX().Get("violation"); len(have) != 1 || have[0] != want {
+		t.Errorf("unexpected TX:violation, want %q, have %v", want, have)
+	}
+	if want, have := "abc", tx.Variables().ArgsPath().Get("id"); len(have) != 1 || have[0] != want {
+		t.Errorf("unexpected ARGS_PATH:id, want %q, have %v", want, have)
+	}
+
+	for _, directive := range []string{
+		"SecOpenAPISpec",
+		"SecOpenAPISpec /non-existing/openapi.yaml",
+		"SecOpenAPISpec ../../coraza.conf-recommended",
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
+func TestSecHashEngine(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	p := NewParser(waf)
+	if err := p.FromString(`
+SecRuleEngine On
+SecResponseBodyAccess On
+SecResponseBodyMimeType text/html
+SecHashEngine On
+SecHashKey "this_is_my_key" KeyOnly
+SecHashParam "sig"
+SecHashMethodRx HashHref "product_info|product_list"
+SecHashMethodPm HashLocation "checkout"
+SecRule REQUEST_URI "@validateHash product_info|product_list|checkout" "id:1,phase:1,deny,status:403"
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := waf.NewTransaction()
+	tx.ProcessURI("/index.php", "GET", "HTTP/1.1")
+	tx.AddRequestHeader("Host", "shop.example.com")
+	if it := tx.ProcessRequestHeaders(); it != nil {
+		t.Fatalf("unexpected interruption of the index: %v", it)
+	}
+	tx.AddResponseHeader("Content-Type", "text/html")
+	tx.AddResponseHeader("Location", "/checkout.php?step=1")
+	tx.ProcessResponseHeaders(200, "HTTP/1.1")
+	if _, _, err := tx.WriteResponseBody([]byte(`<a href="/product_info.php?id=1">One</a><a href="/about.php">About</a>`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.ProcessResponseBody(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := tx.ResponseBodyReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Close()
+
+	link := regexp.MustCompile(`href="(/product_info[^"]+)"`).FindStringSubmatch(string(body))
+	if link == nil || !strings.Contains(link[1], "sig=") || !strings.Contains(string(body), `href="/about.php"`) {
+		t.Fatalf("unexpected signed body %q", body)
+	}
+	var location string
+	for _, m := range tx.ResponseHeaderMutations() {
+		if m.Name == "Location" {
+			location = m.Value
+		}
+	}
+	if !strings.Contains(location, "sig=") {
+		t.Fatalf("unexpected signed location %q", location)
+	}
+
+	tests := map[string]bool{
+		strings.ReplaceAll(link[1], "&amp;", "&"): false,
+		location:                 false,
+		"/product_info.php?id=1": true,
+		strings.Replace(location, "step=1", "step=3", 1): true,
+		"/about.php": false,
+	}
+	for uri, interrupted := range tests {
+		tx := waf.NewTransaction()
+		tx.ProcessURI(uri, "GET", "HTTP/1.1")
+		if it := tx.ProcessRequestHeaders(); (it != nil) != interrupted {
+			t.Errorf("unexpected interruption of %q: %v", uri, it)
+		}
+		tx.Close()
+	}
+
+	for _, directive := range []string{
+		"SecHashEngine",
+		"SecHashKey",
+		`SecHashKey "key" SessionID`,
+		"SecHashParam",
+		"SecHashMethodRx HashHref",
+		`SecHashMethodRx HashScript "product"`,
+		`SecHashMethodRx HashHref "("`,
+		`SecHashMethodPm HashHref“product_info list_product”`,
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
+func TestSecRblDirectives(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	p := NewParser(waf)
+	if err := p.FromString(`SecRule REMOTE_ADDR "@rbl dnsbl.httpbl.org" "id:1,phase:1,pass"`); err == nil {
+		t.Error("expected error for @rbl dnsbl.httpbl.org without SecHttpBlKey")
+	}
+	if err := p.FromString(`
+SecHttpBlKey whdkfieyhtnf
+SecRblTimeout 200
+SecRblCacheTTL 0
+SecRule REMOTE_ADDR "@rbl dnsbl.httpbl.org" "id:1,phase:1,pass"
+`); err != nil {
+		t.Fatal(err)
+	}
+	want := plugintypes.RBLOptions{HTTPBLKey: "whdkfieyhtnf", Timeout: 200 * time.Millisecond, CacheTTL: -1}
+	if have := p.options.Parser.RBL; have != want {
+		t.Errorf("unexpected RBL options %+v, want %+v", have, want)
+	}
+
+	for _, directive := range []string{
+		"SecHttpBlKey",
+		"SecRblTimeout",
+		"SecRblTimeout 0",
+		"SecRblTimeout 1s",
+		"SecRblCacheTTL",
+		"SecRblCacheTTL -1",
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
+func TestSecDataFileReloadInterval(t *testing.T) {
+	p := NewParser(corazawaf.NewWAF())
+	if err := p.FromString("SecDataFileReloadInterval 60"); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := time.Minute, p.options.Parser.DataFileReloadInterval; want != have {
+		t.Errorf("unexpected interval %s, want %s", have, want)
+	}
+	for _, directive := range []string{
+		"SecDataFileReloadInterval",
+		"SecDataFileReloadInterval -1",
+		"SecDataFileReloadInterval 1m",
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
+func TestSecInspectFileDirectives(t *testing.T) {
+	p := NewParser(corazawaf.NewWAF())
+	if err := p.FromString("SecInspectFileTimeout 2500\nSecInspectFileConcurrency 4"); err != nil {
+		t.Fatal(err)
+	}
+	want := plugintypes.InspectFileOptions{Timeout: 2500 * time.Millisecond}
+	if have := p.options.Parser.InspectFile; have != want {
+		t.Errorf("unexpected options %+v, want %+v", have, want)
+	}
+	if want, have := 4, p.options.WAF.InspectFileConcurrency(); want != have {
+		t.Errorf("unexpected concurrency %d, want %d", have, want)
+	}
+	for _, directive := range []string{
+		"SecInspectFileTimeout",
+		"SecInspectFileTimeout 0",
+		"SecInspectFileTimeout 10s",
+		"SecInspectFileConcurrency",
+		"SecInspectFileConcurrency 0",
+		"SecInspectFileConcurrency -1",
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
+func TestSecRegexEngineDirectives(t *testing.T) {
+	p := NewParser(corazawaf.NewWAF())
+	if err := p.FromString("SecRegexEngine Backtrack\nSecPcreMatchLimit 5000\nSecPcreMatchLimitRecursion 500"); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "backtrack", p.options.Parser.RegexEngine; want != have {
+		t.Errorf("unexpected engine %q, want %q", have, want)
+	}
+	want := plugintypes.RegexOptions{MatchLimit: 5000, MatchLimitRecursion: 500}
+	if have := p.options.Parser.Regex; have != want {
+		t.Errorf("unexpected options %+v, want %+v", have, want)
+	}
+	if err := p.FromString(`SecRule ARGS "@rx (?<=\$)\d+" "id:1,phase:2,pass"`); err != nil {
+		t.Errorf("unexpected error with the backtrack engine: %s", err)
+	}
+	if err := p.FromString("SecRegexEngine re2\n" + `SecRule ARGS "@rx (?<=\$)\d+" "id:2,phase:2,pass"`); err == nil {
+		t.Error("expected RE2 to reject lookbehinds")
+	}
+	for _, directive := range []string{
+		"SecRegexEngine",
+		"SecRegexEngine pcre2",
+		"SecPcreMatchLimit",
+		"SecPcreMatchLimit 0",
+		"SecPcreMatchLimitRecursion -1",
+	} {
+		if err := p.FromString(directive); err == nil {
+			t.Errorf("expected error for %q", directive)
+		}
+	}
+}
+
 func TestDirectives(t *testing.T) {
 	type directiveCase struct {
 		opts  string
@@ -151,6 +481,31 @@ func TestDirectives(t *testing.T) {
 			{"", expectErrorOnDirective},
 			{"test123", func(w *corazawaf.WAF) bool { return w.WebAppID == "test123" }},
 		},
+		"SecHostnameLookups": {
+			{"", expectErrorOnDirective},
+			{"Twice", expectErrorOnDirective},
+			{"On", func(w *corazawaf.WAF) bool { return w.HostnameLookups == rdns.ModeOn }},
+			{"double", func(w *corazawaf.WAF) bool { return w.HostnameLookups == rdns.ModeDouble }},
+			{"Off", func(w *corazawaf.WAF) bool { return w.HostnameLookups == rdns.ModeOff }},
+		},
+		"SecHostnameLookupTimeout": {
+			{"", expectErrorOnDirective},
+			{"0", expectErrorOnDirective},
+			{"1s", expectErrorOnDirective},
+			{"300", func(w *corazawaf.WAF) bool { return w.HostnameResolver.Timeout == 300*time.Millisecond }},
+		},
+		"SecSSRFResolveTimeout": {
+			{"", expectErrorOnDirective},
+			{"0", expectErrorOnDirective},
+			{"1s", expectErrorOnDirective},
+			{"200", func(w *corazawaf.WAF) bool { return w.SSRFResolver.Timeout == 200*time.Millisecond }},
+		},
+		"SecSSRFResolveCacheTTL": {
+			{"", expectErrorOnDirective},
+			{"-1", expectErrorOnDirective},
+			{"0", func(w *corazawaf.WAF) bool { return w.SSRFResolver.TTL == 0 }},
+			{"10", func(w *corazawaf.WAF) bool { return w.SSRFResolver.TTL == 10*time.Second }},
+		},
 		"SecUploadKeepFiles": {
 			{"", expectErrorOnDirective},
 			{"Ox", expectErrorOnDirective},
@@ -303,6 +658,19 @@ func TestDirectives(t *testing.T) {
 		"SecAuditLog": {
 			{"", expectErrorOnDirective},
 		},
+		"SecAuditLogRedact": {
+			{"", expectErrorOnDirective},
+			{"REQUEST_HEADERS", expectErrorOnDirective},
+			{"TX:secret", expectErrorOnDirective},
+			{"ARGS:/(/", expectErrorOnDirective},
+			{"REQUEST_HEADERS:Authorization ARGS:/^pass/", func(w *corazawaf.WAF) bool { return !w.AuditLogRedaction.IsEmpty() }},
+		},
+		"SecPauseConcurrencyLimit": {
+			{"", expectErrorOnDirective},
+			{"-1", expectErrorOnDirective},
+			{"0", func(waf *corazawaf.WAF) bool { return waf.PauseConcurrencyLimit == 0 }},
+			{"50", func(waf *corazawaf.WAF) bool { return waf.PauseConcurrencyLimit == 50 }},
+		},
 		"SecArgumentsLimit": {
 			{"", expectErrorOnDirective},
 			{"0", expectErrorOnDirective},
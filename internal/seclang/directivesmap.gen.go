@@ -15,6 +15,9 @@ var (
 	_ directive = directiveSecRequestBodyAccess
 	_ directive = directiveSecRuleEngine
 	_ directive = directiveSecWebAppID
+	_ directive = directiveSecErrorDocument
+	_ directive = directiveSecGeoLookupDb
+	_ directive = directiveSecOpenAPISpec
 	_ directive = directiveSecServerSignature
 	_ directive = directiveSecRuleRemoveByTag
 	_ directive = directiveSecRuleRemoveByMsg
@@ -32,7 +35,17 @@ var (
 	_ directive = directiveSecConnReadStateLimit
 	_ directive = directiveSecPcreMatchLimitRecursion
 	_ directive = directiveSecPcreMatchLimit
+	_ directive = directiveSecRegexEngine
 	_ directive = directiveSecHTTPBlKey
+	_ directive = directiveSecRblTimeout
+	_ directive = directiveSecRblCacheTTL
+	_ directive = directiveSecHostnameLookups
+	_ directive = directiveSecHostnameLookupTimeout
+	_ directive = directiveSecSSRFResolveTimeout
+	_ directive = directiveSecSSRFResolveCacheTTL
+	_ directive = directiveSecDataFileReloadInterval
+	_ directive = directiveSecInspectFileTimeout
+	_ directive = directiveSecInspectFileConcurrency
 	_ directive = directiveSecGsbLookupDb
 	_ directive = directiveSecHashMethodPm
 	_ directive = directiveSecHashMethodRx
@@ -50,6 +63,7 @@ var (
 	_ directive = directiveSecAuditLogFileMode
 	_ directive = directiveSecAuditLogRelevantStatus
 	_ directive = directiveSecAuditLogParts
+	_ directive = directiveSecAuditLogRedact
 	_ directive = directiveSecAuditEngine
 	_ directive = directiveSecDataDir
 	_ directive = directiveSecUploadKeepFiles
@@ -65,6 +79,7 @@ var (
 	_ directive = directiveSecIgnoreRuleCompilationErrors
 	_ directive = directiveSecDataset
 	_ directive = directiveSecArgumentsLimit
+	_ directive = directiveSecPauseConcurrencyLimit
 )
 
 var directivesMap = map[string]directive{
@@ -77,6 +92,9 @@ var directivesMap = map[string]directive{
 	"secrequestbodyaccess":           directiveSecRequestBodyAccess,
 	"secruleengine":                  directiveSecRuleEngine,
 	"secwebappid":                    directiveSecWebAppID,
+	"secerrordocument":               directiveSecErrorDocument,
+	"secgeolookupdb":                 directiveSecGeoLookupDb,
+	"secopenapispec":                 directiveSecOpenAPISpec,
 	"secserversignature":             directiveSecServerSignature,
 	"secruleremovebytag":             directiveSecRuleRemoveByTag,
 	"secruleremovebymsg":             directiveSecRuleRemoveByMsg,
@@ -94,7 +112,17 @@ var directivesMap = map[string]directive{
 	"secconnreadstatelimit":          directiveSecConnReadStateLimit,
 	"secpcrematchlimitrecursion":     directiveSecPcreMatchLimitRecursion,
 	"secpcrematchlimit":              directiveSecPcreMatchLimit,
+	"secregexengine":                 directiveSecRegexEngine,
 	"sechttpblkey":                   directiveSecHTTPBlKey,
+	"secrbltimeout":                  directiveSecRblTimeout,
+	"secrblcachettl":                 directiveSecRblCacheTTL,
+	"sechostnamelookups":             directiveSecHostnameLookups,
+	"sechostnamelookuptimeout":       directiveSecHostnameLookupTimeout,
+	"secssrfresolvetimeout":          directiveSecSSRFResolveTimeout,
+	"secssrfresolvecachettl":         directiveSecSSRFResolveCacheTTL,
+	"secdatafilereloadinterval":      directiveSecDataFileReloadInterval,
+	"secinspectfiletimeout":          directiveSecInspectFileTimeout,
+	"secinspectfileconcurrency":      directiveSecInspectFileConcurrency,
 	"secgsblookupdb":                 directiveSecGsbLookupDb,
 	"sechashmethodpm":                directiveSecHashMethodPm,
 	"sechashmethodrx":                directiveSecHashMethodRx,
@@ -112,6 +140,7 @@ var directivesMap = map[string]directive{
 	"secauditlogfilemode":            directiveSecAuditLogFileMode,
 	"secauditlogrelevantstatus":      directiveSecAuditLogRelevantStatus,
 	"secauditlogparts":               directiveSecAuditLogParts,
+	"secauditlogredact":              directiveSecAuditLogRedact,
 	"secauditengine":                 directiveSecAuditEngine,
 	"secdatadir":                     directiveSecDataDir,
 	"secuploadkeepfiles":             directiveSecUploadKeepFiles,
@@ -127,6 +156,7 @@ var directivesMap = map[string]directive{
 	"secignorerulecompilationerrors": directiveSecIgnoreRuleCompilationErrors,
 	"secdataset":                     directiveSecDataset,
 	"secargumentslimit":              directiveSecArgumentsLimit,
+	"secpauseconcurrencylimit":       directiveSecPauseConcurrencyLimit,
 
 	// Unsupported directives
 	"secargumentseparator":     directiveUnsupported,
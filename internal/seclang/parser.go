@@ -11,10 +11,14 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
 	"github.com/corazawaf/coraza/v3/internal/corazawaf"
 	"github.com/corazawaf/coraza/v3/internal/environment"
+	"github.com/corazawaf/coraza/v3/internal/geo"
 	"github.com/corazawaf/coraza/v3/internal/io"
+	"github.com/corazawaf/coraza/v3/internal/openapi"
 )
 
 // maxIncludeRecursion is used to avoid DDOS by including files that include
@@ -238,4 +242,11 @@ type ParserConfig struct {
 	ConfigDir                   string
 	Root                        fs.FS
 	WorkingDir                  string
+	GeoLookupDB                 *geo.Database
+	OpenAPISpec                 *openapi.Spec
+	RBL                         plugintypes.RBLOptions
+	DataFileReloadInterval      time.Duration
+	InspectFile                 plugintypes.InspectFileOptions
+	RegexEngine                 string
+	Regex                       plugintypes.RegexOptions
 }
@@ -31,6 +31,8 @@ type RuleParser struct {
 	rule           *corazawaf.Rule
 	defaultActions map[types.RulePhase][]ruleAction
 	options        RuleOptions
+	// regexEngine is the engine selected by the regexEngine action
+	regexEngine string
 }
 
 // ParseVariables parses variables from a string and transforms it into
@@ -191,14 +193,31 @@ func (rp *RuleParser) ParseOperator(operator string) error {
 		Path: []string{
 			rp.options.ParserConfig.ConfigDir,
 		},
-		Root:     rp.options.ParserConfig.Root,
-		Datasets: rp.options.Datasets,
+		Root:           rp.options.ParserConfig.Root,
+		Datasets:       rp.options.Datasets,
+		RBL:            rp.options.ParserConfig.RBL,
+		ReloadInterval: rp.options.ParserConfig.DataFileReloadInterval,
+		InspectFile:    rp.options.ParserConfig.InspectFile,
+		RegexEngine:    rp.options.ParserConfig.RegexEngine,
+		Regex:          rp.options.ParserConfig.Regex,
+	}
+
+	if rp.regexEngine != "" {
+		opts.RegexEngine = rp.regexEngine
 	}
 
 	if wd := rp.options.ParserConfig.WorkingDir; wd != "" {
 		opts.Path = append(opts.Path, wd)
 	}
 
+	if db := rp.options.ParserConfig.GeoLookupDB; db != nil {
+		opts.GeoLookup = db
+	}
+
+	if spec := rp.options.ParserConfig.OpenAPISpec; spec != nil {
+		opts.OpenAPI = spec
+	}
+
 	opfn, err := operators.Get(op, opts)
 	if err != nil {
 		return err
@@ -365,6 +384,10 @@ func ParseRule(options RuleOptions) (*corazawaf.Rule, error) {
 		if err := rp.ParseVariables(vars); err != nil {
 			return nil, err
 		}
+		// the operator is compiled with the regex engine selected by the actions
+		if rp.regexEngine, err = regexEngineAction(acts); err != nil {
+			return nil, err
+		}
 		if err := rp.ParseOperator(operator); err != nil {
 			return nil, err
 		}
@@ -546,6 +569,25 @@ func parseActions(actions string) ([]ruleAction, error) {
 	return res, nil
 }
 
+// regexEngineAction returns the engine selected by the regexEngine action
+// of a list of actions, empty if there is none
+func regexEngineAction(actions string) (string, error) {
+	if !strings.Contains(strings.ToLower(actions), "regexengine") {
+		return "", nil
+	}
+	act, err := parseActions(actions)
+	if err != nil {
+		return "", err
+	}
+	engine := ""
+	for _, a := range act {
+		if a.Key == "regexengine" {
+			engine = a.Value
+		}
+	}
+	return engine, nil
+}
+
 func appendRuleAction(res []ruleAction, key string, val string, disruptiveActionIndex int) ([]ruleAction, int, error) {
 	key = strings.ToLower(strings.TrimSpace(key))
 	val = strings.TrimSpace(val) // We may want to keep case sensitive values (e.g. Messages)
@@ -309,3 +309,41 @@ func BenchmarkParseActions(b *testing.B) {
 		_, _ = parseActions(actionsToBeParsed)
 	}
 }
+
+func TestRegexEngineAction(t *testing.T) {
+	waf := corazawaf.NewWAF()
+	p := NewParser(waf)
+	if err := p.FromString(`SecRule ARGS "@rx (['\"]).*?\1" "id:1,phase:2,pass,regexEngine:backtrack"`); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.FromString(`SecRule ARGS "@rx (['\"]).*?\1" "id:2,phase:2,pass"`); err == nil {
+		t.Error("expected RE2 to reject backreferences")
+	}
+	if err := p.FromString(`SecRule ARGS "@rx a" "id:3,phase:2,pass,regexEngine:unknown"`); err == nil {
+		t.Error("expected an error for an unknown engine")
+	}
+
+	tx := waf.NewTransaction()
+	tx.AddGetRequestArgument("q", `say "hi"`)
+	tx.ProcessRequestHeaders()
+	if _, err := tx.ProcessRequestBody(); err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.MatchedRules()) != 1 {
+		t.Errorf("expected rule 1 to match, matched %d rules", len(tx.MatchedRules()))
+	}
+}
+
+func TestProxyActionPhase(t *testing.T) {
+	for rule, wantErr := range map[string]bool{
+		`SecRule REQUEST_URI "@beginsWith /admin" "id:1,phase:1,proxy:http://honeypot:8080"`: false,
+		`SecRule ARGS "@contains x" "id:2,proxy:http://honeypot:8080"`:                       false,
+		`SecRule RESPONSE_STATUS "@streq 200" "id:3,proxy:http://honeypot:8080,phase:3"`:     true,
+		`SecRule RESPONSE_BODY "@contains x" "id:4,phase:4,proxy:http://honeypot:8080"`:      true,
+	} {
+		p := NewParser(corazawaf.NewWAF())
+		if err := p.FromString(rule); (err != nil) != wantErr {
+			t.Errorf("unexpected error for %q: %v", rule, err)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssdeep
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Digest is a parsed ssdeep digest
+type Digest struct {
+	BlockSize int
+	// Digest1 is the digest for the block size and Digest2 the one for
+	// the double of the block size.
+	Digest1, Digest2 string
+}
+
+// Parse parses a blocksize:digest:digest formatted ssdeep digest. Any suffix
+// after a comma (like the file name in the ssdeep output) is ignored.
+func Parse(s string) (Digest, error) {
+	s, _, _ = strings.Cut(s, ",")
+	bs, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return Digest{}, errors.New("invalid ssdeep digest: missing block size")
+	}
+	d1, d2, ok := strings.Cut(rest, ":")
+	if !ok || strings.Contains(d2, ":") {
+		return Digest{}, errors.New("invalid ssdeep digest: expected blocksize:digest:digest")
+	}
+
+	n, err := strconv.Atoi(bs)
+	if err != nil || n < minBlockSize || n%minBlockSize != 0 || (n/minBlockSize)&(n/minBlockSize-1) != 0 {
+		return Digest{}, errors.New("invalid ssdeep digest: invalid block size")
+	}
+	if len(d1) > spamsumLength || len(d2) > spamsumLength {
+		return Digest{}, errors.New("invalid ssdeep digest: digest too long")
+	}
+	for _, d := range []string{d1, d2} {
+		for i := 0; i < len(d); i++ {
+			if strings.IndexByte(b64, d[i]) == -1 {
+				return Digest{}, errors.New("invalid ssdeep digest: invalid character")
+			}
+		}
+	}
+
+	return Digest{BlockSize: n, Digest1: d1, Digest2: d2}, nil
+}
+
+// String returns the digest formatted as blocksize:digest:digest
+func (d Digest) String() string {
+	return strconv.Itoa(d.BlockSize) + ":" + d.Digest1 + ":" + d.Digest2
+}
+
+// Compare returns the similarity of two digests between 0 (no similarity)
+// and 100 (identical), matching the scores of ssdeep.
+func Compare(a, b Digest) int {
+	bs1, bs2 := a.BlockSize, b.BlockSize
+	if bs1 != bs2 && bs1 != bs2*2 && bs2 != bs1*2 {
+		return 0
+	}
+
+	// sequences of identical characters carry little information and are
+	// reduced to three characters before comparing
+	a1, a2 := eliminateSequences(a.Digest1), eliminateSequences(a.Digest2)
+	b1, b2 := eliminateSequences(b.Digest1), eliminateSequences(b.Digest2)
+
+	if bs1 == bs2 && a1 == b1 {
+		return 100
+	}
+
+	switch {
+	case bs1 == bs2:
+		s1 := scoreStrings(a1, b1, bs1)
+		s2 := scoreStrings(a2, b2, bs1*2)
+		if s1 > s2 {
+			return s1
+		}
+		return s2
+	case bs1 == bs2*2:
+		return scoreStrings(a1, b2, bs1)
+	default:
+		return scoreStrings(a2, b1, bs2)
+	}
+}
+
+func eliminateSequences(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		if i >= 3 && s[i] == s[i-1] && s[i] == s[i-2] && s[i] == s[i-3] {
+			if b == nil {
+				b = append(make([]byte, 0, len(s)), s[:i]...)
+			}
+			continue
+		}
+		if b != nil {
+			b = append(b, s[i])
+		}
+	}
+	if b == nil {
+		return s
+	}
+	return string(b)
+}
+
+func scoreStrings(s1, s2 string, blockSize int) int {
+	if len(s1) > spamsumLength || len(s2) > spamsumLength {
+		return 0
+	}
+	if !hasCommonSubstring(s1, s2) {
+		return 0
+	}
+
+	score := editDistance(s1, s2)
+	score = score * spamsumLength / (len(s1) + len(s2))
+	score = 100 * score / spamsumLength
+	if score >= 100 {
+		return 0
+	}
+	score = 100 - score
+
+	// small block sizes are capped so small inputs do not exaggerate the similarity
+	if blockSize >= (99+rollingWindow)/rollingWindow*minBlockSize {
+		return score
+	}
+	if limit := blockSize / minBlockSize * min(len(s1), len(s2)); score > limit {
+		return limit
+	}
+	return score
+}
+
+// hasCommonSubstring returns true if the strings share a substring of the
+// length of the rolling window.
+func hasCommonSubstring(s1, s2 string) bool {
+	for i := 0; i+rollingWindow <= len(s1); i++ {
+		if strings.Contains(s2, s1[i:i+rollingWindow]) {
+			return true
+		}
+	}
+	return false
+}
+
+// editDistance returns the weighted Levenshtein distance used by ssdeep, where
+// insertions and deletions cost 1 and substitutions cost 2.
+func editDistance(s1, s2 string) int {
+	prev := make([]int, len(s2)+1)
+	cur := make([]int, len(s2)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s1); i++ {
+		cur[0] = i
+		for j := 1; j <= len(s2); j++ {
+			cost := 2
+			if s1[i-1] == s2[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(s2)]
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssdeep implements the context triggered piecewise hashes (CTPH) of
+// ssdeep, compatible with the digests and the similarity scores of ssdeep 2.x.
+// See https://ssdeep-project.github.io/ssdeep/
+package ssdeep
+
+const (
+	rollingWindow  = 7
+	minBlockSize   = 3
+	hashInit       = 0x27
+	spamsumLength  = 64
+	numBlockHashes = 31
+)
+
+const b64 = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// rollingHash is the Adler-32 inspired rolling hash that triggers the
+// boundaries of the pieces.
+type rollingHash struct {
+	window     [rollingWindow]byte
+	h1, h2, h3 uint32
+	n          uint32
+}
+
+func (r *rollingHash) roll(c byte) uint32 {
+	r.h2 -= r.h1
+	r.h2 += rollingWindow * uint32(c)
+	r.h1 += uint32(c)
+	r.h1 -= uint32(r.window[r.n%rollingWindow])
+	r.window[r.n%rollingWindow] = c
+	r.n++
+	r.h3 <<= 5
+	r.h3 ^= uint32(c)
+	return r.h1 + r.h2 + r.h3
+}
+
+// sumHash is the FNV hash of the pieces, only the 6 bits used by the
+// digest characters are kept.
+func sumHash(c byte, h byte) byte {
+	return byte((uint32(h)*0x01000193)^uint32(c)) & 0x3f
+}
+
+// blockHash holds the digest for a block size
+type blockHash struct {
+	h, halfh byte
+	digest   [spamsumLength]byte
+	// dlen is the length of the digest, digest[dlen] is only set once the
+	// digest is full.
+	dlen       int
+	halfdigest byte
+}
+
+func blockSize(i int) int {
+	return minBlockSize << i
+}
+
+// Hash returns the ssdeep digest of b
+func Hash(b []byte) Digest {
+	var (
+		roll  rollingHash
+		bh    [numBlockHashes]blockHash
+		bhEnd = 1
+		h     uint32
+	)
+	for i := range bh {
+		bh[i].h = hashInit
+		bh[i].halfh = hashInit
+	}
+
+	for _, c := range b {
+		h = roll.roll(c)
+		for i := 0; i < bhEnd; i++ {
+			bh[i].h = sumHash(c, bh[i].h)
+			bh[i].halfh = sumHash(c, bh[i].halfh)
+		}
+
+		for i := 0; i < bhEnd; i++ {
+			bs := uint32(blockSize(i))
+			if h%bs != bs-1 {
+				// larger block sizes cannot trigger either
+				break
+			}
+			if bh[i].dlen == 0 && bhEnd < numBlockHashes {
+				// the next block size starts with the state of this one
+				bh[bhEnd].h = bh[bhEnd-1].h
+				bh[bhEnd].halfh = bh[bhEnd-1].halfh
+				bhEnd++
+			}
+
+			bh[i].digest[bh[i].dlen] = b64[bh[i].h]
+			bh[i].halfdigest = b64[bh[i].halfh]
+			if bh[i].dlen < spamsumLength-1 {
+				bh[i].dlen++
+				bh[i].h = hashInit
+				if bh[i].dlen < spamsumLength/2 {
+					bh[i].halfh = hashInit
+					bh[i].halfdigest = 0
+				}
+			}
+		}
+	}
+
+	// the block size is the smallest one expected to produce a full digest,
+	// reduced while the digest is too short.
+	bi := 0
+	for bi < bhEnd-1 && blockSize(bi)*spamsumLength < len(b) {
+		bi++
+	}
+	for bi > 0 && bh[bi].dlen < spamsumLength/2 {
+		bi--
+	}
+
+	d := Digest{BlockSize: blockSize(bi)}
+	out := make([]byte, 0, spamsumLength)
+	out = append(out, bh[bi].digest[:bh[bi].dlen]...)
+	if h != 0 {
+		out = append(out, b64[bh[bi].h])
+	} else if c := bh[bi].digest[bh[bi].dlen]; c != 0 {
+		// the digest is full and its last character was already computed
+		out = append(out, c)
+	}
+	d.Digest1 = string(out)
+	out = out[:0]
+
+	if bi < bhEnd-1 {
+		bi++
+		n := bh[bi].dlen
+		if n > spamsumLength/2-1 {
+			n = spamsumLength/2 - 1
+		}
+		out = append(out, bh[bi].digest[:n]...)
+		if h != 0 {
+			out = append(out, b64[bh[bi].halfh])
+		} else if bh[bi].halfdigest != 0 {
+			out = append(out, bh[bi].halfdigest)
+		}
+	} else if h != 0 {
+		out = append(out, b64[bh[bi].h])
+	}
+	d.Digest2 = string(out)
+
+	return d
+}
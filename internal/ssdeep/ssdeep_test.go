@@ -0,0 +1,145 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssdeep
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randomBytes(seed int64, n int) []byte {
+	b := make([]byte, n)
+	rand.New(rand.NewSource(seed)).Read(b)
+	return b
+}
+
+func TestHash(t *testing.T) {
+	tests := map[string]string{
+		"":            "3::",
+		"a":           "3:E:E",
+		"hello world": "3:iKFSMPn:rJPn",
+	}
+	for in, want := range tests {
+		if have := Hash([]byte(in)).String(); want != have {
+			t.Errorf("unexpected hash for %q, want %q, have %q", in, want, have)
+		}
+	}
+
+	// the block size grows with the input so digests keep a similar length
+	d := Hash(randomBytes(1, 100000))
+	if want, have := 1536, d.BlockSize; want != have {
+		t.Errorf("unexpected block size, want %d, have %d", want, have)
+	}
+	if len(d.Digest1) < spamsumLength/2 || len(d.Digest1) > spamsumLength {
+		t.Errorf("unexpected digest length %d", len(d.Digest1))
+	}
+	if len(d.Digest2) > spamsumLength/2 {
+		t.Errorf("unexpected second digest length %d", len(d.Digest2))
+	}
+}
+
+func TestCompare(t *testing.T) {
+	original := randomBytes(1, 20000)
+
+	modified := append([]byte{}, original...)
+	copy(modified[5000:], "a small change in the middle of the file")
+
+	appended := append(append([]byte{}, original...), randomBytes(2, 2000)...)
+
+	tests := map[string]struct {
+		data     []byte
+		minScore int
+		maxScore int
+	}{
+		"identical":  {data: original, minScore: 100, maxScore: 100},
+		"modified":   {data: modified, minScore: 90, maxScore: 99},
+		"appended":   {data: appended, minScore: 70, maxScore: 99},
+		"unrelated":  {data: randomBytes(3, 20000), minScore: 0, maxScore: 0},
+		"block size": {data: original[:1000], minScore: 0, maxScore: 0},
+	}
+
+	h := Hash(original)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			score := Compare(h, Hash(tc.data))
+			if score < tc.minScore || score > tc.maxScore {
+				t.Errorf("unexpected score %d, want between %d and %d", score, tc.minScore, tc.maxScore)
+			}
+			if reverse := Compare(Hash(tc.data), h); reverse != score {
+				t.Errorf("asymmetric score, %d and %d", score, reverse)
+			}
+		})
+	}
+}
+
+func TestCompareSmallBlockSize(t *testing.T) {
+	// scores of small inputs are capped by the digest length
+	a, _ := Parse("3:ABCDEFGHIJ:ABCDE")
+	b, _ := Parse("3:ABCDEFGHIK:ABCDE")
+	if want, have := 10, Compare(a, b); want != have {
+		t.Errorf("unexpected score, want %d, have %d", want, have)
+	}
+}
+
+func TestParse(t *testing.T) {
+	d, err := Parse(`96:s4Ud1Lj96tHHlZDrwciQmA+4uy1I0G4HYuL8N3TzS8QsO/wqWXLcMSx:sF1LjEtHHlZDrw/Zx1ELgjzS8QsO/S,"/tmp/x.exe"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 96, d.BlockSize; want != have {
+		t.Errorf("unexpected block size, want %d, have %d", want, have)
+	}
+	if want, have := "sF1LjEtHHlZDrw/Zx1ELgjzS8QsO/S", d.Digest2; want != have {
+		t.Errorf("unexpected digest, want %q, have %q", want, have)
+	}
+
+	for _, in := range []string{
+		"",
+		"96",
+		"96:abc",
+		"96:abc:def:ghi",
+		"abc:def:ghi",
+		"0:abc:def",
+		"5:abc:def",
+		"9:abc:def",
+		"3:ab$:def",
+		"3:" + string(make([]byte, 65)) + ":",
+	} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
+
+func TestEliminateSequences(t *testing.T) {
+	tests := map[string]string{
+		"":           "",
+		"AAA":        "AAA",
+		"AAAAAAB":    "AAAB",
+		"ABBBBCCCCC": "ABBBCCC",
+	}
+	for in, want := range tests {
+		if have := eliminateSequences(in); want != have {
+			t.Errorf("unexpected result for %q, want %q, have %q", in, want, have)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		s1, s2 string
+		want   int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"abc", "abd", 2},
+		{"abc", "abcd", 1},
+		{"kitten", "sitting", 5},
+	}
+	for _, tc := range tests {
+		if have := editDistance(tc.s1, tc.s2); tc.want != have {
+			t.Errorf("unexpected distance for %q and %q, want %d, have %d", tc.s1, tc.s2, tc.want, have)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo
+// +build !tinygo
+
+package ssrf
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+)
+
+// NewNetResolver returns a Resolver looking up hosts with resolver instead
+// of net.DefaultResolver
+func NewNetResolver(resolver *net.Resolver) *Resolver {
+	r := NewResolver()
+	r.lookup = func(ctx context.Context, host string) ([]netip.Addr, error) {
+		return netLookupHost(ctx, resolver, host)
+	}
+	return r
+}
+
+// lookupHost resolves host with net.DefaultResolver
+func lookupHost(ctx context.Context, host string) ([]netip.Addr, error) {
+	return netLookupHost(ctx, net.DefaultResolver, host)
+}
+
+// netLookupHost resolves host with resolver, missing names have no addresses
+func netLookupHost(ctx context.Context, resolver *net.Resolver, host string) ([]netip.Addr, error) {
+	addrs, err := resolver.LookupNetIP(ctx, "ip", host)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return nil, nil
+	}
+	return addrs, err
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !tinygo
+// +build !tinygo
+
+package ssrf
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/foxcpp/go-mockdns"
+)
+
+func TestResolve(t *testing.T) {
+	srv, err := mockdns.NewServer(map[string]mockdns.Zone{
+		"internal.example.com.": {
+			A: []string{"10.0.0.5"},
+		},
+		"rebind.example.com.": {
+			A:    []string{"203.0.113.1"},
+			AAAA: []string{"::1"},
+		},
+		"public.example.com.": {
+			A: []string{"203.0.113.1"},
+		},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	resolver := &net.Resolver{}
+	srv.PatchNet(resolver)
+
+	tests := map[string]string{
+		"http://internal.example.com/":                    RangePrivate,
+		"https://rebind.example.com:8443/x":               RangeLoopback,
+		"http://public.example.com@internal.example.com/": RangePrivate,
+		"http://public.example.com/":                      "",
+		"http://missing.example.com/":                     "",
+		"http://127.0.0.1/":                               "",
+		"http://intranet/":                                "",
+	}
+	r := NewNetResolver(resolver)
+	for value, want := range tests {
+		res, ok, err := r.Resolve(context.Background(), value)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", value, err.Error())
+			continue
+		}
+		if ok != (want != "") || res.Range != want {
+			t.Errorf("unexpected result for %q: %+v, want %q", value, res, want)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tinygo
+// +build tinygo
+
+package ssrf
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+)
+
+// lookupHost fails as DNS resolution is not available with TinyGo
+func lookupHost(context.Context, string) ([]netip.Addr, error) {
+	return nil, errors.New("DNS resolution is not supported")
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssrf
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/corazawaf/coraza/v3/internal/cache"
+)
+
+const (
+	// DefaultTimeout bounds the resolution of the hosts of a URL
+	DefaultTimeout = 500 * time.Millisecond
+	// DefaultTTL is the default time resolved hosts are cached for. It is
+	// kept short as the cache must not outlive the records by much.
+	DefaultTTL = 30 * time.Second
+
+	cacheSize = 4096
+)
+
+// lookupFunc resolves the addresses of a host, an error is returned when the
+// result is not conclusive and must not be cached.
+type lookupFunc func(ctx context.Context, host string) ([]netip.Addr, error)
+
+// Resolver resolves the host names of URLs, caching the addresses of the
+// hosts. It is safe for concurrent use.
+type Resolver struct {
+	// Timeout is the timeout of the resolution of the hosts of a URL
+	Timeout time.Duration
+	// TTL is the time resolved hosts are cached for, they are not cached
+	// when it is not positive.
+	TTL time.Duration
+
+	lookup lookupFunc
+	cache  *cache.LRU[string, []netip.Addr]
+	now    func() time.Time
+}
+
+// NewResolver returns a Resolver with the default timeout and TTL
+func NewResolver() *Resolver {
+	return &Resolver{
+		Timeout: DefaultTimeout,
+		TTL:     DefaultTTL,
+		lookup:  lookupHost,
+		cache:   cache.NewLRU[string, []netip.Addr](cacheSize),
+		now:     time.Now,
+	}
+}
+
+// Resolve resolves the host names of a URL and returns the first one
+// resolving to an internal address. Hosts given as addresses are left to
+// Check. The lookups end with ctx or once the timeout elapsed. The error
+// reports failed lookups, other than missing names, when no host is internal.
+func (r *Resolver) Resolve(ctx context.Context, value string) (Result, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+
+	var lookupErr error
+	hosts, _ := parseHosts(value)
+	for _, host := range hosts {
+		if _, ok := ParseIP(host); ok || !isHostname(host) {
+			continue
+		}
+		addrs, ok := r.cache.Get(host, r.now())
+		if !ok {
+			var err error
+			if addrs, err = r.lookup(ctx, host); err != nil {
+				lookupErr = err
+				continue
+			}
+			if r.TTL > 0 {
+				r.cache.Put(host, addrs, r.now().Add(r.TTL))
+			}
+		}
+		for _, addr := range addrs {
+			if rng := Classify(addr); rng != "" {
+				return Result{Host: host, Addr: addr, Range: rng}, true, nil
+			}
+		}
+	}
+	return Result{}, false, lookupErr
+}
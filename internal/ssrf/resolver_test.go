@@ -0,0 +1,76 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssrf
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestResolverCache(t *testing.T) {
+	now := time.Now()
+	lookups := 0
+	r := NewResolver()
+	r.now = func() time.Time { return now }
+	r.lookup = func(_ context.Context, host string) ([]netip.Addr, error) {
+		lookups++
+		if host == "timeout.example.com" {
+			return nil, errors.New("timeout")
+		}
+		return []netip.Addr{netip.MustParseAddr("10.0.0.5")}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if res, ok, _ := r.Resolve(context.Background(), "http://internal.example.com/"); !ok || res.Range != RangePrivate {
+			t.Errorf("unexpected result %+v", res)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("unexpected number of lookups %d", lookups)
+	}
+
+	now = now.Add(DefaultTTL + time.Second)
+	r.Resolve(context.Background(), "http://internal.example.com/")
+	if lookups != 2 {
+		t.Errorf("expected a lookup after the expiration, have %d", lookups)
+	}
+
+	// failures are not cached
+	for i := 0; i < 2; i++ {
+		if _, ok, err := r.Resolve(context.Background(), "http://timeout.example.com/"); ok || err == nil {
+			t.Errorf("expected a failed lookup, have %t, %v", ok, err)
+		}
+	}
+	if lookups != 4 {
+		t.Errorf("unexpected number of lookups %d", lookups)
+	}
+
+	r.TTL = 0
+	r.Resolve(context.Background(), "http://other.example.com/")
+	r.Resolve(context.Background(), "http://other.example.com/")
+	if lookups != 6 {
+		t.Errorf("unexpected number of lookups without cache %d", lookups)
+	}
+}
+
+func TestResolverContext(t *testing.T) {
+	r := NewResolver()
+	r.lookup = func(ctx context.Context, _ string) ([]netip.Addr, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, ok, err := r.Resolve(ctx, "http://internal.example.com/"); ok || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the lookup to be cancelled, have %t, %v", ok, err)
+	}
+
+	r.Timeout = time.Millisecond
+	if _, ok, err := r.Resolve(context.Background(), "http://internal.example.com/"); ok || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the lookup to time out, have %t, %v", ok, err)
+	}
+}
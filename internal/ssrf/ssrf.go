@@ -0,0 +1,423 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssrf detects URLs targeting internal destinations. URLs are parsed
+// leniently, as the different parsers of applications would, and hosts are
+// normalised, undoing the encodings of IP addresses resolvers accept.
+package ssrf
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxHosts bounds the number of candidate hosts of a URL
+	maxHosts = 8
+)
+
+// Ranges of internal destinations
+const (
+	RangeUnspecified = "unspecified"
+	RangeLoopback    = "loopback"
+	RangePrivate     = "private"
+	RangeLinkLocal   = "link-local"
+	RangeMetadata    = "metadata"
+)
+
+// Result is an internal destination
+type Result struct {
+	// Host is the normalised host of the URL
+	Host string
+	// Addr is the address of the host, invalid for names like localhost
+	Addr netip.Addr
+	// Range is the range of the destination, e.g. loopback
+	Range string
+}
+
+// metadataAddrs are the instance metadata services of cloud providers
+var metadataAddrs = map[netip.Addr]bool{
+	netip.MustParseAddr("169.254.169.254"): true, // AWS, GCP, Azure, OpenStack...
+	netip.MustParseAddr("169.254.170.2"):   true, // AWS ECS task metadata
+	netip.MustParseAddr("fd00:ec2::254"):   true, // AWS IMDS over IPv6
+	netip.MustParseAddr("100.100.100.200"): true, // Alibaba Cloud
+}
+
+var (
+	zeroNet   = netip.MustParsePrefix("0.0.0.0/8")
+	sharedNet = netip.MustParsePrefix("100.64.0.0/10")
+	nat64Net  = netip.MustParsePrefix("64:ff9b::/96")
+	sixToFour = netip.MustParsePrefix("2002::/16")
+)
+
+// internalNames are host names of internal destinations
+var internalNames = map[string]string{
+	"localhost":                  RangeLoopback,
+	"localhost.localdomain":      RangeLoopback,
+	"ip6-localhost":              RangeLoopback,
+	"ip6-loopback":               RangeLoopback,
+	"metadata":                   RangeMetadata,
+	"metadata.google.internal":   RangeMetadata,
+	"metadata.goog":              RangeMetadata,
+	"instance-data":              RangeMetadata,
+	"instance-data.ec2.internal": RangeMetadata,
+}
+
+// loopbackDomains are public domains whose names all resolve to 127.0.0.1
+var loopbackDomains = []string{"localhost", "localtest.me", "lvh.me", "vcap.me"}
+
+// wildcardDomains are public domains resolving names embedding an address to
+// that address, like 10.0.0.1.nip.io or app-10-0-0-1.nip.io
+var wildcardDomains = []string{"nip.io", "sslip.io", "xip.io", "traefik.me"}
+
+// Check parses value as a URL, or a host, and returns the internal
+// destination it targets. Every host a parser could extract from the URL is
+// checked, e.g. both hosts of http://evil.com#@127.0.0.1/.
+func Check(value string) (Result, bool) {
+	hosts, isURL := parseHosts(value)
+	for _, host := range hosts {
+		if addr, ok := ParseIP(host); ok {
+			// shorthand addresses like 10 or 0.5 are only addresses in URLs
+			if !isURL && strings.Count(host, ".") < 3 && strings.IndexByte(host, ':') < 0 {
+				continue
+			}
+			if r := Classify(addr); r != "" {
+				return Result{Host: host, Addr: addr, Range: r}, true
+			}
+			continue
+		}
+		if r, addr := classifyName(host); r != "" {
+			return Result{Host: host, Addr: addr, Range: r}, true
+		}
+	}
+	return Result{}, false
+}
+
+// parseHosts returns the normalised candidate hosts of a URL, or of a host
+// with an optional port, and whether the value is a URL. Values starting with
+// a single slash are paths and have no host.
+func parseHosts(value string) ([]string, bool) {
+	s := strings.ReplaceAll(strings.TrimSpace(value), "\\", "/")
+	isURL := true
+	if i := schemeEnd(s); i > 0 {
+		// special schemes accept any number of slashes, e.g. http:/host
+		s = strings.TrimLeft(s[i+1:], "/")
+	} else if strings.HasPrefix(s, "//") {
+		s = s[2:]
+	} else if strings.HasPrefix(s, "/") {
+		return nil, false
+	} else {
+		isURL = false
+	}
+
+	// a parser ends the authority at the first /, ? or # and takes the host
+	// after the last @, others split differently. Every piece between these
+	// delimiters is a candidate.
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		s = s[:i]
+	}
+	var hosts []string
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == '@' || r == '?' || r == '#' || r == ' ' || r == '\t'
+	}) {
+		if h := normalizeHost(part); h != "" && !contains(hosts, h) {
+			hosts = append(hosts, h)
+			if len(hosts) == maxHosts {
+				break
+			}
+		}
+	}
+	return hosts, isURL
+}
+
+// schemeEnd returns the index of the colon ending the scheme of s, or -1.
+// host:port is not mistaken for a scheme.
+func schemeEnd(s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ':':
+			if i == 0 {
+				return -1
+			}
+			rest := s[i+1:]
+			if j := strings.IndexAny(rest, "/?#"); j >= 0 {
+				rest = rest[:j]
+			}
+			if rest != "" && isDigits(rest) {
+				return -1
+			}
+			return i
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '+' || c == '-' || c == '.'):
+		default:
+			return -1
+		}
+	}
+	return -1
+}
+
+// normalizeHost percent decodes the host, maps fullwidth digits and dots to
+// ASCII, lowercases it and removes the port and the trailing dot
+func normalizeHost(h string) string {
+	h = strings.ToLower(mapFullwidth(percentDecode(h)))
+	if strings.HasPrefix(h, "[") {
+		if i := strings.IndexByte(h, ']'); i > 0 {
+			return h[1:i]
+		}
+		return strings.TrimPrefix(h, "[")
+	}
+	if i := strings.LastIndexByte(h, ':'); i >= 0 && strings.Count(h, ":") == 1 {
+		if port := h[i+1:]; port == "" || isDigits(port) {
+			h = h[:i]
+		}
+	}
+	return strings.TrimSuffix(h, ".")
+}
+
+// ParseIP parses an address the way inet_aton and URL parsers do, IPv4
+// addresses may be given as one to four decimal, octal or hexadecimal parts,
+// e.g. 2130706433, 0177.1 or 0x7f.0.0.1 for 127.0.0.1. IPv6 zones are
+// ignored.
+func ParseIP(host string) (netip.Addr, bool) {
+	if strings.IndexByte(host, ':') >= 0 {
+		if i := strings.IndexByte(host, '%'); i >= 0 {
+			host = host[:i]
+		}
+		addr, err := netip.ParseAddr(host)
+		return addr, err == nil
+	}
+
+	parts := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+	var ip uint64
+	for i, p := range parts {
+		n, ok := parseIPv4Part(p)
+		if !ok {
+			return netip.Addr{}, false
+		}
+		if i < len(parts)-1 {
+			if n > 0xff {
+				return netip.Addr{}, false
+			}
+			ip |= n << (8 * (3 - i))
+			continue
+		}
+		// the last part fills the remaining bytes
+		if n >= 1<<(8*(4-i)) {
+			return netip.Addr{}, false
+		}
+		ip |= n
+	}
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true
+}
+
+func parseIPv4Part(p string) (uint64, bool) {
+	if p == "" {
+		return 0, false
+	}
+	base := 10
+	switch {
+	case len(p) > 1 && (p[:2] == "0x" || p[:2] == "0X"):
+		base, p = 16, p[2:]
+		if p == "" {
+			// 0x is zero for inet_aton
+			return 0, true
+		}
+	case len(p) > 1 && p[0] == '0':
+		base, p = 8, p[1:]
+	}
+	n, err := strconv.ParseUint(p, base, 32)
+	return n, err == nil
+}
+
+// Classify returns the range of an internal address, empty for public
+// addresses. IPv4 addresses embedded in IPv6 addresses, mapped, compatible,
+// NAT64 or 6to4, are classified as IPv4 addresses.
+func Classify(addr netip.Addr) string {
+	addr = embeddedIPv4(addr.WithZone(""))
+	switch {
+	case metadataAddrs[addr]:
+		return RangeMetadata
+	case addr.IsUnspecified(), zeroNet.Contains(addr):
+		return RangeUnspecified
+	case addr.IsLoopback():
+		return RangeLoopback
+	case addr.IsLinkLocalUnicast():
+		return RangeLinkLocal
+	case addr.IsPrivate(), sharedNet.Contains(addr):
+		return RangePrivate
+	}
+	return ""
+}
+
+func embeddedIPv4(addr netip.Addr) netip.Addr {
+	if !addr.Is6() {
+		return addr
+	}
+	if addr.Is4In6() {
+		return addr.Unmap()
+	}
+	b := addr.As16()
+	var v4 [4]byte
+	switch {
+	case nat64Net.Contains(addr):
+		copy(v4[:], b[12:])
+	case sixToFour.Contains(addr):
+		copy(v4[:], b[2:6])
+	case isZero(b[:12]) && !isZero(b[12:14]):
+		// IPv4-compatible addresses, ::1 is the IPv6 loopback
+		copy(v4[:], b[12:])
+	default:
+		return addr
+	}
+	return netip.AddrFrom4(v4)
+}
+
+// classifyName returns the range of internal host names, along with the
+// address embedded in wildcard DNS names
+func classifyName(host string) (string, netip.Addr) {
+	if r, ok := internalNames[host]; ok {
+		return r, netip.Addr{}
+	}
+	for _, d := range loopbackDomains {
+		if strings.HasSuffix(host, "."+d) || host == d {
+			return RangeLoopback, netip.Addr{}
+		}
+	}
+	for _, d := range wildcardDomains {
+		prefix, ok := strings.CutSuffix(host, "."+d)
+		if !ok {
+			continue
+		}
+		if addr, ok := wildcardAddr(prefix); ok {
+			return Classify(addr), addr
+		}
+	}
+	return "", netip.Addr{}
+}
+
+// wildcardAddr extracts the address of wildcard DNS names, given with dots,
+// dashes or in hexadecimal
+func wildcardAddr(prefix string) (netip.Addr, bool) {
+	labels := strings.Split(prefix, ".")
+	if len(labels) >= 4 {
+		if addr, err := netip.ParseAddr(strings.Join(labels[len(labels)-4:], ".")); err == nil {
+			return addr, true
+		}
+	}
+	last := labels[len(labels)-1]
+	if dashes := strings.Split(last, "-"); len(dashes) >= 4 {
+		if addr, err := netip.ParseAddr(strings.Join(dashes[len(dashes)-4:], ".")); err == nil {
+			return addr, true
+		}
+		// IPv6 addresses use dashes for colons, e.g. --1.sslip.io
+		if addr, err := netip.ParseAddr(strings.ReplaceAll(last, "-", ":")); err == nil {
+			return addr, true
+		}
+	}
+	if i := strings.LastIndexByte(last, '-'); i >= 0 {
+		last = last[i+1:]
+	}
+	if len(last) == 8 {
+		if n, err := strconv.ParseUint(last, 16, 32); err == nil {
+			return netip.AddrFrom4([4]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}), true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+// isHostname returns whether host is a fully qualified domain name worth
+// resolving
+func isHostname(host string) bool {
+	if len(host) > 253 || strings.IndexByte(host, '.') < 0 {
+		return false
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func percentDecode(s string) string {
+	if strings.IndexByte(s, '%') < 0 {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			n, _ := strconv.ParseUint(s[i+1:i+3], 16, 8)
+			b.WriteByte(byte(n))
+			i += 2
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// mapFullwidth maps fullwidth digits, letters and dots to ASCII, as IDNA
+// does
+func mapFullwidth(s string) string {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '！' && r <= '～':
+			return r - '！' + '!'
+		case r == '。', r == '｡':
+			return '.'
+		}
+		return r
+	}, s)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
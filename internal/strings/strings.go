@@ -106,3 +106,9 @@ func InSlice(a string, list []string) bool {
 func WrapUnsafe(buf []byte) string {
 	return *(*string)(unsafe.Pointer(&buf))
 }
+
+// UnwrapUnsafe returns the bytes of the provided string without copying
+// them. The returned buffer must not be mutated.
+func UnwrapUnsafe(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
@@ -23,17 +23,25 @@ transform all characters to lowercase
 func cmdLine(data string) (string, bool, error) {
 	for i := 0; i < len(data); i++ {
 		if needsTransform(data[i]) {
-			return doCMDLine(data, i), true, nil
+			// Some characters will be removed so the result is likely smaller than the input,
+			// but it shouldn't be much so preallocate to that anyways.
+			return strings.WrapUnsafe(doCMDLine(make([]byte, 0, len(data)), data, i)), true, nil
 		}
 	}
 	return data, false, nil
 }
 
-func doCMDLine(input string, pos int) string {
-	// Some characters will be removed so the result is likely smaller than the input,
-	// but it shouldn't be much so preallocate to that anyways.
-	ret := make([]byte, pos, len(input))
-	copy(ret, input[:pos])
+func cmdLineBuffer(dst, src []byte) ([]byte, bool, error) {
+	for i := 0; i < len(src); i++ {
+		if needsTransform(src[i]) {
+			return doCMDLine(dst[:0], strings.WrapUnsafe(src), i), true, nil
+		}
+	}
+	return dst, false, nil
+}
+
+func doCMDLine(ret []byte, input string, pos int) []byte {
+	ret = append(ret, input[:pos]...)
 
 	space := false
 	for i := pos; i < len(input); i++ {
@@ -64,7 +72,7 @@ func doCMDLine(input string, pos int) string {
 			space = false
 		}
 	}
-	return strings.WrapUnsafe(ret)
+	return ret
 }
 
 func needsTransform(c byte) bool {
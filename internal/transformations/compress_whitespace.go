@@ -10,16 +10,28 @@ import (
 func compressWhitespace(value string) (string, bool, error) {
 	for i := 0; i < len(value); i++ {
 		if isLatinSpace(value[i]) {
-			transformedValue, changed := doCompressWhitespace(value, i)
-			return transformedValue, changed, nil
+			// The output may be significantly different in length (shorter) than the input, so we don't preallocate
+			transformedValue, changed := doCompressWhitespace(nil, value, i)
+			return strings.WrapUnsafe(transformedValue), changed, nil
 		}
 	}
 	return value, false, nil
 }
 
-func doCompressWhitespace(input string, pos int) (string, bool) {
-	// The output may be significantly different in length (shorter) than the input, so we don't preallocate
-	ret := []byte(input[0:pos])
+func compressWhitespaceBuffer(dst, src []byte) ([]byte, bool, error) {
+	for i := 0; i < len(src); i++ {
+		if isLatinSpace(src[i]) {
+			// changed is only reported for runs of spaces, but single spaces
+			// are still replaced by ' '.
+			transformedValue, _ := doCompressWhitespace(dst[:0], strings.WrapUnsafe(src), i)
+			return transformedValue, true, nil
+		}
+	}
+	return dst, false, nil
+}
+
+func doCompressWhitespace(ret []byte, input string, pos int) ([]byte, bool) {
+	ret = append(ret, input[:pos]...)
 
 	changed := false
 	inWhiteSpace := false
@@ -40,7 +52,7 @@ func doCompressWhitespace(input string, pos int) (string, bool) {
 		i++
 	}
 
-	return strings.WrapUnsafe(ret), changed
+	return ret, changed
 }
 
 func isLatinSpace(c byte) bool { // copied from unicode.IsSpace
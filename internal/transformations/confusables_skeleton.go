@@ -0,0 +1,57 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"unicode/utf8"
+
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+)
+
+// confusablesSkeleton maps the characters confusable with ASCII, such as
+// homoglyphs from other scripts or fullwidth and mathematical forms, to the
+// ASCII text they look like, and removes combining marks and invisible
+// formatting characters. Unlike the skeletons of Unicode Technical Standard
+// #39, ASCII characters are kept as is, so the output can be matched against
+// the keywords the confusables imitate.
+//
+// Besides compatibility decompositions, every confusables.txt mapping of
+// Unicode 15.0.0 to ASCII text is applied. As these take precedence, some
+// characters map to what they look like rather than to their compatibility
+// form, e.g. ſ to f and ⅿ to rn; apply unicodeNormalizeNFKC first to fold
+// them to s and m. Confusables mapped to non-ASCII text are left unchanged.
+func confusablesSkeleton(data string) (string, bool, error) {
+	for i := 0; i < len(data); {
+		if data[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(data[i:])
+		if _, ok := lookupMapping(confusablesSkeletons, confusablesData, r); ok {
+			return doConfusablesSkeleton(data, i), true, nil
+		}
+		i += size
+	}
+	return data, false, nil
+}
+
+func doConfusablesSkeleton(input string, pos int) string {
+	res := make([]byte, 0, len(input))
+	res = append(res, input[:pos]...)
+	for i := pos; i < len(input); {
+		if input[i] < utf8.RuneSelf {
+			res = append(res, input[i])
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(input[i:])
+		if skeleton, ok := lookupMapping(confusablesSkeletons, confusablesData, r); ok {
+			res = append(res, skeleton...)
+		} else {
+			res = append(res, input[i:i+size]...)
+		}
+		i += size
+	}
+	return utils.WrapUnsafe(res)
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var confusablesSkeletonTests = []struct {
+	input string
+	want  string
+}{
+	{
+		input: "",
+		want:  "",
+	},
+	{
+		input: "TestCase",
+		want:  "TestCase",
+	},
+	{
+		input: "ѕеlеct * frоm users",
+		want:  "select * from users",
+	},
+	{
+		input: "ＳＥＬＥＣＴ 𝐬𝐥𝐞𝐞𝐩(①)",
+		want:  "SELECT sleep(1)",
+	},
+	{
+		input: "s\u0301e\u0308l\u200bect",
+		want:  "select",
+	},
+	{
+		input: "’ OR ‘1’=‘1’ –",
+		want:  "' OR '1'='1' -",
+	},
+	{
+		input: "ꜱelect ꮪelect ꓢELECT",
+		want:  "select select SELECT",
+	},
+	{
+		input: "ſ ⅿ",
+		want:  "f rn",
+	},
+	{
+		input: "ΑΒΓ αβγ",
+		want:  "ABΓ aβy",
+	},
+	{
+		input: "中文 \xff",
+		want:  "中文 \xff",
+	},
+}
+
+func TestConfusablesSkeleton(t *testing.T) {
+	for _, tt := range confusablesSkeletonTests {
+		t.Run(tt.input, func(t *testing.T) {
+			have, changed, err := confusablesSkeleton(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			shouldChange := tt.input != tt.want
+			if changed != shouldChange {
+				t.Errorf("unexpected changed value, want %t, have %t", shouldChange, changed)
+			}
+
+			if have != tt.want {
+				t.Errorf("unexpected value, want %q, have %q", tt.want, have)
+			}
+		})
+	}
+}
+
+func FuzzConfusablesSkeleton(f *testing.F) {
+	for _, tc := range confusablesSkeletonTests {
+		f.Add(tc.input)
+	}
+	f.Fuzz(func(t *testing.T, tc string) {
+		data, changed, err := confusablesSkeleton(tc)
+		if err != nil {
+			t.Error(err)
+		}
+		if changed == (data == tc) {
+			t.Errorf("unexpected changed value %t for input %q", changed, tc)
+		}
+		// Invalid UTF-8 bytes are kept, and may form runes once separating
+		// marks are removed.
+		if again, changed, _ := confusablesSkeleton(data); changed && utf8.ValidString(tc) {
+			t.Errorf("skeleton %q of input %q changes again to %q", data, tc, again)
+		}
+		// ASCII characters are kept
+		var ascii strings.Builder
+		for i := 0; i < len(tc); i++ {
+			if tc[i] < utf8.RuneSelf {
+				ascii.WriteByte(tc[i])
+			}
+		}
+		if tc == ascii.String() && data != tc {
+			t.Errorf("unexpected change of ASCII input %q", tc)
+		}
+	})
+}
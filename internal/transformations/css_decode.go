@@ -4,6 +4,7 @@
 package transformations
 
 import (
+	"bytes"
 	"strings"
 
 	utils "github.com/corazawaf/coraza/v3/internal/strings"
@@ -11,15 +12,21 @@ import (
 
 func cssDecode(data string) (string, bool, error) {
 	if i := strings.IndexByte(data, '\\'); i != -1 {
-		// TODO: This will transform even if the backslash isn't followed by hex,
-		// but keep it simple for now.
-		return cssDecodeInplace(data, i), true, nil
+		// As in ModSecurity, a backslash is always removed, whether it escapes
+		// hex digits, a newline or any other character.
+		return utils.WrapUnsafe(cssDecodeInplace(data, []byte(data), i)), true, nil
 	}
 	return data, false, nil
 }
 
-func cssDecodeInplace(input string, pos int) string {
-	d := []byte(input)
+func cssDecodeBuffer(dst, src []byte) ([]byte, bool, error) {
+	if i := bytes.IndexByte(src, '\\'); i != -1 {
+		return cssDecodeInplace(utils.WrapUnsafe(src), append(dst[:0], src...), i), true, nil
+	}
+	return dst, false, nil
+}
+
+func cssDecodeInplace(input string, d []byte, pos int) []byte {
 	inputLen := len(d)
 	i := pos
 	c := pos
@@ -132,9 +139,7 @@ func cssDecodeInplace(input string, pos int) string {
 	}
 
 	/* Terminate output string. */
-	d = d[:c]
-
-	return utils.WrapUnsafe(d)
+	return d[:c]
 }
 
 /**
@@ -4,6 +4,7 @@
 package transformations
 
 import (
+	"bytes"
 	"strconv"
 	"strings"
 
@@ -12,17 +13,23 @@ import (
 
 func escapeSeqDecode(input string) (string, bool, error) {
 	if i := strings.IndexByte(input, '\\'); i != -1 {
-		// TODO: This will transform even if the backslash isn't followed by an escape,
-		// but keep it simple for now.
-		transformedInput, changed := doEscapeSeqDecode(input, i)
-		return transformedInput, changed, nil
+		transformedInput, changed := doEscapeSeqDecode(input, []byte(input), i)
+		return utils.WrapUnsafe(transformedInput), changed, nil
 	}
 	return input, false, nil
 }
 
-func doEscapeSeqDecode(input string, pos int) (string, bool) {
+func escapeSeqDecodeBuffer(dst, src []byte) ([]byte, bool, error) {
+	if i := bytes.IndexByte(src, '\\'); i != -1 {
+		// changed only reports decoded sequences, but the buffer was rewritten
+		transformedInput, _ := doEscapeSeqDecode(utils.WrapUnsafe(src), append(dst[:0], src...), i)
+		return transformedInput, true, nil
+	}
+	return dst, false, nil
+}
+
+func doEscapeSeqDecode(input string, data []byte, pos int) ([]byte, bool) {
 	inputLen := len(input)
-	data := []byte(input)
 	changed := false
 	d := pos
 	i := pos
@@ -85,7 +92,8 @@ func doEscapeSeqDecode(input string, pos int) (string, bool) {
 					j += 1
 				}
 
-				bc, _ := strconv.ParseUint(input[i+1:i+j], 8, 8)
+				// Values above \377 overflow the byte, as in ModSecurity
+				bc, _ := strconv.ParseUint(input[i+1:i+j], 8, 16)
 				data[d] = byte(bc)
 				d += 1
 				i += j
@@ -97,6 +105,7 @@ func doEscapeSeqDecode(input string, pos int) (string, bool) {
 			data[d] = input[i+1]
 			d++
 			i += 2
+			changed = true
 		} else {
 			/* Input character not a backslash, copy it. */
 			data[d] = input[i]
@@ -104,7 +113,7 @@ func doEscapeSeqDecode(input string, pos int) (string, bool) {
 			i++
 		}
 	}
-	return utils.WrapUnsafe(data[:d]), changed
+	return data[:d], changed
 }
 
 func isODigit(c byte) bool {
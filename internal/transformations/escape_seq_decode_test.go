@@ -30,6 +30,14 @@ func TestEscapeSeqDecode(t *testing.T) {
 			input: "\\a\\b\\f\\n\\r\\t\\v\\u0000\\?\\'\\\"\\0\\12\\123\\x00\\xff",
 			want:  "\a\b\f\n\r\t\vu0000?'\"\x00\nS\x00\xff",
 		},
+		{
+			input: "\\q",
+			want:  "q",
+		},
+		{
+			input: "\\x4g\\377\\477",
+			want:  "x4g\xff?",
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,287 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Generates the Unicode tables of the unicodeNormalizeNFKC and
+// confusablesSkeleton transformations, from the Unicode Character Database
+// of golang.org/x/text and a confusables.txt file of the pinned version,
+// either the mappings to ASCII of generator/confusables.txt or the full file
+// published by Unicode.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:embed unicodetables.go.tmpl
+var unicodeTablesTmpl string
+
+type runeRange struct {
+	Lo, Hi rune
+	Value  uint8
+}
+
+type runeMapping struct {
+	Rune     rune
+	Off, Len int
+}
+
+type composition struct {
+	First, Second, Rune rune
+}
+
+// confusablesVersion is the version of Unicode's confusables.txt the
+// skeletons are generated from, its full list is published at
+// https://www.unicode.org/Public/security/15.0.0/confusables.txt
+const confusablesVersion = "15.0.0"
+
+type unicodeTables struct {
+	UnicodeVersion     string
+	ConfusablesVersion string
+	ConfusablesCount   int
+	CombiningClasses   []runeRange
+	QuickCheckNo       []runeRange
+	Decompositions     []runeMapping
+	DecompositionData  []string
+	Compositions       []composition
+	Skeletons          []runeMapping
+	SkeletonData       []string
+}
+
+const (
+	hangulSBase  = 0xAC00
+	hangulSCount = 11172
+	hangulVFirst = 0x1161
+	hangulVLast  = 0x1175
+	hangulTFirst = 0x11A8
+	hangulTLast  = 0x11C2
+)
+
+func main() {
+	confusablesFile := flag.String("confusables", "./generator/confusables.txt", "confusables.txt file mapping homoglyphs, of version "+confusablesVersion)
+	flag.Parse()
+
+	confusables, err := readConfusables(*confusablesFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tmpl, err := template.New("unicodetables").Parse(unicodeTablesTmpl)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tables := unicodeTables{
+		UnicodeVersion:     norm.Version,
+		ConfusablesVersion: confusablesVersion,
+		ConfusablesCount:   len(confusables),
+	}
+	seconds := map[rune]bool{}
+	var decompositions, skeletons strings.Builder
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if !utf8.ValidRune(r) {
+			continue
+		}
+		s := string(r)
+		hangul := r >= hangulSBase && r < hangulSBase+hangulSCount
+
+		if d := norm.NFKD.String(s); d != s && !hangul {
+			tables.Decompositions = append(tables.Decompositions, runeMapping{r, decompositions.Len(), len(d)})
+			decompositions.WriteString(d)
+		}
+		if first, second, ok := primaryComposite(r); ok && !hangul {
+			tables.Compositions = append(tables.Compositions, composition{first, second, r})
+			seconds[second] = true
+		}
+		if skeleton, ok := asciiSkeleton(r, confusables); ok {
+			tables.Skeletons = append(tables.Skeletons, runeMapping{r, skeletons.Len(), len(skeleton)})
+			skeletons.WriteString(skeleton)
+		}
+	}
+	for r := rune(hangulVFirst); r <= hangulVLast; r++ {
+		seconds[r] = true
+	}
+	for r := rune(hangulTFirst); r <= hangulTLast; r++ {
+		seconds[r] = true
+	}
+
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if !utf8.ValidRune(r) {
+			continue
+		}
+		s := string(r)
+		ccc := norm.NFKD.PropertiesString(s).CCC()
+		if ccc != 0 {
+			tables.CombiningClasses = appendRange(tables.CombiningClasses, r, ccc)
+		}
+		if ccc != 0 || seconds[r] || norm.NFKC.String(s) != s {
+			tables.QuickCheckNo = appendRange(tables.QuickCheckNo, r, 0)
+		}
+	}
+	sort.Slice(tables.Compositions, func(i, j int) bool {
+		a, b := tables.Compositions[i], tables.Compositions[j]
+		return a.First < b.First || a.First == b.First && a.Second < b.Second
+	})
+	tables.DecompositionData = chunk(decompositions.String())
+	tables.SkeletonData = chunk(skeletons.String())
+
+	var src bytes.Buffer
+	if err := tmpl.Execute(&src, tables); err != nil {
+		log.Fatal(err)
+	}
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("./unicodetables.gen.go", formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// primaryComposite returns the pair of runes the rune is the canonical
+// composition of
+func primaryComposite(r rune) (rune, rune, bool) {
+	d := []rune(norm.NFD.String(string(r)))
+	if len(d) < 2 {
+		return 0, 0, false
+	}
+	// the last rune of the decomposition may have been reordered
+	for i := len(d) - 1; i > 0; i-- {
+		rest := append(append([]rune{}, d[:i]...), d[i+1:]...)
+		first := []rune(norm.NFC.String(string(rest)))
+		if len(first) == 1 && norm.NFC.String(string(first)+string(d[i])) == string(r) {
+			return first[0], d[i], true
+		}
+	}
+	return 0, 0, false
+}
+
+// asciiSkeleton returns the ASCII text a rune is confusable with, after
+// decomposing it and removing combining marks and invisible formatting
+// characters
+func asciiSkeleton(r rune, confusables map[rune]string) (string, bool) {
+	if r < utf8.RuneSelf {
+		return "", false
+	}
+	if skeleton, ok := confusables[r]; ok {
+		return skeleton, true
+	}
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return "", true
+	}
+	d := norm.NFKD.String(string(r))
+	if d == string(r) {
+		return "", false
+	}
+	var skeleton strings.Builder
+	for _, c := range d {
+		switch {
+		case c < utf8.RuneSelf:
+			skeleton.WriteRune(c)
+		case confusables[c] != "":
+			skeleton.WriteString(confusables[c])
+		case unicode.In(c, unicode.Mn, unicode.Me):
+		default:
+			return "", false
+		}
+	}
+	return skeleton.String(), true
+}
+
+// readConfusables reads the mappings of non-ASCII runes to ASCII text of a
+// file in the format of Unicode's confusables.txt, which must be of the
+// pinned version
+func readConfusables(name string) (map[rune]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	version := ""
+	confusables := map[rune]string{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		text := strings.TrimPrefix(s.Text(), "\ufeff")
+		if v, ok := strings.CutPrefix(text, "# Version:"); ok && version == "" {
+			version = strings.TrimSpace(v)
+		}
+		line, _, _ := strings.Cut(text, "#")
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		source, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		var target strings.Builder
+		for _, cp := range strings.Fields(fields[1]) {
+			r, err := strconv.ParseUint(cp, 16, 32)
+			if err != nil {
+				return nil, err
+			}
+			target.WriteRune(rune(r))
+		}
+		if source >= utf8.RuneSelf && isASCII(target.String()) {
+			confusables[rune(source)] = target.String()
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if version != confusablesVersion {
+		return nil, fmt.Errorf("confusables.txt version %q, want %q", version, confusablesVersion)
+	}
+	return confusables, nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// appendRange appends the rune to the ranges, extending the last one when
+// contiguous and with the same value
+func appendRange(ranges []runeRange, r rune, value uint8) []runeRange {
+	if n := len(ranges); n > 0 && ranges[n-1].Hi == r-1 && ranges[n-1].Value == value {
+		ranges[n-1].Hi = r
+		return ranges
+	}
+	return append(ranges, runeRange{r, r, value})
+}
+
+// chunk splits data in lines, without splitting UTF-8 sequences
+func chunk(data string) []string {
+	var chunks []string
+	for len(data) > 0 {
+		n := 48
+		if n >= len(data) {
+			n = len(data)
+		}
+		for n < len(data) && !utf8.RuneStart(data[n]) {
+			n++
+		}
+		chunks = append(chunks, data[:n])
+		data = data[n:]
+	}
+	return chunks
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"encoding/hex"
+
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+)
+
+// Decodes a hex-encoded string. Unlike hexDecode, this version uses a forgiving
+// implementation, which ignores invalid characters such as whitespace and "%",
+// and a trailing odd digit.
+func hexDecodeExt(data string) (string, bool, error) {
+	digits := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		if utils.ValidHex(data[i]) {
+			digits = append(digits, data[i])
+		}
+	}
+	// Decoding in place is safe as every output byte consumes two input ones.
+	n, err := hex.Decode(digits, digits[:len(digits)&^1])
+	if err != nil {
+		return "", false, err
+	}
+	return utils.WrapUnsafe(digits[:n]), true, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+var hexDecodeExtTests = []struct {
+	name     string
+	input    string
+	expected string
+}{
+	{
+		name:     "Empty",
+		input:    "",
+		expected: "",
+	},
+	{
+		name:     "Valid",
+		input:    "5465737443617365",
+		expected: "TestCase",
+	},
+	{
+		name:     "Valid with \u0000",
+		input:    "546573740043617365",
+		expected: "Test\x00Case",
+	},
+	{
+		name:     "Separated by invalid characters",
+		input:    "%54 %65:73-74",
+		expected: "Test",
+	},
+	{
+		name:     "Trailing odd digit",
+		input:    "48656c6c6f7",
+		expected: "Hello",
+	},
+	{
+		name:     "No hex digits",
+		input:    "zzz",
+		expected: "",
+	},
+}
+
+func TestHexDecodeExt(t *testing.T) {
+	for _, tt := range hexDecodeExtTests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, _, err := hexDecodeExt(tt.input)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if actual != tt.expected {
+				t.Errorf("Expected %q, but got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func FuzzHexDecodeExt(f *testing.F) {
+	for _, tc := range hexDecodeExtTests {
+		f.Add(tc.input)
+	}
+	f.Fuzz(func(t *testing.T, tc string) {
+		data, _, err := hexDecodeExt(tc)
+		// Invalid characters are skipped so there is no error case.
+		if err != nil {
+			t.Error(err)
+		}
+
+		// When the standard library decoder succeeds, both should match.
+		if refData, err := hex.DecodeString(tc); err == nil && data != string(refData) {
+			t.Errorf("mismatch with stdlib for input %q", tc)
+		}
+	})
+}
@@ -4,10 +4,119 @@
 package transformations
 
 import (
+	"bytes"
+	"unicode/utf8"
+
 	"golang.org/x/net/html"
+
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
 )
 
 func htmlEntityDecode(data string) (string, bool, error) {
 	transformedData := html.UnescapeString(data)
 	return transformedData, len(data) != len(transformedData), nil
 }
+
+// htmlEntityDecodeBuffer decodes numeric references natively and named ones
+// with html.UnescapeString, one at a time, which does not allocate for the
+// references decoded to a single byte, such as &lt;.
+func htmlEntityDecodeBuffer(dst, src []byte) ([]byte, bool, error) {
+	i := bytes.IndexByte(src, '&')
+	if i < 0 {
+		return dst, false, nil
+	}
+	dst = append(dst[:0], src[:i]...)
+	for i < len(src) {
+		n := 0
+		if s := src[i:]; len(s) > 1 && s[1] == '#' {
+			dst, n = appendNumericEntity(dst, s)
+		} else {
+			n = namedEntityLen(s)
+			dst = append(dst, html.UnescapeString(utils.WrapUnsafe(s[:n]))...)
+		}
+		i += n
+		next := bytes.IndexByte(src[i:], '&')
+		if next < 0 {
+			next = len(src) - i
+		}
+		dst = append(dst, src[i:i+next]...)
+		i += next
+	}
+	// Decoded references are always shorter than their source
+	return dst, len(dst) != len(src), nil
+}
+
+// htmlReplacementTable replaces the numeric references 0x80 to 0x9F with
+// their Windows-1252 characters.
+var htmlReplacementTable = [...]rune{
+	'\u20AC', '\u0081', '\u201A', '\u0192', '\u201E', '\u2026', '\u2020', '\u2021',
+	'\u02C6', '\u2030', '\u0160', '\u2039', '\u0152', '\u008D', '\u017D', '\u008F',
+	'\u0090', '\u2018', '\u2019', '\u201C', '\u201D', '\u2022', '\u2013', '\u2014',
+	'\u02DC', '\u2122', '\u0161', '\u203A', '\u0153', '\u009D', '\u017E', '\u0178',
+}
+
+// appendNumericEntity appends the character of the numeric reference at the
+// start of s, which begins with "&#", and returns the number of bytes read.
+// It follows the decoding of golang.org/x/net/html.
+func appendNumericEntity(dst, s []byte) ([]byte, int) {
+	if len(s) <= 3 {
+		return append(dst, '&'), 1
+	}
+	i := 2
+	hex := false
+	if s[i] == 'x' || s[i] == 'X' {
+		hex = true
+		i++
+	}
+
+	x := rune(0)
+	for i < len(s) {
+		c := s[i]
+		i++
+		if hex {
+			if '0' <= c && c <= '9' {
+				x = 16*x + rune(c) - '0'
+				continue
+			} else if 'a' <= c && c <= 'f' {
+				x = 16*x + rune(c) - 'a' + 10
+				continue
+			} else if 'A' <= c && c <= 'F' {
+				x = 16*x + rune(c) - 'A' + 10
+				continue
+			}
+		} else if '0' <= c && c <= '9' {
+			x = 10*x + rune(c) - '0'
+			continue
+		}
+		if c != ';' {
+			i--
+		}
+		break
+	}
+
+	if i <= 3 {
+		// no digits
+		return append(dst, '&'), 1
+	}
+	if 0x80 <= x && x <= 0x9F {
+		x = htmlReplacementTable[x-0x80]
+	} else if x == 0 || (0xD800 <= x && x <= 0xDFFF) || x > 0x10FFFF {
+		x = utf8.RuneError
+	}
+	return utf8.AppendRune(dst, x), i
+}
+
+// namedEntityLen returns the length of the named reference at the start of s,
+// which begins with '&', as read by html.UnescapeString: the alphanumeric name
+// and its optional semicolon. Decoding it alone gives the same result as
+// decoding it in place.
+func namedEntityLen(s []byte) int {
+	i := 1
+	for i < len(s) && ('a' <= s[i] && s[i] <= 'z' || 'A' <= s[i] && s[i] <= 'Z' || '0' <= s[i] && s[i] <= '9') {
+		i++
+	}
+	if i < len(s) && s[i] == ';' {
+		i++
+	}
+	return i
+}
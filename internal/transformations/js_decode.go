@@ -4,6 +4,7 @@
 package transformations
 
 import (
+	"bytes"
 	"strconv"
 	"strings"
 
@@ -12,17 +13,23 @@ import (
 
 func jsDecode(data string) (string, bool, error) {
 	if i := strings.IndexByte(data, '\\'); i != -1 {
-		// TODO: This will transform even if the backslash isn't followed by an escape,
-		// but keep it simple for now.
-		transformedData, changed := doJsDecode(data, i)
-		return transformedData, changed, nil
+		transformedData, changed := doJsDecode(data, []byte(data), i)
+		return utils.WrapUnsafe(transformedData), changed, nil
 	}
 	return data, false, nil
 }
 
+func jsDecodeBuffer(dst, src []byte) ([]byte, bool, error) {
+	if i := bytes.IndexByte(src, '\\'); i != -1 {
+		// changed only reports decoded sequences, but the buffer was rewritten
+		transformedData, _ := doJsDecode(utils.WrapUnsafe(src), append(dst[:0], src...), i)
+		return transformedData, true, nil
+	}
+	return dst, false, nil
+}
+
 // https://github.com/SpiderLabs/ModSecurity/blob/b66224853b4e9d30e0a44d16b29d5ed3842a6b11/src/actions/transformations/js_decode.cc
-func doJsDecode(input string, pos int) (string, bool) {
-	d := []byte(input)
+func doJsDecode(input string, d []byte, pos int) ([]byte, bool) {
 	inputLen := len(input)
 	changed := false
 
@@ -62,9 +69,9 @@ func doJsDecode(input string, pos int) (string, bool) {
 				j := 0
 
 				for (i+1+j < inputLen) && (j < 3) {
-					buf[j] = input[i+j]
+					buf[j] = input[i+1+j]
 					j++
-					if !isodigit(input[i+j]) {
+					if i+1+j < inputLen && !isodigit(input[i+1+j]) {
 						break
 					}
 				}
@@ -76,7 +83,7 @@ func doJsDecode(input string, pos int) (string, bool) {
 						j = 2
 						buf = buf[:j]
 					}
-					nn, _ := strconv.ParseInt(string(buf), 8, 8)
+					nn, _ := strconv.ParseUint(string(buf), 8, 8)
 					d[c] = byte(nn)
 					changed = true
 					c++
@@ -124,8 +131,7 @@ func doJsDecode(input string, pos int) (string, bool) {
 		}
 	}
 
-	return utils.WrapUnsafe(d[:c]), changed
-
+	return d[:c], changed
 }
 
 func isodigit(x byte) bool {
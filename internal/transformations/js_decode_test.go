@@ -26,6 +26,18 @@ func TestCJSDecode(t *testing.T) {
 			input: "\\",
 			want:  "\\",
 		},
+		{
+			input: "\\101\\0\\12x\\377\\477",
+			want:  "A\x00\nx\xff'7",
+		},
+		{
+			input: "\\x41\\x4\\u0041\\uff21\\u004",
+			want:  "Ax4AAu004",
+		},
+		{
+			input: "\\q\\'\\n",
+			want:  "q'\n",
+		},
 	}
 
 	for _, tc := range tests {
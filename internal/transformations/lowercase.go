@@ -5,6 +5,7 @@ package transformations
 
 import (
 	"strings"
+	"unicode/utf8"
 )
 
 func lowerCase(data string) (string, bool, error) {
@@ -13,3 +14,30 @@ func lowerCase(data string) (string, bool, error) {
 	transformedData := strings.ToLower(data)
 	return transformedData, data != transformedData, nil
 }
+
+// lowerCaseBuffer only handles ASCII input natively, other input goes through
+// strings.ToLower as the case mapping may change its length.
+func lowerCaseBuffer(dst, src []byte) ([]byte, bool, error) {
+	changed := false
+	for _, c := range src {
+		if c >= utf8.RuneSelf {
+			return unicodeLowerCaseBuffer(dst, src)
+		}
+		if 'A' <= c && c <= 'Z' {
+			changed = true
+		}
+	}
+	if !changed {
+		return dst, false, nil
+	}
+	dst = dst[:0]
+	for _, c := range src {
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst, true, nil
+}
+
+var unicodeLowerCaseBuffer = bufferOf(lowerCase)
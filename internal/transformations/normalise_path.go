@@ -4,6 +4,7 @@
 package transformations
 
 import (
+	"bytes"
 	"path/filepath"
 )
 
@@ -21,3 +22,77 @@ func normalisePath(data string) (string, bool, error) {
 	}
 	return clean, data != clean, nil
 }
+
+// normalisePathBuffer matches normalisePath on systems using slashes as
+// separator, where filepath.Clean is path.Clean, and is only registered there.
+func normalisePathBuffer(dst, src []byte) ([]byte, bool, error) {
+	return doNormalisePathBuffer(dst, src, false)
+}
+
+// doNormalisePathBuffer treats backslashes as separators too with win
+func doNormalisePathBuffer(dst, src []byte, win bool) ([]byte, bool, error) {
+	if len(src) < 1 {
+		return dst, false, nil
+	}
+	dst = cleanPath(dst[:0], src, win)
+	if len(dst) == 0 {
+		return dst, true, nil
+	}
+	if c := src[len(src)-1]; c == '/' || win && c == '\\' {
+		return append(dst, '/'), true, nil
+	}
+	return dst, !bytes.Equal(dst, src), nil
+}
+
+// cleanPath appends the shortest path equivalent to path, as path.Clean
+// returns it, to the empty dst, or nothing instead of ".".
+func cleanPath(dst, path []byte, win bool) []byte {
+	isSep := func(c byte) bool {
+		return c == '/' || win && c == '\\'
+	}
+	n := len(path)
+	rooted := isSep(path[0])
+	// r is the next byte to process, dotdot is where .. must stop
+	r, dotdot := 0, 0
+	if rooted {
+		dst = append(dst, '/')
+		r, dotdot = 1, 1
+	}
+	for r < n {
+		switch {
+		case isSep(path[r]):
+			// empty path element
+			r++
+		case path[r] == '.' && (r+1 == n || isSep(path[r+1])):
+			// . element
+			r++
+		case path[r] == '.' && path[r+1] == '.' && (r+2 == n || isSep(path[r+2])):
+			// .. element: remove to last /
+			r += 2
+			switch {
+			case len(dst) > dotdot:
+				w := len(dst) - 1
+				for w > dotdot && dst[w] != '/' {
+					w--
+				}
+				dst = dst[:w]
+			case !rooted:
+				// cannot backtrack, but not rooted, so append .. element
+				if len(dst) > 0 {
+					dst = append(dst, '/')
+				}
+				dst = append(dst, '.', '.')
+				dotdot = len(dst)
+			}
+		default:
+			// real path element, add slash if needed
+			if rooted && len(dst) != 1 || !rooted && len(dst) != 0 {
+				dst = append(dst, '/')
+			}
+			for ; r < n && !isSep(path[r]); r++ {
+				dst = append(dst, path[r])
+			}
+		}
+	}
+	return dst
+}
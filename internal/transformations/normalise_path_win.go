@@ -15,3 +15,8 @@ func normalisePathWin(data string) (string, bool, error) {
 	data = strings.ReplaceAll(data, "\\", "/")
 	return normalisePath(data)
 }
+
+// normalisePathWinBuffer is registered along with normalisePathBuffer
+func normalisePathWinBuffer(dst, src []byte) ([]byte, bool, error) {
+	return doNormalisePathBuffer(dst, src, true)
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"math/bits"
+
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+)
+
+// parityEven7bit calculates even parity of 7-bit data, replacing the 8th bit
+// of each byte with the calculated parity bit.
+func parityEven7bit(data string) (string, bool, error) {
+	return setParity(data, evenParity)
+}
+
+// parityOdd7bit calculates odd parity of 7-bit data, replacing the 8th bit
+// of each byte with the calculated parity bit.
+func parityOdd7bit(data string) (string, bool, error) {
+	return setParity(data, oddParity)
+}
+
+// parityZero7bit assumes 7-bit data and zeroes the 8th bit of each byte,
+// allowing to inspect data encoded with even or odd parity.
+func parityZero7bit(data string) (string, bool, error) {
+	return setParity(data, zeroParity)
+}
+
+func parityEven7bitBuffer(dst, src []byte) ([]byte, bool, error) {
+	return setParityBuffer(dst, src, evenParity)
+}
+
+func parityOdd7bitBuffer(dst, src []byte) ([]byte, bool, error) {
+	return setParityBuffer(dst, src, oddParity)
+}
+
+func parityZero7bitBuffer(dst, src []byte) ([]byte, bool, error) {
+	return setParityBuffer(dst, src, zeroParity)
+}
+
+func setParityBuffer(dst, src []byte, parity func(byte) byte) ([]byte, bool, error) {
+	for i := 0; i < len(src); i++ {
+		if parity(src[i]) == src[i] {
+			continue
+		}
+		dst = append(dst[:0], src...)
+		for ; i < len(dst); i++ {
+			dst[i] = parity(dst[i])
+		}
+		return dst, true, nil
+	}
+	return dst, false, nil
+}
+
+func setParity(data string, parity func(byte) byte) (string, bool, error) {
+	for i := 0; i < len(data); i++ {
+		if parity(data[i]) == data[i] {
+			continue
+		}
+		d := []byte(data)
+		for ; i < len(d); i++ {
+			d[i] = parity(d[i])
+		}
+		return utils.WrapUnsafe(d), true, nil
+	}
+	return data, false, nil
+}
+
+// As in ModSecurity, the parity is calculated over the whole byte, so data
+// that is not 7-bit is not left unchanged.
+// https://github.com/owasp-modsecurity/ModSecurity/blob/v2/master/apache2/re_tfns.c
+
+func evenParity(c byte) byte {
+	if bits.OnesCount8(c)%2 == 1 {
+		return c | 0x80
+	}
+	return c & 0x7f
+}
+
+func oddParity(c byte) byte {
+	if bits.OnesCount8(c)%2 == 0 {
+		return c | 0x80
+	}
+	return c & 0x7f
+}
+
+func zeroParity(c byte) byte {
+	return c & 0x7f
+}
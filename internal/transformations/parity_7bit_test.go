@@ -0,0 +1,71 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+var parityTests = []struct {
+	name      string
+	transform plugintypes.Transformation
+	input     string
+	want      string
+}{
+	{name: "even", transform: parityEven7bit, input: "", want: ""},
+	{name: "even", transform: parityEven7bit, input: "abc", want: "\xe1\xe2c"},
+	{name: "even", transform: parityEven7bit, input: "\x80\xff", want: "\x80\x7f"},
+	{name: "odd", transform: parityOdd7bit, input: "", want: ""},
+	{name: "odd", transform: parityOdd7bit, input: "abc", want: "ab\xe3"},
+	{name: "odd", transform: parityOdd7bit, input: "\x80\xff", want: "\x00\xff"},
+	{name: "zero", transform: parityZero7bit, input: "", want: ""},
+	{name: "zero", transform: parityZero7bit, input: "abc", want: "abc"},
+	{name: "zero", transform: parityZero7bit, input: "\xe1\xe2c\xff", want: "abc\x7f"},
+}
+
+func TestParity7bit(t *testing.T) {
+	for _, tt := range parityTests {
+		t.Run(tt.name+"/"+tt.input, func(t *testing.T) {
+			have, changed, err := tt.transform(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			shouldChange := tt.input != tt.want
+			if changed != shouldChange {
+				t.Errorf("unexpected changed value, want %t, have %t", shouldChange, changed)
+			}
+
+			if have != tt.want {
+				t.Errorf("unexpected value, want %q, have %q", tt.want, have)
+			}
+		})
+	}
+}
+
+func FuzzParity7bit(f *testing.F) {
+	for _, tc := range parityTests {
+		f.Add(tc.input)
+	}
+	f.Fuzz(func(t *testing.T, tc string) {
+		even, _, _ := parityEven7bit(tc)
+		odd, _, _ := parityOdd7bit(tc)
+		zero, _, _ := parityZero7bit(tc)
+		if len(even) != len(tc) || len(odd) != len(tc) || len(zero) != len(tc) {
+			t.Fatalf("unexpected length change for input %q", tc)
+		}
+		for i := 0; i < len(tc); i++ {
+			if even[i]&0x7f != tc[i]&0x7f || odd[i]&0x7f != tc[i]&0x7f || zero[i] != tc[i]&0x7f {
+				t.Errorf("unexpected change of the 7-bit data of input %q", tc)
+			}
+			// For 7-bit data the parity is the one of the resulting byte
+			if tc[i] < 0x80 && (bits.OnesCount8(even[i])%2 != 0 || bits.OnesCount8(odd[i])%2 != 1) {
+				t.Errorf("unexpected parity of %q for input %q", even[i], tc)
+			}
+		}
+	})
+}
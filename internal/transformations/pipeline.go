@@ -0,0 +1,178 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+	"github.com/corazawaf/coraza/v3/internal/sync"
+)
+
+// bufferTransformation is the buffer form of a transformation. It reads src
+// and writes the result into dst, reusing its capacity. src must not be
+// modified and must not alias dst. changed must only be false if the result
+// is equal to src, in which case dst is returned untouched.
+type bufferTransformation func(dst, src []byte) ([]byte, bool, error)
+
+// bufferTransformations has an entry for every built-in transformation with
+// its buffer form, or nil if it has none. Built-in transformations don't retain
+// their input, so they can read the pipeline buffers without copying them.
+var bufferTransformations = map[string]bufferTransformation{}
+
+// registerBuffer registers the buffer form of a built-in transformation.
+func registerBuffer(name string, trans bufferTransformation) {
+	bufferTransformations[strings.ToLower(name)] = trans
+}
+
+// bufferOf adapts a string transformation to the buffer form.
+func bufferOf(trans plugintypes.Transformation) bufferTransformation {
+	return func(dst, src []byte) ([]byte, bool, error) {
+		in := utils.WrapUnsafe(src)
+		res, _, err := trans(in)
+		// The result is compared as the changed flag of some transformations
+		// is not accurate.
+		if err != nil || res == in {
+			return dst, false, err
+		}
+		return append(dst[:0], res...), true, nil
+	}
+}
+
+// maxPooledBufferSize is the maximum capacity of the buffers returned to the
+// pool, larger ones are left to the garbage collector.
+const maxPooledBufferSize = 64 * 1024
+
+type pipelineBuffers struct {
+	src, dst []byte
+}
+
+var pipelineBuffersPool = sync.NewPool(func() interface{} {
+	return &pipelineBuffers{}
+})
+
+type pipelineStep struct {
+	trans   plugintypes.Transformation
+	buffer  bufferTransformation
+	builtin bool
+}
+
+// Pipeline runs a list of transformations fused together. Steps with a buffer
+// form work on reusable buffers, so intermediate results are not allocated and
+// only the final value is copied to a new string when it was transformed.
+type Pipeline struct {
+	steps []pipelineStep
+}
+
+// Add appends a transformation to the pipeline. name is the name it was
+// registered with and is used to look up its buffer form. Transformations
+// overridden with Register run as a regular string transformation.
+func (p *Pipeline) Add(name string, trans plugintypes.Transformation) {
+	buffer, builtin := bufferTransformations[strings.ToLower(name)]
+	p.steps = append(p.steps, pipelineStep{trans: trans, buffer: buffer, builtin: builtin})
+}
+
+// Len returns the number of transformations in the pipeline.
+func (p *Pipeline) Len() int {
+	return len(p.steps)
+}
+
+// Reset removes all the transformations from the pipeline.
+func (p *Pipeline) Reset() {
+	p.steps = nil
+}
+
+// Transform runs the transformations over value. As with the sequential
+// execution, a transformation returning an error is skipped and its error
+// is returned along with the result of the remaining ones.
+func (p *Pipeline) Transform(value string) (string, []error) {
+	switch len(p.steps) {
+	case 0:
+		return value, nil
+	case 1:
+		// Nothing to fuse, the string form allocates at most the result.
+		v, _, err := p.steps[0].trans(value)
+		if err != nil {
+			return value, []error{err}
+		}
+		return v, nil
+	}
+
+	var errs []error
+	bufs := pipelineBuffersPool.Get().(*pipelineBuffers)
+	// src is the current value. It is a read only view of value, or of
+	// bufs.src when dirty, which then has to be copied to a new string.
+	src := utils.UnwrapUnsafe(value)
+	dirty := false
+	for _, s := range p.steps {
+		if s.buffer == nil {
+			in := value
+			if dirty {
+				if s.builtin {
+					in = utils.WrapUnsafe(src)
+				} else {
+					value = string(src)
+					in = value
+					dirty = false
+				}
+			}
+			v, _, err := s.trans(in)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if v == in {
+				continue
+			}
+			if dirty && aliases(v, src) {
+				// v is a substring of bufs.src
+				bufs.dst = append(bufs.dst[:0], v...)
+				src = bufs.dst
+				bufs.src, bufs.dst = bufs.dst, bufs.src
+				continue
+			}
+			value = v
+			src = utils.UnwrapUnsafe(value)
+			dirty = false
+			continue
+		}
+
+		dst, changed, err := s.buffer(bufs.dst, src)
+		// dst may have grown even if the step did not change the value.
+		bufs.dst = dst
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if changed {
+			src = dst
+			bufs.src, bufs.dst = dst, bufs.src
+			dirty = true
+		}
+	}
+
+	if dirty {
+		value = string(src)
+	}
+	if cap(bufs.src) > maxPooledBufferSize {
+		bufs.src = nil
+	}
+	if cap(bufs.dst) > maxPooledBufferSize {
+		bufs.dst = nil
+	}
+	pipelineBuffersPool.Put(bufs)
+	return value, errs
+}
+
+// aliases reports whether s points to the memory of b.
+func aliases(s string, b []byte) bool {
+	if len(s) == 0 || cap(b) == 0 {
+		return false
+	}
+	p := uintptr(unsafe.Pointer(unsafe.StringData(s)))
+	start := uintptr(unsafe.Pointer(unsafe.SliceData(b)))
+	return p >= start && p < start+uintptr(cap(b))
+}
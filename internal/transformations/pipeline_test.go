@@ -0,0 +1,271 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
+)
+
+// crsTransformations are the transformation chains used by the CRS v4 rules,
+// sorted by the number of rules using them.
+var crsTransformations = []string{
+	"urlDecodeUni",
+	"lowercase",
+	"utf8toUnicode,urlDecodeUni,htmlEntityDecode,jsDecode,cssDecode,removeNulls",
+	"escapeSeqDecode",
+	"cmdLine",
+	"urlDecodeUni,replaceComments",
+	"htmlEntityDecode,lowercase",
+	"cmdLine,normalizePath",
+	"htmlEntityDecode",
+	"utf8toUnicode,urlDecodeUni,normalizePathWin",
+	"lowercase,urlDecodeUni",
+	"urlDecodeUni,jsDecode,htmlEntityDecode",
+	"sha1,hexEncode",
+	"length",
+	"utf8toUnicode,urlDecodeUni,removeNulls,cmdLine",
+	"normalisePath,urlDecodeUni",
+	"urlDecodeUni,replaceComments,removeWhitespace",
+	"utf8toUnicode,urlDecodeUni,htmlEntityDecode,jsDecode,cssDecode,lowercase,removeNulls",
+	"urlDecodeUni,compressWhitespace",
+	"htmlEntityDecode,compressWhitespace",
+	"utf8toUnicode,urlDecodeUni,removeNulls",
+	"lowercase,length",
+	"urlDecodeUni,lowercase",
+	"urlDecodeUni,htmlEntityDecode",
+	"escapeSeqDecode,compressWhitespace",
+	"urlDecodeUni,normalizePath,cmdLine",
+	"normalisePath",
+	"urlDecodeUni,jsDecode,removeWhitespace,base64Decode,urlDecodeUni,jsDecode,removeWhitespace",
+	"urlDecodeUni,jsDecode",
+	"urlDecodeUni,jsDecode,base64Decode,urlDecodeUni,jsDecode,replaceComments",
+	"urlDecodeUni,jsDecode,base64Decode,urlDecodeUni,jsDecode",
+	"utf8toUnicode,urlDecodeUni,htmlEntityDecode,jsDecode,cssDecode,removeNulls,removeWhitespace",
+	"lowercase,urlDecodeUni,htmlEntityDecode,jsDecode",
+	"urlDecodeUni,htmlEntityDecode,jsDecode",
+	"htmlEntityDecode,jsDecode",
+	"jsDecode,lowercase",
+	"urlDecodeUni,removeCommentsChar",
+	"urlDecodeUni,lowercase,removeWhitespace",
+	"utf8toUnicode,urlDecodeUni",
+	"removeWhitespace,lowercase",
+}
+
+var crsPayloads = []string{
+	"",
+	"/index.html",
+	"Mozilla/5.0 (X11; Linux x86_64; rv:120.0) Gecko/20100101 Firefox/120.0",
+	"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+	"1' UNION/**/SELECT password FROM users-- -",
+	"1%27%20or%20%271%27%3D%271",
+	"%3Cscript%3Ealert(%22xss%22)%3C%2Fscript%3E",
+	"&lt;img src=x onerror=\\u0061lert(1)&gt;",
+	"<a href=\"jav&#x09;ascript:alert(1)\">x</a>",
+	"..%2f..%2f..%2fetc%2fpasswd",
+	"..\\..\\windows\\win.ini",
+	";c\\a\\t /e'tc'/pa\"ss\"wd",
+	"\\x3cscript\\x3e \\74svg\\76 \\u003c",
+	"SELECT\x00 * FROM\x00 t",
+	"%u0053%uFF25LECT 1",
+	"café 中文 ＜script＞",
+	"Y2F0IC9ldGMvcGFzc3dk",
+	"a\t\t b\n\n  c",
+	"0x414243 UNION SELECT",
+}
+
+func crsPipeline(t testing.TB, chain string) (*Pipeline, []plugintypes.Transformation) {
+	t.Helper()
+	p := &Pipeline{}
+	var trans []plugintypes.Transformation
+	for _, name := range strings.Split(chain, ",") {
+		tt, err := GetTransformation(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.Add(name, tt)
+		trans = append(trans, tt)
+	}
+	return p, trans
+}
+
+func sequentialTransform(trans []plugintypes.Transformation, value string) (string, []error) {
+	var errs []error
+	for _, t := range trans {
+		v, _, err := t(value)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		value = v
+	}
+	return value, errs
+}
+
+func TestPipeline(t *testing.T) {
+	for _, chain := range crsTransformations {
+		p, trans := crsPipeline(t, chain)
+		if want, have := len(trans), p.Len(); want != have {
+			t.Errorf("unexpected length, want %d, have %d", want, have)
+		}
+		for _, payload := range crsPayloads {
+			want, wantErrs := sequentialTransform(trans, payload)
+			have, haveErrs := p.Transform(payload)
+			if want != have {
+				t.Errorf("%s(%q): want %q, have %q", chain, payload, want, have)
+			}
+			if len(wantErrs) != len(haveErrs) {
+				t.Errorf("%s(%q): want %d errors, have %d", chain, payload, len(wantErrs), len(haveErrs))
+			}
+		}
+	}
+}
+
+func TestPipelineErrors(t *testing.T) {
+	errFailed := errors.New("failed")
+	p := &Pipeline{}
+	p.Add("lowercase", lowerCase)
+	p.Add("fail", func(string) (string, bool, error) {
+		return "", true, errFailed
+	})
+	p.Add("removeNulls", removeNulls)
+
+	have, errs := p.Transform("ABC\x00")
+	if want := "abc"; want != have {
+		t.Errorf("want %q, have %q", want, have)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errFailed) {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	p.Reset()
+	if p.Len() != 0 {
+		t.Errorf("unexpected length after reset: %d", p.Len())
+	}
+	if have, errs := p.Transform("ABC"); have != "ABC" || errs != nil {
+		t.Errorf("unexpected result after reset: %q, %v", have, errs)
+	}
+}
+
+func TestPipelineRegisterOverride(t *testing.T) {
+	t.Cleanup(func() {
+		Register("lowercase", lowerCase)
+		registerBuffer("lowercase", lowerCaseBuffer)
+	})
+
+	upper, _ := GetTransformation("uppercase")
+	Register("lowerCase", upper)
+
+	p := &Pipeline{}
+	p.Add("lowercase", upper)
+	if have, _ := p.Transform("abc"); have != "ABC" {
+		t.Errorf("overridden transformation not used, have %q", have)
+	}
+}
+
+// TestBufferTransformations checks the buffer forms match their string form,
+// reusing a dirty buffer for dst.
+func TestBufferTransformations(t *testing.T) {
+	inputs := append([]string{}, crsPayloads...)
+	inputs = append(inputs, cmdLineTests...)
+	for _, tt := range b64DecodeTests {
+		inputs = append(inputs, tt.input)
+	}
+	inputs = append(inputs,
+		"&amp;&lt;&gt;&quot;&apos; &colon;&lpar;&Tab;&NewLine;",
+		"&ampfoo &notit; &notin; &NotEqualTilde; &AMP &a &;",
+		"&#x110000; &#0; &#128; &#65 &#x41 &#6 &#x; &#; &#xD800;",
+		"&&&# &#x3c;script&#X3E; &#12345678901234567890;",
+		"&#x80000000; &#x9F; &#159 &#65a &#xg &#xFFFFFFFFF; &#x0041; &#",
+		"/a/b/../c/./d/", "/../..", "./", ".", "/", "//", "a//b/", "..",
+		"..\\..\\x\\", "c:\\a\\..\\b", "\\", "./..\\",
+	)
+
+	for name, buffer := range bufferTransformations {
+		if buffer == nil {
+			continue
+		}
+		trans := transformations[name]
+		dst := []byte("dirty buffer content")
+		for _, input := range inputs {
+			want, _, wantErr := trans(input)
+			res, changed, err := buffer(dst, []byte(input))
+			if (wantErr != nil) != (err != nil) {
+				t.Errorf("%s(%q): want error %v, have %v", name, input, wantErr, err)
+			}
+			if wantErr != nil {
+				// Steps returning an error are skipped
+				continue
+			}
+			have := input
+			if changed {
+				have = string(res)
+				dst = res
+			}
+			if want != have {
+				t.Errorf("%s(%q): want %q, have %q", name, input, want, have)
+			}
+		}
+	}
+}
+
+func BenchmarkPipelineCRS(b *testing.B) {
+	type chain struct {
+		pipeline *Pipeline
+		trans    []plugintypes.Transformation
+	}
+	chains := make([]chain, 0, len(crsTransformations))
+	for _, c := range crsTransformations {
+		p, trans := crsPipeline(b, c)
+		chains = append(chains, chain{pipeline: p, trans: trans})
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, c := range chains {
+				for _, payload := range crsPayloads {
+					sequentialTransform(c.trans, payload)
+				}
+			}
+		}
+	})
+	b.Run("pipeline", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, c := range chains {
+				for _, payload := range crsPayloads {
+					c.pipeline.Transform(payload)
+				}
+			}
+		}
+	})
+}
+
+func FuzzPipeline(f *testing.F) {
+	for _, payload := range crsPayloads {
+		f.Add(payload)
+	}
+	type chain struct {
+		name     string
+		pipeline *Pipeline
+		trans    []plugintypes.Transformation
+	}
+	var chains []chain
+	for _, c := range crsTransformations {
+		p, trans := crsPipeline(f, c)
+		chains = append(chains, chain{name: c, pipeline: p, trans: trans})
+	}
+	f.Fuzz(func(t *testing.T, tc string) {
+		for _, c := range chains {
+			want, _ := sequentialTransform(c.trans, tc)
+			if have, _ := c.pipeline.Transform(tc); want != have {
+				t.Errorf("%s(%q): want %q, have %q", c.name, tc, want, have)
+			}
+		}
+	})
+}
@@ -4,6 +4,7 @@
 package transformations
 
 import (
+	"bytes"
 	"strings"
 )
 
@@ -12,3 +13,16 @@ func removeNulls(data string) (string, bool, error) {
 	transformedData := strings.ReplaceAll(data, "\x00", "")
 	return transformedData, len(data) != len(transformedData), nil
 }
+
+func removeNullsBuffer(dst, src []byte) ([]byte, bool, error) {
+	if bytes.IndexByte(src, 0) == -1 {
+		return dst, false, nil
+	}
+	dst = dst[:0]
+	for _, c := range src {
+		if c != 0 {
+			dst = append(dst, c)
+		}
+	}
+	return dst, true, nil
+}
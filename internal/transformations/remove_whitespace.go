@@ -6,6 +6,7 @@ package transformations
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // removeWhitespace removes all whitespace characters from input.
@@ -23,3 +24,37 @@ func removeWhitespace(data string) (string, bool, error) {
 
 	return transformedData, changed, nil
 }
+
+// removeWhitespaceBuffer only handles ASCII input natively, other input goes
+// through strings.Map to match the handling of invalid UTF-8.
+func removeWhitespaceBuffer(dst, src []byte) ([]byte, bool, error) {
+	changed := false
+	for _, c := range src {
+		if c >= utf8.RuneSelf {
+			return unicodeRemoveWhitespaceBuffer(dst, src)
+		}
+		if isASCIISpace(c) {
+			changed = true
+		}
+	}
+	if !changed {
+		return dst, false, nil
+	}
+	dst = dst[:0]
+	for _, c := range src {
+		if !isASCIISpace(c) {
+			dst = append(dst, c)
+		}
+	}
+	return dst, true, nil
+}
+
+var unicodeRemoveWhitespaceBuffer = bufferOf(removeWhitespace)
+
+func isASCIISpace(c byte) bool { // copied from unicode.IsSpace
+	switch c {
+	case '\t', '\n', '\v', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
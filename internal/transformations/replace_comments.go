@@ -3,17 +3,34 @@
 
 package transformations
 
+import (
+	"bytes"
+
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+)
+
 func replaceComments(data string) (string, bool, error) {
-	transformedData, changed := doReplaceComments(data)
-	return transformedData, changed, nil
+	transformedData, changed := doReplaceComments([]byte(data))
+	if !changed {
+		return data, false, nil
+	}
+	return utils.WrapUnsafe(transformedData), true, nil
+}
+
+func replaceCommentsBuffer(dst, src []byte) ([]byte, bool, error) {
+	if !bytes.Contains(src, []byte("/*")) {
+		return dst, false, nil
+	}
+	transformedData, _ := doReplaceComments(append(dst[:0], src...))
+	return transformedData, true, nil
 }
 
-func doReplaceComments(value string) (string, bool) {
+// doReplaceComments replaces comments in input in place.
+func doReplaceComments(input []byte) ([]byte, bool) {
 	var i, j int
 	incomment := false
 	changed := false
 
-	input := []byte(value)
 	inputLen := len(input)
 	for i < inputLen {
 		if !incomment {
@@ -43,5 +60,5 @@ func doReplaceComments(value string) (string, bool) {
 		j++
 	}
 
-	return string(input[0:j]), changed
+	return input[0:j], changed
 }
@@ -3,9 +3,25 @@
 
 package transformations
 
-import "strings"
+import (
+	"bytes"
+	"strings"
+)
 
 func replaceNulls(data string) (string, bool, error) {
 	transformedData := strings.ReplaceAll(data, "\x00", " ")
 	return transformedData, data != transformedData, nil
 }
+
+func replaceNullsBuffer(dst, src []byte) ([]byte, bool, error) {
+	if bytes.IndexByte(src, 0) == -1 {
+		return dst, false, nil
+	}
+	dst = append(dst[:0], src...)
+	for i, c := range dst {
+		if c == 0 {
+			dst[i] = ' '
+		}
+	}
+	return dst, true, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+)
+
+// sqlHexDecode decodes SQL hex literals, e.g. 0x414243 is decoded to ABC.
+// A trailing odd digit is kept as is.
+func sqlHexDecode(data string) (string, bool, error) {
+	for i := 0; i < len(data); i++ {
+		if isSQLHexLiteral(data, i) {
+			return utils.WrapUnsafe(doSQLHexDecode(data, []byte(data), i)), true, nil
+		}
+	}
+	return data, false, nil
+}
+
+func sqlHexDecodeBuffer(dst, src []byte) ([]byte, bool, error) {
+	input := utils.WrapUnsafe(src)
+	for i := 0; i < len(input); i++ {
+		if isSQLHexLiteral(input, i) {
+			return doSQLHexDecode(input, append(dst[:0], src...), i), true, nil
+		}
+	}
+	return dst, false, nil
+}
+
+// https://github.com/owasp-modsecurity/ModSecurity/blob/v3/master/src/actions/transformations/sql_hex_decode.cc
+func doSQLHexDecode(input string, d []byte, pos int) []byte {
+	inputLen := len(input)
+	i := pos
+	c := pos
+
+	for i < inputLen {
+		if !isSQLHexLiteral(input, i) {
+			d[c] = input[i]
+			c++
+			i++
+			continue
+		}
+		/* Skip the 0x prefix and decode every pair of hex digits. */
+		i += 2
+		for i+1 < inputLen && utils.ValidHex(input[i]) && utils.ValidHex(input[i+1]) {
+			d[c] = utils.X2c(input[i:])
+			c++
+			i += 2
+		}
+	}
+
+	return d[:c]
+}
+
+// isSQLHexLiteral reports whether a 0x prefix followed by at least two hex
+// digits starts at i
+func isSQLHexLiteral(input string, i int) bool {
+	return i+3 < len(input) && input[i] == '0' && (input[i+1] == 'x' || input[i+1] == 'X') &&
+		utils.ValidHex(input[i+2]) && utils.ValidHex(input[i+3])
+}
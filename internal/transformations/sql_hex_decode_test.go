@@ -0,0 +1,78 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import "testing"
+
+var sqlHexDecodeTests = []struct {
+	input string
+	want  string
+}{
+	{
+		input: "",
+		want:  "",
+	},
+	{
+		input: "TestCase",
+		want:  "TestCase",
+	},
+	{
+		input: "0x414243",
+		want:  "ABC",
+	},
+	{
+		input: "SELECT 0X53514c, 0x6a6f686e",
+		want:  "SELECT SQL, john",
+	},
+	{
+		input: "0x4142434",
+		want:  "ABC4",
+	},
+	{
+		input: "0x4g 0x 0x",
+		want:  "0x4g 0x 0x",
+	},
+	{
+		input: "00x41",
+		want:  "0A",
+	},
+}
+
+func TestSQLHexDecode(t *testing.T) {
+	for _, tt := range sqlHexDecodeTests {
+		t.Run(tt.input, func(t *testing.T) {
+			have, changed, err := sqlHexDecode(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			shouldChange := tt.input != tt.want
+			if changed != shouldChange {
+				t.Errorf("unexpected changed value, want %t, have %t", shouldChange, changed)
+			}
+
+			if have != tt.want {
+				t.Errorf("unexpected value, want %q, have %q", tt.want, have)
+			}
+		})
+	}
+}
+
+func FuzzSQLHexDecode(f *testing.F) {
+	for _, tc := range sqlHexDecodeTests {
+		f.Add(tc.input)
+	}
+	f.Fuzz(func(t *testing.T, tc string) {
+		data, changed, err := sqlHexDecode(tc)
+		if err != nil {
+			t.Error(err)
+		}
+		if changed == (data == tc) {
+			t.Errorf("unexpected changed value %t for input %q", changed, tc)
+		}
+		if len(data) > len(tc) {
+			t.Errorf("decoded %q is longer than input %q", data, tc)
+		}
+	})
+}
@@ -5,6 +5,7 @@ package transformations
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/corazawaf/coraza/v3/experimental/plugins/plugintypes"
@@ -15,7 +16,10 @@ var transformations = map[string]plugintypes.Transformation{}
 // Register registers a transformation by name
 // If the transformation is already registered, it will be overwritten
 func Register(name string, trans plugintypes.Transformation) {
-	transformations[strings.ToLower(name)] = trans
+	name = strings.ToLower(name)
+	transformations[name] = trans
+	// Overridden transformations run through their string form
+	delete(bufferTransformations, name)
 }
 
 // GetTransformation returns a transformation by name
@@ -33,9 +37,11 @@ func init() {
 	Register("base64Encode", base64encode)
 	Register("cmdLine", cmdLine)
 	Register("compressWhitespace", compressWhitespace)
+	Register("confusablesSkeleton", confusablesSkeleton)
 	Register("cssDecode", cssDecode)
 	Register("escapeSeqDecode", escapeSeqDecode)
 	Register("hexDecode", hexDecode)
+	Register("hexDecodeExt", hexDecodeExt)
 	Register("hexEncode", hexEncode)
 	Register("htmlEntityDecode", htmlEntityDecode)
 	Register("jsDecode", jsDecode)
@@ -47,6 +53,9 @@ func init() {
 	Register("normalisePathWin", normalisePathWin)
 	Register("normalizePath", normalisePath)
 	Register("normalizePathWin", normalisePathWin)
+	Register("parityEven7bit", parityEven7bit)
+	Register("parityOdd7bit", parityOdd7bit)
+	Register("parityZero7bit", parityZero7bit)
 	Register("removeComments", removeComments)
 	Register("removeCommentsChar", removeCommentsChar)
 	Register("removeNulls", removeNulls)
@@ -54,6 +63,8 @@ func init() {
 	Register("replaceComments", replaceComments)
 	Register("replaceNulls", replaceNulls)
 	Register("sha1", sha1T)
+	Register("sqlHexDecode", sqlHexDecode)
+	Register("unicodeNormalizeNFKC", unicodeNormalizeNFKC)
 	Register("uppercase", upperCase)
 	Register("urlDecode", urlDecode)
 	Register("urlDecodeUni", urlDecodeUni)
@@ -62,4 +73,33 @@ func init() {
 	Register("trim", trim)
 	Register("trimLeft", trimLeft)
 	Register("trimRight", trimRight)
+
+	for name := range transformations {
+		registerBuffer(name, nil)
+	}
+	registerBuffer("cmdLine", cmdLineBuffer)
+	registerBuffer("compressWhitespace", compressWhitespaceBuffer)
+	registerBuffer("cssDecode", cssDecodeBuffer)
+	registerBuffer("escapeSeqDecode", escapeSeqDecodeBuffer)
+	registerBuffer("htmlEntityDecode", htmlEntityDecodeBuffer)
+	registerBuffer("jsDecode", jsDecodeBuffer)
+	registerBuffer("lowercase", lowerCaseBuffer)
+	registerBuffer("parityEven7bit", parityEven7bitBuffer)
+	registerBuffer("parityOdd7bit", parityOdd7bitBuffer)
+	registerBuffer("parityZero7bit", parityZero7bitBuffer)
+	registerBuffer("removeNulls", removeNullsBuffer)
+	registerBuffer("removeWhitespace", removeWhitespaceBuffer)
+	registerBuffer("replaceComments", replaceCommentsBuffer)
+	registerBuffer("replaceNulls", replaceNullsBuffer)
+	registerBuffer("sqlHexDecode", sqlHexDecodeBuffer)
+	registerBuffer("uppercase", upperCaseBuffer)
+	registerBuffer("urlDecode", urlDecodeBuffer)
+	registerBuffer("urlDecodeUni", urlDecodeUniBuffer)
+	registerBuffer("utf8toUnicode", utf8ToUnicodeBuffer)
+	if filepath.Separator == '/' {
+		registerBuffer("normalisePath", normalisePathBuffer)
+		registerBuffer("normalisePathWin", normalisePathWinBuffer)
+		registerBuffer("normalizePath", normalisePathBuffer)
+		registerBuffer("normalizePathWin", normalisePathWinBuffer)
+	}
 }
@@ -0,0 +1,214 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"cmp"
+	"slices"
+	"unicode/utf8"
+
+	utils "github.com/corazawaf/coraza/v3/internal/strings"
+)
+
+//go:generate go run generator/main.go
+
+// runeRange is a range of runes sharing the value of a property
+type runeRange struct {
+	lo, hi rune
+	value  uint8
+}
+
+// runeMapping maps a rune to the slice of a string
+type runeMapping struct {
+	r   rune
+	off uint16
+	n   uint8
+}
+
+// runeComposition is the canonical composition of a pair of runes
+type runeComposition struct {
+	first, second, r rune
+}
+
+// https://www.unicode.org/versions/Unicode15.0.0/ch03.pdf#G56669
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// unicodeNormalizeNFKC normalizes data to the Unicode Normalization Form KC,
+// folding compatibility characters such as fullwidth forms, ligatures and
+// superscripts, and composing combining marks. Invalid UTF-8 bytes are kept
+// as is.
+func unicodeNormalizeNFKC(data string) (string, bool, error) {
+	// The runes before the first one failing the quick check are kept as is,
+	// but for the last one that may compose with the following runes.
+	prev := 0
+	for i := 0; i < len(data); {
+		if data[i] < utf8.RuneSelf {
+			prev = i
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(data[i:])
+		if size > 1 && inRanges(nfkcQuickCheckNo, r) {
+			res := make([]byte, 0, len(data)+utf8.UTFMax)
+			res = append(res, data[:prev]...)
+			res = appendNFKC(res, data[prev:])
+			if string(res) == data {
+				return data, false, nil
+			}
+			return utils.WrapUnsafe(res), true, nil
+		}
+		prev = i
+		i += size
+	}
+	return data, false, nil
+}
+
+// appendNFKC appends the normalization of s to dst. Invalid UTF-8 bytes are
+// boundaries, neither reordered nor composed.
+func appendNFKC(dst []byte, s string) []byte {
+	var seg []rune
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = appendComposed(dst, seg)
+			dst = append(dst, s[i])
+			seg = seg[:0]
+			i++
+			continue
+		}
+		seg = appendDecomposed(seg, r)
+		i += size
+	}
+	return appendComposed(dst, seg)
+}
+
+// appendDecomposed appends the full compatibility decomposition of r
+func appendDecomposed(dst []rune, r rune) []rune {
+	if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+		dst = append(dst, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			dst = append(dst, hangulTBase+t)
+		}
+		return dst
+	}
+	if d, ok := lookupMapping(nfkdDecompositions, nfkdData, r); ok {
+		for _, c := range d {
+			dst = append(dst, c)
+		}
+		return dst
+	}
+	return append(dst, r)
+}
+
+// appendComposed puts the decomposed runes in canonical order, composes them
+// and appends them to dst
+func appendComposed(dst []byte, runes []rune) []byte {
+	for i := 0; i < len(runes); {
+		if combiningClass(runes[i]) == 0 {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(runes) && combiningClass(runes[j]) != 0 {
+			j++
+		}
+		slices.SortStableFunc(runes[i:j], func(a, b rune) int {
+			return cmp.Compare(combiningClass(a), combiningClass(b))
+		})
+		i = j
+	}
+
+	// https://www.unicode.org/versions/Unicode15.0.0/ch03.pdf#G50628
+	starter, lastClass := -1, -1
+	n := 0
+	for _, r := range runes {
+		class := int(combiningClass(r))
+		if starter >= 0 && (lastClass == -1 || lastClass < class) {
+			if c, ok := compose(runes[starter], r); ok {
+				runes[starter] = c
+				continue
+			}
+		}
+		if class == 0 {
+			starter, lastClass = n, -1
+		} else {
+			lastClass = class
+		}
+		runes[n] = r
+		n++
+	}
+
+	for _, r := range runes[:n] {
+		dst = utf8.AppendRune(dst, r)
+	}
+	return dst
+}
+
+// compose returns the primary composite of a pair of runes
+func compose(a, b rune) (rune, bool) {
+	if l, v := a-hangulLBase, b-hangulVBase; l >= 0 && l < hangulLCount && v >= 0 && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, t := a-hangulSBase, b-hangulTBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 && t > 0 && t < hangulTCount {
+		return a + t, true
+	}
+	i, ok := slices.BinarySearchFunc(nfkcCompositions, [2]rune{a, b}, func(c runeComposition, pair [2]rune) int {
+		if c.first != pair[0] {
+			return cmp.Compare(c.first, pair[0])
+		}
+		return cmp.Compare(c.second, pair[1])
+	})
+	if !ok {
+		return 0, false
+	}
+	return nfkcCompositions[i].r, true
+}
+
+func combiningClass(r rune) uint8 {
+	if r < 0x300 {
+		return 0
+	}
+	i, ok := slices.BinarySearchFunc(nfkcCombiningClasses, r, compareRange)
+	if !ok {
+		return 0
+	}
+	return nfkcCombiningClasses[i].value
+}
+
+func inRanges(ranges []runeRange, r rune) bool {
+	_, ok := slices.BinarySearchFunc(ranges, r, compareRange)
+	return ok
+}
+
+func compareRange(rr runeRange, r rune) int {
+	switch {
+	case rr.hi < r:
+		return -1
+	case rr.lo > r:
+		return 1
+	}
+	return 0
+}
+
+// lookupMapping returns the slice of data a rune is mapped to
+func lookupMapping(mappings []runeMapping, data string, r rune) (string, bool) {
+	i, ok := slices.BinarySearchFunc(mappings, r, func(m runeMapping, r rune) int {
+		return cmp.Compare(m.r, r)
+	})
+	if !ok {
+		return "", false
+	}
+	m := mappings[i]
+	return data[m.off : int(m.off)+int(m.n)], true
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformations
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var unicodeNormalizeNFKCTests = []struct {
+	input string
+	want  string
+}{
+	{
+		input: "",
+		want:  "",
+	},
+	{
+		input: "TestCase",
+		want:  "TestCase",
+	},
+	{
+		input: "ＳＥＬＥＣＴ 𝐬𝐥𝐞𝐞𝐩(①)",
+		want:  "SELECT sleep(1)",
+	},
+	{
+		input: "ﬁle ½ x²",
+		want:  "file 1⁄2 x2",
+	},
+	{
+		input: "cafe\u0301 d\u0307\u0323 q\u0307\u0323",
+		want:  "caf\u00e9 \u1e0d\u0307 q\u0323\u0307",
+	},
+	{
+		input: "caf\u00e9 中文 \u03a9",
+		want:  "caf\u00e9 中文 \u03a9",
+	},
+	{
+		input: "\u1100\u1161\u11a8 \uac00\u11a8 \u3131",
+		want:  "\uac01 \uac01 \u1100",
+	},
+	{
+		input: "\u0301\u00e1",
+		want:  "\u0301\u00e1",
+	},
+	{
+		input: "e\xff\u0301",
+		want:  "e\xff\u0301",
+	},
+	{
+		input: "\U00011f41\u0300",
+		want:  "\U00011f41\u0300",
+	},
+}
+
+func TestUnicodeNormalizeNFKC(t *testing.T) {
+	for _, tt := range unicodeNormalizeNFKCTests {
+		t.Run(tt.input, func(t *testing.T) {
+			have, changed, err := unicodeNormalizeNFKC(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			shouldChange := tt.input != tt.want
+			if changed != shouldChange {
+				t.Errorf("unexpected changed value, want %t, have %t", shouldChange, changed)
+			}
+
+			if have != tt.want {
+				t.Errorf("unexpected value, want %q, have %q", tt.want, have)
+			}
+		})
+	}
+}
+
+func BenchmarkUnicodeNormalizeNFKC(b *testing.B) {
+	tests := []string{
+		"hello world",
+		"café crème brûlée",
+		"ＳＥＬＥＣＴ 𝐬𝐥𝐞𝐞𝐩(①)",
+	}
+
+	for _, tt := range tests {
+		b.Run(tt, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, _, err := unicodeNormalizeNFKC(tt); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func FuzzUnicodeNormalizeNFKC(f *testing.F) {
+	for _, tc := range unicodeNormalizeNFKCTests {
+		f.Add(tc.input)
+	}
+	f.Fuzz(func(t *testing.T, tc string) {
+		data, changed, err := unicodeNormalizeNFKC(tc)
+		if err != nil {
+			t.Error(err)
+		}
+		if changed == (data == tc) {
+			t.Errorf("unexpected changed value %t for input %q", changed, tc)
+		}
+		if again, _, _ := unicodeNormalizeNFKC(data); again != data {
+			t.Errorf("normalizing %q again gives %q", data, again)
+		}
+		// The composition of golang.org/x/text is not used as reference, as it
+		// wrongly composes some supplementary runes and combining starters, but
+		// the results must be equivalent.
+		if utf8.ValidString(tc) && norm.NFKD.String(data) != norm.NFKD.String(tc) {
+			t.Errorf("normalized %q is not equivalent to input %q", data, tc)
+		}
+	})
+}
@@ -0,0 +1,13446 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by internal/transformations/generator DO NOT EDIT.
+
+package transformations
+
+// unicodeVersion is the version of the Unicode Character Database the tables
+// are generated from
+const unicodeVersion = "15.0.0"
+
+// confusablesVersion is the version of Unicode's confusables.txt the skeletons
+// are generated from, of which 1781 mappings to ASCII are used
+const confusablesVersion = "15.0.0"
+
+// nfkcCombiningClasses are the ranges of runes with a non-zero canonical
+// combining class
+var nfkcCombiningClasses = []runeRange{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216},
+	{0x031C, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230},
+	{0x034D, 0x034E, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035A, 220},
+	{0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233},
+	{0x035D, 0x035E, 234},
+	{0x035F, 0x035F, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036F, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220},
+	{0x059C, 0x05A1, 230},
+	{0x05A2, 0x05A7, 220},
+	{0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220},
+	{0x05AB, 0x05AC, 230},
+	{0x05AD, 0x05AD, 222},
+	{0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230},
+	{0x05B0, 0x05B0, 10},
+	{0x05B1, 0x05B1, 11},
+	{0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13},
+	{0x05B4, 0x05B4, 14},
+	{0x05B5, 0x05B5, 15},
+	{0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17},
+	{0x05B8, 0x05B8, 18},
+	{0x05B9, 0x05BA, 19},
+	{0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21},
+	{0x05BD, 0x05BD, 22},
+	{0x05BF, 0x05BF, 23},
+	{0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25},
+	{0x05C4, 0x05C4, 230},
+	{0x05C5, 0x05C5, 220},
+	{0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27},
+	{0x064C, 0x064C, 28},
+	{0x064D, 0x064D, 29},
+	{0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065B, 230},
+	{0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230},
+	{0x065F, 0x065F, 220},
+	{0x0670, 0x0670, 35},
+	{0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230},
+	{0x06E3, 0x06E3, 220},
+	{0x06E4, 0x06E4, 230},
+	{0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220},
+	{0x06EB, 0x06EC, 230},
+	{0x06ED, 0x06ED, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073A, 0x073A, 230},
+	{0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230},
+	{0x073E, 0x073E, 220},
+	{0x073F, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074A, 230},
+	{0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220},
+	{0x07F3, 0x07F3, 230},
+	{0x07FD, 0x07FD, 220},
+	{0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082D, 230},
+	{0x0859, 0x085B, 220},
+	{0x0898, 0x0898, 230},
+	{0x0899, 0x089B, 220},
+	{0x089C, 0x089F, 230},
+	{0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220},
+	{0x08D4, 0x08E1, 230},
+	{0x08E3, 0x08E3, 220},
+	{0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220},
+	{0x08E7, 0x08E8, 230},
+	{0x08E9, 0x08E9, 220},
+	{0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220},
+	{0x08F0, 0x08F0, 27},
+	{0x08F1, 0x08F1, 28},
+	{0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230},
+	{0x08F6, 0x08F6, 220},
+	{0x08F7, 0x08F8, 230},
+	{0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230},
+	{0x093C, 0x093C, 7},
+	{0x094D, 0x094D, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09BC, 0x09BC, 7},
+	{0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230},
+	{0x0A3C, 0x0A3C, 7},
+	{0x0A4D, 0x0A4D, 9},
+	{0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9},
+	{0x0B3C, 0x0B3C, 7},
+	{0x0B4D, 0x0B4D, 9},
+	{0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7},
+	{0x0C4D, 0x0C4D, 9},
+	{0x0C55, 0x0C55, 84},
+	{0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7},
+	{0x0CCD, 0x0CCD, 9},
+	{0x0D3B, 0x0D3C, 9},
+	{0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9},
+	{0x0E38, 0x0E39, 103},
+	{0x0E3A, 0x0E3A, 9},
+	{0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118},
+	{0x0EBA, 0x0EBA, 9},
+	{0x0EC8, 0x0ECB, 122},
+	{0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220},
+	{0x0F37, 0x0F37, 220},
+	{0x0F39, 0x0F39, 216},
+	{0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130},
+	{0x0F74, 0x0F74, 132},
+	{0x0F7A, 0x0F7D, 130},
+	{0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230},
+	{0x0F84, 0x0F84, 9},
+	{0x0F86, 0x0F87, 230},
+	{0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103A, 9},
+	{0x108D, 0x108D, 220},
+	{0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17D2, 0x17D2, 9},
+	{0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193A, 0x193A, 230},
+	{0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230},
+	{0x1A18, 0x1A18, 220},
+	{0x1A60, 0x1A60, 9},
+	{0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220},
+	{0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220},
+	{0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220},
+	{0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230},
+	{0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230},
+	{0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ACE, 230},
+	{0x1B34, 0x1B34, 7},
+	{0x1B44, 0x1B44, 9},
+	{0x1B6B, 0x1B6B, 230},
+	{0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230},
+	{0x1BAA, 0x1BAB, 9},
+	{0x1BE6, 0x1BE6, 7},
+	{0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7},
+	{0x1CD0, 0x1CD2, 230},
+	{0x1CD4, 0x1CD4, 1},
+	{0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230},
+	{0x1CDC, 0x1CDF, 220},
+	{0x1CE0, 0x1CE0, 230},
+	{0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220},
+	{0x1CF4, 0x1CF4, 230},
+	{0x1CF8, 0x1CF9, 230},
+	{0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220},
+	{0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220},
+	{0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234},
+	{0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220},
+	{0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230},
+	{0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228},
+	{0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218},
+	{0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233},
+	{0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230},
+	{0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230},
+	{0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230},
+	{0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230},
+	{0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1},
+	{0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220},
+	{0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1},
+	{0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230},
+	{0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9},
+	{0x2DE0, 0x2DFF, 230},
+	{0x302A, 0x302A, 218},
+	{0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232},
+	{0x302D, 0x302D, 222},
+	{0x302E, 0x302F, 224},
+	{0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230},
+	{0xA674, 0xA67D, 230},
+	{0xA69E, 0xA69F, 230},
+	{0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9},
+	{0xA82C, 0xA82C, 9},
+	{0xA8C4, 0xA8C4, 9},
+	{0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220},
+	{0xA953, 0xA953, 9},
+	{0xA9B3, 0xA9B3, 7},
+	{0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230},
+	{0xAAB2, 0xAAB3, 230},
+	{0xAAB4, 0xAAB4, 220},
+	{0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230},
+	{0xAAC1, 0xAAC1, 230},
+	{0xAAF6, 0xAAF6, 9},
+	{0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26},
+	{0xFE20, 0xFE26, 230},
+	{0xFE27, 0xFE2D, 220},
+	{0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220},
+	{0x102E0, 0x102E0, 220},
+	{0x10376, 0x1037A, 230},
+	{0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230},
+	{0x10A38, 0x10A38, 230},
+	{0x10A39, 0x10A39, 1},
+	{0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9},
+	{0x10AE5, 0x10AE5, 230},
+	{0x10AE6, 0x10AE6, 220},
+	{0x10D24, 0x10D27, 230},
+	{0x10EAB, 0x10EAC, 230},
+	{0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220},
+	{0x10F48, 0x10F4A, 230},
+	{0x10F4B, 0x10F4B, 220},
+	{0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220},
+	{0x10F82, 0x10F82, 230},
+	{0x10F83, 0x10F83, 220},
+	{0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9},
+	{0x110BA, 0x110BA, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111C0, 0x111C0, 9},
+	{0x111CA, 0x111CA, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112E9, 0x112E9, 7},
+	{0x112EA, 0x112EA, 9},
+	{0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9},
+	{0x11366, 0x1136C, 230},
+	{0x11370, 0x11374, 230},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145E, 0x1145E, 230},
+	{0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7},
+	{0x115BF, 0x115BF, 9},
+	{0x115C0, 0x115C0, 7},
+	{0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9},
+	{0x116B7, 0x116B7, 7},
+	{0x1172B, 0x1172B, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7},
+	{0x1193D, 0x1193E, 9},
+	{0x11943, 0x11943, 7},
+	{0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9},
+	{0x11A47, 0x11A47, 9},
+	{0x11A99, 0x11A99, 9},
+	{0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7},
+	{0x11D44, 0x11D45, 9},
+	{0x11D97, 0x11D97, 9},
+	{0x11F41, 0x11F42, 9},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 230},
+	{0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1},
+	{0x1D165, 0x1D166, 216},
+	{0x1D167, 0x1D169, 1},
+	{0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216},
+	{0x1D17B, 0x1D182, 220},
+	{0x1D185, 0x1D189, 230},
+	{0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230},
+	{0x1D242, 0x1D244, 230},
+	{0x1E000, 0x1E006, 230},
+	{0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230},
+	{0x1E023, 0x1E024, 230},
+	{0x1E026, 0x1E02A, 230},
+	{0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230},
+	{0x1E2AE, 0x1E2AE, 230},
+	{0x1E2EC, 0x1E2EF, 230},
+	{0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220},
+	{0x1E4EF, 0x1E4EF, 230},
+	{0x1E8D0, 0x1E8D6, 220},
+	{0x1E944, 0x1E949, 230},
+	{0x1E94A, 0x1E94A, 7},
+}
+
+// nfkcQuickCheckNo are the ranges of runes that may change when normalizing
+// to NFKC, either decomposing, reordering or combining with a previous rune
+var nfkcQuickCheckNo = []runeRange{
+	{0x00A0, 0x00A0, 0},
+	{0x00A8, 0x00A8, 0},
+	{0x00AA, 0x00AA, 0},
+	{0x00AF, 0x00AF, 0},
+	{0x00B2, 0x00B5, 0},
+	{0x00B8, 0x00BA, 0},
+	{0x00BC, 0x00BE, 0},
+	{0x0132, 0x0133, 0},
+	{0x013F, 0x0140, 0},
+	{0x0149, 0x0149, 0},
+	{0x017F, 0x017F, 0},
+	{0x01C4, 0x01CC, 0},
+	{0x01F1, 0x01F3, 0},
+	{0x02B0, 0x02B8, 0},
+	{0x02D8, 0x02DD, 0},
+	{0x02E0, 0x02E4, 0},
+	{0x0300, 0x034E, 0},
+	{0x0350, 0x036F, 0},
+	{0x0374, 0x0374, 0},
+	{0x037A, 0x037A, 0},
+	{0x037E, 0x037E, 0},
+	{0x0384, 0x0385, 0},
+	{0x0387, 0x0387, 0},
+	{0x03D0, 0x03D6, 0},
+	{0x03F0, 0x03F2, 0},
+	{0x03F4, 0x03F5, 0},
+	{0x03F9, 0x03F9, 0},
+	{0x0483, 0x0487, 0},
+	{0x0587, 0x0587, 0},
+	{0x0591, 0x05BD, 0},
+	{0x05BF, 0x05BF, 0},
+	{0x05C1, 0x05C2, 0},
+	{0x05C4, 0x05C5, 0},
+	{0x05C7, 0x05C7, 0},
+	{0x0610, 0x061A, 0},
+	{0x064B, 0x065F, 0},
+	{0x0670, 0x0670, 0},
+	{0x0675, 0x0678, 0},
+	{0x06D6, 0x06DC, 0},
+	{0x06DF, 0x06E4, 0},
+	{0x06E7, 0x06E8, 0},
+	{0x06EA, 0x06ED, 0},
+	{0x0711, 0x0711, 0},
+	{0x0730, 0x074A, 0},
+	{0x07EB, 0x07F3, 0},
+	{0x07FD, 0x07FD, 0},
+	{0x0816, 0x0819, 0},
+	{0x081B, 0x0823, 0},
+	{0x0825, 0x0827, 0},
+	{0x0829, 0x082D, 0},
+	{0x0859, 0x085B, 0},
+	{0x0898, 0x089F, 0},
+	{0x08CA, 0x08E1, 0},
+	{0x08E3, 0x08FF, 0},
+	{0x093C, 0x093C, 0},
+	{0x094D, 0x094D, 0},
+	{0x0951, 0x0954, 0},
+	{0x0958, 0x095F, 0},
+	{0x09BC, 0x09BC, 0},
+	{0x09BE, 0x09BE, 0},
+	{0x09CD, 0x09CD, 0},
+	{0x09D7, 0x09D7, 0},
+	{0x09DC, 0x09DD, 0},
+	{0x09DF, 0x09DF, 0},
+	{0x09FE, 0x09FE, 0},
+	{0x0A33, 0x0A33, 0},
+	{0x0A36, 0x0A36, 0},
+	{0x0A3C, 0x0A3C, 0},
+	{0x0A4D, 0x0A4D, 0},
+	{0x0A59, 0x0A5B, 0},
+	{0x0A5E, 0x0A5E, 0},
+	{0x0ABC, 0x0ABC, 0},
+	{0x0ACD, 0x0ACD, 0},
+	{0x0B3C, 0x0B3C, 0},
+	{0x0B3E, 0x0B3E, 0},
+	{0x0B4D, 0x0B4D, 0},
+	{0x0B56, 0x0B57, 0},
+	{0x0B5C, 0x0B5D, 0},
+	{0x0BBE, 0x0BBE, 0},
+	{0x0BCD, 0x0BCD, 0},
+	{0x0BD7, 0x0BD7, 0},
+	{0x0C3C, 0x0C3C, 0},
+	{0x0C4D, 0x0C4D, 0},
+	{0x0C55, 0x0C56, 0},
+	{0x0CBC, 0x0CBC, 0},
+	{0x0CC2, 0x0CC2, 0},
+	{0x0CCD, 0x0CCD, 0},
+	{0x0CD5, 0x0CD6, 0},
+	{0x0D3B, 0x0D3C, 0},
+	{0x0D3E, 0x0D3E, 0},
+	{0x0D4D, 0x0D4D, 0},
+	{0x0D57, 0x0D57, 0},
+	{0x0DCA, 0x0DCA, 0},
+	{0x0DCF, 0x0DCF, 0},
+	{0x0DDF, 0x0DDF, 0},
+	{0x0E33, 0x0E33, 0},
+	{0x0E38, 0x0E3A, 0},
+	{0x0E48, 0x0E4B, 0},
+	{0x0EB3, 0x0EB3, 0},
+	{0x0EB8, 0x0EBA, 0},
+	{0x0EC8, 0x0ECB, 0},
+	{0x0EDC, 0x0EDD, 0},
+	{0x0F0C, 0x0F0C, 0},
+	{0x0F18, 0x0F19, 0},
+	{0x0F35, 0x0F35, 0},
+	{0x0F37, 0x0F37, 0},
+	{0x0F39, 0x0F39, 0},
+	{0x0F43, 0x0F43, 0},
+	{0x0F4D, 0x0F4D, 0},
+	{0x0F52, 0x0F52, 0},
+	{0x0F57, 0x0F57, 0},
+	{0x0F5C, 0x0F5C, 0},
+	{0x0F69, 0x0F69, 0},
+	{0x0F71, 0x0F7D, 0},
+	{0x0F80, 0x0F84, 0},
+	{0x0F86, 0x0F87, 0},
+	{0x0F93, 0x0F93, 0},
+	{0x0F9D, 0x0F9D, 0},
+	{0x0FA2, 0x0FA2, 0},
+	{0x0FA7, 0x0FA7, 0},
+	{0x0FAC, 0x0FAC, 0},
+	{0x0FB9, 0x0FB9, 0},
+	{0x0FC6, 0x0FC6, 0},
+	{0x102E, 0x102E, 0},
+	{0x1037, 0x1037, 0},
+	{0x1039, 0x103A, 0},
+	{0x108D, 0x108D, 0},
+	{0x10FC, 0x10FC, 0},
+	{0x1161, 0x1175, 0},
+	{0x11A8, 0x11C2, 0},
+	{0x135D, 0x135F, 0},
+	{0x1714, 0x1715, 0},
+	{0x1734, 0x1734, 0},
+	{0x17D2, 0x17D2, 0},
+	{0x17DD, 0x17DD, 0},
+	{0x18A9, 0x18A9, 0},
+	{0x1939, 0x193B, 0},
+	{0x1A17, 0x1A18, 0},
+	{0x1A60, 0x1A60, 0},
+	{0x1A75, 0x1A7C, 0},
+	{0x1A7F, 0x1A7F, 0},
+	{0x1AB0, 0x1ABD, 0},
+	{0x1ABF, 0x1ACE, 0},
+	{0x1B34, 0x1B35, 0},
+	{0x1B44, 0x1B44, 0},
+	{0x1B6B, 0x1B73, 0},
+	{0x1BAA, 0x1BAB, 0},
+	{0x1BE6, 0x1BE6, 0},
+	{0x1BF2, 0x1BF3, 0},
+	{0x1C37, 0x1C37, 0},
+	{0x1CD0, 0x1CD2, 0},
+	{0x1CD4, 0x1CE0, 0},
+	{0x1CE2, 0x1CE8, 0},
+	{0x1CED, 0x1CED, 0},
+	{0x1CF4, 0x1CF4, 0},
+	{0x1CF8, 0x1CF9, 0},
+	{0x1D2C, 0x1D2E, 0},
+	{0x1D30, 0x1D3A, 0},
+	{0x1D3C, 0x1D4D, 0},
+	{0x1D4F, 0x1D6A, 0},
+	{0x1D78, 0x1D78, 0},
+	{0x1D9B, 0x1DFF, 0},
+	{0x1E9A, 0x1E9B, 0},
+	{0x1F71, 0x1F71, 0},
+	{0x1F73, 0x1F73, 0},
+	{0x1F75, 0x1F75, 0},
+	{0x1F77, 0x1F77, 0},
+	{0x1F79, 0x1F79, 0},
+	{0x1F7B, 0x1F7B, 0},
+	{0x1F7D, 0x1F7D, 0},
+	{0x1FBB, 0x1FBB, 0},
+	{0x1FBD, 0x1FC1, 0},
+	{0x1FC9, 0x1FC9, 0},
+	{0x1FCB, 0x1FCB, 0},
+	{0x1FCD, 0x1FCF, 0},
+	{0x1FD3, 0x1FD3, 0},
+	{0x1FDB, 0x1FDB, 0},
+	{0x1FDD, 0x1FDF, 0},
+	{0x1FE3, 0x1FE3, 0},
+	{0x1FEB, 0x1FEB, 0},
+	{0x1FED, 0x1FEF, 0},
+	{0x1FF9, 0x1FF9, 0},
+	{0x1FFB, 0x1FFB, 0},
+	{0x1FFD, 0x1FFE, 0},
+	{0x2000, 0x200A, 0},
+	{0x2011, 0x2011, 0},
+	{0x2017, 0x2017, 0},
+	{0x2024, 0x2026, 0},
+	{0x202F, 0x202F, 0},
+	{0x2033, 0x2034, 0},
+	{0x2036, 0x2037, 0},
+	{0x203C, 0x203C, 0},
+	{0x203E, 0x203E, 0},
+	{0x2047, 0x2049, 0},
+	{0x2057, 0x2057, 0},
+	{0x205F, 0x205F, 0},
+	{0x2070, 0x2071, 0},
+	{0x2074, 0x208E, 0},
+	{0x2090, 0x209C, 0},
+	{0x20A8, 0x20A8, 0},
+	{0x20D0, 0x20DC, 0},
+	{0x20E1, 0x20E1, 0},
+	{0x20E5, 0x20F0, 0},
+	{0x2100, 0x2103, 0},
+	{0x2105, 0x2107, 0},
+	{0x2109, 0x2113, 0},
+	{0x2115, 0x2116, 0},
+	{0x2119, 0x211D, 0},
+	{0x2120, 0x2122, 0},
+	{0x2124, 0x2124, 0},
+	{0x2126, 0x2126, 0},
+	{0x2128, 0x2128, 0},
+	{0x212A, 0x212D, 0},
+	{0x212F, 0x2131, 0},
+	{0x2133, 0x2139, 0},
+	{0x213B, 0x2140, 0},
+	{0x2145, 0x2149, 0},
+	{0x2150, 0x217F, 0},
+	{0x2189, 0x2189, 0},
+	{0x222C, 0x222D, 0},
+	{0x222F, 0x2230, 0},
+	{0x2329, 0x232A, 0},
+	{0x2460, 0x24EA, 0},
+	{0x2A0C, 0x2A0C, 0},
+	{0x2A74, 0x2A76, 0},
+	{0x2ADC, 0x2ADC, 0},
+	{0x2C7C, 0x2C7D, 0},
+	{0x2CEF, 0x2CF1, 0},
+	{0x2D6F, 0x2D6F, 0},
+	{0x2D7F, 0x2D7F, 0},
+	{0x2DE0, 0x2DFF, 0},
+	{0x2E9F, 0x2E9F, 0},
+	{0x2EF3, 0x2EF3, 0},
+	{0x2F00, 0x2FD5, 0},
+	{0x3000, 0x3000, 0},
+	{0x302A, 0x302F, 0},
+	{0x3036, 0x3036, 0},
+	{0x3038, 0x303A, 0},
+	{0x3099, 0x309C, 0},
+	{0x309F, 0x309F, 0},
+	{0x30FF, 0x30FF, 0},
+	{0x3131, 0x318E, 0},
+	{0x3192, 0x319F, 0},
+	{0x3200, 0x321E, 0},
+	{0x3220, 0x3247, 0},
+	{0x3250, 0x327E, 0},
+	{0x3280, 0x33FF, 0},
+	{0xA66F, 0xA66F, 0},
+	{0xA674, 0xA67D, 0},
+	{0xA69C, 0xA69F, 0},
+	{0xA6F0, 0xA6F1, 0},
+	{0xA770, 0xA770, 0},
+	{0xA7F2, 0xA7F4, 0},
+	{0xA7F8, 0xA7F9, 0},
+	{0xA806, 0xA806, 0},
+	{0xA82C, 0xA82C, 0},
+	{0xA8C4, 0xA8C4, 0},
+	{0xA8E0, 0xA8F1, 0},
+	{0xA92B, 0xA92D, 0},
+	{0xA953, 0xA953, 0},
+	{0xA9B3, 0xA9B3, 0},
+	{0xA9C0, 0xA9C0, 0},
+	{0xAAB0, 0xAAB0, 0},
+	{0xAAB2, 0xAAB4, 0},
+	{0xAAB7, 0xAAB8, 0},
+	{0xAABE, 0xAABF, 0},
+	{0xAAC1, 0xAAC1, 0},
+	{0xAAF6, 0xAAF6, 0},
+	{0xAB5C, 0xAB5F, 0},
+	{0xAB69, 0xAB69, 0},
+	{0xABED, 0xABED, 0},
+	{0xF900, 0xFA0D, 0},
+	{0xFA10, 0xFA10, 0},
+	{0xFA12, 0xFA12, 0},
+	{0xFA15, 0xFA1E, 0},
+	{0xFA20, 0xFA20, 0},
+	{0xFA22, 0xFA22, 0},
+	{0xFA25, 0xFA26, 0},
+	{0xFA2A, 0xFA6D, 0},
+	{0xFA70, 0xFAD9, 0},
+	{0xFB00, 0xFB06, 0},
+	{0xFB13, 0xFB17, 0},
+	{0xFB1D, 0xFB36, 0},
+	{0xFB38, 0xFB3C, 0},
+	{0xFB3E, 0xFB3E, 0},
+	{0xFB40, 0xFB41, 0},
+	{0xFB43, 0xFB44, 0},
+	{0xFB46, 0xFBB1, 0},
+	{0xFBD3, 0xFD3D, 0},
+	{0xFD50, 0xFD8F, 0},
+	{0xFD92, 0xFDC7, 0},
+	{0xFDF0, 0xFDFC, 0},
+	{0xFE10, 0xFE19, 0},
+	{0xFE20, 0xFE44, 0},
+	{0xFE47, 0xFE52, 0},
+	{0xFE54, 0xFE66, 0},
+	{0xFE68, 0xFE6B, 0},
+	{0xFE70, 0xFE72, 0},
+	{0xFE74, 0xFE74, 0},
+	{0xFE76, 0xFEFC, 0},
+	{0xFF01, 0xFFBE, 0},
+	{0xFFC2, 0xFFC7, 0},
+	{0xFFCA, 0xFFCF, 0},
+	{0xFFD2, 0xFFD7, 0},
+	{0xFFDA, 0xFFDC, 0},
+	{0xFFE0, 0xFFE6, 0},
+	{0xFFE8, 0xFFEE, 0},
+	{0x101FD, 0x101FD, 0},
+	{0x102E0, 0x102E0, 0},
+	{0x10376, 0x1037A, 0},
+	{0x10781, 0x10785, 0},
+	{0x10787, 0x107B0, 0},
+	{0x107B2, 0x107BA, 0},
+	{0x10A0D, 0x10A0D, 0},
+	{0x10A0F, 0x10A0F, 0},
+	{0x10A38, 0x10A3A, 0},
+	{0x10A3F, 0x10A3F, 0},
+	{0x10AE5, 0x10AE6, 0},
+	{0x10D24, 0x10D27, 0},
+	{0x10EAB, 0x10EAC, 0},
+	{0x10EFD, 0x10EFF, 0},
+	{0x10F46, 0x10F50, 0},
+	{0x10F82, 0x10F85, 0},
+	{0x11046, 0x11046, 0},
+	{0x11070, 0x11070, 0},
+	{0x1107F, 0x1107F, 0},
+	{0x110B9, 0x110BA, 0},
+	{0x11100, 0x11102, 0},
+	{0x11127, 0x11127, 0},
+	{0x11133, 0x11134, 0},
+	{0x11173, 0x11173, 0},
+	{0x111C0, 0x111C0, 0},
+	{0x111CA, 0x111CA, 0},
+	{0x11235, 0x11236, 0},
+	{0x112E9, 0x112EA, 0},
+	{0x1133B, 0x1133C, 0},
+	{0x1133E, 0x1133E, 0},
+	{0x1134D, 0x1134D, 0},
+	{0x11357, 0x11357, 0},
+	{0x11366, 0x1136C, 0},
+	{0x11370, 0x11374, 0},
+	{0x11442, 0x11442, 0},
+	{0x11446, 0x11446, 0},
+	{0x1145E, 0x1145E, 0},
+	{0x114B0, 0x114B0, 0},
+	{0x114BA, 0x114BA, 0},
+	{0x114BD, 0x114BD, 0},
+	{0x114C2, 0x114C3, 0},
+	{0x115AF, 0x115AF, 0},
+	{0x115BF, 0x115C0, 0},
+	{0x1163F, 0x1163F, 0},
+	{0x116B6, 0x116B7, 0},
+	{0x1172B, 0x1172B, 0},
+	{0x11839, 0x1183A, 0},
+	{0x11930, 0x11930, 0},
+	{0x1193D, 0x1193E, 0},
+	{0x11943, 0x11943, 0},
+	{0x119E0, 0x119E0, 0},
+	{0x11A34, 0x11A34, 0},
+	{0x11A47, 0x11A47, 0},
+	{0x11A99, 0x11A99, 0},
+	{0x11C3F, 0x11C3F, 0},
+	{0x11D42, 0x11D42, 0},
+	{0x11D44, 0x11D45, 0},
+	{0x11D97, 0x11D97, 0},
+	{0x11F41, 0x11F42, 0},
+	{0x16AF0, 0x16AF4, 0},
+	{0x16B30, 0x16B36, 0},
+	{0x16FF0, 0x16FF1, 0},
+	{0x1BC9E, 0x1BC9E, 0},
+	{0x1D15E, 0x1D169, 0},
+	{0x1D16D, 0x1D172, 0},
+	{0x1D17B, 0x1D182, 0},
+	{0x1D185, 0x1D18B, 0},
+	{0x1D1AA, 0x1D1AD, 0},
+	{0x1D1BB, 0x1D1C0, 0},
+	{0x1D242, 0x1D244, 0},
+	{0x1D400, 0x1D454, 0},
+	{0x1D456, 0x1D49C, 0},
+	{0x1D49E, 0x1D49F, 0},
+	{0x1D4A2, 0x1D4A2, 0},
+	{0x1D4A5, 0x1D4A6, 0},
+	{0x1D4A9, 0x1D4AC, 0},
+	{0x1D4AE, 0x1D4B9, 0},
+	{0x1D4BB, 0x1D4BB, 0},
+	{0x1D4BD, 0x1D4C3, 0},
+	{0x1D4C5, 0x1D505, 0},
+	{0x1D507, 0x1D50A, 0},
+	{0x1D50D, 0x1D514, 0},
+	{0x1D516, 0x1D51C, 0},
+	{0x1D51E, 0x1D539, 0},
+	{0x1D53B, 0x1D53E, 0},
+	{0x1D540, 0x1D544, 0},
+	{0x1D546, 0x1D546, 0},
+	{0x1D54A, 0x1D550, 0},
+	{0x1D552, 0x1D6A5, 0},
+	{0x1D6A8, 0x1D7CB, 0},
+	{0x1D7CE, 0x1D7FF, 0},
+	{0x1E000, 0x1E006, 0},
+	{0x1E008, 0x1E018, 0},
+	{0x1E01B, 0x1E021, 0},
+	{0x1E023, 0x1E024, 0},
+	{0x1E026, 0x1E02A, 0},
+	{0x1E030, 0x1E06D, 0},
+	{0x1E08F, 0x1E08F, 0},
+	{0x1E130, 0x1E136, 0},
+	{0x1E2AE, 0x1E2AE, 0},
+	{0x1E2EC, 0x1E2EF, 0},
+	{0x1E4EC, 0x1E4EF, 0},
+	{0x1E8D0, 0x1E8D6, 0},
+	{0x1E944, 0x1E94A, 0},
+	{0x1EE00, 0x1EE03, 0},
+	{0x1EE05, 0x1EE1F, 0},
+	{0x1EE21, 0x1EE22, 0},
+	{0x1EE24, 0x1EE24, 0},
+	{0x1EE27, 0x1EE27, 0},
+	{0x1EE29, 0x1EE32, 0},
+	{0x1EE34, 0x1EE37, 0},
+	{0x1EE39, 0x1EE39, 0},
+	{0x1EE3B, 0x1EE3B, 0},
+	{0x1EE42, 0x1EE42, 0},
+	{0x1EE47, 0x1EE47, 0},
+	{0x1EE49, 0x1EE49, 0},
+	{0x1EE4B, 0x1EE4B, 0},
+	{0x1EE4D, 0x1EE4F, 0},
+	{0x1EE51, 0x1EE52, 0},
+	{0x1EE54, 0x1EE54, 0},
+	{0x1EE57, 0x1EE57, 0},
+	{0x1EE59, 0x1EE59, 0},
+	{0x1EE5B, 0x1EE5B, 0},
+	{0x1EE5D, 0x1EE5D, 0},
+	{0x1EE5F, 0x1EE5F, 0},
+	{0x1EE61, 0x1EE62, 0},
+	{0x1EE64, 0x1EE64, 0},
+	{0x1EE67, 0x1EE6A, 0},
+	{0x1EE6C, 0x1EE72, 0},
+	{0x1EE74, 0x1EE77, 0},
+	{0x1EE79, 0x1EE7C, 0},
+	{0x1EE7E, 0x1EE7E, 0},
+	{0x1EE80, 0x1EE89, 0},
+	{0x1EE8B, 0x1EE9B, 0},
+	{0x1EEA1, 0x1EEA3, 0},
+	{0x1EEA5, 0x1EEA9, 0},
+	{0x1EEAB, 0x1EEBB, 0},
+	{0x1F100, 0x1F10A, 0},
+	{0x1F110, 0x1F12E, 0},
+	{0x1F130, 0x1F14F, 0},
+	{0x1F16A, 0x1F16C, 0},
+	{0x1F190, 0x1F190, 0},
+	{0x1F200, 0x1F202, 0},
+	{0x1F210, 0x1F23B, 0},
+	{0x1F240, 0x1F248, 0},
+	{0x1F250, 0x1F251, 0},
+	{0x1FBF0, 0x1FBF9, 0},
+	{0x2F800, 0x2FA1D, 0},
+}
+
+// nfkdDecompositions are the full compatibility decompositions of runes,
+// but Hangul syllables, as slices of nfkdData
+var nfkdDecompositions = []runeMapping{
+	{0x00A0, 0, 1},
+	{0x00A8, 1, 3},
+	{0x00AA, 4, 1},
+	{0x00AF, 5, 3},
+	{0x00B2, 8, 1},
+	{0x00B3, 9, 1},
+	{0x00B4, 10, 3},
+	{0x00B5, 13, 2},
+	{0x00B8, 15, 3},
+	{0x00B9, 18, 1},
+	{0x00BA, 19, 1},
+	{0x00BC, 20, 5},
+	{0x00BD, 25, 5},
+	{0x00BE, 30, 5},
+	{0x00C0, 35, 3},
+	{0x00C1, 38, 3},
+	{0x00C2, 41, 3},
+	{0x00C3, 44, 3},
+	{0x00C4, 47, 3},
+	{0x00C5, 50, 3},
+	{0x00C7, 53, 3},
+	{0x00C8, 56, 3},
+	{0x00C9, 59, 3},
+	{0x00CA, 62, 3},
+	{0x00CB, 65, 3},
+	{0x00CC, 68, 3},
+	{0x00CD, 71, 3},
+	{0x00CE, 74, 3},
+	{0x00CF, 77, 3},
+	{0x00D1, 80, 3},
+	{0x00D2, 83, 3},
+	{0x00D3, 86, 3},
+	{0x00D4, 89, 3},
+	{0x00D5, 92, 3},
+	{0x00D6, 95, 3},
+	{0x00D9, 98, 3},
+	{0x00DA, 101, 3},
+	{0x00DB, 104, 3},
+	{0x00DC, 107, 3},
+	{0x00DD, 110, 3},
+	{0x00E0, 113, 3},
+	{0x00E1, 116, 3},
+	{0x00E2, 119, 3},
+	{0x00E3, 122, 3},
+	{0x00E4, 125, 3},
+	{0x00E5, 128, 3},
+	{0x00E7, 131, 3},
+	{0x00E8, 134, 3},
+	{0x00E9, 137, 3},
+	{0x00EA, 140, 3},
+	{0x00EB, 143, 3},
+	{0x00EC, 146, 3},
+	{0x00ED, 149, 3},
+	{0x00EE, 152, 3},
+	{0x00EF, 155, 3},
+	{0x00F1, 158, 3},
+	{0x00F2, 161, 3},
+	{0x00F3, 164, 3},
+	{0x00F4, 167, 3},
+	{0x00F5, 170, 3},
+	{0x00F6, 173, 3},
+	{0x00F9, 176, 3},
+	{0x00FA, 179, 3},
+	{0x00FB, 182, 3},
+	{0x00FC, 185, 3},
+	{0x00FD, 188, 3},
+	{0x00FF, 191, 3},
+	{0x0100, 194, 3},
+	{0x0101, 197, 3},
+	{0x0102, 200, 3},
+	{0x0103, 203, 3},
+	{0x0104, 206, 3},
+	{0x0105, 209, 3},
+	{0x0106, 212, 3},
+	{0x0107, 215, 3},
+	{0x0108, 218, 3},
+	{0x0109, 221, 3},
+	{0x010A, 224, 3},
+	{0x010B, 227, 3},
+	{0x010C, 230, 3},
+	{0x010D, 233, 3},
+	{0x010E, 236, 3},
+	{0x010F, 239, 3},
+	{0x0112, 242, 3},
+	{0x0113, 245, 3},
+	{0x0114, 248, 3},
+	{0x0115, 251, 3},
+	{0x0116, 254, 3},
+	{0x0117, 257, 3},
+	{0x0118, 260, 3},
+	{0x0119, 263, 3},
+	{0x011A, 266, 3},
+	{0x011B, 269, 3},
+	{0x011C, 272, 3},
+	{0x011D, 275, 3},
+	{0x011E, 278, 3},
+	{0x011F, 281, 3},
+	{0x0120, 284, 3},
+	{0x0121, 287, 3},
+	{0x0122, 290, 3},
+	{0x0123, 293, 3},
+	{0x0124, 296, 3},
+	{0x0125, 299, 3},
+	{0x0128, 302, 3},
+	{0x0129, 305, 3},
+	{0x012A, 308, 3},
+	{0x012B, 311, 3},
+	{0x012C, 314, 3},
+	{0x012D, 317, 3},
+	{0x012E, 320, 3},
+	{0x012F, 323, 3},
+	{0x0130, 326, 3},
+	{0x0132, 329, 2},
+	{0x0133, 331, 2},
+	{0x0134, 333, 3},
+	{0x0135, 336, 3},
+	{0x0136, 339, 3},
+	{0x0137, 342, 3},
+	{0x0139, 345, 3},
+	{0x013A, 348, 3},
+	{0x013B, 351, 3},
+	{0x013C, 354, 3},
+	{0x013D, 357, 3},
+	{0x013E, 360, 3},
+	{0x013F, 363, 3},
+	{0x0140, 366, 3},
+	{0x0143, 369, 3},
+	{0x0144, 372, 3},
+	{0x0145, 375, 3},
+	{0x0146, 378, 3},
+	{0x0147, 381, 3},
+	{0x0148, 384, 3},
+	{0x0149, 387, 3},
+	{0x014C, 390, 3},
+	{0x014D, 393, 3},
+	{0x014E, 396, 3},
+	{0x014F, 399, 3},
+	{0x0150, 402, 3},
+	{0x0151, 405, 3},
+	{0x0154, 408, 3},
+	{0x0155, 411, 3},
+	{0x0156, 414, 3},
+	{0x0157, 417, 3},
+	{0x0158, 420, 3},
+	{0x0159, 423, 3},
+	{0x015A, 426, 3},
+	{0x015B, 429, 3},
+	{0x015C, 432, 3},
+	{0x015D, 435, 3},
+	{0x015E, 438, 3},
+	{0x015F, 441, 3},
+	{0x0160, 444, 3},
+	{0x0161, 447, 3},
+	{0x0162, 450, 3},
+	{0x0163, 453, 3},
+	{0x0164, 456, 3},
+	{0x0165, 459, 3},
+	{0x0168, 462, 3},
+	{0x0169, 465, 3},
+	{0x016A, 468, 3},
+	{0x016B, 471, 3},
+	{0x016C, 474, 3},
+	{0x016D, 477, 3},
+	{0x016E, 480, 3},
+	{0x016F, 483, 3},
+	{0x0170, 486, 3},
+	{0x0171, 489, 3},
+	{0x0172, 492, 3},
+	{0x0173, 495, 3},
+	{0x0174, 498, 3},
+	{0x0175, 501, 3},
+	{0x0176, 504, 3},
+	{0x0177, 507, 3},
+	{0x0178, 510, 3},
+	{0x0179, 513, 3},
+	{0x017A, 516, 3},
+	{0x017B, 519, 3},
+	{0x017C, 522, 3},
+	{0x017D, 525, 3},
+	{0x017E, 528, 3},
+	{0x017F, 531, 1},
+	{0x01A0, 532, 3},
+	{0x01A1, 535, 3},
+	{0x01AF, 538, 3},
+	{0x01B0, 541, 3},
+	{0x01C4, 544, 4},
+	{0x01C5, 548, 4},
+	{0x01C6, 552, 4},
+	{0x01C7, 556, 2},
+	{0x01C8, 558, 2},
+	{0x01C9, 560, 2},
+	{0x01CA, 562, 2},
+	{0x01CB, 564, 2},
+	{0x01CC, 566, 2},
+	{0x01CD, 568, 3},
+	{0x01CE, 571, 3},
+	{0x01CF, 574, 3},
+	{0x01D0, 577, 3},
+	{0x01D1, 580, 3},
+	{0x01D2, 583, 3},
+	{0x01D3, 586, 3},
+	{0x01D4, 589, 3},
+	{0x01D5, 592, 5},
+	{0x01D6, 597, 5},
+	{0x01D7, 602, 5},
+	{0x01D8, 607, 5},
+	{0x01D9, 612, 5},
+	{0x01DA, 617, 5},
+	{0x01DB, 622, 5},
+	{0x01DC, 627, 5},
+	{0x01DE, 632, 5},
+	{0x01DF, 637, 5},
+	{0x01E0, 642, 5},
+	{0x01E1, 647, 5},
+	{0x01E2, 652, 4},
+	{0x01E3, 656, 4},
+	{0x01E6, 660, 3},
+	{0x01E7, 663, 3},
+	{0x01E8, 666, 3},
+	{0x01E9, 669, 3},
+	{0x01EA, 672, 3},
+	{0x01EB, 675, 3},
+	{0x01EC, 678, 5},
+	{0x01ED, 683, 5},
+	{0x01EE, 688, 4},
+	{0x01EF, 692, 4},
+	{0x01F0, 696, 3},
+	{0x01F1, 699, 2},
+	{0x01F2, 701, 2},
+	{0x01F3, 703, 2},
+	{0x01F4, 705, 3},
+	{0x01F5, 708, 3},
+	{0x01F8, 711, 3},
+	{0x01F9, 714, 3},
+	{0x01FA, 717, 5},
+	{0x01FB, 722, 5},
+	{0x01FC, 727, 4},
+	{0x01FD, 731, 4},
+	{0x01FE, 735, 4},
+	{0x01FF, 739, 4},
+	{0x0200, 743, 3},
+	{0x0201, 746, 3},
+	{0x0202, 749, 3},
+	{0x0203, 752, 3},
+	{0x0204, 755, 3},
+	{0x0205, 758, 3},
+	{0x0206, 761, 3},
+	{0x0207, 764, 3},
+	{0x0208, 767, 3},
+	{0x0209, 770, 3},
+	{0x020A, 773, 3},
+	{0x020B, 776, 3},
+	{0x020C, 779, 3},
+	{0x020D, 782, 3},
+	{0x020E, 785, 3},
+	{0x020F, 788, 3},
+	{0x0210, 791, 3},
+	{0x0211, 794, 3},
+	{0x0212, 797, 3},
+	{0x0213, 800, 3},
+	{0x0214, 803, 3},
+	{0x0215, 806, 3},
+	{0x0216, 809, 3},
+	{0x0217, 812, 3},
+	{0x0218, 815, 3},
+	{0x0219, 818, 3},
+	{0x021A, 821, 3},
+	{0x021B, 824, 3},
+	{0x021E, 827, 3},
+	{0x021F, 830, 3},
+	{0x0226, 833, 3},
+	{0x0227, 836, 3},
+	{0x0228, 839, 3},
+	{0x0229, 842, 3},
+	{0x022A, 845, 5},
+	{0x022B, 850, 5},
+	{0x022C, 855, 5},
+	{0x022D, 860, 5},
+	{0x022E, 865, 3},
+	{0x022F, 868, 3},
+	{0x0230, 871, 5},
+	{0x0231, 876, 5},
+	{0x0232, 881, 3},
+	{0x0233, 884, 3},
+	{0x02B0, 887, 1},
+	{0x02B1, 888, 2},
+	{0x02B2, 890, 1},
+	{0x02B3, 891, 1},
+	{0x02B4, 892, 2},
+	{0x02B5, 894, 2},
+	{0x02B6, 896, 2},
+	{0x02B7, 898, 1},
+	{0x02B8, 899, 1},
+	{0x02D8, 900, 3},
+	{0x02D9, 903, 3},
+	{0x02DA, 906, 3},
+	{0x02DB, 909, 3},
+	{0x02DC, 912, 3},
+	{0x02DD, 915, 3},
+	{0x02E0, 918, 2},
+	{0x02E1, 920, 1},
+	{0x02E2, 921, 1},
+	{0x02E3, 922, 1},
+	{0x02E4, 923, 2},
+	{0x0340, 925, 2},
+	{0x0341, 927, 2},
+	{0x0343, 929, 2},
+	{0x0344, 931, 4},
+	{0x0374, 935, 2},
+	{0x037A, 937, 3},
+	{0x037E, 940, 1},
+	{0x0384, 941, 3},
+	{0x0385, 944, 5},
+	{0x0386, 949, 4},
+	{0x0387, 953, 2},
+	{0x0388, 955, 4},
+	{0x0389, 959, 4},
+	{0x038A, 963, 4},
+	{0x038C, 967, 4},
+	{0x038E, 971, 4},
+	{0x038F, 975, 4},
+	{0x0390, 979, 6},
+	{0x03AA, 985, 4},
+	{0x03AB, 989, 4},
+	{0x03AC, 993, 4},
+	{0x03AD, 997, 4},
+	{0x03AE, 1001, 4},
+	{0x03AF, 1005, 4},
+	{0x03B0, 1009, 6},
+	{0x03CA, 1015, 4},
+	{0x03CB, 1019, 4},
+	{0x03CC, 1023, 4},
+	{0x03CD, 1027, 4},
+	{0x03CE, 1031, 4},
+	{0x03D0, 1035, 2},
+	{0x03D1, 1037, 2},
+	{0x03D2, 1039, 2},
+	{0x03D3, 1041, 4},
+	{0x03D4, 1045, 4},
+	{0x03D5, 1049, 2},
+	{0x03D6, 1051, 2},
+	{0x03F0, 1053, 2},
+	{0x03F1, 1055, 2},
+	{0x03F2, 1057, 2},
+	{0x03F4, 1059, 2},
+	{0x03F5, 1061, 2},
+	{0x03F9, 1063, 2},
+	{0x0400, 1065, 4},
+	{0x0401, 1069, 4},
+	{0x0403, 1073, 4},
+	{0x0407, 1077, 4},
+	{0x040C, 1081, 4},
+	{0x040D, 1085, 4},
+	{0x040E, 1089, 4},
+	{0x0419, 1093, 4},
+	{0x0439, 1097, 4},
+	{0x0450, 1101, 4},
+	{0x0451, 1105, 4},
+	{0x0453, 1109, 4},
+	{0x0457, 1113, 4},
+	{0x045C, 1117, 4},
+	{0x045D, 1121, 4},
+	{0x045E, 1125, 4},
+	{0x0476, 1129, 4},
+	{0x0477, 1133, 4},
+	{0x04C1, 1137, 4},
+	{0x04C2, 1141, 4},
+	{0x04D0, 1145, 4},
+	{0x04D1, 1149, 4},
+	{0x04D2, 1153, 4},
+	{0x04D3, 1157, 4},
+	{0x04D6, 1161, 4},
+	{0x04D7, 1165, 4},
+	{0x04DA, 1169, 4},
+	{0x04DB, 1173, 4},
+	{0x04DC, 1177, 4},
+	{0x04DD, 1181, 4},
+	{0x04DE, 1185, 4},
+	{0x04DF, 1189, 4},
+	{0x04E2, 1193, 4},
+	{0x04E3, 1197, 4},
+	{0x04E4, 1201, 4},
+	{0x04E5, 1205, 4},
+	{0x04E6, 1209, 4},
+	{0x04E7, 1213, 4},
+	{0x04EA, 1217, 4},
+	{0x04EB, 1221, 4},
+	{0x04EC, 1225, 4},
+	{0x04ED, 1229, 4},
+	{0x04EE, 1233, 4},
+	{0x04EF, 1237, 4},
+	{0x04F0, 1241, 4},
+	{0x04F1, 1245, 4},
+	{0x04F2, 1249, 4},
+	{0x04F3, 1253, 4},
+	{0x04F4, 1257, 4},
+	{0x04F5, 1261, 4},
+	{0x04F8, 1265, 4},
+	{0x04F9, 1269, 4},
+	{0x0587, 1273, 4},
+	{0x0622, 1277, 4},
+	{0x0623, 1281, 4},
+	{0x0624, 1285, 4},
+	{0x0625, 1289, 4},
+	{0x0626, 1293, 4},
+	{0x0675, 1297, 4},
+	{0x0676, 1301, 4},
+	{0x0677, 1305, 4},
+	{0x0678, 1309, 4},
+	{0x06C0, 1313, 4},
+	{0x06C2, 1317, 4},
+	{0x06D3, 1321, 4},
+	{0x0929, 1325, 6},
+	{0x0931, 1331, 6},
+	{0x0934, 1337, 6},
+	{0x0958, 1343, 6},
+	{0x0959, 1349, 6},
+	{0x095A, 1355, 6},
+	{0x095B, 1361, 6},
+	{0x095C, 1367, 6},
+	{0x095D, 1373, 6},
+	{0x095E, 1379, 6},
+	{0x095F, 1385, 6},
+	{0x09CB, 1391, 6},
+	{0x09CC, 1397, 6},
+	{0x09DC, 1403, 6},
+	{0x09DD, 1409, 6},
+	{0x09DF, 1415, 6},
+	{0x0A33, 1421, 6},
+	{0x0A36, 1427, 6},
+	{0x0A59, 1433, 6},
+	{0x0A5A, 1439, 6},
+	{0x0A5B, 1445, 6},
+	{0x0A5E, 1451, 6},
+	{0x0B48, 1457, 6},
+	{0x0B4B, 1463, 6},
+	{0x0B4C, 1469, 6},
+	{0x0B5C, 1475, 6},
+	{0x0B5D, 1481, 6},
+	{0x0B94, 1487, 6},
+	{0x0BCA, 1493, 6},
+	{0x0BCB, 1499, 6},
+	{0x0BCC, 1505, 6},
+	{0x0C48, 1511, 6},
+	{0x0CC0, 1517, 6},
+	{0x0CC7, 1523, 6},
+	{0x0CC8, 1529, 6},
+	{0x0CCA, 1535, 6},
+	{0x0CCB, 1541, 9},
+	{0x0D4A, 1550, 6},
+	{0x0D4B, 1556, 6},
+	{0x0D4C, 1562, 6},
+	{0x0DDA, 1568, 6},
+	{0x0DDC, 1574, 6},
+	{0x0DDD, 1580, 9},
+	{0x0DDE, 1589, 6},
+	{0x0E33, 1595, 6},
+	{0x0EB3, 1601, 6},
+	{0x0EDC, 1607, 6},
+	{0x0EDD, 1613, 6},
+	{0x0F0C, 1619, 3},
+	{0x0F43, 1622, 6},
+	{0x0F4D, 1628, 6},
+	{0x0F52, 1634, 6},
+	{0x0F57, 1640, 6},
+	{0x0F5C, 1646, 6},
+	{0x0F69, 1652, 6},
+	{0x0F73, 1658, 6},
+	{0x0F75, 1664, 6},
+	{0x0F76, 1670, 6},
+	{0x0F77, 1676, 9},
+	{0x0F78, 1685, 6},
+	{0x0F79, 1691, 9},
+	{0x0F81, 1700, 6},
+	{0x0F93, 1706, 6},
+	{0x0F9D, 1712, 6},
+	{0x0FA2, 1718, 6},
+	{0x0FA7, 1724, 6},
+	{0x0FAC, 1730, 6},
+	{0x0FB9, 1736, 6},
+	{0x1026, 1742, 6},
+	{0x10FC, 1748, 3},
+	{0x1B06, 1751, 6},
+	{0x1B08, 1757, 6},
+	{0x1B0A, 1763, 6},
+	{0x1B0C, 1769, 6},
+	{0x1B0E, 1775, 6},
+	{0x1B12, 1781, 6},
+	{0x1B3B, 1787, 6},
+	{0x1B3D, 1793, 6},
+	{0x1B40, 1799, 6},
+	{0x1B41, 1805, 6},
+	{0x1B43, 1811, 6},
+	{0x1D2C, 1817, 1},
+	{0x1D2D, 1818, 2},
+	{0x1D2E, 1820, 1},
+	{0x1D30, 1821, 1},
+	{0x1D31, 1822, 1},
+	{0x1D32, 1823, 2},
+	{0x1D33, 1825, 1},
+	{0x1D34, 1826, 1},
+	{0x1D35, 1827, 1},
+	{0x1D36, 1828, 1},
+	{0x1D37, 1829, 1},
+	{0x1D38, 1830, 1},
+	{0x1D39, 1831, 1},
+	{0x1D3A, 1832, 1},
+	{0x1D3C, 1833, 1},
+	{0x1D3D, 1834, 2},
+	{0x1D3E, 1836, 1},
+	{0x1D3F, 1837, 1},
+	{0x1D40, 1838, 1},
+	{0x1D41, 1839, 1},
+	{0x1D42, 1840, 1},
+	{0x1D43, 1841, 1},
+	{0x1D44, 1842, 2},
+	{0x1D45, 1844, 2},
+	{0x1D46, 1846, 3},
+	{0x1D47, 1849, 1},
+	{0x1D48, 1850, 1},
+	{0x1D49, 1851, 1},
+	{0x1D4A, 1852, 2},
+	{0x1D4B, 1854, 2},
+	{0x1D4C, 1856, 2},
+	{0x1D4D, 1858, 1},
+	{0x1D4F, 1859, 1},
+	{0x1D50, 1860, 1},
+	{0x1D51, 1861, 2},
+	{0x1D52, 1863, 1},
+	{0x1D53, 1864, 2},
+	{0x1D54, 1866, 3},
+	{0x1D55, 1869, 3},
+	{0x1D56, 1872, 1},
+	{0x1D57, 1873, 1},
+	{0x1D58, 1874, 1},
+	{0x1D59, 1875, 3},
+	{0x1D5A, 1878, 2},
+	{0x1D5B, 1880, 1},
+	{0x1D5C, 1881, 3},
+	{0x1D5D, 1884, 2},
+	{0x1D5E, 1886, 2},
+	{0x1D5F, 1888, 2},
+	{0x1D60, 1890, 2},
+	{0x1D61, 1892, 2},
+	{0x1D62, 1894, 1},
+	{0x1D63, 1895, 1},
+	{0x1D64, 1896, 1},
+	{0x1D65, 1897, 1},
+	{0x1D66, 1898, 2},
+	{0x1D67, 1900, 2},
+	{0x1D68, 1902, 2},
+	{0x1D69, 1904, 2},
+	{0x1D6A, 1906, 2},
+	{0x1D78, 1908, 2},
+	{0x1D9B, 1910, 2},
+	{0x1D9C, 1912, 1},
+	{0x1D9D, 1913, 2},
+	{0x1D9E, 1915, 2},
+	{0x1D9F, 1917, 2},
+	{0x1DA0, 1919, 1},
+	{0x1DA1, 1920, 2},
+	{0x1DA2, 1922, 2},
+	{0x1DA3, 1924, 2},
+	{0x1DA4, 1926, 2},
+	{0x1DA5, 1928, 2},
+	{0x1DA6, 1930, 2},
+	{0x1DA7, 1932, 3},
+	{0x1DA8, 1935, 2},
+	{0x1DA9, 1937, 2},
+	{0x1DAA, 1939, 3},
+	{0x1DAB, 1942, 2},
+	{0x1DAC, 1944, 2},
+	{0x1DAD, 1946, 2},
+	{0x1DAE, 1948, 2},
+	{0x1DAF, 1950, 2},
+	{0x1DB0, 1952, 2},
+	{0x1DB1, 1954, 2},
+	{0x1DB2, 1956, 2},
+	{0x1DB3, 1958, 2},
+	{0x1DB4, 1960, 2},
+	{0x1DB5, 1962, 2},
+	{0x1DB6, 1964, 2},
+	{0x1DB7, 1966, 2},
+	{0x1DB8, 1968, 3},
+	{0x1DB9, 1971, 2},
+	{0x1DBA, 1973, 2},
+	{0x1DBB, 1975, 1},
+	{0x1DBC, 1976, 2},
+	{0x1DBD, 1978, 2},
+	{0x1DBE, 1980, 2},
+	{0x1DBF, 1982, 2},
+	{0x1E00, 1984, 3},
+	{0x1E01, 1987, 3},
+	{0x1E02, 1990, 3},
+	{0x1E03, 1993, 3},
+	{0x1E04, 1996, 3},
+	{0x1E05, 1999, 3},
+	{0x1E06, 2002, 3},
+	{0x1E07, 2005, 3},
+	{0x1E08, 2008, 5},
+	{0x1E09, 2013, 5},
+	{0x1E0A, 2018, 3},
+	{0x1E0B, 2021, 3},
+	{0x1E0C, 2024, 3},
+	{0x1E0D, 2027, 3},
+	{0x1E0E, 2030, 3},
+	{0x1E0F, 2033, 3},
+	{0x1E10, 2036, 3},
+	{0x1E11, 2039, 3},
+	{0x1E12, 2042, 3},
+	{0x1E13, 2045, 3},
+	{0x1E14, 2048, 5},
+	{0x1E15, 2053, 5},
+	{0x1E16, 2058, 5},
+	{0x1E17, 2063, 5},
+	{0x1E18, 2068, 3},
+	{0x1E19, 2071, 3},
+	{0x1E1A, 2074, 3},
+	{0x1E1B, 2077, 3},
+	{0x1E1C, 2080, 5},
+	{0x1E1D, 2085, 5},
+	{0x1E1E, 2090, 3},
+	{0x1E1F, 2093, 3},
+	{0x1E20, 2096, 3},
+	{0x1E21, 2099, 3},
+	{0x1E22, 2102, 3},
+	{0x1E23, 2105, 3},
+	{0x1E24, 2108, 3},
+	{0x1E25, 2111, 3},
+	{0x1E26, 2114, 3},
+	{0x1E27, 2117, 3},
+	{0x1E28, 2120, 3},
+	{0x1E29, 2123, 3},
+	{0x1E2A, 2126, 3},
+	{0x1E2B, 2129, 3},
+	{0x1E2C, 2132, 3},
+	{0x1E2D, 2135, 3},
+	{0x1E2E, 2138, 5},
+	{0x1E2F, 2143, 5},
+	{0x1E30, 2148, 3},
+	{0x1E31, 2151, 3},
+	{0x1E32, 2154, 3},
+	{0x1E33, 2157, 3},
+	{0x1E34, 2160, 3},
+	{0x1E35, 2163, 3},
+	{0x1E36, 2166, 3},
+	{0x1E37, 2169, 3},
+	{0x1E38, 2172, 5},
+	{0x1E39, 2177, 5},
+	{0x1E3A, 2182, 3},
+	{0x1E3B, 2185, 3},
+	{0x1E3C, 2188, 3},
+	{0x1E3D, 2191, 3},
+	{0x1E3E, 2194, 3},
+	{0x1E3F, 2197, 3},
+	{0x1E40, 2200, 3},
+	{0x1E41, 2203, 3},
+	{0x1E42, 2206, 3},
+	{0x1E43, 2209, 3},
+	{0x1E44, 2212, 3},
+	{0x1E45, 2215, 3},
+	{0x1E46, 2218, 3},
+	{0x1E47, 2221, 3},
+	{0x1E48, 2224, 3},
+	{0x1E49, 2227, 3},
+	{0x1E4A, 2230, 3},
+	{0x1E4B, 2233, 3},
+	{0x1E4C, 2236, 5},
+	{0x1E4D, 2241, 5},
+	{0x1E4E, 2246, 5},
+	{0x1E4F, 2251, 5},
+	{0x1E50, 2256, 5},
+	{0x1E51, 2261, 5},
+	{0x1E52, 2266, 5},
+	{0x1E53, 2271, 5},
+	{0x1E54, 2276, 3},
+	{0x1E55, 2279, 3},
+	{0x1E56, 2282, 3},
+	{0x1E57, 2285, 3},
+	{0x1E58, 2288, 3},
+	{0x1E59, 2291, 3},
+	{0x1E5A, 2294, 3},
+	{0x1E5B, 2297, 3},
+	{0x1E5C, 2300, 5},
+	{0x1E5D, 2305, 5},
+	{0x1E5E, 2310, 3},
+	{0x1E5F, 2313, 3},
+	{0x1E60, 2316, 3},
+	{0x1E61, 2319, 3},
+	{0x1E62, 2322, 3},
+	{0x1E63, 2325, 3},
+	{0x1E64, 2328, 5},
+	{0x1E65, 2333, 5},
+	{0x1E66, 2338, 5},
+	{0x1E67, 2343, 5},
+	{0x1E68, 2348, 5},
+	{0x1E69, 2353, 5},
+	{0x1E6A, 2358, 3},
+	{0x1E6B, 2361, 3},
+	{0x1E6C, 2364, 3},
+	{0x1E6D, 2367, 3},
+	{0x1E6E, 2370, 3},
+	{0x1E6F, 2373, 3},
+	{0x1E70, 2376, 3},
+	{0x1E71, 2379, 3},
+	{0x1E72, 2382, 3},
+	{0x1E73, 2385, 3},
+	{0x1E74, 2388, 3},
+	{0x1E75, 2391, 3},
+	{0x1E76, 2394, 3},
+	{0x1E77, 2397, 3},
+	{0x1E78, 2400, 5},
+	{0x1E79, 2405, 5},
+	{0x1E7A, 2410, 5},
+	{0x1E7B, 2415, 5},
+	{0x1E7C, 2420, 3},
+	{0x1E7D, 2423, 3},
+	{0x1E7E, 2426, 3},
+	{0x1E7F, 2429, 3},
+	{0x1E80, 2432, 3},
+	{0x1E81, 2435, 3},
+	{0x1E82, 2438, 3},
+	{0x1E83, 2441, 3},
+	{0x1E84, 2444, 3},
+	{0x1E85, 2447, 3},
+	{0x1E86, 2450, 3},
+	{0x1E87, 2453, 3},
+	{0x1E88, 2456, 3},
+	{0x1E89, 2459, 3},
+	{0x1E8A, 2462, 3},
+	{0x1E8B, 2465, 3},
+	{0x1E8C, 2468, 3},
+	{0x1E8D, 2471, 3},
+	{0x1E8E, 2474, 3},
+	{0x1E8F, 2477, 3},
+	{0x1E90, 2480, 3},
+	{0x1E91, 2483, 3},
+	{0x1E92, 2486, 3},
+	{0x1E93, 2489, 3},
+	{0x1E94, 2492, 3},
+	{0x1E95, 2495, 3},
+	{0x1E96, 2498, 3},
+	{0x1E97, 2501, 3},
+	{0x1E98, 2504, 3},
+	{0x1E99, 2507, 3},
+	{0x1E9A, 2510, 3},
+	{0x1E9B, 2513, 3},
+	{0x1EA0, 2516, 3},
+	{0x1EA1, 2519, 3},
+	{0x1EA2, 2522, 3},
+	{0x1EA3, 2525, 3},
+	{0x1EA4, 2528, 5},
+	{0x1EA5, 2533, 5},
+	{0x1EA6, 2538, 5},
+	{0x1EA7, 2543, 5},
+	{0x1EA8, 2548, 5},
+	{0x1EA9, 2553, 5},
+	{0x1EAA, 2558, 5},
+	{0x1EAB, 2563, 5},
+	{0x1EAC, 2568, 5},
+	{0x1EAD, 2573, 5},
+	{0x1EAE, 2578, 5},
+	{0x1EAF, 2583, 5},
+	{0x1EB0, 2588, 5},
+	{0x1EB1, 2593, 5},
+	{0x1EB2, 2598, 5},
+	{0x1EB3, 2603, 5},
+	{0x1EB4, 2608, 5},
+	{0x1EB5, 2613, 5},
+	{0x1EB6, 2618, 5},
+	{0x1EB7, 2623, 5},
+	{0x1EB8, 2628, 3},
+	{0x1EB9, 2631, 3},
+	{0x1EBA, 2634, 3},
+	{0x1EBB, 2637, 3},
+	{0x1EBC, 2640, 3},
+	{0x1EBD, 2643, 3},
+	{0x1EBE, 2646, 5},
+	{0x1EBF, 2651, 5},
+	{0x1EC0, 2656, 5},
+	{0x1EC1, 2661, 5},
+	{0x1EC2, 2666, 5},
+	{0x1EC3, 2671, 5},
+	{0x1EC4, 2676, 5},
+	{0x1EC5, 2681, 5},
+	{0x1EC6, 2686, 5},
+	{0x1EC7, 2691, 5},
+	{0x1EC8, 2696, 3},
+	{0x1EC9, 2699, 3},
+	{0x1ECA, 2702, 3},
+	{0x1ECB, 2705, 3},
+	{0x1ECC, 2708, 3},
+	{0x1ECD, 2711, 3},
+	{0x1ECE, 2714, 3},
+	{0x1ECF, 2717, 3},
+	{0x1ED0, 2720, 5},
+	{0x1ED1, 2725, 5},
+	{0x1ED2, 2730, 5},
+	{0x1ED3, 2735, 5},
+	{0x1ED4, 2740, 5},
+	{0x1ED5, 2745, 5},
+	{0x1ED6, 2750, 5},
+	{0x1ED7, 2755, 5},
+	{0x1ED8, 2760, 5},
+	{0x1ED9, 2765, 5},
+	{0x1EDA, 2770, 5},
+	{0x1EDB, 2775, 5},
+	{0x1EDC, 2780, 5},
+	{0x1EDD, 2785, 5},
+	{0x1EDE, 2790, 5},
+	{0x1EDF, 2795, 5},
+	{0x1EE0, 2800, 5},
+	{0x1EE1, 2805, 5},
+	{0x1EE2, 2810, 5},
+	{0x1EE3, 2815, 5},
+	{0x1EE4, 2820, 3},
+	{0x1EE5, 2823, 3},
+	{0x1EE6, 2826, 3},
+	{0x1EE7, 2829, 3},
+	{0x1EE8, 2832, 5},
+	{0x1EE9, 2837, 5},
+	{0x1EEA, 2842, 5},
+	{0x1EEB, 2847, 5},
+	{0x1EEC, 2852, 5},
+	{0x1EED, 2857, 5},
+	{0x1EEE, 2862, 5},
+	{0x1EEF, 2867, 5},
+	{0x1EF0, 2872, 5},
+	{0x1EF1, 2877, 5},
+	{0x1EF2, 2882, 3},
+	{0x1EF3, 2885, 3},
+	{0x1EF4, 2888, 3},
+	{0x1EF5, 2891, 3},
+	{0x1EF6, 2894, 3},
+	{0x1EF7, 2897, 3},
+	{0x1EF8, 2900, 3},
+	{0x1EF9, 2903, 3},
+	{0x1F00, 2906, 4},
+	{0x1F01, 2910, 4},
+	{0x1F02, 2914, 6},
+	{0x1F03, 2920, 6},
+	{0x1F04, 2926, 6},
+	{0x1F05, 2932, 6},
+	{0x1F06, 2938, 6},
+	{0x1F07, 2944, 6},
+	{0x1F08, 2950, 4},
+	{0x1F09, 2954, 4},
+	{0x1F0A, 2958, 6},
+	{0x1F0B, 2964, 6},
+	{0x1F0C, 2970, 6},
+	{0x1F0D, 2976, 6},
+	{0x1F0E, 2982, 6},
+	{0x1F0F, 2988, 6},
+	{0x1F10, 2994, 4},
+	{0x1F11, 2998, 4},
+	{0x1F12, 3002, 6},
+	{0x1F13, 3008, 6},
+	{0x1F14, 3014, 6},
+	{0x1F15, 3020, 6},
+	{0x1F18, 3026, 4},
+	{0x1F19, 3030, 4},
+	{0x1F1A, 3034, 6},
+	{0x1F1B, 3040, 6},
+	{0x1F1C, 3046, 6},
+	{0x1F1D, 3052, 6},
+	{0x1F20, 3058, 4},
+	{0x1F21, 3062, 4},
+	{0x1F22, 3066, 6},
+	{0x1F23, 3072, 6},
+	{0x1F24, 3078, 6},
+	{0x1F25, 3084, 6},
+	{0x1F26, 3090, 6},
+	{0x1F27, 3096, 6},
+	{0x1F28, 3102, 4},
+	{0x1F29, 3106, 4},
+	{0x1F2A, 3110, 6},
+	{0x1F2B, 3116, 6},
+	{0x1F2C, 3122, 6},
+	{0x1F2D, 3128, 6},
+	{0x1F2E, 3134, 6},
+	{0x1F2F, 3140, 6},
+	{0x1F30, 3146, 4},
+	{0x1F31, 3150, 4},
+	{0x1F32, 3154, 6},
+	{0x1F33, 3160, 6},
+	{0x1F34, 3166, 6},
+	{0x1F35, 3172, 6},
+	{0x1F36, 3178, 6},
+	{0x1F37, 3184, 6},
+	{0x1F38, 3190, 4},
+	{0x1F39, 3194, 4},
+	{0x1F3A, 3198, 6},
+	{0x1F3B, 3204, 6},
+	{0x1F3C, 3210, 6},
+	{0x1F3D, 3216, 6},
+	{0x1F3E, 3222, 6},
+	{0x1F3F, 3228, 6},
+	{0x1F40, 3234, 4},
+	{0x1F41, 3238, 4},
+	{0x1F42, 3242, 6},
+	{0x1F43, 3248, 6},
+	{0x1F44, 3254, 6},
+	{0x1F45, 3260, 6},
+	{0x1F48, 3266, 4},
+	{0x1F49, 3270, 4},
+	{0x1F4A, 3274, 6},
+	{0x1F4B, 3280, 6},
+	{0x1F4C, 3286, 6},
+	{0x1F4D, 3292, 6},
+	{0x1F50, 3298, 4},
+	{0x1F51, 3302, 4},
+	{0x1F52, 3306, 6},
+	{0x1F53, 3312, 6},
+	{0x1F54, 3318, 6},
+	{0x1F55, 3324, 6},
+	{0x1F56, 3330, 6},
+	{0x1F57, 3336, 6},
+	{0x1F59, 3342, 4},
+	{0x1F5B, 3346, 6},
+	{0x1F5D, 3352, 6},
+	{0x1F5F, 3358, 6},
+	{0x1F60, 3364, 4},
+	{0x1F61, 3368, 4},
+	{0x1F62, 3372, 6},
+	{0x1F63, 3378, 6},
+	{0x1F64, 3384, 6},
+	{0x1F65, 3390, 6},
+	{0x1F66, 3396, 6},
+	{0x1F67, 3402, 6},
+	{0x1F68, 3408, 4},
+	{0x1F69, 3412, 4},
+	{0x1F6A, 3416, 6},
+	{0x1F6B, 3422, 6},
+	{0x1F6C, 3428, 6},
+	{0x1F6D, 3434, 6},
+	{0x1F6E, 3440, 6},
+	{0x1F6F, 3446, 6},
+	{0x1F70, 3452, 4},
+	{0x1F71, 3456, 4},
+	{0x1F72, 3460, 4},
+	{0x1F73, 3464, 4},
+	{0x1F74, 3468, 4},
+	{0x1F75, 3472, 4},
+	{0x1F76, 3476, 4},
+	{0x1F77, 3480, 4},
+	{0x1F78, 3484, 4},
+	{0x1F79, 3488, 4},
+	{0x1F7A, 3492, 4},
+	{0x1F7B, 3496, 4},
+	{0x1F7C, 3500, 4},
+	{0x1F7D, 3504, 4},
+	{0x1F80, 3508, 6},
+	{0x1F81, 3514, 6},
+	{0x1F82, 3520, 8},
+	{0x1F83, 3528, 8},
+	{0x1F84, 3536, 8},
+	{0x1F85, 3544, 8},
+	{0x1F86, 3552, 8},
+	{0x1F87, 3560, 8},
+	{0x1F88, 3568, 6},
+	{0x1F89, 3574, 6},
+	{0x1F8A, 3580, 8},
+	{0x1F8B, 3588, 8},
+	{0x1F8C, 3596, 8},
+	{0x1F8D, 3604, 8},
+	{0x1F8E, 3612, 8},
+	{0x1F8F, 3620, 8},
+	{0x1F90, 3628, 6},
+	{0x1F91, 3634, 6},
+	{0x1F92, 3640, 8},
+	{0x1F93, 3648, 8},
+	{0x1F94, 3656, 8},
+	{0x1F95, 3664, 8},
+	{0x1F96, 3672, 8},
+	{0x1F97, 3680, 8},
+	{0x1F98, 3688, 6},
+	{0x1F99, 3694, 6},
+	{0x1F9A, 3700, 8},
+	{0x1F9B, 3708, 8},
+	{0x1F9C, 3716, 8},
+	{0x1F9D, 3724, 8},
+	{0x1F9E, 3732, 8},
+	{0x1F9F, 3740, 8},
+	{0x1FA0, 3748, 6},
+	{0x1FA1, 3754, 6},
+	{0x1FA2, 3760, 8},
+	{0x1FA3, 3768, 8},
+	{0x1FA4, 3776, 8},
+	{0x1FA5, 3784, 8},
+	{0x1FA6, 3792, 8},
+	{0x1FA7, 3800, 8},
+	{0x1FA8, 3808, 6},
+	{0x1FA9, 3814, 6},
+	{0x1FAA, 3820, 8},
+	{0x1FAB, 3828, 8},
+	{0x1FAC, 3836, 8},
+	{0x1FAD, 3844, 8},
+	{0x1FAE, 3852, 8},
+	{0x1FAF, 3860, 8},
+	{0x1FB0, 3868, 4},
+	{0x1FB1, 3872, 4},
+	{0x1FB2, 3876, 6},
+	{0x1FB3, 3882, 4},
+	{0x1FB4, 3886, 6},
+	{0x1FB6, 3892, 4},
+	{0x1FB7, 3896, 6},
+	{0x1FB8, 3902, 4},
+	{0x1FB9, 3906, 4},
+	{0x1FBA, 3910, 4},
+	{0x1FBB, 3914, 4},
+	{0x1FBC, 3918, 4},
+	{0x1FBD, 3922, 3},
+	{0x1FBE, 3925, 2},
+	{0x1FBF, 3927, 3},
+	{0x1FC0, 3930, 3},
+	{0x1FC1, 3933, 5},
+	{0x1FC2, 3938, 6},
+	{0x1FC3, 3944, 4},
+	{0x1FC4, 3948, 6},
+	{0x1FC6, 3954, 4},
+	{0x1FC7, 3958, 6},
+	{0x1FC8, 3964, 4},
+	{0x1FC9, 3968, 4},
+	{0x1FCA, 3972, 4},
+	{0x1FCB, 3976, 4},
+	{0x1FCC, 3980, 4},
+	{0x1FCD, 3984, 5},
+	{0x1FCE, 3989, 5},
+	{0x1FCF, 3994, 5},
+	{0x1FD0, 3999, 4},
+	{0x1FD1, 4003, 4},
+	{0x1FD2, 4007, 6},
+	{0x1FD3, 4013, 6},
+	{0x1FD6, 4019, 4},
+	{0x1FD7, 4023, 6},
+	{0x1FD8, 4029, 4},
+	{0x1FD9, 4033, 4},
+	{0x1FDA, 4037, 4},
+	{0x1FDB, 4041, 4},
+	{0x1FDD, 4045, 5},
+	{0x1FDE, 4050, 5},
+	{0x1FDF, 4055, 5},
+	{0x1FE0, 4060, 4},
+	{0x1FE1, 4064, 4},
+	{0x1FE2, 4068, 6},
+	{0x1FE3, 4074, 6},
+	{0x1FE4, 4080, 4},
+	{0x1FE5, 4084, 4},
+	{0x1FE6, 4088, 4},
+	{0x1FE7, 4092, 6},
+	{0x1FE8, 4098, 4},
+	{0x1FE9, 4102, 4},
+	{0x1FEA, 4106, 4},
+	{0x1FEB, 4110, 4},
+	{0x1FEC, 4114, 4},
+	{0x1FED, 4118, 5},
+	{0x1FEE, 4123, 5},
+	{0x1FEF, 4128, 1},
+	{0x1FF2, 4129, 6},
+	{0x1FF3, 4135, 4},
+	{0x1FF4, 4139, 6},
+	{0x1FF6, 4145, 4},
+	{0x1FF7, 4149, 6},
+	{0x1FF8, 4155, 4},
+	{0x1FF9, 4159, 4},
+	{0x1FFA, 4163, 4},
+	{0x1FFB, 4167, 4},
+	{0x1FFC, 4171, 4},
+	{0x1FFD, 4175, 3},
+	{0x1FFE, 4178, 3},
+	{0x2000, 4181, 1},
+	{0x2001, 4182, 1},
+	{0x2002, 4183, 1},
+	{0x2003, 4184, 1},
+	{0x2004, 4185, 1},
+	{0x2005, 4186, 1},
+	{0x2006, 4187, 1},
+	{0x2007, 4188, 1},
+	{0x2008, 4189, 1},
+	{0x2009, 4190, 1},
+	{0x200A, 4191, 1},
+	{0x2011, 4192, 3},
+	{0x2017, 4195, 3},
+	{0x2024, 4198, 1},
+	{0x2025, 4199, 2},
+	{0x2026, 4201, 3},
+	{0x202F, 4204, 1},
+	{0x2033, 4205, 6},
+	{0x2034, 4211, 9},
+	{0x2036, 4220, 6},
+	{0x2037, 4226, 9},
+	{0x203C, 4235, 2},
+	{0x203E, 4237, 3},
+	{0x2047, 4240, 2},
+	{0x2048, 4242, 2},
+	{0x2049, 4244, 2},
+	{0x2057, 4246, 12},
+	{0x205F, 4258, 1},
+	{0x2070, 4259, 1},
+	{0x2071, 4260, 1},
+	{0x2074, 4261, 1},
+	{0x2075, 4262, 1},
+	{0x2076, 4263, 1},
+	{0x2077, 4264, 1},
+	{0x2078, 4265, 1},
+	{0x2079, 4266, 1},
+	{0x207A, 4267, 1},
+	{0x207B, 4268, 3},
+	{0x207C, 4271, 1},
+	{0x207D, 4272, 1},
+	{0x207E, 4273, 1},
+	{0x207F, 4274, 1},
+	{0x2080, 4275, 1},
+	{0x2081, 4276, 1},
+	{0x2082, 4277, 1},
+	{0x2083, 4278, 1},
+	{0x2084, 4279, 1},
+	{0x2085, 4280, 1},
+	{0x2086, 4281, 1},
+	{0x2087, 4282, 1},
+	{0x2088, 4283, 1},
+	{0x2089, 4284, 1},
+	{0x208A, 4285, 1},
+	{0x208B, 4286, 3},
+	{0x208C, 4289, 1},
+	{0x208D, 4290, 1},
+	{0x208E, 4291, 1},
+	{0x2090, 4292, 1},
+	{0x2091, 4293, 1},
+	{0x2092, 4294, 1},
+	{0x2093, 4295, 1},
+	{0x2094, 4296, 2},
+	{0x2095, 4298, 1},
+	{0x2096, 4299, 1},
+	{0x2097, 4300, 1},
+	{0x2098, 4301, 1},
+	{0x2099, 4302, 1},
+	{0x209A, 4303, 1},
+	{0x209B, 4304, 1},
+	{0x209C, 4305, 1},
+	{0x20A8, 4306, 2},
+	{0x2100, 4308, 3},
+	{0x2101, 4311, 3},
+	{0x2102, 4314, 1},
+	{0x2103, 4315, 3},
+	{0x2105, 4318, 3},
+	{0x2106, 4321, 3},
+	{0x2107, 4324, 2},
+	{0x2109, 4326, 3},
+	{0x210A, 4329, 1},
+	{0x210B, 4330, 1},
+	{0x210C, 4331, 1},
+	{0x210D, 4332, 1},
+	{0x210E, 4333, 1},
+	{0x210F, 4334, 2},
+	{0x2110, 4336, 1},
+	{0x2111, 4337, 1},
+	{0x2112, 4338, 1},
+	{0x2113, 4339, 1},
+	{0x2115, 4340, 1},
+	{0x2116, 4341, 2},
+	{0x2119, 4343, 1},
+	{0x211A, 4344, 1},
+	{0x211B, 4345, 1},
+	{0x211C, 4346, 1},
+	{0x211D, 4347, 1},
+	{0x2120, 4348, 2},
+	{0x2121, 4350, 3},
+	{0x2122, 4353, 2},
+	{0x2124, 4355, 1},
+	{0x2126, 4356, 2},
+	{0x2128, 4358, 1},
+	{0x212A, 4359, 1},
+	{0x212B, 4360, 3},
+	{0x212C, 4363, 1},
+	{0x212D, 4364, 1},
+	{0x212F, 4365, 1},
+	{0x2130, 4366, 1},
+	{0x2131, 4367, 1},
+	{0x2133, 4368, 1},
+	{0x2134, 4369, 1},
+	{0x2135, 4370, 2},
+	{0x2136, 4372, 2},
+	{0x2137, 4374, 2},
+	{0x2138, 4376, 2},
+	{0x2139, 4378, 1},
+	{0x213B, 4379, 3},
+	{0x213C, 4382, 2},
+	{0x213D, 4384, 2},
+	{0x213E, 4386, 2},
+	{0x213F, 4388, 2},
+	{0x2140, 4390, 3},
+	{0x2145, 4393, 1},
+	{0x2146, 4394, 1},
+	{0x2147, 4395, 1},
+	{0x2148, 4396, 1},
+	{0x2149, 4397, 1},
+	{0x2150, 4398, 5},
+	{0x2151, 4403, 5},
+	{0x2152, 4408, 6},
+	{0x2153, 4414, 5},
+	{0x2154, 4419, 5},
+	{0x2155, 4424, 5},
+	{0x2156, 4429, 5},
+	{0x2157, 4434, 5},
+	{0x2158, 4439, 5},
+	{0x2159, 4444, 5},
+	{0x215A, 4449, 5},
+	{0x215B, 4454, 5},
+	{0x215C, 4459, 5},
+	{0x215D, 4464, 5},
+	{0x215E, 4469, 5},
+	{0x215F, 4474, 4},
+	{0x2160, 4478, 1},
+	{0x2161, 4479, 2},
+	{0x2162, 4481, 3},
+	{0x2163, 4484, 2},
+	{0x2164, 4486, 1},
+	{0x2165, 4487, 2},
+	{0x2166, 4489, 3},
+	{0x2167, 4492, 4},
+	{0x2168, 4496, 2},
+	{0x2169, 4498, 1},
+	{0x216A, 4499, 2},
+	{0x216B, 4501, 3},
+	{0x216C, 4504, 1},
+	{0x216D, 4505, 1},
+	{0x216E, 4506, 1},
+	{0x216F, 4507, 1},
+	{0x2170, 4508, 1},
+	{0x2171, 4509, 2},
+	{0x2172, 4511, 3},
+	{0x2173, 4514, 2},
+	{0x2174, 4516, 1},
+	{0x2175, 4517, 2},
+	{0x2176, 4519, 3},
+	{0x2177, 4522, 4},
+	{0x2178, 4526, 2},
+	{0x2179, 4528, 1},
+	{0x217A, 4529, 2},
+	{0x217B, 4531, 3},
+	{0x217C, 4534, 1},
+	{0x217D, 4535, 1},
+	{0x217E, 4536, 1},
+	{0x217F, 4537, 1},
+	{0x2189, 4538, 5},
+	{0x219A, 4543, 5},
+	{0x219B, 4548, 5},
+	{0x21AE, 4553, 5},
+	{0x21CD, 4558, 5},
+	{0x21CE, 4563, 5},
+	{0x21CF, 4568, 5},
+	{0x2204, 4573, 5},
+	{0x2209, 4578, 5},
+	{0x220C, 4583, 5},
+	{0x2224, 4588, 5},
+	{0x2226, 4593, 5},
+	{0x222C, 4598, 6},
+	{0x222D, 4604, 9},
+	{0x222F, 4613, 6},
+	{0x2230, 4619, 9},
+	{0x2241, 4628, 5},
+	{0x2244, 4633, 5},
+	{0x2247, 4638, 5},
+	{0x2249, 4643, 5},
+	{0x2260, 4648, 3},
+	{0x2262, 4651, 5},
+	{0x226D, 4656, 5},
+	{0x226E, 4661, 3},
+	{0x226F, 4664, 3},
+	{0x2270, 4667, 5},
+	{0x2271, 4672, 5},
+	{0x2274, 4677, 5},
+	{0x2275, 4682, 5},
+	{0x2278, 4687, 5},
+	{0x2279, 4692, 5},
+	{0x2280, 4697, 5},
+	{0x2281, 4702, 5},
+	{0x2284, 4707, 5},
+	{0x2285, 4712, 5},
+	{0x2288, 4717, 5},
+	{0x2289, 4722, 5},
+	{0x22AC, 4727, 5},
+	{0x22AD, 4732, 5},
+	{0x22AE, 4737, 5},
+	{0x22AF, 4742, 5},
+	{0x22E0, 4747, 5},
+	{0x22E1, 4752, 5},
+	{0x22E2, 4757, 5},
+	{0x22E3, 4762, 5},
+	{0x22EA, 4767, 5},
+	{0x22EB, 4772, 5},
+	{0x22EC, 4777, 5},
+	{0x22ED, 4782, 5},
+	{0x2329, 4787, 3},
+	{0x232A, 4790, 3},
+	{0x2460, 4793, 1},
+	{0x2461, 4794, 1},
+	{0x2462, 4795, 1},
+	{0x2463, 4796, 1},
+	{0x2464, 4797, 1},
+	{0x2465, 4798, 1},
+	{0x2466, 4799, 1},
+	{0x2467, 4800, 1},
+	{0x2468, 4801, 1},
+	{0x2469, 4802, 2},
+	{0x246A, 4804, 2},
+	{0x246B, 4806, 2},
+	{0x246C, 4808, 2},
+	{0x246D, 4810, 2},
+	{0x246E, 4812, 2},
+	{0x246F, 4814, 2},
+	{0x2470, 4816, 2},
+	{0x2471, 4818, 2},
+	{0x2472, 4820, 2},
+	{0x2473, 4822, 2},
+	{0x2474, 4824, 3},
+	{0x2475, 4827, 3},
+	{0x2476, 4830, 3},
+	{0x2477, 4833, 3},
+	{0x2478, 4836, 3},
+	{0x2479, 4839, 3},
+	{0x247A, 4842, 3},
+	{0x247B, 4845, 3},
+	{0x247C, 4848, 3},
+	{0x247D, 4851, 4},
+	{0x247E, 4855, 4},
+	{0x247F, 4859, 4},
+	{0x2480, 4863, 4},
+	{0x2481, 4867, 4},
+	{0x2482, 4871, 4},
+	{0x2483, 4875, 4},
+	{0x2484, 4879, 4},
+	{0x2485, 4883, 4},
+	{0x2486, 4887, 4},
+	{0x2487, 4891, 4},
+	{0x2488, 4895, 2},
+	{0x2489, 4897, 2},
+	{0x248A, 4899, 2},
+	{0x248B, 4901, 2},
+	{0x248C, 4903, 2},
+	{0x248D, 4905, 2},
+	{0x248E, 4907, 2},
+	{0x248F, 4909, 2},
+	{0x2490, 4911, 2},
+	{0x2491, 4913, 3},
+	{0x2492, 4916, 3},
+	{0x2493, 4919, 3},
+	{0x2494, 4922, 3},
+	{0x2495, 4925, 3},
+	{0x2496, 4928, 3},
+	{0x2497, 4931, 3},
+	{0x2498, 4934, 3},
+	{0x2499, 4937, 3},
+	{0x249A, 4940, 3},
+	{0x249B, 4943, 3},
+	{0x249C, 4946, 3},
+	{0x249D, 4949, 3},
+	{0x249E, 4952, 3},
+	{0x249F, 4955, 3},
+	{0x24A0, 4958, 3},
+	{0x24A1, 4961, 3},
+	{0x24A2, 4964, 3},
+	{0x24A3, 4967, 3},
+	{0x24A4, 4970, 3},
+	{0x24A5, 4973, 3},
+	{0x24A6, 4976, 3},
+	{0x24A7, 4979, 3},
+	{0x24A8, 4982, 3},
+	{0x24A9, 4985, 3},
+	{0x24AA, 4988, 3},
+	{0x24AB, 4991, 3},
+	{0x24AC, 4994, 3},
+	{0x24AD, 4997, 3},
+	{0x24AE, 5000, 3},
+	{0x24AF, 5003, 3},
+	{0x24B0, 5006, 3},
+	{0x24B1, 5009, 3},
+	{0x24B2, 5012, 3},
+	{0x24B3, 5015, 3},
+	{0x24B4, 5018, 3},
+	{0x24B5, 5021, 3},
+	{0x24B6, 5024, 1},
+	{0x24B7, 5025, 1},
+	{0x24B8, 5026, 1},
+	{0x24B9, 5027, 1},
+	{0x24BA, 5028, 1},
+	{0x24BB, 5029, 1},
+	{0x24BC, 5030, 1},
+	{0x24BD, 5031, 1},
+	{0x24BE, 5032, 1},
+	{0x24BF, 5033, 1},
+	{0x24C0, 5034, 1},
+	{0x24C1, 5035, 1},
+	{0x24C2, 5036, 1},
+	{0x24C3, 5037, 1},
+	{0x24C4, 5038, 1},
+	{0x24C5, 5039, 1},
+	{0x24C6, 5040, 1},
+	{0x24C7, 5041, 1},
+	{0x24C8, 5042, 1},
+	{0x24C9, 5043, 1},
+	{0x24CA, 5044, 1},
+	{0x24CB, 5045, 1},
+	{0x24CC, 5046, 1},
+	{0x24CD, 5047, 1},
+	{0x24CE, 5048, 1},
+	{0x24CF, 5049, 1},
+	{0x24D0, 5050, 1},
+	{0x24D1, 5051, 1},
+	{0x24D2, 5052, 1},
+	{0x24D3, 5053, 1},
+	{0x24D4, 5054, 1},
+	{0x24D5, 5055, 1},
+	{0x24D6, 5056, 1},
+	{0x24D7, 5057, 1},
+	{0x24D8, 5058, 1},
+	{0x24D9, 5059, 1},
+	{0x24DA, 5060, 1},
+	{0x24DB, 5061, 1},
+	{0x24DC, 5062, 1},
+	{0x24DD, 5063, 1},
+	{0x24DE, 5064, 1},
+	{0x24DF, 5065, 1},
+	{0x24E0, 5066, 1},
+	{0x24E1, 5067, 1},
+	{0x24E2, 5068, 1},
+	{0x24E3, 5069, 1},
+	{0x24E4, 5070, 1},
+	{0x24E5, 5071, 1},
+	{0x24E6, 5072, 1},
+	{0x24E7, 5073, 1},
+	{0x24E8, 5074, 1},
+	{0x24E9, 5075, 1},
+	{0x24EA, 5076, 1},
+	{0x2A0C, 5077, 12},
+	{0x2A74, 5089, 3},
+	{0x2A75, 5092, 2},
+	{0x2A76, 5094, 3},
+	{0x2ADC, 5097, 5},
+	{0x2C7C, 5102, 1},
+	{0x2C7D, 5103, 1},
+	{0x2D6F, 5104, 3},
+	{0x2E9F, 5107, 3},
+	{0x2EF3, 5110, 3},
+	{0x2F00, 5113, 3},
+	{0x2F01, 5116, 3},
+	{0x2F02, 5119, 3},
+	{0x2F03, 5122, 3},
+	{0x2F04, 5125, 3},
+	{0x2F05, 5128, 3},
+	{0x2F06, 5131, 3},
+	{0x2F07, 5134, 3},
+	{0x2F08, 5137, 3},
+	{0x2F09, 5140, 3},
+	{0x2F0A, 5143, 3},
+	{0x2F0B, 5146, 3},
+	{0x2F0C, 5149, 3},
+	{0x2F0D, 5152, 3},
+	{0x2F0E, 5155, 3},
+	{0x2F0F, 5158, 3},
+	{0x2F10, 5161, 3},
+	{0x2F11, 5164, 3},
+	{0x2F12, 5167, 3},
+	{0x2F13, 5170, 3},
+	{0x2F14, 5173, 3},
+	{0x2F15, 5176, 3},
+	{0x2F16, 5179, 3},
+	{0x2F17, 5182, 3},
+	{0x2F18, 5185, 3},
+	{0x2F19, 5188, 3},
+	{0x2F1A, 5191, 3},
+	{0x2F1B, 5194, 3},
+	{0x2F1C, 5197, 3},
+	{0x2F1D, 5200, 3},
+	{0x2F1E, 5203, 3},
+	{0x2F1F, 5206, 3},
+	{0x2F20, 5209, 3},
+	{0x2F21, 5212, 3},
+	{0x2F22, 5215, 3},
+	{0x2F23, 5218, 3},
+	{0x2F24, 5221, 3},
+	{0x2F25, 5224, 3},
+	{0x2F26, 5227, 3},
+	{0x2F27, 5230, 3},
+	{0x2F28, 5233, 3},
+	{0x2F29, 5236, 3},
+	{0x2F2A, 5239, 3},
+	{0x2F2B, 5242, 3},
+	{0x2F2C, 5245, 3},
+	{0x2F2D, 5248, 3},
+	{0x2F2E, 5251, 3},
+	{0x2F2F, 5254, 3},
+	{0x2F30, 5257, 3},
+	{0x2F31, 5260, 3},
+	{0x2F32, 5263, 3},
+	{0x2F33, 5266, 3},
+	{0x2F34, 5269, 3},
+	{0x2F35, 5272, 3},
+	{0x2F36, 5275, 3},
+	{0x2F37, 5278, 3},
+	{0x2F38, 5281, 3},
+	{0x2F39, 5284, 3},
+	{0x2F3A, 5287, 3},
+	{0x2F3B, 5290, 3},
+	{0x2F3C, 5293, 3},
+	{0x2F3D, 5296, 3},
+	{0x2F3E, 5299, 3},
+	{0x2F3F, 5302, 3},
+	{0x2F40, 5305, 3},
+	{0x2F41, 5308, 3},
+	{0x2F42, 5311, 3},
+	{0x2F43, 5314, 3},
+	{0x2F44, 5317, 3},
+	{0x2F45, 5320, 3},
+	{0x2F46, 5323, 3},
+	{0x2F47, 5326, 3},
+	{0x2F48, 5329, 3},
+	{0x2F49, 5332, 3},
+	{0x2F4A, 5335, 3},
+	{0x2F4B, 5338, 3},
+	{0x2F4C, 5341, 3},
+	{0x2F4D, 5344, 3},
+	{0x2F4E, 5347, 3},
+	{0x2F4F, 5350, 3},
+	{0x2F50, 5353, 3},
+	{0x2F51, 5356, 3},
+	{0x2F52, 5359, 3},
+	{0x2F53, 5362, 3},
+	{0x2F54, 5365, 3},
+	{0x2F55, 5368, 3},
+	{0x2F56, 5371, 3},
+	{0x2F57, 5374, 3},
+	{0x2F58, 5377, 3},
+	{0x2F59, 5380, 3},
+	{0x2F5A, 5383, 3},
+	{0x2F5B, 5386, 3},
+	{0x2F5C, 5389, 3},
+	{0x2F5D, 5392, 3},
+	{0x2F5E, 5395, 3},
+	{0x2F5F, 5398, 3},
+	{0x2F60, 5401, 3},
+	{0x2F61, 5404, 3},
+	{0x2F62, 5407, 3},
+	{0x2F63, 5410, 3},
+	{0x2F64, 5413, 3},
+	{0x2F65, 5416, 3},
+	{0x2F66, 5419, 3},
+	{0x2F67, 5422, 3},
+	{0x2F68, 5425, 3},
+	{0x2F69, 5428, 3},
+	{0x2F6A, 5431, 3},
+	{0x2F6B, 5434, 3},
+	{0x2F6C, 5437, 3},
+	{0x2F6D, 5440, 3},
+	{0x2F6E, 5443, 3},
+	{0x2F6F, 5446, 3},
+	{0x2F70, 5449, 3},
+	{0x2F71, 5452, 3},
+	{0x2F72, 5455, 3},
+	{0x2F73, 5458, 3},
+	{0x2F74, 5461, 3},
+	{0x2F75, 5464, 3},
+	{0x2F76, 5467, 3},
+	{0x2F77, 5470, 3},
+	{0x2F78, 5473, 3},
+	{0x2F79, 5476, 3},
+	{0x2F7A, 5479, 3},
+	{0x2F7B, 5482, 3},
+	{0x2F7C, 5485, 3},
+	{0x2F7D, 5488, 3},
+	{0x2F7E, 5491, 3},
+	{0x2F7F, 5494, 3},
+	{0x2F80, 5497, 3},
+	{0x2F81, 5500, 3},
+	{0x2F82, 5503, 3},
+	{0x2F83, 5506, 3},
+	{0x2F84, 5509, 3},
+	{0x2F85, 5512, 3},
+	{0x2F86, 5515, 3},
+	{0x2F87, 5518, 3},
+	{0x2F88, 5521, 3},
+	{0x2F89, 5524, 3},
+	{0x2F8A, 5527, 3},
+	{0x2F8B, 5530, 3},
+	{0x2F8C, 5533, 3},
+	{0x2F8D, 5536, 3},
+	{0x2F8E, 5539, 3},
+	{0x2F8F, 5542, 3},
+	{0x2F90, 5545, 3},
+	{0x2F91, 5548, 3},
+	{0x2F92, 5551, 3},
+	{0x2F93, 5554, 3},
+	{0x2F94, 5557, 3},
+	{0x2F95, 5560, 3},
+	{0x2F96, 5563, 3},
+	{0x2F97, 5566, 3},
+	{0x2F98, 5569, 3},
+	{0x2F99, 5572, 3},
+	{0x2F9A, 5575, 3},
+	{0x2F9B, 5578, 3},
+	{0x2F9C, 5581, 3},
+	{0x2F9D, 5584, 3},
+	{0x2F9E, 5587, 3},
+	{0x2F9F, 5590, 3},
+	{0x2FA0, 5593, 3},
+	{0x2FA1, 5596, 3},
+	{0x2FA2, 5599, 3},
+	{0x2FA3, 5602, 3},
+	{0x2FA4, 5605, 3},
+	{0x2FA5, 5608, 3},
+	{0x2FA6, 5611, 3},
+	{0x2FA7, 5614, 3},
+	{0x2FA8, 5617, 3},
+	{0x2FA9, 5620, 3},
+	{0x2FAA, 5623, 3},
+	{0x2FAB, 5626, 3},
+	{0x2FAC, 5629, 3},
+	{0x2FAD, 5632, 3},
+	{0x2FAE, 5635, 3},
+	{0x2FAF, 5638, 3},
+	{0x2FB0, 5641, 3},
+	{0x2FB1, 5644, 3},
+	{0x2FB2, 5647, 3},
+	{0x2FB3, 5650, 3},
+	{0x2FB4, 5653, 3},
+	{0x2FB5, 5656, 3},
+	{0x2FB6, 5659, 3},
+	{0x2FB7, 5662, 3},
+	{0x2FB8, 5665, 3},
+	{0x2FB9, 5668, 3},
+	{0x2FBA, 5671, 3},
+	{0x2FBB, 5674, 3},
+	{0x2FBC, 5677, 3},
+	{0x2FBD, 5680, 3},
+	{0x2FBE, 5683, 3},
+	{0x2FBF, 5686, 3},
+	{0x2FC0, 5689, 3},
+	{0x2FC1, 5692, 3},
+	{0x2FC2, 5695, 3},
+	{0x2FC3, 5698, 3},
+	{0x2FC4, 5701, 3},
+	{0x2FC5, 5704, 3},
+	{0x2FC6, 5707, 3},
+	{0x2FC7, 5710, 3},
+	{0x2FC8, 5713, 3},
+	{0x2FC9, 5716, 3},
+	{0x2FCA, 5719, 3},
+	{0x2FCB, 5722, 3},
+	{0x2FCC, 5725, 3},
+	{0x2FCD, 5728, 3},
+	{0x2FCE, 5731, 3},
+	{0x2FCF, 5734, 3},
+	{0x2FD0, 5737, 3},
+	{0x2FD1, 5740, 3},
+	{0x2FD2, 5743, 3},
+	{0x2FD3, 5746, 3},
+	{0x2FD4, 5749, 3},
+	{0x2FD5, 5752, 3},
+	{0x3000, 5755, 1},
+	{0x3036, 5756, 3},
+	{0x3038, 5759, 3},
+	{0x3039, 5762, 3},
+	{0x303A, 5765, 3},
+	{0x304C, 5768, 6},
+	{0x304E, 5774, 6},
+	{0x3050, 5780, 6},
+	{0x3052, 5786, 6},
+	{0x3054, 5792, 6},
+	{0x3056, 5798, 6},
+	{0x3058, 5804, 6},
+	{0x305A, 5810, 6},
+	{0x305C, 5816, 6},
+	{0x305E, 5822, 6},
+	{0x3060, 5828, 6},
+	{0x3062, 5834, 6},
+	{0x3065, 5840, 6},
+	{0x3067, 5846, 6},
+	{0x3069, 5852, 6},
+	{0x3070, 5858, 6},
+	{0x3071, 5864, 6},
+	{0x3073, 5870, 6},
+	{0x3074, 5876, 6},
+	{0x3076, 5882, 6},
+	{0x3077, 5888, 6},
+	{0x3079, 5894, 6},
+	{0x307A, 5900, 6},
+	{0x307C, 5906, 6},
+	{0x307D, 5912, 6},
+	{0x3094, 5918, 6},
+	{0x309B, 5924, 4},
+	{0x309C, 5928, 4},
+	{0x309E, 5932, 6},
+	{0x309F, 5938, 6},
+	{0x30AC, 5944, 6},
+	{0x30AE, 5950, 6},
+	{0x30B0, 5956, 6},
+	{0x30B2, 5962, 6},
+	{0x30B4, 5968, 6},
+	{0x30B6, 5974, 6},
+	{0x30B8, 5980, 6},
+	{0x30BA, 5986, 6},
+	{0x30BC, 5992, 6},
+	{0x30BE, 5998, 6},
+	{0x30C0, 6004, 6},
+	{0x30C2, 6010, 6},
+	{0x30C5, 6016, 6},
+	{0x30C7, 6022, 6},
+	{0x30C9, 6028, 6},
+	{0x30D0, 6034, 6},
+	{0x30D1, 6040, 6},
+	{0x30D3, 6046, 6},
+	{0x30D4, 6052, 6},
+	{0x30D6, 6058, 6},
+	{0x30D7, 6064, 6},
+	{0x30D9, 6070, 6},
+	{0x30DA, 6076, 6},
+	{0x30DC, 6082, 6},
+	{0x30DD, 6088, 6},
+	{0x30F4, 6094, 6},
+	{0x30F7, 6100, 6},
+	{0x30F8, 6106, 6},
+	{0x30F9, 6112, 6},
+	{0x30FA, 6118, 6},
+	{0x30FE, 6124, 6},
+	{0x30FF, 6130, 6},
+	{0x3131, 6136, 3},
+	{0x3132, 6139, 3},
+	{0x3133, 6142, 3},
+	{0x3134, 6145, 3},
+	{0x3135, 6148, 3},
+	{0x3136, 6151, 3},
+	{0x3137, 6154, 3},
+	{0x3138, 6157, 3},
+	{0x3139, 6160, 3},
+	{0x313A, 6163, 3},
+	{0x313B, 6166, 3},
+	{0x313C, 6169, 3},
+	{0x313D, 6172, 3},
+	{0x313E, 6175, 3},
+	{0x313F, 6178, 3},
+	{0x3140, 6181, 3},
+	{0x3141, 6184, 3},
+	{0x3142, 6187, 3},
+	{0x3143, 6190, 3},
+	{0x3144, 6193, 3},
+	{0x3145, 6196, 3},
+	{0x3146, 6199, 3},
+	{0x3147, 6202, 3},
+	{0x3148, 6205, 3},
+	{0x3149, 6208, 3},
+	{0x314A, 6211, 3},
+	{0x314B, 6214, 3},
+	{0x314C, 6217, 3},
+	{0x314D, 6220, 3},
+	{0x314E, 6223, 3},
+	{0x314F, 6226, 3},
+	{0x3150, 6229, 3},
+	{0x3151, 6232, 3},
+	{0x3152, 6235, 3},
+	{0x3153, 6238, 3},
+	{0x3154, 6241, 3},
+	{0x3155, 6244, 3},
+	{0x3156, 6247, 3},
+	{0x3157, 6250, 3},
+	{0x3158, 6253, 3},
+	{0x3159, 6256, 3},
+	{0x315A, 6259, 3},
+	{0x315B, 6262, 3},
+	{0x315C, 6265, 3},
+	{0x315D, 6268, 3},
+	{0x315E, 6271, 3},
+	{0x315F, 6274, 3},
+	{0x3160, 6277, 3},
+	{0x3161, 6280, 3},
+	{0x3162, 6283, 3},
+	{0x3163, 6286, 3},
+	{0x3164, 6289, 3},
+	{0x3165, 6292, 3},
+	{0x3166, 6295, 3},
+	{0x3167, 6298, 3},
+	{0x3168, 6301, 3},
+	{0x3169, 6304, 3},
+	{0x316A, 6307, 3},
+	{0x316B, 6310, 3},
+	{0x316C, 6313, 3},
+	{0x316D, 6316, 3},
+	{0x316E, 6319, 3},
+	{0x316F, 6322, 3},
+	{0x3170, 6325, 3},
+	{0x3171, 6328, 3},
+	{0x3172, 6331, 3},
+	{0x3173, 6334, 3},
+	{0x3174, 6337, 3},
+	{0x3175, 6340, 3},
+	{0x3176, 6343, 3},
+	{0x3177, 6346, 3},
+	{0x3178, 6349, 3},
+	{0x3179, 6352, 3},
+	{0x317A, 6355, 3},
+	{0x317B, 6358, 3},
+	{0x317C, 6361, 3},
+	{0x317D, 6364, 3},
+	{0x317E, 6367, 3},
+	{0x317F, 6370, 3},
+	{0x3180, 6373, 3},
+	{0x3181, 6376, 3},
+	{0x3182, 6379, 3},
+	{0x3183, 6382, 3},
+	{0x3184, 6385, 3},
+	{0x3185, 6388, 3},
+	{0x3186, 6391, 3},
+	{0x3187, 6394, 3},
+	{0x3188, 6397, 3},
+	{0x3189, 6400, 3},
+	{0x318A, 6403, 3},
+	{0x318B, 6406, 3},
+	{0x318C, 6409, 3},
+	{0x318D, 6412, 3},
+	{0x318E, 6415, 3},
+	{0x3192, 6418, 3},
+	{0x3193, 6421, 3},
+	{0x3194, 6424, 3},
+	{0x3195, 6427, 3},
+	{0x3196, 6430, 3},
+	{0x3197, 6433, 3},
+	{0x3198, 6436, 3},
+	{0x3199, 6439, 3},
+	{0x319A, 6442, 3},
+	{0x319B, 6445, 3},
+	{0x319C, 6448, 3},
+	{0x319D, 6451, 3},
+	{0x319E, 6454, 3},
+	{0x319F, 6457, 3},
+	{0x3200, 6460, 5},
+	{0x3201, 6465, 5},
+	{0x3202, 6470, 5},
+	{0x3203, 6475, 5},
+	{0x3204, 6480, 5},
+	{0x3205, 6485, 5},
+	{0x3206, 6490, 5},
+	{0x3207, 6495, 5},
+	{0x3208, 6500, 5},
+	{0x3209, 6505, 5},
+	{0x320A, 6510, 5},
+	{0x320B, 6515, 5},
+	{0x320C, 6520, 5},
+	{0x320D, 6525, 5},
+	{0x320E, 6530, 8},
+	{0x320F, 6538, 8},
+	{0x3210, 6546, 8},
+	{0x3211, 6554, 8},
+	{0x3212, 6562, 8},
+	{0x3213, 6570, 8},
+	{0x3214, 6578, 8},
+	{0x3215, 6586, 8},
+	{0x3216, 6594, 8},
+	{0x3217, 6602, 8},
+	{0x3218, 6610, 8},
+	{0x3219, 6618, 8},
+	{0x321A, 6626, 8},
+	{0x321B, 6634, 8},
+	{0x321C, 6642, 8},
+	{0x321D, 6650, 17},
+	{0x321E, 6667, 14},
+	{0x3220, 6681, 5},
+	{0x3221, 6686, 5},
+	{0x3222, 6691, 5},
+	{0x3223, 6696, 5},
+	{0x3224, 6701, 5},
+	{0x3225, 6706, 5},
+	{0x3226, 6711, 5},
+	{0x3227, 6716, 5},
+	{0x3228, 6721, 5},
+	{0x3229, 6726, 5},
+	{0x322A, 6731, 5},
+	{0x322B, 6736, 5},
+	{0x322C, 6741, 5},
+	{0x322D, 6746, 5},
+	{0x322E, 6751, 5},
+	{0x322F, 6756, 5},
+	{0x3230, 6761, 5},
+	{0x3231, 6766, 5},
+	{0x3232, 6771, 5},
+	{0x3233, 6776, 5},
+	{0x3234, 6781, 5},
+	{0x3235, 6786, 5},
+	{0x3236, 6791, 5},
+	{0x3237, 6796, 5},
+	{0x3238, 6801, 5},
+	{0x3239, 6806, 5},
+	{0x323A, 6811, 5},
+	{0x323B, 6816, 5},
+	{0x323C, 6821, 5},
+	{0x323D, 6826, 5},
+	{0x323E, 6831, 5},
+	{0x323F, 6836, 5},
+	{0x3240, 6841, 5},
+	{0x3241, 6846, 5},
+	{0x3242, 6851, 5},
+	{0x3243, 6856, 5},
+	{0x3244, 6861, 3},
+	{0x3245, 6864, 3},
+	{0x3246, 6867, 3},
+	{0x3247, 6870, 3},
+	{0x3250, 6873, 3},
+	{0x3251, 6876, 2},
+	{0x3252, 6878, 2},
+	{0x3253, 6880, 2},
+	{0x3254, 6882, 2},
+	{0x3255, 6884, 2},
+	{0x3256, 6886, 2},
+	{0x3257, 6888, 2},
+	{0x3258, 6890, 2},
+	{0x3259, 6892, 2},
+	{0x325A, 6894, 2},
+	{0x325B, 6896, 2},
+	{0x325C, 6898, 2},
+	{0x325D, 6900, 2},
+	{0x325E, 6902, 2},
+	{0x325F, 6904, 2},
+	{0x3260, 6906, 3},
+	{0x3261, 6909, 3},
+	{0x3262, 6912, 3},
+	{0x3263, 6915, 3},
+	{0x3264, 6918, 3},
+	{0x3265, 6921, 3},
+	{0x3266, 6924, 3},
+	{0x3267, 6927, 3},
+	{0x3268, 6930, 3},
+	{0x3269, 6933, 3},
+	{0x326A, 6936, 3},
+	{0x326B, 6939, 3},
+	{0x326C, 6942, 3},
+	{0x326D, 6945, 3},
+	{0x326E, 6948, 6},
+	{0x326F, 6954, 6},
+	{0x3270, 6960, 6},
+	{0x3271, 6966, 6},
+	{0x3272, 6972, 6},
+	{0x3273, 6978, 6},
+	{0x3274, 6984, 6},
+	{0x3275, 6990, 6},
+	{0x3276, 6996, 6},
+	{0x3277, 7002, 6},
+	{0x3278, 7008, 6},
+	{0x3279, 7014, 6},
+	{0x327A, 7020, 6},
+	{0x327B, 7026, 6},
+	{0x327C, 7032, 15},
+	{0x327D, 7047, 12},
+	{0x327E, 7059, 6},
+	{0x3280, 7065, 3},
+	{0x3281, 7068, 3},
+	{0x3282, 7071, 3},
+	{0x3283, 7074, 3},
+	{0x3284, 7077, 3},
+	{0x3285, 7080, 3},
+	{0x3286, 7083, 3},
+	{0x3287, 7086, 3},
+	{0x3288, 7089, 3},
+	{0x3289, 7092, 3},
+	{0x328A, 7095, 3},
+	{0x328B, 7098, 3},
+	{0x328C, 7101, 3},
+	{0x328D, 7104, 3},
+	{0x328E, 7107, 3},
+	{0x328F, 7110, 3},
+	{0x3290, 7113, 3},
+	{0x3291, 7116, 3},
+	{0x3292, 7119, 3},
+	{0x3293, 7122, 3},
+	{0x3294, 7125, 3},
+	{0x3295, 7128, 3},
+	{0x3296, 7131, 3},
+	{0x3297, 7134, 3},
+	{0x3298, 7137, 3},
+	{0x3299, 7140, 3},
+	{0x329A, 7143, 3},
+	{0x329B, 7146, 3},
+	{0x329C, 7149, 3},
+	{0x329D, 7152, 3},
+	{0x329E, 7155, 3},
+	{0x329F, 7158, 3},
+	{0x32A0, 7161, 3},
+	{0x32A1, 7164, 3},
+	{0x32A2, 7167, 3},
+	{0x32A3, 7170, 3},
+	{0x32A4, 7173, 3},
+	{0x32A5, 7176, 3},
+	{0x32A6, 7179, 3},
+	{0x32A7, 7182, 3},
+	{0x32A8, 7185, 3},
+	{0x32A9, 7188, 3},
+	{0x32AA, 7191, 3},
+	{0x32AB, 7194, 3},
+	{0x32AC, 7197, 3},
+	{0x32AD, 7200, 3},
+	{0x32AE, 7203, 3},
+	{0x32AF, 7206, 3},
+	{0x32B0, 7209, 3},
+	{0x32B1, 7212, 2},
+	{0x32B2, 7214, 2},
+	{0x32B3, 7216, 2},
+	{0x32B4, 7218, 2},
+	{0x32B5, 7220, 2},
+	{0x32B6, 7222, 2},
+	{0x32B7, 7224, 2},
+	{0x32B8, 7226, 2},
+	{0x32B9, 7228, 2},
+	{0x32BA, 7230, 2},
+	{0x32BB, 7232, 2},
+	{0x32BC, 7234, 2},
+	{0x32BD, 7236, 2},
+	{0x32BE, 7238, 2},
+	{0x32BF, 7240, 2},
+	{0x32C0, 7242, 4},
+	{0x32C1, 7246, 4},
+	{0x32C2, 7250, 4},
+	{0x32C3, 7254, 4},
+	{0x32C4, 7258, 4},
+	{0x32C5, 7262, 4},
+	{0x32C6, 7266, 4},
+	{0x32C7, 7270, 4},
+	{0x32C8, 7274, 4},
+	{0x32C9, 7278, 5},
+	{0x32CA, 7283, 5},
+	{0x32CB, 7288, 5},
+	{0x32CC, 7293, 2},
+	{0x32CD, 7295, 3},
+	{0x32CE, 7298, 2},
+	{0x32CF, 7300, 3},
+	{0x32D0, 7303, 3},
+	{0x32D1, 7306, 3},
+	{0x32D2, 7309, 3},
+	{0x32D3, 7312, 3},
+	{0x32D4, 7315, 3},
+	{0x32D5, 7318, 3},
+	{0x32D6, 7321, 3},
+	{0x32D7, 7324, 3},
+	{0x32D8, 7327, 3},
+	{0x32D9, 7330, 3},
+	{0x32DA, 7333, 3},
+	{0x32DB, 7336, 3},
+	{0x32DC, 7339, 3},
+	{0x32DD, 7342, 3},
+	{0x32DE, 7345, 3},
+	{0x32DF, 7348, 3},
+	{0x32E0, 7351, 3},
+	{0x32E1, 7354, 3},
+	{0x32E2, 7357, 3},
+	{0x32E3, 7360, 3},
+	{0x32E4, 7363, 3},
+	{0x32E5, 7366, 3},
+	{0x32E6, 7369, 3},
+	{0x32E7, 7372, 3},
+	{0x32E8, 7375, 3},
+	{0x32E9, 7378, 3},
+	{0x32EA, 7381, 3},
+	{0x32EB, 7384, 3},
+	{0x32EC, 7387, 3},
+	{0x32ED, 7390, 3},
+	{0x32EE, 7393, 3},
+	{0x32EF, 7396, 3},
+	{0x32F0, 7399, 3},
+	{0x32F1, 7402, 3},
+	{0x32F2, 7405, 3},
+	{0x32F3, 7408, 3},
+	{0x32F4, 7411, 3},
+	{0x32F5, 7414, 3},
+	{0x32F6, 7417, 3},
+	{0x32F7, 7420, 3},
+	{0x32F8, 7423, 3},
+	{0x32F9, 7426, 3},
+	{0x32FA, 7429, 3},
+	{0x32FB, 7432, 3},
+	{0x32FC, 7435, 3},
+	{0x32FD, 7438, 3},
+	{0x32FE, 7441, 3},
+	{0x32FF, 7444, 6},
+	{0x3300, 7450, 15},
+	{0x3301, 7465, 12},
+	{0x3302, 7477, 15},
+	{0x3303, 7492, 9},
+	{0x3304, 7501, 15},
+	{0x3305, 7516, 9},
+	{0x3306, 7525, 9},
+	{0x3307, 7534, 18},
+	{0x3308, 7552, 12},
+	{0x3309, 7564, 9},
+	{0x330A, 7573, 9},
+	{0x330B, 7582, 9},
+	{0x330C, 7591, 12},
+	{0x330D, 7603, 12},
+	{0x330E, 7615, 12},
+	{0x330F, 7627, 12},
+	{0x3310, 7639, 12},
+	{0x3311, 7651, 12},
+	{0x3312, 7663, 12},
+	{0x3313, 7675, 18},
+	{0x3314, 7693, 6},
+	{0x3315, 7699, 18},
+	{0x3316, 7717, 18},
+	{0x3317, 7735, 15},
+	{0x3318, 7750, 12},
+	{0x3319, 7762, 18},
+	{0x331A, 7780, 18},
+	{0x331B, 7798, 12},
+	{0x331C, 7810, 9},
+	{0x331D, 7819, 9},
+	{0x331E, 7828, 12},
+	{0x331F, 7840, 12},
+	{0x3320, 7852, 15},
+	{0x3321, 7867, 15},
+	{0x3322, 7882, 9},
+	{0x3323, 7891, 9},
+	{0x3324, 7900, 12},
+	{0x3325, 7912, 9},
+	{0x3326, 7921, 9},
+	{0x3327, 7930, 6},
+	{0x3328, 7936, 6},
+	{0x3329, 7942, 9},
+	{0x332A, 7951, 9},
+	{0x332B, 7960, 18},
+	{0x332C, 7978, 12},
+	{0x332D, 7990, 15},
+	{0x332E, 8005, 18},
+	{0x332F, 8023, 12},
+	{0x3330, 8035, 9},
+	{0x3331, 8044, 9},
+	{0x3332, 8053, 18},
+	{0x3333, 8071, 12},
+	{0x3334, 8083, 18},
+	{0x3335, 8101, 9},
+	{0x3336, 8110, 15},
+	{0x3337, 8125, 9},
+	{0x3338, 8134, 12},
+	{0x3339, 8146, 9},
+	{0x333A, 8155, 12},
+	{0x333B, 8167, 15},
+	{0x333C, 8182, 12},
+	{0x333D, 8194, 15},
+	{0x333E, 8209, 12},
+	{0x333F, 8221, 6},
+	{0x3340, 8227, 15},
+	{0x3341, 8242, 9},
+	{0x3342, 8251, 9},
+	{0x3343, 8260, 12},
+	{0x3344, 8272, 9},
+	{0x3345, 8281, 9},
+	{0x3346, 8290, 9},
+	{0x3347, 8299, 15},
+	{0x3348, 8314, 12},
+	{0x3349, 8326, 6},
+	{0x334A, 8332, 18},
+	{0x334B, 8350, 9},
+	{0x334C, 8359, 15},
+	{0x334D, 8374, 12},
+	{0x334E, 8386, 12},
+	{0x334F, 8398, 9},
+	{0x3350, 8407, 9},
+	{0x3351, 8416, 12},
+	{0x3352, 8428, 6},
+	{0x3353, 8434, 12},
+	{0x3354, 8446, 15},
+	{0x3355, 8461, 6},
+	{0x3356, 8467, 18},
+	{0x3357, 8485, 9},
+	{0x3358, 8494, 4},
+	{0x3359, 8498, 4},
+	{0x335A, 8502, 4},
+	{0x335B, 8506, 4},
+	{0x335C, 8510, 4},
+	{0x335D, 8514, 4},
+	{0x335E, 8518, 4},
+	{0x335F, 8522, 4},
+	{0x3360, 8526, 4},
+	{0x3361, 8530, 4},
+	{0x3362, 8534, 5},
+	{0x3363, 8539, 5},
+	{0x3364, 8544, 5},
+	{0x3365, 8549, 5},
+	{0x3366, 8554, 5},
+	{0x3367, 8559, 5},
+	{0x3368, 8564, 5},
+	{0x3369, 8569, 5},
+	{0x336A, 8574, 5},
+	{0x336B, 8579, 5},
+	{0x336C, 8584, 5},
+	{0x336D, 8589, 5},
+	{0x336E, 8594, 5},
+	{0x336F, 8599, 5},
+	{0x3370, 8604, 5},
+	{0x3371, 8609, 3},
+	{0x3372, 8612, 2},
+	{0x3373, 8614, 2},
+	{0x3374, 8616, 3},
+	{0x3375, 8619, 2},
+	{0x3376, 8621, 2},
+	{0x3377, 8623, 2},
+	{0x3378, 8625, 3},
+	{0x3379, 8628, 3},
+	{0x337A, 8631, 2},
+	{0x337B, 8633, 6},
+	{0x337C, 8639, 6},
+	{0x337D, 8645, 6},
+	{0x337E, 8651, 6},
+	{0x337F, 8657, 12},
+	{0x3380, 8669, 2},
+	{0x3381, 8671, 2},
+	{0x3382, 8673, 3},
+	{0x3383, 8676, 2},
+	{0x3384, 8678, 2},
+	{0x3385, 8680, 2},
+	{0x3386, 8682, 2},
+	{0x3387, 8684, 2},
+	{0x3388, 8686, 3},
+	{0x3389, 8689, 4},
+	{0x338A, 8693, 2},
+	{0x338B, 8695, 2},
+	{0x338C, 8697, 3},
+	{0x338D, 8700, 3},
+	{0x338E, 8703, 2},
+	{0x338F, 8705, 2},
+	{0x3390, 8707, 2},
+	{0x3391, 8709, 3},
+	{0x3392, 8712, 3},
+	{0x3393, 8715, 3},
+	{0x3394, 8718, 3},
+	{0x3395, 8721, 3},
+	{0x3396, 8724, 2},
+	{0x3397, 8726, 2},
+	{0x3398, 8728, 2},
+	{0x3399, 8730, 2},
+	{0x339A, 8732, 2},
+	{0x339B, 8734, 3},
+	{0x339C, 8737, 2},
+	{0x339D, 8739, 2},
+	{0x339E, 8741, 2},
+	{0x339F, 8743, 3},
+	{0x33A0, 8746, 3},
+	{0x33A1, 8749, 2},
+	{0x33A2, 8751, 3},
+	{0x33A3, 8754, 3},
+	{0x33A4, 8757, 3},
+	{0x33A5, 8760, 2},
+	{0x33A6, 8762, 3},
+	{0x33A7, 8765, 5},
+	{0x33A8, 8770, 6},
+	{0x33A9, 8776, 2},
+	{0x33AA, 8778, 3},
+	{0x33AB, 8781, 3},
+	{0x33AC, 8784, 3},
+	{0x33AD, 8787, 3},
+	{0x33AE, 8790, 7},
+	{0x33AF, 8797, 8},
+	{0x33B0, 8805, 2},
+	{0x33B1, 8807, 2},
+	{0x33B2, 8809, 3},
+	{0x33B3, 8812, 2},
+	{0x33B4, 8814, 2},
+	{0x33B5, 8816, 2},
+	{0x33B6, 8818, 3},
+	{0x33B7, 8821, 2},
+	{0x33B8, 8823, 2},
+	{0x33B9, 8825, 2},
+	{0x33BA, 8827, 2},
+	{0x33BB, 8829, 2},
+	{0x33BC, 8831, 3},
+	{0x33BD, 8834, 2},
+	{0x33BE, 8836, 2},
+	{0x33BF, 8838, 2},
+	{0x33C0, 8840, 3},
+	{0x33C1, 8843, 3},
+	{0x33C2, 8846, 4},
+	{0x33C3, 8850, 2},
+	{0x33C4, 8852, 2},
+	{0x33C5, 8854, 2},
+	{0x33C6, 8856, 6},
+	{0x33C7, 8862, 3},
+	{0x33C8, 8865, 2},
+	{0x33C9, 8867, 2},
+	{0x33CA, 8869, 2},
+	{0x33CB, 8871, 2},
+	{0x33CC, 8873, 2},
+	{0x33CD, 8875, 2},
+	{0x33CE, 8877, 2},
+	{0x33CF, 8879, 2},
+	{0x33D0, 8881, 2},
+	{0x33D1, 8883, 2},
+	{0x33D2, 8885, 3},
+	{0x33D3, 8888, 2},
+	{0x33D4, 8890, 2},
+	{0x33D5, 8892, 3},
+	{0x33D6, 8895, 3},
+	{0x33D7, 8898, 2},
+	{0x33D8, 8900, 4},
+	{0x33D9, 8904, 3},
+	{0x33DA, 8907, 2},
+	{0x33DB, 8909, 2},
+	{0x33DC, 8911, 2},
+	{0x33DD, 8913, 2},
+	{0x33DE, 8915, 5},
+	{0x33DF, 8920, 5},
+	{0x33E0, 8925, 4},
+	{0x33E1, 8929, 4},
+	{0x33E2, 8933, 4},
+	{0x33E3, 8937, 4},
+	{0x33E4, 8941, 4},
+	{0x33E5, 8945, 4},
+	{0x33E6, 8949, 4},
+	{0x33E7, 8953, 4},
+	{0x33E8, 8957, 4},
+	{0x33E9, 8961, 5},
+	{0x33EA, 8966, 5},
+	{0x33EB, 8971, 5},
+	{0x33EC, 8976, 5},
+	{0x33ED, 8981, 5},
+	{0x33EE, 8986, 5},
+	{0x33EF, 8991, 5},
+	{0x33F0, 8996, 5},
+	{0x33F1, 9001, 5},
+	{0x33F2, 9006, 5},
+	{0x33F3, 9011, 5},
+	{0x33F4, 9016, 5},
+	{0x33F5, 9021, 5},
+	{0x33F6, 9026, 5},
+	{0x33F7, 9031, 5},
+	{0x33F8, 9036, 5},
+	{0x33F9, 9041, 5},
+	{0x33FA, 9046, 5},
+	{0x33FB, 9051, 5},
+	{0x33FC, 9056, 5},
+	{0x33FD, 9061, 5},
+	{0x33FE, 9066, 5},
+	{0x33FF, 9071, 3},
+	{0xA69C, 9074, 2},
+	{0xA69D, 9076, 2},
+	{0xA770, 9078, 3},
+	{0xA7F2, 9081, 1},
+	{0xA7F3, 9082, 1},
+	{0xA7F4, 9083, 1},
+	{0xA7F8, 9084, 2},
+	{0xA7F9, 9086, 2},
+	{0xAB5C, 9088, 3},
+	{0xAB5D, 9091, 3},
+	{0xAB5E, 9094, 2},
+	{0xAB5F, 9096, 3},
+	{0xAB69, 9099, 2},
+	{0xF900, 9101, 3},
+	{0xF901, 9104, 3},
+	{0xF902, 9107, 3},
+	{0xF903, 9110, 3},
+	{0xF904, 9113, 3},
+	{0xF905, 9116, 3},
+	{0xF906, 9119, 3},
+	{0xF907, 9122, 3},
+	{0xF908, 9125, 3},
+	{0xF909, 9128, 3},
+	{0xF90A, 9131, 3},
+	{0xF90B, 9134, 3},
+	{0xF90C, 9137, 3},
+	{0xF90D, 9140, 3},
+	{0xF90E, 9143, 3},
+	{0xF90F, 9146, 3},
+	{0xF910, 9149, 3},
+	{0xF911, 9152, 3},
+	{0xF912, 9155, 3},
+	{0xF913, 9158, 3},
+	{0xF914, 9161, 3},
+	{0xF915, 9164, 3},
+	{0xF916, 9167, 3},
+	{0xF917, 9170, 3},
+	{0xF918, 9173, 3},
+	{0xF919, 9176, 3},
+	{0xF91A, 9179, 3},
+	{0xF91B, 9182, 3},
+	{0xF91C, 9185, 3},
+	{0xF91D, 9188, 3},
+	{0xF91E, 9191, 3},
+	{0xF91F, 9194, 3},
+	{0xF920, 9197, 3},
+	{0xF921, 9200, 3},
+	{0xF922, 9203, 3},
+	{0xF923, 9206, 3},
+	{0xF924, 9209, 3},
+	{0xF925, 9212, 3},
+	{0xF926, 9215, 3},
+	{0xF927, 9218, 3},
+	{0xF928, 9221, 3},
+	{0xF929, 9224, 3},
+	{0xF92A, 9227, 3},
+	{0xF92B, 9230, 3},
+	{0xF92C, 9233, 3},
+	{0xF92D, 9236, 3},
+	{0xF92E, 9239, 3},
+	{0xF92F, 9242, 3},
+	{0xF930, 9245, 3},
+	{0xF931, 9248, 3},
+	{0xF932, 9251, 3},
+	{0xF933, 9254, 3},
+	{0xF934, 9257, 3},
+	{0xF935, 9260, 3},
+	{0xF936, 9263, 3},
+	{0xF937, 9266, 3},
+	{0xF938, 9269, 3},
+	{0xF939, 9272, 3},
+	{0xF93A, 9275, 3},
+	{0xF93B, 9278, 3},
+	{0xF93C, 9281, 3},
+	{0xF93D, 9284, 3},
+	{0xF93E, 9287, 3},
+	{0xF93F, 9290, 3},
+	{0xF940, 9293, 3},
+	{0xF941, 9296, 3},
+	{0xF942, 9299, 3},
+	{0xF943, 9302, 3},
+	{0xF944, 9305, 3},
+	{0xF945, 9308, 3},
+	{0xF946, 9311, 3},
+	{0xF947, 9314, 3},
+	{0xF948, 9317, 3},
+	{0xF949, 9320, 3},
+	{0xF94A, 9323, 3},
+	{0xF94B, 9326, 3},
+	{0xF94C, 9329, 3},
+	{0xF94D, 9332, 3},
+	{0xF94E, 9335, 3},
+	{0xF94F, 9338, 3},
+	{0xF950, 9341, 3},
+	{0xF951, 9344, 3},
+	{0xF952, 9347, 3},
+	{0xF953, 9350, 3},
+	{0xF954, 9353, 3},
+	{0xF955, 9356, 3},
+	{0xF956, 9359, 3},
+	{0xF957, 9362, 3},
+	{0xF958, 9365, 3},
+	{0xF959, 9368, 3},
+	{0xF95A, 9371, 3},
+	{0xF95B, 9374, 3},
+	{0xF95C, 9377, 3},
+	{0xF95D, 9380, 3},
+	{0xF95E, 9383, 3},
+	{0xF95F, 9386, 3},
+	{0xF960, 9389, 3},
+	{0xF961, 9392, 3},
+	{0xF962, 9395, 3},
+	{0xF963, 9398, 3},
+	{0xF964, 9401, 3},
+	{0xF965, 9404, 3},
+	{0xF966, 9407, 3},
+	{0xF967, 9410, 3},
+	{0xF968, 9413, 3},
+	{0xF969, 9416, 3},
+	{0xF96A, 9419, 3},
+	{0xF96B, 9422, 3},
+	{0xF96C, 9425, 3},
+	{0xF96D, 9428, 3},
+	{0xF96E, 9431, 3},
+	{0xF96F, 9434, 3},
+	{0xF970, 9437, 3},
+	{0xF971, 9440, 3},
+	{0xF972, 9443, 3},
+	{0xF973, 9446, 3},
+	{0xF974, 9449, 3},
+	{0xF975, 9452, 3},
+	{0xF976, 9455, 3},
+	{0xF977, 9458, 3},
+	{0xF978, 9461, 3},
+	{0xF979, 9464, 3},
+	{0xF97A, 9467, 3},
+	{0xF97B, 9470, 3},
+	{0xF97C, 9473, 3},
+	{0xF97D, 9476, 3},
+	{0xF97E, 9479, 3},
+	{0xF97F, 9482, 3},
+	{0xF980, 9485, 3},
+	{0xF981, 9488, 3},
+	{0xF982, 9491, 3},
+	{0xF983, 9494, 3},
+	{0xF984, 9497, 3},
+	{0xF985, 9500, 3},
+	{0xF986, 9503, 3},
+	{0xF987, 9506, 3},
+	{0xF988, 9509, 3},
+	{0xF989, 9512, 3},
+	{0xF98A, 9515, 3},
+	{0xF98B, 9518, 3},
+	{0xF98C, 9521, 3},
+	{0xF98D, 9524, 3},
+	{0xF98E, 9527, 3},
+	{0xF98F, 9530, 3},
+	{0xF990, 9533, 3},
+	{0xF991, 9536, 3},
+	{0xF992, 9539, 3},
+	{0xF993, 9542, 3},
+	{0xF994, 9545, 3},
+	{0xF995, 9548, 3},
+	{0xF996, 9551, 3},
+	{0xF997, 9554, 3},
+	{0xF998, 9557, 3},
+	{0xF999, 9560, 3},
+	{0xF99A, 9563, 3},
+	{0xF99B, 9566, 3},
+	{0xF99C, 9569, 3},
+	{0xF99D, 9572, 3},
+	{0xF99E, 9575, 3},
+	{0xF99F, 9578, 3},
+	{0xF9A0, 9581, 3},
+	{0xF9A1, 9584, 3},
+	{0xF9A2, 9587, 3},
+	{0xF9A3, 9590, 3},
+	{0xF9A4, 9593, 3},
+	{0xF9A5, 9596, 3},
+	{0xF9A6, 9599, 3},
+	{0xF9A7, 9602, 3},
+	{0xF9A8, 9605, 3},
+	{0xF9A9, 9608, 3},
+	{0xF9AA, 9611, 3},
+	{0xF9AB, 9614, 3},
+	{0xF9AC, 9617, 3},
+	{0xF9AD, 9620, 3},
+	{0xF9AE, 9623, 3},
+	{0xF9AF, 9626, 3},
+	{0xF9B0, 9629, 3},
+	{0xF9B1, 9632, 3},
+	{0xF9B2, 9635, 3},
+	{0xF9B3, 9638, 3},
+	{0xF9B4, 9641, 3},
+	{0xF9B5, 9644, 3},
+	{0xF9B6, 9647, 3},
+	{0xF9B7, 9650, 3},
+	{0xF9B8, 9653, 3},
+	{0xF9B9, 9656, 3},
+	{0xF9BA, 9659, 3},
+	{0xF9BB, 9662, 3},
+	{0xF9BC, 9665, 3},
+	{0xF9BD, 9668, 3},
+	{0xF9BE, 9671, 3},
+	{0xF9BF, 9674, 3},
+	{0xF9C0, 9677, 3},
+	{0xF9C1, 9680, 3},
+	{0xF9C2, 9683, 3},
+	{0xF9C3, 9686, 3},
+	{0xF9C4, 9689, 3},
+	{0xF9C5, 9692, 3},
+	{0xF9C6, 9695, 3},
+	{0xF9C7, 9698, 3},
+	{0xF9C8, 9701, 3},
+	{0xF9C9, 9704, 3},
+	{0xF9CA, 9707, 3},
+	{0xF9CB, 9710, 3},
+	{0xF9CC, 9713, 3},
+	{0xF9CD, 9716, 3},
+	{0xF9CE, 9719, 3},
+	{0xF9CF, 9722, 3},
+	{0xF9D0, 9725, 3},
+	{0xF9D1, 9728, 3},
+	{0xF9D2, 9731, 3},
+	{0xF9D3, 9734, 3},
+	{0xF9D4, 9737, 3},
+	{0xF9D5, 9740, 3},
+	{0xF9D6, 9743, 3},
+	{0xF9D7, 9746, 3},
+	{0xF9D8, 9749, 3},
+	{0xF9D9, 9752, 3},
+	{0xF9DA, 9755, 3},
+	{0xF9DB, 9758, 3},
+	{0xF9DC, 9761, 3},
+	{0xF9DD, 9764, 3},
+	{0xF9DE, 9767, 3},
+	{0xF9DF, 9770, 3},
+	{0xF9E0, 9773, 3},
+	{0xF9E1, 9776, 3},
+	{0xF9E2, 9779, 3},
+	{0xF9E3, 9782, 3},
+	{0xF9E4, 9785, 3},
+	{0xF9E5, 9788, 3},
+	{0xF9E6, 9791, 3},
+	{0xF9E7, 9794, 3},
+	{0xF9E8, 9797, 3},
+	{0xF9E9, 9800, 3},
+	{0xF9EA, 9803, 3},
+	{0xF9EB, 9806, 3},
+	{0xF9EC, 9809, 3},
+	{0xF9ED, 9812, 3},
+	{0xF9EE, 9815, 3},
+	{0xF9EF, 9818, 3},
+	{0xF9F0, 9821, 3},
+	{0xF9F1, 9824, 3},
+	{0xF9F2, 9827, 3},
+	{0xF9F3, 9830, 3},
+	{0xF9F4, 9833, 3},
+	{0xF9F5, 9836, 3},
+	{0xF9F6, 9839, 3},
+	{0xF9F7, 9842, 3},
+	{0xF9F8, 9845, 3},
+	{0xF9F9, 9848, 3},
+	{0xF9FA, 9851, 3},
+	{0xF9FB, 9854, 3},
+	{0xF9FC, 9857, 3},
+	{0xF9FD, 9860, 3},
+	{0xF9FE, 9863, 3},
+	{0xF9FF, 9866, 3},
+	{0xFA00, 9869, 3},
+	{0xFA01, 9872, 3},
+	{0xFA02, 9875, 3},
+	{0xFA03, 9878, 3},
+	{0xFA04, 9881, 3},
+	{0xFA05, 9884, 3},
+	{0xFA06, 9887, 3},
+	{0xFA07, 9890, 3},
+	{0xFA08, 9893, 3},
+	{0xFA09, 9896, 3},
+	{0xFA0A, 9899, 3},
+	{0xFA0B, 9902, 3},
+	{0xFA0C, 9905, 3},
+	{0xFA0D, 9908, 3},
+	{0xFA10, 9911, 3},
+	{0xFA12, 9914, 3},
+	{0xFA15, 9917, 3},
+	{0xFA16, 9920, 3},
+	{0xFA17, 9923, 3},
+	{0xFA18, 9926, 3},
+	{0xFA19, 9929, 3},
+	{0xFA1A, 9932, 3},
+	{0xFA1B, 9935, 3},
+	{0xFA1C, 9938, 3},
+	{0xFA1D, 9941, 3},
+	{0xFA1E, 9944, 3},
+	{0xFA20, 9947, 3},
+	{0xFA22, 9950, 3},
+	{0xFA25, 9953, 3},
+	{0xFA26, 9956, 3},
+	{0xFA2A, 9959, 3},
+	{0xFA2B, 9962, 3},
+	{0xFA2C, 9965, 3},
+	{0xFA2D, 9968, 3},
+	{0xFA2E, 9971, 3},
+	{0xFA2F, 9974, 3},
+	{0xFA30, 9977, 3},
+	{0xFA31, 9980, 3},
+	{0xFA32, 9983, 3},
+	{0xFA33, 9986, 3},
+	{0xFA34, 9989, 3},
+	{0xFA35, 9992, 3},
+	{0xFA36, 9995, 3},
+	{0xFA37, 9998, 3},
+	{0xFA38, 10001, 3},
+	{0xFA39, 10004, 3},
+	{0xFA3A, 10007, 3},
+	{0xFA3B, 10010, 3},
+	{0xFA3C, 10013, 3},
+	{0xFA3D, 10016, 3},
+	{0xFA3E, 10019, 3},
+	{0xFA3F, 10022, 3},
+	{0xFA40, 10025, 3},
+	{0xFA41, 10028, 3},
+	{0xFA42, 10031, 3},
+	{0xFA43, 10034, 3},
+	{0xFA44, 10037, 3},
+	{0xFA45, 10040, 3},
+	{0xFA46, 10043, 3},
+	{0xFA47, 10046, 3},
+	{0xFA48, 10049, 3},
+	{0xFA49, 10052, 3},
+	{0xFA4A, 10055, 3},
+	{0xFA4B, 10058, 3},
+	{0xFA4C, 10061, 3},
+	{0xFA4D, 10064, 3},
+	{0xFA4E, 10067, 3},
+	{0xFA4F, 10070, 3},
+	{0xFA50, 10073, 3},
+	{0xFA51, 10076, 3},
+	{0xFA52, 10079, 3},
+	{0xFA53, 10082, 3},
+	{0xFA54, 10085, 3},
+	{0xFA55, 10088, 3},
+	{0xFA56, 10091, 3},
+	{0xFA57, 10094, 3},
+	{0xFA58, 10097, 3},
+	{0xFA59, 10100, 3},
+	{0xFA5A, 10103, 3},
+	{0xFA5B, 10106, 3},
+	{0xFA5C, 10109, 3},
+	{0xFA5D, 10112, 3},
+	{0xFA5E, 10115, 3},
+	{0xFA5F, 10118, 3},
+	{0xFA60, 10121, 3},
+	{0xFA61, 10124, 3},
+	{0xFA62, 10127, 3},
+	{0xFA63, 10130, 3},
+	{0xFA64, 10133, 3},
+	{0xFA65, 10136, 3},
+	{0xFA66, 10139, 3},
+	{0xFA67, 10142, 3},
+	{0xFA68, 10145, 3},
+	{0xFA69, 10148, 3},
+	{0xFA6A, 10151, 3},
+	{0xFA6B, 10154, 3},
+	{0xFA6C, 10157, 4},
+	{0xFA6D, 10161, 3},
+	{0xFA70, 10164, 3},
+	{0xFA71, 10167, 3},
+	{0xFA72, 10170, 3},
+	{0xFA73, 10173, 3},
+	{0xFA74, 10176, 3},
+	{0xFA75, 10179, 3},
+	{0xFA76, 10182, 3},
+	{0xFA77, 10185, 3},
+	{0xFA78, 10188, 3},
+	{0xFA79, 10191, 3},
+	{0xFA7A, 10194, 3},
+	{0xFA7B, 10197, 3},
+	{0xFA7C, 10200, 3},
+	{0xFA7D, 10203, 3},
+	{0xFA7E, 10206, 3},
+	{0xFA7F, 10209, 3},
+	{0xFA80, 10212, 3},
+	{0xFA81, 10215, 3},
+	{0xFA82, 10218, 3},
+	{0xFA83, 10221, 3},
+	{0xFA84, 10224, 3},
+	{0xFA85, 10227, 3},
+	{0xFA86, 10230, 3},
+	{0xFA87, 10233, 3},
+	{0xFA88, 10236, 3},
+	{0xFA89, 10239, 3},
+	{0xFA8A, 10242, 3},
+	{0xFA8B, 10245, 3},
+	{0xFA8C, 10248, 3},
+	{0xFA8D, 10251, 3},
+	{0xFA8E, 10254, 3},
+	{0xFA8F, 10257, 3},
+	{0xFA90, 10260, 3},
+	{0xFA91, 10263, 3},
+	{0xFA92, 10266, 3},
+	{0xFA93, 10269, 3},
+	{0xFA94, 10272, 3},
+	{0xFA95, 10275, 3},
+	{0xFA96, 10278, 3},
+	{0xFA97, 10281, 3},
+	{0xFA98, 10284, 3},
+	{0xFA99, 10287, 3},
+	{0xFA9A, 10290, 3},
+	{0xFA9B, 10293, 3},
+	{0xFA9C, 10296, 3},
+	{0xFA9D, 10299, 3},
+	{0xFA9E, 10302, 3},
+	{0xFA9F, 10305, 3},
+	{0xFAA0, 10308, 3},
+	{0xFAA1, 10311, 3},
+	{0xFAA2, 10314, 3},
+	{0xFAA3, 10317, 3},
+	{0xFAA4, 10320, 3},
+	{0xFAA5, 10323, 3},
+	{0xFAA6, 10326, 3},
+	{0xFAA7, 10329, 3},
+	{0xFAA8, 10332, 3},
+	{0xFAA9, 10335, 3},
+	{0xFAAA, 10338, 3},
+	{0xFAAB, 10341, 3},
+	{0xFAAC, 10344, 3},
+	{0xFAAD, 10347, 3},
+	{0xFAAE, 10350, 3},
+	{0xFAAF, 10353, 3},
+	{0xFAB0, 10356, 3},
+	{0xFAB1, 10359, 3},
+	{0xFAB2, 10362, 3},
+	{0xFAB3, 10365, 3},
+	{0xFAB4, 10368, 3},
+	{0xFAB5, 10371, 3},
+	{0xFAB6, 10374, 3},
+	{0xFAB7, 10377, 3},
+	{0xFAB8, 10380, 3},
+	{0xFAB9, 10383, 3},
+	{0xFABA, 10386, 3},
+	{0xFABB, 10389, 3},
+	{0xFABC, 10392, 3},
+	{0xFABD, 10395, 3},
+	{0xFABE, 10398, 3},
+	{0xFABF, 10401, 3},
+	{0xFAC0, 10404, 3},
+	{0xFAC1, 10407, 3},
+	{0xFAC2, 10410, 3},
+	{0xFAC3, 10413, 3},
+	{0xFAC4, 10416, 3},
+	{0xFAC5, 10419, 3},
+	{0xFAC6, 10422, 3},
+	{0xFAC7, 10425, 3},
+	{0xFAC8, 10428, 3},
+	{0xFAC9, 10431, 3},
+	{0xFACA, 10434, 3},
+	{0xFACB, 10437, 3},
+	{0xFACC, 10440, 3},
+	{0xFACD, 10443, 3},
+	{0xFACE, 10446, 3},
+	{0xFACF, 10449, 4},
+	{0xFAD0, 10453, 4},
+	{0xFAD1, 10457, 4},
+	{0xFAD2, 10461, 3},
+	{0xFAD3, 10464, 3},
+	{0xFAD4, 10467, 3},
+	{0xFAD5, 10470, 4},
+	{0xFAD6, 10474, 4},
+	{0xFAD7, 10478, 4},
+	{0xFAD8, 10482, 3},
+	{0xFAD9, 10485, 3},
+	{0xFB00, 10488, 2},
+	{0xFB01, 10490, 2},
+	{0xFB02, 10492, 2},
+	{0xFB03, 10494, 3},
+	{0xFB04, 10497, 3},
+	{0xFB05, 10500, 2},
+	{0xFB06, 10502, 2},
+	{0xFB13, 10504, 4},
+	{0xFB14, 10508, 4},
+	{0xFB15, 10512, 4},
+	{0xFB16, 10516, 4},
+	{0xFB17, 10520, 4},
+	{0xFB1D, 10524, 4},
+	{0xFB1F, 10528, 4},
+	{0xFB20, 10532, 2},
+	{0xFB21, 10534, 2},
+	{0xFB22, 10536, 2},
+	{0xFB23, 10538, 2},
+	{0xFB24, 10540, 2},
+	{0xFB25, 10542, 2},
+	{0xFB26, 10544, 2},
+	{0xFB27, 10546, 2},
+	{0xFB28, 10548, 2},
+	{0xFB29, 10550, 1},
+	{0xFB2A, 10551, 4},
+	{0xFB2B, 10555, 4},
+	{0xFB2C, 10559, 6},
+	{0xFB2D, 10565, 6},
+	{0xFB2E, 10571, 4},
+	{0xFB2F, 10575, 4},
+	{0xFB30, 10579, 4},
+	{0xFB31, 10583, 4},
+	{0xFB32, 10587, 4},
+	{0xFB33, 10591, 4},
+	{0xFB34, 10595, 4},
+	{0xFB35, 10599, 4},
+	{0xFB36, 10603, 4},
+	{0xFB38, 10607, 4},
+	{0xFB39, 10611, 4},
+	{0xFB3A, 10615, 4},
+	{0xFB3B, 10619, 4},
+	{0xFB3C, 10623, 4},
+	{0xFB3E, 10627, 4},
+	{0xFB40, 10631, 4},
+	{0xFB41, 10635, 4},
+	{0xFB43, 10639, 4},
+	{0xFB44, 10643, 4},
+	{0xFB46, 10647, 4},
+	{0xFB47, 10651, 4},
+	{0xFB48, 10655, 4},
+	{0xFB49, 10659, 4},
+	{0xFB4A, 10663, 4},
+	{0xFB4B, 10667, 4},
+	{0xFB4C, 10671, 4},
+	{0xFB4D, 10675, 4},
+	{0xFB4E, 10679, 4},
+	{0xFB4F, 10683, 4},
+	{0xFB50, 10687, 2},
+	{0xFB51, 10689, 2},
+	{0xFB52, 10691, 2},
+	{0xFB53, 10693, 2},
+	{0xFB54, 10695, 2},
+	{0xFB55, 10697, 2},
+	{0xFB56, 10699, 2},
+	{0xFB57, 10701, 2},
+	{0xFB58, 10703, 2},
+	{0xFB59, 10705, 2},
+	{0xFB5A, 10707, 2},
+	{0xFB5B, 10709, 2},
+	{0xFB5C, 10711, 2},
+	{0xFB5D, 10713, 2},
+	{0xFB5E, 10715, 2},
+	{0xFB5F, 10717, 2},
+	{0xFB60, 10719, 2},
+	{0xFB61, 10721, 2},
+	{0xFB62, 10723, 2},
+	{0xFB63, 10725, 2},
+	{0xFB64, 10727, 2},
+	{0xFB65, 10729, 2},
+	{0xFB66, 10731, 2},
+	{0xFB67, 10733, 2},
+	{0xFB68, 10735, 2},
+	{0xFB69, 10737, 2},
+	{0xFB6A, 10739, 2},
+	{0xFB6B, 10741, 2},
+	{0xFB6C, 10743, 2},
+	{0xFB6D, 10745, 2},
+	{0xFB6E, 10747, 2},
+	{0xFB6F, 10749, 2},
+	{0xFB70, 10751, 2},
+	{0xFB71, 10753, 2},
+	{0xFB72, 10755, 2},
+	{0xFB73, 10757, 2},
+	{0xFB74, 10759, 2},
+	{0xFB75, 10761, 2},
+	{0xFB76, 10763, 2},
+	{0xFB77, 10765, 2},
+	{0xFB78, 10767, 2},
+	{0xFB79, 10769, 2},
+	{0xFB7A, 10771, 2},
+	{0xFB7B, 10773, 2},
+	{0xFB7C, 10775, 2},
+	{0xFB7D, 10777, 2},
+	{0xFB7E, 10779, 2},
+	{0xFB7F, 10781, 2},
+	{0xFB80, 10783, 2},
+	{0xFB81, 10785, 2},
+	{0xFB82, 10787, 2},
+	{0xFB83, 10789, 2},
+	{0xFB84, 10791, 2},
+	{0xFB85, 10793, 2},
+	{0xFB86, 10795, 2},
+	{0xFB87, 10797, 2},
+	{0xFB88, 10799, 2},
+	{0xFB89, 10801, 2},
+	{0xFB8A, 10803, 2},
+	{0xFB8B, 10805, 2},
+	{0xFB8C, 10807, 2},
+	{0xFB8D, 10809, 2},
+	{0xFB8E, 10811, 2},
+	{0xFB8F, 10813, 2},
+	{0xFB90, 10815, 2},
+	{0xFB91, 10817, 2},
+	{0xFB92, 10819, 2},
+	{0xFB93, 10821, 2},
+	{0xFB94, 10823, 2},
+	{0xFB95, 10825, 2},
+	{0xFB96, 10827, 2},
+	{0xFB97, 10829, 2},
+	{0xFB98, 10831, 2},
+	{0xFB99, 10833, 2},
+	{0xFB9A, 10835, 2},
+	{0xFB9B, 10837, 2},
+	{0xFB9C, 10839, 2},
+	{0xFB9D, 10841, 2},
+	{0xFB9E, 10843, 2},
+	{0xFB9F, 10845, 2},
+	{0xFBA0, 10847, 2},
+	{0xFBA1, 10849, 2},
+	{0xFBA2, 10851, 2},
+	{0xFBA3, 10853, 2},
+	{0xFBA4, 10855, 4},
+	{0xFBA5, 10859, 4},
+	{0xFBA6, 10863, 2},
+	{0xFBA7, 10865, 2},
+	{0xFBA8, 10867, 2},
+	{0xFBA9, 10869, 2},
+	{0xFBAA, 10871, 2},
+	{0xFBAB, 10873, 2},
+	{0xFBAC, 10875, 2},
+	{0xFBAD, 10877, 2},
+	{0xFBAE, 10879, 2},
+	{0xFBAF, 10881, 2},
+	{0xFBB0, 10883, 4},
+	{0xFBB1, 10887, 4},
+	{0xFBD3, 10891, 2},
+	{0xFBD4, 10893, 2},
+	{0xFBD5, 10895, 2},
+	{0xFBD6, 10897, 2},
+	{0xFBD7, 10899, 2},
+	{0xFBD8, 10901, 2},
+	{0xFBD9, 10903, 2},
+	{0xFBDA, 10905, 2},
+	{0xFBDB, 10907, 2},
+	{0xFBDC, 10909, 2},
+	{0xFBDD, 10911, 4},
+	{0xFBDE, 10915, 2},
+	{0xFBDF, 10917, 2},
+	{0xFBE0, 10919, 2},
+	{0xFBE1, 10921, 2},
+	{0xFBE2, 10923, 2},
+	{0xFBE3, 10925, 2},
+	{0xFBE4, 10927, 2},
+	{0xFBE5, 10929, 2},
+	{0xFBE6, 10931, 2},
+	{0xFBE7, 10933, 2},
+	{0xFBE8, 10935, 2},
+	{0xFBE9, 10937, 2},
+	{0xFBEA, 10939, 6},
+	{0xFBEB, 10945, 6},
+	{0xFBEC, 10951, 6},
+	{0xFBED, 10957, 6},
+	{0xFBEE, 10963, 6},
+	{0xFBEF, 10969, 6},
+	{0xFBF0, 10975, 6},
+	{0xFBF1, 10981, 6},
+	{0xFBF2, 10987, 6},
+	{0xFBF3, 10993, 6},
+	{0xFBF4, 10999, 6},
+	{0xFBF5, 11005, 6},
+	{0xFBF6, 11011, 6},
+	{0xFBF7, 11017, 6},
+	{0xFBF8, 11023, 6},
+	{0xFBF9, 11029, 6},
+	{0xFBFA, 11035, 6},
+	{0xFBFB, 11041, 6},
+	{0xFBFC, 11047, 2},
+	{0xFBFD, 11049, 2},
+	{0xFBFE, 11051, 2},
+	{0xFBFF, 11053, 2},
+	{0xFC00, 11055, 6},
+	{0xFC01, 11061, 6},
+	{0xFC02, 11067, 6},
+	{0xFC03, 11073, 6},
+	{0xFC04, 11079, 6},
+	{0xFC05, 11085, 4},
+	{0xFC06, 11089, 4},
+	{0xFC07, 11093, 4},
+	{0xFC08, 11097, 4},
+	{0xFC09, 11101, 4},
+	{0xFC0A, 11105, 4},
+	{0xFC0B, 11109, 4},
+	{0xFC0C, 11113, 4},
+	{0xFC0D, 11117, 4},
+	{0xFC0E, 11121, 4},
+	{0xFC0F, 11125, 4},
+	{0xFC10, 11129, 4},
+	{0xFC11, 11133, 4},
+	{0xFC12, 11137, 4},
+	{0xFC13, 11141, 4},
+	{0xFC14, 11145, 4},
+	{0xFC15, 11149, 4},
+	{0xFC16, 11153, 4},
+	{0xFC17, 11157, 4},
+	{0xFC18, 11161, 4},
+	{0xFC19, 11165, 4},
+	{0xFC1A, 11169, 4},
+	{0xFC1B, 11173, 4},
+	{0xFC1C, 11177, 4},
+	{0xFC1D, 11181, 4},
+	{0xFC1E, 11185, 4},
+	{0xFC1F, 11189, 4},
+	{0xFC20, 11193, 4},
+	{0xFC21, 11197, 4},
+	{0xFC22, 11201, 4},
+	{0xFC23, 11205, 4},
+	{0xFC24, 11209, 4},
+	{0xFC25, 11213, 4},
+	{0xFC26, 11217, 4},
+	{0xFC27, 11221, 4},
+	{0xFC28, 11225, 4},
+	{0xFC29, 11229, 4},
+	{0xFC2A, 11233, 4},
+	{0xFC2B, 11237, 4},
+	{0xFC2C, 11241, 4},
+	{0xFC2D, 11245, 4},
+	{0xFC2E, 11249, 4},
+	{0xFC2F, 11253, 4},
+	{0xFC30, 11257, 4},
+	{0xFC31, 11261, 4},
+	{0xFC32, 11265, 4},
+	{0xFC33, 11269, 4},
+	{0xFC34, 11273, 4},
+	{0xFC35, 11277, 4},
+	{0xFC36, 11281, 4},
+	{0xFC37, 11285, 4},
+	{0xFC38, 11289, 4},
+	{0xFC39, 11293, 4},
+	{0xFC3A, 11297, 4},
+	{0xFC3B, 11301, 4},
+	{0xFC3C, 11305, 4},
+	{0xFC3D, 11309, 4},
+	{0xFC3E, 11313, 4},
+	{0xFC3F, 11317, 4},
+	{0xFC40, 11321, 4},
+	{0xFC41, 11325, 4},
+	{0xFC42, 11329, 4},
+	{0xFC43, 11333, 4},
+	{0xFC44, 11337, 4},
+	{0xFC45, 11341, 4},
+	{0xFC46, 11345, 4},
+	{0xFC47, 11349, 4},
+	{0xFC48, 11353, 4},
+	{0xFC49, 11357, 4},
+	{0xFC4A, 11361, 4},
+	{0xFC4B, 11365, 4},
+	{0xFC4C, 11369, 4},
+	{0xFC4D, 11373, 4},
+	{0xFC4E, 11377, 4},
+	{0xFC4F, 11381, 4},
+	{0xFC50, 11385, 4},
+	{0xFC51, 11389, 4},
+	{0xFC52, 11393, 4},
+	{0xFC53, 11397, 4},
+	{0xFC54, 11401, 4},
+	{0xFC55, 11405, 4},
+	{0xFC56, 11409, 4},
+	{0xFC57, 11413, 4},
+	{0xFC58, 11417, 4},
+	{0xFC59, 11421, 4},
+	{0xFC5A, 11425, 4},
+	{0xFC5B, 11429, 4},
+	{0xFC5C, 11433, 4},
+	{0xFC5D, 11437, 4},
+	{0xFC5E, 11441, 5},
+	{0xFC5F, 11446, 5},
+	{0xFC60, 11451, 5},
+	{0xFC61, 11456, 5},
+	{0xFC62, 11461, 5},
+	{0xFC63, 11466, 5},
+	{0xFC64, 11471, 6},
+	{0xFC65, 11477, 6},
+	{0xFC66, 11483, 6},
+	{0xFC67, 11489, 6},
+	{0xFC68, 11495, 6},
+	{0xFC69, 11501, 6},
+	{0xFC6A, 11507, 4},
+	{0xFC6B, 11511, 4},
+	{0xFC6C, 11515, 4},
+	{0xFC6D, 11519, 4},
+	{0xFC6E, 11523, 4},
+	{0xFC6F, 11527, 4},
+	{0xFC70, 11531, 4},
+	{0xFC71, 11535, 4},
+	{0xFC72, 11539, 4},
+	{0xFC73, 11543, 4},
+	{0xFC74, 11547, 4},
+	{0xFC75, 11551, 4},
+	{0xFC76, 11555, 4},
+	{0xFC77, 11559, 4},
+	{0xFC78, 11563, 4},
+	{0xFC79, 11567, 4},
+	{0xFC7A, 11571, 4},
+	{0xFC7B, 11575, 4},
+	{0xFC7C, 11579, 4},
+	{0xFC7D, 11583, 4},
+	{0xFC7E, 11587, 4},
+	{0xFC7F, 11591, 4},
+	{0xFC80, 11595, 4},
+	{0xFC81, 11599, 4},
+	{0xFC82, 11603, 4},
+	{0xFC83, 11607, 4},
+	{0xFC84, 11611, 4},
+	{0xFC85, 11615, 4},
+	{0xFC86, 11619, 4},
+	{0xFC87, 11623, 4},
+	{0xFC88, 11627, 4},
+	{0xFC89, 11631, 4},
+	{0xFC8A, 11635, 4},
+	{0xFC8B, 11639, 4},
+	{0xFC8C, 11643, 4},
+	{0xFC8D, 11647, 4},
+	{0xFC8E, 11651, 4},
+	{0xFC8F, 11655, 4},
+	{0xFC90, 11659, 4},
+	{0xFC91, 11663, 4},
+	{0xFC92, 11667, 4},
+	{0xFC93, 11671, 4},
+	{0xFC94, 11675, 4},
+	{0xFC95, 11679, 4},
+	{0xFC96, 11683, 4},
+	{0xFC97, 11687, 6},
+	{0xFC98, 11693, 6},
+	{0xFC99, 11699, 6},
+	{0xFC9A, 11705, 6},
+	{0xFC9B, 11711, 6},
+	{0xFC9C, 11717, 4},
+	{0xFC9D, 11721, 4},
+	{0xFC9E, 11725, 4},
+	{0xFC9F, 11729, 4},
+	{0xFCA0, 11733, 4},
+	{0xFCA1, 11737, 4},
+	{0xFCA2, 11741, 4},
+	{0xFCA3, 11745, 4},
+	{0xFCA4, 11749, 4},
+	{0xFCA5, 11753, 4},
+	{0xFCA6, 11757, 4},
+	{0xFCA7, 11761, 4},
+	{0xFCA8, 11765, 4},
+	{0xFCA9, 11769, 4},
+	{0xFCAA, 11773, 4},
+	{0xFCAB, 11777, 4},
+	{0xFCAC, 11781, 4},
+	{0xFCAD, 11785, 4},
+	{0xFCAE, 11789, 4},
+	{0xFCAF, 11793, 4},
+	{0xFCB0, 11797, 4},
+	{0xFCB1, 11801, 4},
+	{0xFCB2, 11805, 4},
+	{0xFCB3, 11809, 4},
+	{0xFCB4, 11813, 4},
+	{0xFCB5, 11817, 4},
+	{0xFCB6, 11821, 4},
+	{0xFCB7, 11825, 4},
+	{0xFCB8, 11829, 4},
+	{0xFCB9, 11833, 4},
+	{0xFCBA, 11837, 4},
+	{0xFCBB, 11841, 4},
+	{0xFCBC, 11845, 4},
+	{0xFCBD, 11849, 4},
+	{0xFCBE, 11853, 4},
+	{0xFCBF, 11857, 4},
+	{0xFCC0, 11861, 4},
+	{0xFCC1, 11865, 4},
+	{0xFCC2, 11869, 4},
+	{0xFCC3, 11873, 4},
+	{0xFCC4, 11877, 4},
+	{0xFCC5, 11881, 4},
+	{0xFCC6, 11885, 4},
+	{0xFCC7, 11889, 4},
+	{0xFCC8, 11893, 4},
+	{0xFCC9, 11897, 4},
+	{0xFCCA, 11901, 4},
+	{0xFCCB, 11905, 4},
+	{0xFCCC, 11909, 4},
+	{0xFCCD, 11913, 4},
+	{0xFCCE, 11917, 4},
+	{0xFCCF, 11921, 4},
+	{0xFCD0, 11925, 4},
+	{0xFCD1, 11929, 4},
+	{0xFCD2, 11933, 4},
+	{0xFCD3, 11937, 4},
+	{0xFCD4, 11941, 4},
+	{0xFCD5, 11945, 4},
+	{0xFCD6, 11949, 4},
+	{0xFCD7, 11953, 4},
+	{0xFCD8, 11957, 4},
+	{0xFCD9, 11961, 4},
+	{0xFCDA, 11965, 4},
+	{0xFCDB, 11969, 4},
+	{0xFCDC, 11973, 4},
+	{0xFCDD, 11977, 4},
+	{0xFCDE, 11981, 4},
+	{0xFCDF, 11985, 6},
+	{0xFCE0, 11991, 6},
+	{0xFCE1, 11997, 4},
+	{0xFCE2, 12001, 4},
+	{0xFCE3, 12005, 4},
+	{0xFCE4, 12009, 4},
+	{0xFCE5, 12013, 4},
+	{0xFCE6, 12017, 4},
+	{0xFCE7, 12021, 4},
+	{0xFCE8, 12025, 4},
+	{0xFCE9, 12029, 4},
+	{0xFCEA, 12033, 4},
+	{0xFCEB, 12037, 4},
+	{0xFCEC, 12041, 4},
+	{0xFCED, 12045, 4},
+	{0xFCEE, 12049, 4},
+	{0xFCEF, 12053, 4},
+	{0xFCF0, 12057, 4},
+	{0xFCF1, 12061, 4},
+	{0xFCF2, 12065, 6},
+	{0xFCF3, 12071, 6},
+	{0xFCF4, 12077, 6},
+	{0xFCF5, 12083, 4},
+	{0xFCF6, 12087, 4},
+	{0xFCF7, 12091, 4},
+	{0xFCF8, 12095, 4},
+	{0xFCF9, 12099, 4},
+	{0xFCFA, 12103, 4},
+	{0xFCFB, 12107, 4},
+	{0xFCFC, 12111, 4},
+	{0xFCFD, 12115, 4},
+	{0xFCFE, 12119, 4},
+	{0xFCFF, 12123, 4},
+	{0xFD00, 12127, 4},
+	{0xFD01, 12131, 4},
+	{0xFD02, 12135, 4},
+	{0xFD03, 12139, 4},
+	{0xFD04, 12143, 4},
+	{0xFD05, 12147, 4},
+	{0xFD06, 12151, 4},
+	{0xFD07, 12155, 4},
+	{0xFD08, 12159, 4},
+	{0xFD09, 12163, 4},
+	{0xFD0A, 12167, 4},
+	{0xFD0B, 12171, 4},
+	{0xFD0C, 12175, 4},
+	{0xFD0D, 12179, 4},
+	{0xFD0E, 12183, 4},
+	{0xFD0F, 12187, 4},
+	{0xFD10, 12191, 4},
+	{0xFD11, 12195, 4},
+	{0xFD12, 12199, 4},
+	{0xFD13, 12203, 4},
+	{0xFD14, 12207, 4},
+	{0xFD15, 12211, 4},
+	{0xFD16, 12215, 4},
+	{0xFD17, 12219, 4},
+	{0xFD18, 12223, 4},
+	{0xFD19, 12227, 4},
+	{0xFD1A, 12231, 4},
+	{0xFD1B, 12235, 4},
+	{0xFD1C, 12239, 4},
+	{0xFD1D, 12243, 4},
+	{0xFD1E, 12247, 4},
+	{0xFD1F, 12251, 4},
+	{0xFD20, 12255, 4},
+	{0xFD21, 12259, 4},
+	{0xFD22, 12263, 4},
+	{0xFD23, 12267, 4},
+	{0xFD24, 12271, 4},
+	{0xFD25, 12275, 4},
+	{0xFD26, 12279, 4},
+	{0xFD27, 12283, 4},
+	{0xFD28, 12287, 4},
+	{0xFD29, 12291, 4},
+	{0xFD2A, 12295, 4},
+	{0xFD2B, 12299, 4},
+	{0xFD2C, 12303, 4},
+	{0xFD2D, 12307, 4},
+	{0xFD2E, 12311, 4},
+	{0xFD2F, 12315, 4},
+	{0xFD30, 12319, 4},
+	{0xFD31, 12323, 4},
+	{0xFD32, 12327, 4},
+	{0xFD33, 12331, 4},
+	{0xFD34, 12335, 4},
+	{0xFD35, 12339, 4},
+	{0xFD36, 12343, 4},
+	{0xFD37, 12347, 4},
+	{0xFD38, 12351, 4},
+	{0xFD39, 12355, 4},
+	{0xFD3A, 12359, 4},
+	{0xFD3B, 12363, 4},
+	{0xFD3C, 12367, 4},
+	{0xFD3D, 12371, 4},
+	{0xFD50, 12375, 6},
+	{0xFD51, 12381, 6},
+	{0xFD52, 12387, 6},
+	{0xFD53, 12393, 6},
+	{0xFD54, 12399, 6},
+	{0xFD55, 12405, 6},
+	{0xFD56, 12411, 6},
+	{0xFD57, 12417, 6},
+	{0xFD58, 12423, 6},
+	{0xFD59, 12429, 6},
+	{0xFD5A, 12435, 6},
+	{0xFD5B, 12441, 6},
+	{0xFD5C, 12447, 6},
+	{0xFD5D, 12453, 6},
+	{0xFD5E, 12459, 6},
+	{0xFD5F, 12465, 6},
+	{0xFD60, 12471, 6},
+	{0xFD61, 12477, 6},
+	{0xFD62, 12483, 6},
+	{0xFD63, 12489, 6},
+	{0xFD64, 12495, 6},
+	{0xFD65, 12501, 6},
+	{0xFD66, 12507, 6},
+	{0xFD67, 12513, 6},
+	{0xFD68, 12519, 6},
+	{0xFD69, 12525, 6},
+	{0xFD6A, 12531, 6},
+	{0xFD6B, 12537, 6},
+	{0xFD6C, 12543, 6},
+	{0xFD6D, 12549, 6},
+	{0xFD6E, 12555, 6},
+	{0xFD6F, 12561, 6},
+	{0xFD70, 12567, 6},
+	{0xFD71, 12573, 6},
+	{0xFD72, 12579, 6},
+	{0xFD73, 12585, 6},
+	{0xFD74, 12591, 6},
+	{0xFD75, 12597, 6},
+	{0xFD76, 12603, 6},
+	{0xFD77, 12609, 6},
+	{0xFD78, 12615, 6},
+	{0xFD79, 12621, 6},
+	{0xFD7A, 12627, 6},
+	{0xFD7B, 12633, 6},
+	{0xFD7C, 12639, 6},
+	{0xFD7D, 12645, 6},
+	{0xFD7E, 12651, 6},
+	{0xFD7F, 12657, 6},
+	{0xFD80, 12663, 6},
+	{0xFD81, 12669, 6},
+	{0xFD82, 12675, 6},
+	{0xFD83, 12681, 6},
+	{0xFD84, 12687, 6},
+	{0xFD85, 12693, 6},
+	{0xFD86, 12699, 6},
+	{0xFD87, 12705, 6},
+	{0xFD88, 12711, 6},
+	{0xFD89, 12717, 6},
+	{0xFD8A, 12723, 6},
+	{0xFD8B, 12729, 6},
+	{0xFD8C, 12735, 6},
+	{0xFD8D, 12741, 6},
+	{0xFD8E, 12747, 6},
+	{0xFD8F, 12753, 6},
+	{0xFD92, 12759, 6},
+	{0xFD93, 12765, 6},
+	{0xFD94, 12771, 6},
+	{0xFD95, 12777, 6},
+	{0xFD96, 12783, 6},
+	{0xFD97, 12789, 6},
+	{0xFD98, 12795, 6},
+	{0xFD99, 12801, 6},
+	{0xFD9A, 12807, 6},
+	{0xFD9B, 12813, 6},
+	{0xFD9C, 12819, 6},
+	{0xFD9D, 12825, 6},
+	{0xFD9E, 12831, 6},
+	{0xFD9F, 12837, 6},
+	{0xFDA0, 12843, 6},
+	{0xFDA1, 12849, 6},
+	{0xFDA2, 12855, 6},
+	{0xFDA3, 12861, 6},
+	{0xFDA4, 12867, 6},
+	{0xFDA5, 12873, 6},
+	{0xFDA6, 12879, 6},
+	{0xFDA7, 12885, 6},
+	{0xFDA8, 12891, 6},
+	{0xFDA9, 12897, 6},
+	{0xFDAA, 12903, 6},
+	{0xFDAB, 12909, 6},
+	{0xFDAC, 12915, 6},
+	{0xFDAD, 12921, 6},
+	{0xFDAE, 12927, 6},
+	{0xFDAF, 12933, 6},
+	{0xFDB0, 12939, 6},
+	{0xFDB1, 12945, 6},
+	{0xFDB2, 12951, 6},
+	{0xFDB3, 12957, 6},
+	{0xFDB4, 12963, 6},
+	{0xFDB5, 12969, 6},
+	{0xFDB6, 12975, 6},
+	{0xFDB7, 12981, 6},
+	{0xFDB8, 12987, 6},
+	{0xFDB9, 12993, 6},
+	{0xFDBA, 12999, 6},
+	{0xFDBB, 13005, 6},
+	{0xFDBC, 13011, 6},
+	{0xFDBD, 13017, 6},
+	{0xFDBE, 13023, 6},
+	{0xFDBF, 13029, 6},
+	{0xFDC0, 13035, 6},
+	{0xFDC1, 13041, 6},
+	{0xFDC2, 13047, 6},
+	{0xFDC3, 13053, 6},
+	{0xFDC4, 13059, 6},
+	{0xFDC5, 13065, 6},
+	{0xFDC6, 13071, 6},
+	{0xFDC7, 13077, 6},
+	{0xFDF0, 13083, 6},
+	{0xFDF1, 13089, 6},
+	{0xFDF2, 13095, 8},
+	{0xFDF3, 13103, 8},
+	{0xFDF4, 13111, 8},
+	{0xFDF5, 13119, 8},
+	{0xFDF6, 13127, 8},
+	{0xFDF7, 13135, 8},
+	{0xFDF8, 13143, 8},
+	{0xFDF9, 13151, 6},
+	{0xFDFA, 13157, 33},
+	{0xFDFB, 13190, 15},
+	{0xFDFC, 13205, 8},
+	{0xFE10, 13213, 1},
+	{0xFE11, 13214, 3},
+	{0xFE12, 13217, 3},
+	{0xFE13, 13220, 1},
+	{0xFE14, 13221, 1},
+	{0xFE15, 13222, 1},
+	{0xFE16, 13223, 1},
+	{0xFE17, 13224, 3},
+	{0xFE18, 13227, 3},
+	{0xFE19, 13230, 3},
+	{0xFE30, 13233, 2},
+	{0xFE31, 13235, 3},
+	{0xFE32, 13238, 3},
+	{0xFE33, 13241, 1},
+	{0xFE34, 13242, 1},
+	{0xFE35, 13243, 1},
+	{0xFE36, 13244, 1},
+	{0xFE37, 13245, 1},
+	{0xFE38, 13246, 1},
+	{0xFE39, 13247, 3},
+	{0xFE3A, 13250, 3},
+	{0xFE3B, 13253, 3},
+	{0xFE3C, 13256, 3},
+	{0xFE3D, 13259, 3},
+	{0xFE3E, 13262, 3},
+	{0xFE3F, 13265, 3},
+	{0xFE40, 13268, 3},
+	{0xFE41, 13271, 3},
+	{0xFE42, 13274, 3},
+	{0xFE43, 13277, 3},
+	{0xFE44, 13280, 3},
+	{0xFE47, 13283, 1},
+	{0xFE48, 13284, 1},
+	{0xFE49, 13285, 3},
+	{0xFE4A, 13288, 3},
+	{0xFE4B, 13291, 3},
+	{0xFE4C, 13294, 3},
+	{0xFE4D, 13297, 1},
+	{0xFE4E, 13298, 1},
+	{0xFE4F, 13299, 1},
+	{0xFE50, 13300, 1},
+	{0xFE51, 13301, 3},
+	{0xFE52, 13304, 1},
+	{0xFE54, 13305, 1},
+	{0xFE55, 13306, 1},
+	{0xFE56, 13307, 1},
+	{0xFE57, 13308, 1},
+	{0xFE58, 13309, 3},
+	{0xFE59, 13312, 1},
+	{0xFE5A, 13313, 1},
+	{0xFE5B, 13314, 1},
+	{0xFE5C, 13315, 1},
+	{0xFE5D, 13316, 3},
+	{0xFE5E, 13319, 3},
+	{0xFE5F, 13322, 1},
+	{0xFE60, 13323, 1},
+	{0xFE61, 13324, 1},
+	{0xFE62, 13325, 1},
+	{0xFE63, 13326, 1},
+	{0xFE64, 13327, 1},
+	{0xFE65, 13328, 1},
+	{0xFE66, 13329, 1},
+	{0xFE68, 13330, 1},
+	{0xFE69, 13331, 1},
+	{0xFE6A, 13332, 1},
+	{0xFE6B, 13333, 1},
+	{0xFE70, 13334, 3},
+	{0xFE71, 13337, 4},
+	{0xFE72, 13341, 3},
+	{0xFE74, 13344, 3},
+	{0xFE76, 13347, 3},
+	{0xFE77, 13350, 4},
+	{0xFE78, 13354, 3},
+	{0xFE79, 13357, 4},
+	{0xFE7A, 13361, 3},
+	{0xFE7B, 13364, 4},
+	{0xFE7C, 13368, 3},
+	{0xFE7D, 13371, 4},
+	{0xFE7E, 13375, 3},
+	{0xFE7F, 13378, 4},
+	{0xFE80, 13382, 2},
+	{0xFE81, 13384, 4},
+	{0xFE82, 13388, 4},
+	{0xFE83, 13392, 4},
+	{0xFE84, 13396, 4},
+	{0xFE85, 13400, 4},
+	{0xFE86, 13404, 4},
+	{0xFE87, 13408, 4},
+	{0xFE88, 13412, 4},
+	{0xFE89, 13416, 4},
+	{0xFE8A, 13420, 4},
+	{0xFE8B, 13424, 4},
+	{0xFE8C, 13428, 4},
+	{0xFE8D, 13432, 2},
+	{0xFE8E, 13434, 2},
+	{0xFE8F, 13436, 2},
+	{0xFE90, 13438, 2},
+	{0xFE91, 13440, 2},
+	{0xFE92, 13442, 2},
+	{0xFE93, 13444, 2},
+	{0xFE94, 13446, 2},
+	{0xFE95, 13448, 2},
+	{0xFE96, 13450, 2},
+	{0xFE97, 13452, 2},
+	{0xFE98, 13454, 2},
+	{0xFE99, 13456, 2},
+	{0xFE9A, 13458, 2},
+	{0xFE9B, 13460, 2},
+	{0xFE9C, 13462, 2},
+	{0xFE9D, 13464, 2},
+	{0xFE9E, 13466, 2},
+	{0xFE9F, 13468, 2},
+	{0xFEA0, 13470, 2},
+	{0xFEA1, 13472, 2},
+	{0xFEA2, 13474, 2},
+	{0xFEA3, 13476, 2},
+	{0xFEA4, 13478, 2},
+	{0xFEA5, 13480, 2},
+	{0xFEA6, 13482, 2},
+	{0xFEA7, 13484, 2},
+	{0xFEA8, 13486, 2},
+	{0xFEA9, 13488, 2},
+	{0xFEAA, 13490, 2},
+	{0xFEAB, 13492, 2},
+	{0xFEAC, 13494, 2},
+	{0xFEAD, 13496, 2},
+	{0xFEAE, 13498, 2},
+	{0xFEAF, 13500, 2},
+	{0xFEB0, 13502, 2},
+	{0xFEB1, 13504, 2},
+	{0xFEB2, 13506, 2},
+	{0xFEB3, 13508, 2},
+	{0xFEB4, 13510, 2},
+	{0xFEB5, 13512, 2},
+	{0xFEB6, 13514, 2},
+	{0xFEB7, 13516, 2},
+	{0xFEB8, 13518, 2},
+	{0xFEB9, 13520, 2},
+	{0xFEBA, 13522, 2},
+	{0xFEBB, 13524, 2},
+	{0xFEBC, 13526, 2},
+	{0xFEBD, 13528, 2},
+	{0xFEBE, 13530, 2},
+	{0xFEBF, 13532, 2},
+	{0xFEC0, 13534, 2},
+	{0xFEC1, 13536, 2},
+	{0xFEC2, 13538, 2},
+	{0xFEC3, 13540, 2},
+	{0xFEC4, 13542, 2},
+	{0xFEC5, 13544, 2},
+	{0xFEC6, 13546, 2},
+	{0xFEC7, 13548, 2},
+	{0xFEC8, 13550, 2},
+	{0xFEC9, 13552, 2},
+	{0xFECA, 13554, 2},
+	{0xFECB, 13556, 2},
+	{0xFECC, 13558, 2},
+	{0xFECD, 13560, 2},
+	{0xFECE, 13562, 2},
+	{0xFECF, 13564, 2},
+	{0xFED0, 13566, 2},
+	{0xFED1, 13568, 2},
+	{0xFED2, 13570, 2},
+	{0xFED3, 13572, 2},
+	{0xFED4, 13574, 2},
+	{0xFED5, 13576, 2},
+	{0xFED6, 13578, 2},
+	{0xFED7, 13580, 2},
+	{0xFED8, 13582, 2},
+	{0xFED9, 13584, 2},
+	{0xFEDA, 13586, 2},
+	{0xFEDB, 13588, 2},
+	{0xFEDC, 13590, 2},
+	{0xFEDD, 13592, 2},
+	{0xFEDE, 13594, 2},
+	{0xFEDF, 13596, 2},
+	{0xFEE0, 13598, 2},
+	{0xFEE1, 13600, 2},
+	{0xFEE2, 13602, 2},
+	{0xFEE3, 13604, 2},
+	{0xFEE4, 13606, 2},
+	{0xFEE5, 13608, 2},
+	{0xFEE6, 13610, 2},
+	{0xFEE7, 13612, 2},
+	{0xFEE8, 13614, 2},
+	{0xFEE9, 13616, 2},
+	{0xFEEA, 13618, 2},
+	{0xFEEB, 13620, 2},
+	{0xFEEC, 13622, 2},
+	{0xFEED, 13624, 2},
+	{0xFEEE, 13626, 2},
+	{0xFEEF, 13628, 2},
+	{0xFEF0, 13630, 2},
+	{0xFEF1, 13632, 2},
+	{0xFEF2, 13634, 2},
+	{0xFEF3, 13636, 2},
+	{0xFEF4, 13638, 2},
+	{0xFEF5, 13640, 6},
+	{0xFEF6, 13646, 6},
+	{0xFEF7, 13652, 6},
+	{0xFEF8, 13658, 6},
+	{0xFEF9, 13664, 6},
+	{0xFEFA, 13670, 6},
+	{0xFEFB, 13676, 4},
+	{0xFEFC, 13680, 4},
+	{0xFF01, 13684, 1},
+	{0xFF02, 13685, 1},
+	{0xFF03, 13686, 1},
+	{0xFF04, 13687, 1},
+	{0xFF05, 13688, 1},
+	{0xFF06, 13689, 1},
+	{0xFF07, 13690, 1},
+	{0xFF08, 13691, 1},
+	{0xFF09, 13692, 1},
+	{0xFF0A, 13693, 1},
+	{0xFF0B, 13694, 1},
+	{0xFF0C, 13695, 1},
+	{0xFF0D, 13696, 1},
+	{0xFF0E, 13697, 1},
+	{0xFF0F, 13698, 1},
+	{0xFF10, 13699, 1},
+	{0xFF11, 13700, 1},
+	{0xFF12, 13701, 1},
+	{0xFF13, 13702, 1},
+	{0xFF14, 13703, 1},
+	{0xFF15, 13704, 1},
+	{0xFF16, 13705, 1},
+	{0xFF17, 13706, 1},
+	{0xFF18, 13707, 1},
+	{0xFF19, 13708, 1},
+	{0xFF1A, 13709, 1},
+	{0xFF1B, 13710, 1},
+	{0xFF1C, 13711, 1},
+	{0xFF1D, 13712, 1},
+	{0xFF1E, 13713, 1},
+	{0xFF1F, 13714, 1},
+	{0xFF20, 13715, 1},
+	{0xFF21, 13716, 1},
+	{0xFF22, 13717, 1},
+	{0xFF23, 13718, 1},
+	{0xFF24, 13719, 1},
+	{0xFF25, 13720, 1},
+	{0xFF26, 13721, 1},
+	{0xFF27, 13722, 1},
+	{0xFF28, 13723, 1},
+	{0xFF29, 13724, 1},
+	{0xFF2A, 13725, 1},
+	{0xFF2B, 13726, 1},
+	{0xFF2C, 13727, 1},
+	{0xFF2D, 13728, 1},
+	{0xFF2E, 13729, 1},
+	{0xFF2F, 13730, 1},
+	{0xFF30, 13731, 1},
+	{0xFF31, 13732, 1},
+	{0xFF32, 13733, 1},
+	{0xFF33, 13734, 1},
+	{0xFF34, 13735, 1},
+	{0xFF35, 13736, 1},
+	{0xFF36, 13737, 1},
+	{0xFF37, 13738, 1},
+	{0xFF38, 13739, 1},
+	{0xFF39, 13740, 1},
+	{0xFF3A, 13741, 1},
+	{0xFF3B, 13742, 1},
+	{0xFF3C, 13743, 1},
+	{0xFF3D, 13744, 1},
+	{0xFF3E, 13745, 1},
+	{0xFF3F, 13746, 1},
+	{0xFF40, 13747, 1},
+	{0xFF41, 13748, 1},
+	{0xFF42, 13749, 1},
+	{0xFF43, 13750, 1},
+	{0xFF44, 13751, 1},
+	{0xFF45, 13752, 1},
+	{0xFF46, 13753, 1},
+	{0xFF47, 13754, 1},
+	{0xFF48, 13755, 1},
+	{0xFF49, 13756, 1},
+	{0xFF4A, 13757, 1},
+	{0xFF4B, 13758, 1},
+	{0xFF4C, 13759, 1},
+	{0xFF4D, 13760, 1},
+	{0xFF4E, 13761, 1},
+	{0xFF4F, 13762, 1},
+	{0xFF50, 13763, 1},
+	{0xFF51, 13764, 1},
+	{0xFF52, 13765, 1},
+	{0xFF53, 13766, 1},
+	{0xFF54, 13767, 1},
+	{0xFF55, 13768, 1},
+	{0xFF56, 13769, 1},
+	{0xFF57, 13770, 1},
+	{0xFF58, 13771, 1},
+	{0xFF59, 13772, 1},
+	{0xFF5A, 13773, 1},
+	{0xFF5B, 13774, 1},
+	{0xFF5C, 13775, 1},
+	{0xFF5D, 13776, 1},
+	{0xFF5E, 13777, 1},
+	{0xFF5F, 13778, 3},
+	{0xFF60, 13781, 3},
+	{0xFF61, 13784, 3},
+	{0xFF62, 13787, 3},
+	{0xFF63, 13790, 3},
+	{0xFF64, 13793, 3},
+	{0xFF65, 13796, 3},
+	{0xFF66, 13799, 3},
+	{0xFF67, 13802, 3},
+	{0xFF68, 13805, 3},
+	{0xFF69, 13808, 3},
+	{0xFF6A, 13811, 3},
+	{0xFF6B, 13814, 3},
+	{0xFF6C, 13817, 3},
+	{0xFF6D, 13820, 3},
+	{0xFF6E, 13823, 3},
+	{0xFF6F, 13826, 3},
+	{0xFF70, 13829, 3},
+	{0xFF71, 13832, 3},
+	{0xFF72, 13835, 3},
+	{0xFF73, 13838, 3},
+	{0xFF74, 13841, 3},
+	{0xFF75, 13844, 3},
+	{0xFF76, 13847, 3},
+	{0xFF77, 13850, 3},
+	{0xFF78, 13853, 3},
+	{0xFF79, 13856, 3},
+	{0xFF7A, 13859, 3},
+	{0xFF7B, 13862, 3},
+	{0xFF7C, 13865, 3},
+	{0xFF7D, 13868, 3},
+	{0xFF7E, 13871, 3},
+	{0xFF7F, 13874, 3},
+	{0xFF80, 13877, 3},
+	{0xFF81, 13880, 3},
+	{0xFF82, 13883, 3},
+	{0xFF83, 13886, 3},
+	{0xFF84, 13889, 3},
+	{0xFF85, 13892, 3},
+	{0xFF86, 13895, 3},
+	{0xFF87, 13898, 3},
+	{0xFF88, 13901, 3},
+	{0xFF89, 13904, 3},
+	{0xFF8A, 13907, 3},
+	{0xFF8B, 13910, 3},
+	{0xFF8C, 13913, 3},
+	{0xFF8D, 13916, 3},
+	{0xFF8E, 13919, 3},
+	{0xFF8F, 13922, 3},
+	{0xFF90, 13925, 3},
+	{0xFF91, 13928, 3},
+	{0xFF92, 13931, 3},
+	{0xFF93, 13934, 3},
+	{0xFF94, 13937, 3},
+	{0xFF95, 13940, 3},
+	{0xFF96, 13943, 3},
+	{0xFF97, 13946, 3},
+	{0xFF98, 13949, 3},
+	{0xFF99, 13952, 3},
+	{0xFF9A, 13955, 3},
+	{0xFF9B, 13958, 3},
+	{0xFF9C, 13961, 3},
+	{0xFF9D, 13964, 3},
+	{0xFF9E, 13967, 3},
+	{0xFF9F, 13970, 3},
+	{0xFFA0, 13973, 3},
+	{0xFFA1, 13976, 3},
+	{0xFFA2, 13979, 3},
+	{0xFFA3, 13982, 3},
+	{0xFFA4, 13985, 3},
+	{0xFFA5, 13988, 3},
+	{0xFFA6, 13991, 3},
+	{0xFFA7, 13994, 3},
+	{0xFFA8, 13997, 3},
+	{0xFFA9, 14000, 3},
+	{0xFFAA, 14003, 3},
+	{0xFFAB, 14006, 3},
+	{0xFFAC, 14009, 3},
+	{0xFFAD, 14012, 3},
+	{0xFFAE, 14015, 3},
+	{0xFFAF, 14018, 3},
+	{0xFFB0, 14021, 3},
+	{0xFFB1, 14024, 3},
+	{0xFFB2, 14027, 3},
+	{0xFFB3, 14030, 3},
+	{0xFFB4, 14033, 3},
+	{0xFFB5, 14036, 3},
+	{0xFFB6, 14039, 3},
+	{0xFFB7, 14042, 3},
+	{0xFFB8, 14045, 3},
+	{0xFFB9, 14048, 3},
+	{0xFFBA, 14051, 3},
+	{0xFFBB, 14054, 3},
+	{0xFFBC, 14057, 3},
+	{0xFFBD, 14060, 3},
+	{0xFFBE, 14063, 3},
+	{0xFFC2, 14066, 3},
+	{0xFFC3, 14069, 3},
+	{0xFFC4, 14072, 3},
+	{0xFFC5, 14075, 3},
+	{0xFFC6, 14078, 3},
+	{0xFFC7, 14081, 3},
+	{0xFFCA, 14084, 3},
+	{0xFFCB, 14087, 3},
+	{0xFFCC, 14090, 3},
+	{0xFFCD, 14093, 3},
+	{0xFFCE, 14096, 3},
+	{0xFFCF, 14099, 3},
+	{0xFFD2, 14102, 3},
+	{0xFFD3, 14105, 3},
+	{0xFFD4, 14108, 3},
+	{0xFFD5, 14111, 3},
+	{0xFFD6, 14114, 3},
+	{0xFFD7, 14117, 3},
+	{0xFFDA, 14120, 3},
+	{0xFFDB, 14123, 3},
+	{0xFFDC, 14126, 3},
+	{0xFFE0, 14129, 2},
+	{0xFFE1, 14131, 2},
+	{0xFFE2, 14133, 2},
+	{0xFFE3, 14135, 3},
+	{0xFFE4, 14138, 2},
+	{0xFFE5, 14140, 2},
+	{0xFFE6, 14142, 3},
+	{0xFFE8, 14145, 3},
+	{0xFFE9, 14148, 3},
+	{0xFFEA, 14151, 3},
+	{0xFFEB, 14154, 3},
+	{0xFFEC, 14157, 3},
+	{0xFFED, 14160, 3},
+	{0xFFEE, 14163, 3},
+	{0x10781, 14166, 2},
+	{0x10782, 14168, 2},
+	{0x10783, 14170, 2},
+	{0x10784, 14172, 2},
+	{0x10785, 14174, 2},
+	{0x10787, 14176, 2},
+	{0x10788, 14178, 3},
+	{0x10789, 14181, 2},
+	{0x1078A, 14183, 2},
+	{0x1078B, 14185, 2},
+	{0x1078C, 14187, 2},
+	{0x1078D, 14189, 3},
+	{0x1078E, 14192, 2},
+	{0x1078F, 14194, 2},
+	{0x10790, 14196, 2},
+	{0x10791, 14198, 2},
+	{0x10792, 14200, 2},
+	{0x10793, 14202, 2},
+	{0x10794, 14204, 2},
+	{0x10795, 14206, 2},
+	{0x10796, 14208, 2},
+	{0x10797, 14210, 2},
+	{0x10798, 14212, 2},
+	{0x10799, 14214, 2},
+	{0x1079A, 14216, 2},
+	{0x1079B, 14218, 2},
+	{0x1079C, 14220, 4},
+	{0x1079D, 14224, 3},
+	{0x1079E, 14227, 2},
+	{0x1079F, 14229, 4},
+	{0x107A0, 14233, 2},
+	{0x107A1, 14235, 4},
+	{0x107A2, 14239, 2},
+	{0x107A3, 14241, 2},
+	{0x107A4, 14243, 2},
+	{0x107A5, 14245, 1},
+	{0x107A6, 14246, 2},
+	{0x107A7, 14248, 4},
+	{0x107A8, 14252, 2},
+	{0x107A9, 14254, 2},
+	{0x107AA, 14256, 2},
+	{0x107AB, 14258, 2},
+	{0x107AC, 14260, 2},
+	{0x107AD, 14262, 3},
+	{0x107AE, 14265, 2},
+	{0x107AF, 14267, 2},
+	{0x107B0, 14269, 3},
+	{0x107B2, 14272, 2},
+	{0x107B3, 14274, 2},
+	{0x107B4, 14276, 2},
+	{0x107B5, 14278, 2},
+	{0x107B6, 14280, 2},
+	{0x107B7, 14282, 2},
+	{0x107B8, 14284, 2},
+	{0x107B9, 14286, 4},
+	{0x107BA, 14290, 4},
+	{0x1109A, 14294, 8},
+	{0x1109C, 14302, 8},
+	{0x110AB, 14310, 8},
+	{0x1112E, 14318, 8},
+	{0x1112F, 14326, 8},
+	{0x1134B, 14334, 8},
+	{0x1134C, 14342, 8},
+	{0x114BB, 14350, 8},
+	{0x114BC, 14358, 8},
+	{0x114BE, 14366, 8},
+	{0x115BA, 14374, 8},
+	{0x115BB, 14382, 8},
+	{0x11938, 14390, 8},
+	{0x1D15E, 14398, 8},
+	{0x1D15F, 14406, 8},
+	{0x1D160, 14414, 12},
+	{0x1D161, 14426, 12},
+	{0x1D162, 14438, 12},
+	{0x1D163, 14450, 12},
+	{0x1D164, 14462, 12},
+	{0x1D1BB, 14474, 8},
+	{0x1D1BC, 14482, 8},
+	{0x1D1BD, 14490, 12},
+	{0x1D1BE, 14502, 12},
+	{0x1D1BF, 14514, 12},
+	{0x1D1C0, 14526, 12},
+	{0x1D400, 14538, 1},
+	{0x1D401, 14539, 1},
+	{0x1D402, 14540, 1},
+	{0x1D403, 14541, 1},
+	{0x1D404, 14542, 1},
+	{0x1D405, 14543, 1},
+	{0x1D406, 14544, 1},
+	{0x1D407, 14545, 1},
+	{0x1D408, 14546, 1},
+	{0x1D409, 14547, 1},
+	{0x1D40A, 14548, 1},
+	{0x1D40B, 14549, 1},
+	{0x1D40C, 14550, 1},
+	{0x1D40D, 14551, 1},
+	{0x1D40E, 14552, 1},
+	{0x1D40F, 14553, 1},
+	{0x1D410, 14554, 1},
+	{0x1D411, 14555, 1},
+	{0x1D412, 14556, 1},
+	{0x1D413, 14557, 1},
+	{0x1D414, 14558, 1},
+	{0x1D415, 14559, 1},
+	{0x1D416, 14560, 1},
+	{0x1D417, 14561, 1},
+	{0x1D418, 14562, 1},
+	{0x1D419, 14563, 1},
+	{0x1D41A, 14564, 1},
+	{0x1D41B, 14565, 1},
+	{0x1D41C, 14566, 1},
+	{0x1D41D, 14567, 1},
+	{0x1D41E, 14568, 1},
+	{0x1D41F, 14569, 1},
+	{0x1D420, 14570, 1},
+	{0x1D421, 14571, 1},
+	{0x1D422, 14572, 1},
+	{0x1D423, 14573, 1},
+	{0x1D424, 14574, 1},
+	{0x1D425, 14575, 1},
+	{0x1D426, 14576, 1},
+	{0x1D427, 14577, 1},
+	{0x1D428, 14578, 1},
+	{0x1D429, 14579, 1},
+	{0x1D42A, 14580, 1},
+	{0x1D42B, 14581, 1},
+	{0x1D42C, 14582, 1},
+	{0x1D42D, 14583, 1},
+	{0x1D42E, 14584, 1},
+	{0x1D42F, 14585, 1},
+	{0x1D430, 14586, 1},
+	{0x1D431, 14587, 1},
+	{0x1D432, 14588, 1},
+	{0x1D433, 14589, 1},
+	{0x1D434, 14590, 1},
+	{0x1D435, 14591, 1},
+	{0x1D436, 14592, 1},
+	{0x1D437, 14593, 1},
+	{0x1D438, 14594, 1},
+	{0x1D439, 14595, 1},
+	{0x1D43A, 14596, 1},
+	{0x1D43B, 14597, 1},
+	{0x1D43C, 14598, 1},
+	{0x1D43D, 14599, 1},
+	{0x1D43E, 14600, 1},
+	{0x1D43F, 14601, 1},
+	{0x1D440, 14602, 1},
+	{0x1D441, 14603, 1},
+	{0x1D442, 14604, 1},
+	{0x1D443, 14605, 1},
+	{0x1D444, 14606, 1},
+	{0x1D445, 14607, 1},
+	{0x1D446, 14608, 1},
+	{0x1D447, 14609, 1},
+	{0x1D448, 14610, 1},
+	{0x1D449, 14611, 1},
+	{0x1D44A, 14612, 1},
+	{0x1D44B, 14613, 1},
+	{0x1D44C, 14614, 1},
+	{0x1D44D, 14615, 1},
+	{0x1D44E, 14616, 1},
+	{0x1D44F, 14617, 1},
+	{0x1D450, 14618, 1},
+	{0x1D451, 14619, 1},
+	{0x1D452, 14620, 1},
+	{0x1D453, 14621, 1},
+	{0x1D454, 14622, 1},
+	{0x1D456, 14623, 1},
+	{0x1D457, 14624, 1},
+	{0x1D458, 14625, 1},
+	{0x1D459, 14626, 1},
+	{0x1D45A, 14627, 1},
+	{0x1D45B, 14628, 1},
+	{0x1D45C, 14629, 1},
+	{0x1D45D, 14630, 1},
+	{0x1D45E, 14631, 1},
+	{0x1D45F, 14632, 1},
+	{0x1D460, 14633, 1},
+	{0x1D461, 14634, 1},
+	{0x1D462, 14635, 1},
+	{0x1D463, 14636, 1},
+	{0x1D464, 14637, 1},
+	{0x1D465, 14638, 1},
+	{0x1D466, 14639, 1},
+	{0x1D467, 14640, 1},
+	{0x1D468, 14641, 1},
+	{0x1D469, 14642, 1},
+	{0x1D46A, 14643, 1},
+	{0x1D46B, 14644, 1},
+	{0x1D46C, 14645, 1},
+	{0x1D46D, 14646, 1},
+	{0x1D46E, 14647, 1},
+	{0x1D46F, 14648, 1},
+	{0x1D470, 14649, 1},
+	{0x1D471, 14650, 1},
+	{0x1D472, 14651, 1},
+	{0x1D473, 14652, 1},
+	{0x1D474, 14653, 1},
+	{0x1D475, 14654, 1},
+	{0x1D476, 14655, 1},
+	{0x1D477, 14656, 1},
+	{0x1D478, 14657, 1},
+	{0x1D479, 14658, 1},
+	{0x1D47A, 14659, 1},
+	{0x1D47B, 14660, 1},
+	{0x1D47C, 14661, 1},
+	{0x1D47D, 14662, 1},
+	{0x1D47E, 14663, 1},
+	{0x1D47F, 14664, 1},
+	{0x1D480, 14665, 1},
+	{0x1D481, 14666, 1},
+	{0x1D482, 14667, 1},
+	{0x1D483, 14668, 1},
+	{0x1D484, 14669, 1},
+	{0x1D485, 14670, 1},
+	{0x1D486, 14671, 1},
+	{0x1D487, 14672, 1},
+	{0x1D488, 14673, 1},
+	{0x1D489, 14674, 1},
+	{0x1D48A, 14675, 1},
+	{0x1D48B, 14676, 1},
+	{0x1D48C, 14677, 1},
+	{0x1D48D, 14678, 1},
+	{0x1D48E, 14679, 1},
+	{0x1D48F, 14680, 1},
+	{0x1D490, 14681, 1},
+	{0x1D491, 14682, 1},
+	{0x1D492, 14683, 1},
+	{0x1D493, 14684, 1},
+	{0x1D494, 14685, 1},
+	{0x1D495, 14686, 1},
+	{0x1D496, 14687, 1},
+	{0x1D497, 14688, 1},
+	{0x1D498, 14689, 1},
+	{0x1D499, 14690, 1},
+	{0x1D49A, 14691, 1},
+	{0x1D49B, 14692, 1},
+	{0x1D49C, 14693, 1},
+	{0x1D49E, 14694, 1},
+	{0x1D49F, 14695, 1},
+	{0x1D4A2, 14696, 1},
+	{0x1D4A5, 14697, 1},
+	{0x1D4A6, 14698, 1},
+	{0x1D4A9, 14699, 1},
+	{0x1D4AA, 14700, 1},
+	{0x1D4AB, 14701, 1},
+	{0x1D4AC, 14702, 1},
+	{0x1D4AE, 14703, 1},
+	{0x1D4AF, 14704, 1},
+	{0x1D4B0, 14705, 1},
+	{0x1D4B1, 14706, 1},
+	{0x1D4B2, 14707, 1},
+	{0x1D4B3, 14708, 1},
+	{0x1D4B4, 14709, 1},
+	{0x1D4B5, 14710, 1},
+	{0x1D4B6, 14711, 1},
+	{0x1D4B7, 14712, 1},
+	{0x1D4B8, 14713, 1},
+	{0x1D4B9, 14714, 1},
+	{0x1D4BB, 14715, 1},
+	{0x1D4BD, 14716, 1},
+	{0x1D4BE, 14717, 1},
+	{0x1D4BF, 14718, 1},
+	{0x1D4C0, 14719, 1},
+	{0x1D4C1, 14720, 1},
+	{0x1D4C2, 14721, 1},
+	{0x1D4C3, 14722, 1},
+	{0x1D4C5, 14723, 1},
+	{0x1D4C6, 14724, 1},
+	{0x1D4C7, 14725, 1},
+	{0x1D4C8, 14726, 1},
+	{0x1D4C9, 14727, 1},
+	{0x1D4CA, 14728, 1},
+	{0x1D4CB, 14729, 1},
+	{0x1D4CC, 14730, 1},
+	{0x1D4CD, 14731, 1},
+	{0x1D4CE, 14732, 1},
+	{0x1D4CF, 14733, 1},
+	{0x1D4D0, 14734, 1},
+	{0x1D4D1, 14735, 1},
+	{0x1D4D2, 14736, 1},
+	{0x1D4D3, 14737, 1},
+	{0x1D4D4, 14738, 1},
+	{0x1D4D5, 14739, 1},
+	{0x1D4D6, 14740, 1},
+	{0x1D4D7, 14741, 1},
+	{0x1D4D8, 14742, 1},
+	{0x1D4D9, 14743, 1},
+	{0x1D4DA, 14744, 1},
+	{0x1D4DB, 14745, 1},
+	{0x1D4DC, 14746, 1},
+	{0x1D4DD, 14747, 1},
+	{0x1D4DE, 14748, 1},
+	{0x1D4DF, 14749, 1},
+	{0x1D4E0, 14750, 1},
+	{0x1D4E1, 14751, 1},
+	{0x1D4E2, 14752, 1},
+	{0x1D4E3, 14753, 1},
+	{0x1D4E4, 14754, 1},
+	{0x1D4E5, 14755, 1},
+	{0x1D4E6, 14756, 1},
+	{0x1D4E7, 14757, 1},
+	{0x1D4E8, 14758, 1},
+	{0x1D4E9, 14759, 1},
+	{0x1D4EA, 14760, 1},
+	{0x1D4EB, 14761, 1},
+	{0x1D4EC, 14762, 1},
+	{0x1D4ED, 14763, 1},
+	{0x1D4EE, 14764, 1},
+	{0x1D4EF, 14765, 1},
+	{0x1D4F0, 14766, 1},
+	{0x1D4F1, 14767, 1},
+	{0x1D4F2, 14768, 1},
+	{0x1D4F3, 14769, 1},
+	{0x1D4F4, 14770, 1},
+	{0x1D4F5, 14771, 1},
+	{0x1D4F6, 14772, 1},
+	{0x1D4F7, 14773, 1},
+	{0x1D4F8, 14774, 1},
+	{0x1D4F9, 14775, 1},
+	{0x1D4FA, 14776, 1},
+	{0x1D4FB, 14777, 1},
+	{0x1D4FC, 14778, 1},
+	{0x1D4FD, 14779, 1},
+	{0x1D4FE, 14780, 1},
+	{0x1D4FF, 14781, 1},
+	{0x1D500, 14782, 1},
+	{0x1D501, 14783, 1},
+	{0x1D502, 14784, 1},
+	{0x1D503, 14785, 1},
+	{0x1D504, 14786, 1},
+	{0x1D505, 14787, 1},
+	{0x1D507, 14788, 1},
+	{0x1D508, 14789, 1},
+	{0x1D509, 14790, 1},
+	{0x1D50A, 14791, 1},
+	{0x1D50D, 14792, 1},
+	{0x1D50E, 14793, 1},
+	{0x1D50F, 14794, 1},
+	{0x1D510, 14795, 1},
+	{0x1D511, 14796, 1},
+	{0x1D512, 14797, 1},
+	{0x1D513, 14798, 1},
+	{0x1D514, 14799, 1},
+	{0x1D516, 14800, 1},
+	{0x1D517, 14801, 1},
+	{0x1D518, 14802, 1},
+	{0x1D519, 14803, 1},
+	{0x1D51A, 14804, 1},
+	{0x1D51B, 14805, 1},
+	{0x1D51C, 14806, 1},
+	{0x1D51E, 14807, 1},
+	{0x1D51F, 14808, 1},
+	{0x1D520, 14809, 1},
+	{0x1D521, 14810, 1},
+	{0x1D522, 14811, 1},
+	{0x1D523, 14812, 1},
+	{0x1D524, 14813, 1},
+	{0x1D525, 14814, 1},
+	{0x1D526, 14815, 1},
+	{0x1D527, 14816, 1},
+	{0x1D528, 14817, 1},
+	{0x1D529, 14818, 1},
+	{0x1D52A, 14819, 1},
+	{0x1D52B, 14820, 1},
+	{0x1D52C, 14821, 1},
+	{0x1D52D, 14822, 1},
+	{0x1D52E, 14823, 1},
+	{0x1D52F, 14824, 1},
+	{0x1D530, 14825, 1},
+	{0x1D531, 14826, 1},
+	{0x1D532, 14827, 1},
+	{0x1D533, 14828, 1},
+	{0x1D534, 14829, 1},
+	{0x1D535, 14830, 1},
+	{0x1D536, 14831, 1},
+	{0x1D537, 14832, 1},
+	{0x1D538, 14833, 1},
+	{0x1D539, 14834, 1},
+	{0x1D53B, 14835, 1},
+	{0x1D53C, 14836, 1},
+	{0x1D53D, 14837, 1},
+	{0x1D53E, 14838, 1},
+	{0x1D540, 14839, 1},
+	{0x1D541, 14840, 1},
+	{0x1D542, 14841, 1},
+	{0x1D543, 14842, 1},
+	{0x1D544, 14843, 1},
+	{0x1D546, 14844, 1},
+	{0x1D54A, 14845, 1},
+	{0x1D54B, 14846, 1},
+	{0x1D54C, 14847, 1},
+	{0x1D54D, 14848, 1},
+	{0x1D54E, 14849, 1},
+	{0x1D54F, 14850, 1},
+	{0x1D550, 14851, 1},
+	{0x1D552, 14852, 1},
+	{0x1D553, 14853, 1},
+	{0x1D554, 14854, 1},
+	{0x1D555, 14855, 1},
+	{0x1D556, 14856, 1},
+	{0x1D557, 14857, 1},
+	{0x1D558, 14858, 1},
+	{0x1D559, 14859, 1},
+	{0x1D55A, 14860, 1},
+	{0x1D55B, 14861, 1},
+	{0x1D55C, 14862, 1},
+	{0x1D55D, 14863, 1},
+	{0x1D55E, 14864, 1},
+	{0x1D55F, 14865, 1},
+	{0x1D560, 14866, 1},
+	{0x1D561, 14867, 1},
+	{0x1D562, 14868, 1},
+	{0x1D563, 14869, 1},
+	{0x1D564, 14870, 1},
+	{0x1D565, 14871, 1},
+	{0x1D566, 14872, 1},
+	{0x1D567, 14873, 1},
+	{0x1D568, 14874, 1},
+	{0x1D569, 14875, 1},
+	{0x1D56A, 14876, 1},
+	{0x1D56B, 14877, 1},
+	{0x1D56C, 14878, 1},
+	{0x1D56D, 14879, 1},
+	{0x1D56E, 14880, 1},
+	{0x1D56F, 14881, 1},
+	{0x1D570, 14882, 1},
+	{0x1D571, 14883, 1},
+	{0x1D572, 14884, 1},
+	{0x1D573, 14885, 1},
+	{0x1D574, 14886, 1},
+	{0x1D575, 14887, 1},
+	{0x1D576, 14888, 1},
+	{0x1D577, 14889, 1},
+	{0x1D578, 14890, 1},
+	{0x1D579, 14891, 1},
+	{0x1D57A, 14892, 1},
+	{0x1D57B, 14893, 1},
+	{0x1D57C, 14894, 1},
+	{0x1D57D, 14895, 1},
+	{0x1D57E, 14896, 1},
+	{0x1D57F, 14897, 1},
+	{0x1D580, 14898, 1},
+	{0x1D581, 14899, 1},
+	{0x1D582, 14900, 1},
+	{0x1D583, 14901, 1},
+	{0x1D584, 14902, 1},
+	{0x1D585, 14903, 1},
+	{0x1D586, 14904, 1},
+	{0x1D587, 14905, 1},
+	{0x1D588, 14906, 1},
+	{0x1D589, 14907, 1},
+	{0x1D58A, 14908, 1},
+	{0x1D58B, 14909, 1},
+	{0x1D58C, 14910, 1},
+	{0x1D58D, 14911, 1},
+	{0x1D58E, 14912, 1},
+	{0x1D58F, 14913, 1},
+	{0x1D590, 14914, 1},
+	{0x1D591, 14915, 1},
+	{0x1D592, 14916, 1},
+	{0x1D593, 14917, 1},
+	{0x1D594, 14918, 1},
+	{0x1D595, 14919, 1},
+	{0x1D596, 14920, 1},
+	{0x1D597, 14921, 1},
+	{0x1D598, 14922, 1},
+	{0x1D599, 14923, 1},
+	{0x1D59A, 14924, 1},
+	{0x1D59B, 14925, 1},
+	{0x1D59C, 14926, 1},
+	{0x1D59D, 14927, 1},
+	{0x1D59E, 14928, 1},
+	{0x1D59F, 14929, 1},
+	{0x1D5A0, 14930, 1},
+	{0x1D5A1, 14931, 1},
+	{0x1D5A2, 14932, 1},
+	{0x1D5A3, 14933, 1},
+	{0x1D5A4, 14934, 1},
+	{0x1D5A5, 14935, 1},
+	{0x1D5A6, 14936, 1},
+	{0x1D5A7, 14937, 1},
+	{0x1D5A8, 14938, 1},
+	{0x1D5A9, 14939, 1},
+	{0x1D5AA, 14940, 1},
+	{0x1D5AB, 14941, 1},
+	{0x1D5AC, 14942, 1},
+	{0x1D5AD, 14943, 1},
+	{0x1D5AE, 14944, 1},
+	{0x1D5AF, 14945, 1},
+	{0x1D5B0, 14946, 1},
+	{0x1D5B1, 14947, 1},
+	{0x1D5B2, 14948, 1},
+	{0x1D5B3, 14949, 1},
+	{0x1D5B4, 14950, 1},
+	{0x1D5B5, 14951, 1},
+	{0x1D5B6, 14952, 1},
+	{0x1D5B7, 14953, 1},
+	{0x1D5B8, 14954, 1},
+	{0x1D5B9, 14955, 1},
+	{0x1D5BA, 14956, 1},
+	{0x1D5BB, 14957, 1},
+	{0x1D5BC, 14958, 1},
+	{0x1D5BD, 14959, 1},
+	{0x1D5BE, 14960, 1},
+	{0x1D5BF, 14961, 1},
+	{0x1D5C0, 14962, 1},
+	{0x1D5C1, 14963, 1},
+	{0x1D5C2, 14964, 1},
+	{0x1D5C3, 14965, 1},
+	{0x1D5C4, 14966, 1},
+	{0x1D5C5, 14967, 1},
+	{0x1D5C6, 14968, 1},
+	{0x1D5C7, 14969, 1},
+	{0x1D5C8, 14970, 1},
+	{0x1D5C9, 14971, 1},
+	{0x1D5CA, 14972, 1},
+	{0x1D5CB, 14973, 1},
+	{0x1D5CC, 14974, 1},
+	{0x1D5CD, 14975, 1},
+	{0x1D5CE, 14976, 1},
+	{0x1D5CF, 14977, 1},
+	{0x1D5D0, 14978, 1},
+	{0x1D5D1, 14979, 1},
+	{0x1D5D2, 14980, 1},
+	{0x1D5D3, 14981, 1},
+	{0x1D5D4, 14982, 1},
+	{0x1D5D5, 14983, 1},
+	{0x1D5D6, 14984, 1},
+	{0x1D5D7, 14985, 1},
+	{0x1D5D8, 14986, 1},
+	{0x1D5D9, 14987, 1},
+	{0x1D5DA, 14988, 1},
+	{0x1D5DB, 14989, 1},
+	{0x1D5DC, 14990, 1},
+	{0x1D5DD, 14991, 1},
+	{0x1D5DE, 14992, 1},
+	{0x1D5DF, 14993, 1},
+	{0x1D5E0, 14994, 1},
+	{0x1D5E1, 14995, 1},
+	{0x1D5E2, 14996, 1},
+	{0x1D5E3, 14997, 1},
+	{0x1D5E4, 14998, 1},
+	{0x1D5E5, 14999, 1},
+	{0x1D5E6, 15000, 1},
+	{0x1D5E7, 15001, 1},
+	{0x1D5E8, 15002, 1},
+	{0x1D5E9, 15003, 1},
+	{0x1D5EA, 15004, 1},
+	{0x1D5EB, 15005, 1},
+	{0x1D5EC, 15006, 1},
+	{0x1D5ED, 15007, 1},
+	{0x1D5EE, 15008, 1},
+	{0x1D5EF, 15009, 1},
+	{0x1D5F0, 15010, 1},
+	{0x1D5F1, 15011, 1},
+	{0x1D5F2, 15012, 1},
+	{0x1D5F3, 15013, 1},
+	{0x1D5F4, 15014, 1},
+	{0x1D5F5, 15015, 1},
+	{0x1D5F6, 15016, 1},
+	{0x1D5F7, 15017, 1},
+	{0x1D5F8, 15018, 1},
+	{0x1D5F9, 15019, 1},
+	{0x1D5FA, 15020, 1},
+	{0x1D5FB, 15021, 1},
+	{0x1D5FC, 15022, 1},
+	{0x1D5FD, 15023, 1},
+	{0x1D5FE, 15024, 1},
+	{0x1D5FF, 15025, 1},
+	{0x1D600, 15026, 1},
+	{0x1D601, 15027, 1},
+	{0x1D602, 15028, 1},
+	{0x1D603, 15029, 1},
+	{0x1D604, 15030, 1},
+	{0x1D605, 15031, 1},
+	{0x1D606, 15032, 1},
+	{0x1D607, 15033, 1},
+	{0x1D608, 15034, 1},
+	{0x1D609, 15035, 1},
+	{0x1D60A, 15036, 1},
+	{0x1D60B, 15037, 1},
+	{0x1D60C, 15038, 1},
+	{0x1D60D, 15039, 1},
+	{0x1D60E, 15040, 1},
+	{0x1D60F, 15041, 1},
+	{0x1D610, 15042, 1},
+	{0x1D611, 15043, 1},
+	{0x1D612, 15044, 1},
+	{0x1D613, 15045, 1},
+	{0x1D614, 15046, 1},
+	{0x1D615, 15047, 1},
+	{0x1D616, 15048, 1},
+	{0x1D617, 15049, 1},
+	{0x1D618, 15050, 1},
+	{0x1D619, 15051, 1},
+	{0x1D61A, 15052, 1},
+	{0x1D61B, 15053, 1},
+	{0x1D61C, 15054, 1},
+	{0x1D61D, 15055, 1},
+	{0x1D61E, 15056, 1},
+	{0x1D61F, 15057, 1},
+	{0x1D620, 15058, 1},
+	{0x1D621, 15059, 1},
+	{0x1D622, 15060, 1},
+	{0x1D623, 15061, 1},
+	{0x1D624, 15062, 1},
+	{0x1D625, 15063, 1},
+	{0x1D626, 15064, 1},
+	{0x1D627, 15065, 1},
+	{0x1D628, 15066, 1},
+	{0x1D629, 15067, 1},
+	{0x1D62A, 15068, 1},
+	{0x1D62B, 15069, 1},
+	{0x1D62C, 15070, 1},
+	{0x1D62D, 15071, 1},
+	{0x1D62E, 15072, 1},
+	{0x1D62F, 15073, 1},
+	{0x1D630, 15074, 1},
+	{0x1D631, 15075, 1},
+	{0x1D632, 15076, 1},
+	{0x1D633, 15077, 1},
+	{0x1D634, 15078, 1},
+	{0x1D635, 15079, 1},
+	{0x1D636, 15080, 1},
+	{0x1D637, 15081, 1},
+	{0x1D638, 15082, 1},
+	{0x1D639, 15083, 1},
+	{0x1D63A, 15084, 1},
+	{0x1D63B, 15085, 1},
+	{0x1D63C, 15086, 1},
+	{0x1D63D, 15087, 1},
+	{0x1D63E, 15088, 1},
+	{0x1D63F, 15089, 1},
+	{0x1D640, 15090, 1},
+	{0x1D641, 15091, 1},
+	{0x1D642, 15092, 1},
+	{0x1D643, 15093, 1},
+	{0x1D644, 15094, 1},
+	{0x1D645, 15095, 1},
+	{0x1D646, 15096, 1},
+	{0x1D647, 15097, 1},
+	{0x1D648, 15098, 1},
+	{0x1D649, 15099, 1},
+	{0x1D64A, 15100, 1},
+	{0x1D64B, 15101, 1},
+	{0x1D64C, 15102, 1},
+	{0x1D64D, 15103, 1},
+	{0x1D64E, 15104, 1},
+	{0x1D64F, 15105, 1},
+	{0x1D650, 15106, 1},
+	{0x1D651, 15107, 1},
+	{0x1D652, 15108, 1},
+	{0x1D653, 15109, 1},
+	{0x1D654, 15110, 1},
+	{0x1D655, 15111, 1},
+	{0x1D656, 15112, 1},
+	{0x1D657, 15113, 1},
+	{0x1D658, 15114, 1},
+	{0x1D659, 15115, 1},
+	{0x1D65A, 15116, 1},
+	{0x1D65B, 15117, 1},
+	{0x1D65C, 15118, 1},
+	{0x1D65D, 15119, 1},
+	{0x1D65E, 15120, 1},
+	{0x1D65F, 15121, 1},
+	{0x1D660, 15122, 1},
+	{0x1D661, 15123, 1},
+	{0x1D662, 15124, 1},
+	{0x1D663, 15125, 1},
+	{0x1D664, 15126, 1},
+	{0x1D665, 15127, 1},
+	{0x1D666, 15128, 1},
+	{0x1D667, 15129, 1},
+	{0x1D668, 15130, 1},
+	{0x1D669, 15131, 1},
+	{0x1D66A, 15132, 1},
+	{0x1D66B, 15133, 1},
+	{0x1D66C, 15134, 1},
+	{0x1D66D, 15135, 1},
+	{0x1D66E, 15136, 1},
+	{0x1D66F, 15137, 1},
+	{0x1D670, 15138, 1},
+	{0x1D671, 15139, 1},
+	{0x1D672, 15140, 1},
+	{0x1D673, 15141, 1},
+	{0x1D674, 15142, 1},
+	{0x1D675, 15143, 1},
+	{0x1D676, 15144, 1},
+	{0x1D677, 15145, 1},
+	{0x1D678, 15146, 1},
+	{0x1D679, 15147, 1},
+	{0x1D67A, 15148, 1},
+	{0x1D67B, 15149, 1},
+	{0x1D67C, 15150, 1},
+	{0x1D67D, 15151, 1},
+	{0x1D67E, 15152, 1},
+	{0x1D67F, 15153, 1},
+	{0x1D680, 15154, 1},
+	{0x1D681, 15155, 1},
+	{0x1D682, 15156, 1},
+	{0x1D683, 15157, 1},
+	{0x1D684, 15158, 1},
+	{0x1D685, 15159, 1},
+	{0x1D686, 15160, 1},
+	{0x1D687, 15161, 1},
+	{0x1D688, 15162, 1},
+	{0x1D689, 15163, 1},
+	{0x1D68A, 15164, 1},
+	{0x1D68B, 15165, 1},
+	{0x1D68C, 15166, 1},
+	{0x1D68D, 15167, 1},
+	{0x1D68E, 15168, 1},
+	{0x1D68F, 15169, 1},
+	{0x1D690, 15170, 1},
+	{0x1D691, 15171, 1},
+	{0x1D692, 15172, 1},
+	{0x1D693, 15173, 1},
+	{0x1D694, 15174, 1},
+	{0x1D695, 15175, 1},
+	{0x1D696, 15176, 1},
+	{0x1D697, 15177, 1},
+	{0x1D698, 15178, 1},
+	{0x1D699, 15179, 1},
+	{0x1D69A, 15180, 1},
+	{0x1D69B, 15181, 1},
+	{0x1D69C, 15182, 1},
+	{0x1D69D, 15183, 1},
+	{0x1D69E, 15184, 1},
+	{0x1D69F, 15185, 1},
+	{0x1D6A0, 15186, 1},
+	{0x1D6A1, 15187, 1},
+	{0x1D6A2, 15188, 1},
+	{0x1D6A3, 15189, 1},
+	{0x1D6A4, 15190, 2},
+	{0x1D6A5, 15192, 2},
+	{0x1D6A8, 15194, 2},
+	{0x1D6A9, 15196, 2},
+	{0x1D6AA, 15198, 2},
+	{0x1D6AB, 15200, 2},
+	{0x1D6AC, 15202, 2},
+	{0x1D6AD, 15204, 2},
+	{0x1D6AE, 15206, 2},
+	{0x1D6AF, 15208, 2},
+	{0x1D6B0, 15210, 2},
+	{0x1D6B1, 15212, 2},
+	{0x1D6B2, 15214, 2},
+	{0x1D6B3, 15216, 2},
+	{0x1D6B4, 15218, 2},
+	{0x1D6B5, 15220, 2},
+	{0x1D6B6, 15222, 2},
+	{0x1D6B7, 15224, 2},
+	{0x1D6B8, 15226, 2},
+	{0x1D6B9, 15228, 2},
+	{0x1D6BA, 15230, 2},
+	{0x1D6BB, 15232, 2},
+	{0x1D6BC, 15234, 2},
+	{0x1D6BD, 15236, 2},
+	{0x1D6BE, 15238, 2},
+	{0x1D6BF, 15240, 2},
+	{0x1D6C0, 15242, 2},
+	{0x1D6C1, 15244, 3},
+	{0x1D6C2, 15247, 2},
+	{0x1D6C3, 15249, 2},
+	{0x1D6C4, 15251, 2},
+	{0x1D6C5, 15253, 2},
+	{0x1D6C6, 15255, 2},
+	{0x1D6C7, 15257, 2},
+	{0x1D6C8, 15259, 2},
+	{0x1D6C9, 15261, 2},
+	{0x1D6CA, 15263, 2},
+	{0x1D6CB, 15265, 2},
+	{0x1D6CC, 15267, 2},
+	{0x1D6CD, 15269, 2},
+	{0x1D6CE, 15271, 2},
+	{0x1D6CF, 15273, 2},
+	{0x1D6D0, 15275, 2},
+	{0x1D6D1, 15277, 2},
+	{0x1D6D2, 15279, 2},
+	{0x1D6D3, 15281, 2},
+	{0x1D6D4, 15283, 2},
+	{0x1D6D5, 15285, 2},
+	{0x1D6D6, 15287, 2},
+	{0x1D6D7, 15289, 2},
+	{0x1D6D8, 15291, 2},
+	{0x1D6D9, 15293, 2},
+	{0x1D6DA, 15295, 2},
+	{0x1D6DB, 15297, 3},
+	{0x1D6DC, 15300, 2},
+	{0x1D6DD, 15302, 2},
+	{0x1D6DE, 15304, 2},
+	{0x1D6DF, 15306, 2},
+	{0x1D6E0, 15308, 2},
+	{0x1D6E1, 15310, 2},
+	{0x1D6E2, 15312, 2},
+	{0x1D6E3, 15314, 2},
+	{0x1D6E4, 15316, 2},
+	{0x1D6E5, 15318, 2},
+	{0x1D6E6, 15320, 2},
+	{0x1D6E7, 15322, 2},
+	{0x1D6E8, 15324, 2},
+	{0x1D6E9, 15326, 2},
+	{0x1D6EA, 15328, 2},
+	{0x1D6EB, 15330, 2},
+	{0x1D6EC, 15332, 2},
+	{0x1D6ED, 15334, 2},
+	{0x1D6EE, 15336, 2},
+	{0x1D6EF, 15338, 2},
+	{0x1D6F0, 15340, 2},
+	{0x1D6F1, 15342, 2},
+	{0x1D6F2, 15344, 2},
+	{0x1D6F3, 15346, 2},
+	{0x1D6F4, 15348, 2},
+	{0x1D6F5, 15350, 2},
+	{0x1D6F6, 15352, 2},
+	{0x1D6F7, 15354, 2},
+	{0x1D6F8, 15356, 2},
+	{0x1D6F9, 15358, 2},
+	{0x1D6FA, 15360, 2},
+	{0x1D6FB, 15362, 3},
+	{0x1D6FC, 15365, 2},
+	{0x1D6FD, 15367, 2},
+	{0x1D6FE, 15369, 2},
+	{0x1D6FF, 15371, 2},
+	{0x1D700, 15373, 2},
+	{0x1D701, 15375, 2},
+	{0x1D702, 15377, 2},
+	{0x1D703, 15379, 2},
+	{0x1D704, 15381, 2},
+	{0x1D705, 15383, 2},
+	{0x1D706, 15385, 2},
+	{0x1D707, 15387, 2},
+	{0x1D708, 15389, 2},
+	{0x1D709, 15391, 2},
+	{0x1D70A, 15393, 2},
+	{0x1D70B, 15395, 2},
+	{0x1D70C, 15397, 2},
+	{0x1D70D, 15399, 2},
+	{0x1D70E, 15401, 2},
+	{0x1D70F, 15403, 2},
+	{0x1D710, 15405, 2},
+	{0x1D711, 15407, 2},
+	{0x1D712, 15409, 2},
+	{0x1D713, 15411, 2},
+	{0x1D714, 15413, 2},
+	{0x1D715, 15415, 3},
+	{0x1D716, 15418, 2},
+	{0x1D717, 15420, 2},
+	{0x1D718, 15422, 2},
+	{0x1D719, 15424, 2},
+	{0x1D71A, 15426, 2},
+	{0x1D71B, 15428, 2},
+	{0x1D71C, 15430, 2},
+	{0x1D71D, 15432, 2},
+	{0x1D71E, 15434, 2},
+	{0x1D71F, 15436, 2},
+	{0x1D720, 15438, 2},
+	{0x1D721, 15440, 2},
+	{0x1D722, 15442, 2},
+	{0x1D723, 15444, 2},
+	{0x1D724, 15446, 2},
+	{0x1D725, 15448, 2},
+	{0x1D726, 15450, 2},
+	{0x1D727, 15452, 2},
+	{0x1D728, 15454, 2},
+	{0x1D729, 15456, 2},
+	{0x1D72A, 15458, 2},
+	{0x1D72B, 15460, 2},
+	{0x1D72C, 15462, 2},
+	{0x1D72D, 15464, 2},
+	{0x1D72E, 15466, 2},
+	{0x1D72F, 15468, 2},
+	{0x1D730, 15470, 2},
+	{0x1D731, 15472, 2},
+	{0x1D732, 15474, 2},
+	{0x1D733, 15476, 2},
+	{0x1D734, 15478, 2},
+	{0x1D735, 15480, 3},
+	{0x1D736, 15483, 2},
+	{0x1D737, 15485, 2},
+	{0x1D738, 15487, 2},
+	{0x1D739, 15489, 2},
+	{0x1D73A, 15491, 2},
+	{0x1D73B, 15493, 2},
+	{0x1D73C, 15495, 2},
+	{0x1D73D, 15497, 2},
+	{0x1D73E, 15499, 2},
+	{0x1D73F, 15501, 2},
+	{0x1D740, 15503, 2},
+	{0x1D741, 15505, 2},
+	{0x1D742, 15507, 2},
+	{0x1D743, 15509, 2},
+	{0x1D744, 15511, 2},
+	{0x1D745, 15513, 2},
+	{0x1D746, 15515, 2},
+	{0x1D747, 15517, 2},
+	{0x1D748, 15519, 2},
+	{0x1D749, 15521, 2},
+	{0x1D74A, 15523, 2},
+	{0x1D74B, 15525, 2},
+	{0x1D74C, 15527, 2},
+	{0x1D74D, 15529, 2},
+	{0x1D74E, 15531, 2},
+	{0x1D74F, 15533, 3},
+	{0x1D750, 15536, 2},
+	{0x1D751, 15538, 2},
+	{0x1D752, 15540, 2},
+	{0x1D753, 15542, 2},
+	{0x1D754, 15544, 2},
+	{0x1D755, 15546, 2},
+	{0x1D756, 15548, 2},
+	{0x1D757, 15550, 2},
+	{0x1D758, 15552, 2},
+	{0x1D759, 15554, 2},
+	{0x1D75A, 15556, 2},
+	{0x1D75B, 15558, 2},
+	{0x1D75C, 15560, 2},
+	{0x1D75D, 15562, 2},
+	{0x1D75E, 15564, 2},
+	{0x1D75F, 15566, 2},
+	{0x1D760, 15568, 2},
+	{0x1D761, 15570, 2},
+	{0x1D762, 15572, 2},
+	{0x1D763, 15574, 2},
+	{0x1D764, 15576, 2},
+	{0x1D765, 15578, 2},
+	{0x1D766, 15580, 2},
+	{0x1D767, 15582, 2},
+	{0x1D768, 15584, 2},
+	{0x1D769, 15586, 2},
+	{0x1D76A, 15588, 2},
+	{0x1D76B, 15590, 2},
+	{0x1D76C, 15592, 2},
+	{0x1D76D, 15594, 2},
+	{0x1D76E, 15596, 2},
+	{0x1D76F, 15598, 3},
+	{0x1D770, 15601, 2},
+	{0x1D771, 15603, 2},
+	{0x1D772, 15605, 2},
+	{0x1D773, 15607, 2},
+	{0x1D774, 15609, 2},
+	{0x1D775, 15611, 2},
+	{0x1D776, 15613, 2},
+	{0x1D777, 15615, 2},
+	{0x1D778, 15617, 2},
+	{0x1D779, 15619, 2},
+	{0x1D77A, 15621, 2},
+	{0x1D77B, 15623, 2},
+	{0x1D77C, 15625, 2},
+	{0x1D77D, 15627, 2},
+	{0x1D77E, 15629, 2},
+	{0x1D77F, 15631, 2},
+	{0x1D780, 15633, 2},
+	{0x1D781, 15635, 2},
+	{0x1D782, 15637, 2},
+	{0x1D783, 15639, 2},
+	{0x1D784, 15641, 2},
+	{0x1D785, 15643, 2},
+	{0x1D786, 15645, 2},
+	{0x1D787, 15647, 2},
+	{0x1D788, 15649, 2},
+	{0x1D789, 15651, 3},
+	{0x1D78A, 15654, 2},
+	{0x1D78B, 15656, 2},
+	{0x1D78C, 15658, 2},
+	{0x1D78D, 15660, 2},
+	{0x1D78E, 15662, 2},
+	{0x1D78F, 15664, 2},
+	{0x1D790, 15666, 2},
+	{0x1D791, 15668, 2},
+	{0x1D792, 15670, 2},
+	{0x1D793, 15672, 2},
+	{0x1D794, 15674, 2},
+	{0x1D795, 15676, 2},
+	{0x1D796, 15678, 2},
+	{0x1D797, 15680, 2},
+	{0x1D798, 15682, 2},
+	{0x1D799, 15684, 2},
+	{0x1D79A, 15686, 2},
+	{0x1D79B, 15688, 2},
+	{0x1D79C, 15690, 2},
+	{0x1D79D, 15692, 2},
+	{0x1D79E, 15694, 2},
+	{0x1D79F, 15696, 2},
+	{0x1D7A0, 15698, 2},
+	{0x1D7A1, 15700, 2},
+	{0x1D7A2, 15702, 2},
+	{0x1D7A3, 15704, 2},
+	{0x1D7A4, 15706, 2},
+	{0x1D7A5, 15708, 2},
+	{0x1D7A6, 15710, 2},
+	{0x1D7A7, 15712, 2},
+	{0x1D7A8, 15714, 2},
+	{0x1D7A9, 15716, 3},
+	{0x1D7AA, 15719, 2},
+	{0x1D7AB, 15721, 2},
+	{0x1D7AC, 15723, 2},
+	{0x1D7AD, 15725, 2},
+	{0x1D7AE, 15727, 2},
+	{0x1D7AF, 15729, 2},
+	{0x1D7B0, 15731, 2},
+	{0x1D7B1, 15733, 2},
+	{0x1D7B2, 15735, 2},
+	{0x1D7B3, 15737, 2},
+	{0x1D7B4, 15739, 2},
+	{0x1D7B5, 15741, 2},
+	{0x1D7B6, 15743, 2},
+	{0x1D7B7, 15745, 2},
+	{0x1D7B8, 15747, 2},
+	{0x1D7B9, 15749, 2},
+	{0x1D7BA, 15751, 2},
+	{0x1D7BB, 15753, 2},
+	{0x1D7BC, 15755, 2},
+	{0x1D7BD, 15757, 2},
+	{0x1D7BE, 15759, 2},
+	{0x1D7BF, 15761, 2},
+	{0x1D7C0, 15763, 2},
+	{0x1D7C1, 15765, 2},
+	{0x1D7C2, 15767, 2},
+	{0x1D7C3, 15769, 3},
+	{0x1D7C4, 15772, 2},
+	{0x1D7C5, 15774, 2},
+	{0x1D7C6, 15776, 2},
+	{0x1D7C7, 15778, 2},
+	{0x1D7C8, 15780, 2},
+	{0x1D7C9, 15782, 2},
+	{0x1D7CA, 15784, 2},
+	{0x1D7CB, 15786, 2},
+	{0x1D7CE, 15788, 1},
+	{0x1D7CF, 15789, 1},
+	{0x1D7D0, 15790, 1},
+	{0x1D7D1, 15791, 1},
+	{0x1D7D2, 15792, 1},
+	{0x1D7D3, 15793, 1},
+	{0x1D7D4, 15794, 1},
+	{0x1D7D5, 15795, 1},
+	{0x1D7D6, 15796, 1},
+	{0x1D7D7, 15797, 1},
+	{0x1D7D8, 15798, 1},
+	{0x1D7D9, 15799, 1},
+	{0x1D7DA, 15800, 1},
+	{0x1D7DB, 15801, 1},
+	{0x1D7DC, 15802, 1},
+	{0x1D7DD, 15803, 1},
+	{0x1D7DE, 15804, 1},
+	{0x1D7DF, 15805, 1},
+	{0x1D7E0, 15806, 1},
+	{0x1D7E1, 15807, 1},
+	{0x1D7E2, 15808, 1},
+	{0x1D7E3, 15809, 1},
+	{0x1D7E4, 15810, 1},
+	{0x1D7E5, 15811, 1},
+	{0x1D7E6, 15812, 1},
+	{0x1D7E7, 15813, 1},
+	{0x1D7E8, 15814, 1},
+	{0x1D7E9, 15815, 1},
+	{0x1D7EA, 15816, 1},
+	{0x1D7EB, 15817, 1},
+	{0x1D7EC, 15818, 1},
+	{0x1D7ED, 15819, 1},
+	{0x1D7EE, 15820, 1},
+	{0x1D7EF, 15821, 1},
+	{0x1D7F0, 15822, 1},
+	{0x1D7F1, 15823, 1},
+	{0x1D7F2, 15824, 1},
+	{0x1D7F3, 15825, 1},
+	{0x1D7F4, 15826, 1},
+	{0x1D7F5, 15827, 1},
+	{0x1D7F6, 15828, 1},
+	{0x1D7F7, 15829, 1},
+	{0x1D7F8, 15830, 1},
+	{0x1D7F9, 15831, 1},
+	{0x1D7FA, 15832, 1},
+	{0x1D7FB, 15833, 1},
+	{0x1D7FC, 15834, 1},
+	{0x1D7FD, 15835, 1},
+	{0x1D7FE, 15836, 1},
+	{0x1D7FF, 15837, 1},
+	{0x1E030, 15838, 2},
+	{0x1E031, 15840, 2},
+	{0x1E032, 15842, 2},
+	{0x1E033, 15844, 2},
+	{0x1E034, 15846, 2},
+	{0x1E035, 15848, 2},
+	{0x1E036, 15850, 2},
+	{0x1E037, 15852, 2},
+	{0x1E038, 15854, 2},
+	{0x1E039, 15856, 2},
+	{0x1E03A, 15858, 2},
+	{0x1E03B, 15860, 2},
+	{0x1E03C, 15862, 2},
+	{0x1E03D, 15864, 2},
+	{0x1E03E, 15866, 2},
+	{0x1E03F, 15868, 2},
+	{0x1E040, 15870, 2},
+	{0x1E041, 15872, 2},
+	{0x1E042, 15874, 2},
+	{0x1E043, 15876, 2},
+	{0x1E044, 15878, 2},
+	{0x1E045, 15880, 2},
+	{0x1E046, 15882, 2},
+	{0x1E047, 15884, 2},
+	{0x1E048, 15886, 2},
+	{0x1E049, 15888, 2},
+	{0x1E04A, 15890, 3},
+	{0x1E04B, 15893, 2},
+	{0x1E04C, 15895, 2},
+	{0x1E04D, 15897, 2},
+	{0x1E04E, 15899, 2},
+	{0x1E04F, 15901, 2},
+	{0x1E050, 15903, 2},
+	{0x1E051, 15905, 2},
+	{0x1E052, 15907, 2},
+	{0x1E053, 15909, 2},
+	{0x1E054, 15911, 2},
+	{0x1E055, 15913, 2},
+	{0x1E056, 15915, 2},
+	{0x1E057, 15917, 2},
+	{0x1E058, 15919, 2},
+	{0x1E059, 15921, 2},
+	{0x1E05A, 15923, 2},
+	{0x1E05B, 15925, 2},
+	{0x1E05C, 15927, 2},
+	{0x1E05D, 15929, 2},
+	{0x1E05E, 15931, 2},
+	{0x1E05F, 15933, 2},
+	{0x1E060, 15935, 2},
+	{0x1E061, 15937, 2},
+	{0x1E062, 15939, 2},
+	{0x1E063, 15941, 2},
+	{0x1E064, 15943, 2},
+	{0x1E065, 15945, 2},
+	{0x1E066, 15947, 2},
+	{0x1E067, 15949, 2},
+	{0x1E068, 15951, 2},
+	{0x1E069, 15953, 2},
+	{0x1E06A, 15955, 2},
+	{0x1E06B, 15957, 2},
+	{0x1E06C, 15959, 3},
+	{0x1E06D, 15962, 2},
+	{0x1EE00, 15964, 2},
+	{0x1EE01, 15966, 2},
+	{0x1EE02, 15968, 2},
+	{0x1EE03, 15970, 2},
+	{0x1EE05, 15972, 2},
+	{0x1EE06, 15974, 2},
+	{0x1EE07, 15976, 2},
+	{0x1EE08, 15978, 2},
+	{0x1EE09, 15980, 2},
+	{0x1EE0A, 15982, 2},
+	{0x1EE0B, 15984, 2},
+	{0x1EE0C, 15986, 2},
+	{0x1EE0D, 15988, 2},
+	{0x1EE0E, 15990, 2},
+	{0x1EE0F, 15992, 2},
+	{0x1EE10, 15994, 2},
+	{0x1EE11, 15996, 2},
+	{0x1EE12, 15998, 2},
+	{0x1EE13, 16000, 2},
+	{0x1EE14, 16002, 2},
+	{0x1EE15, 16004, 2},
+	{0x1EE16, 16006, 2},
+	{0x1EE17, 16008, 2},
+	{0x1EE18, 16010, 2},
+	{0x1EE19, 16012, 2},
+	{0x1EE1A, 16014, 2},
+	{0x1EE1B, 16016, 2},
+	{0x1EE1C, 16018, 2},
+	{0x1EE1D, 16020, 2},
+	{0x1EE1E, 16022, 2},
+	{0x1EE1F, 16024, 2},
+	{0x1EE21, 16026, 2},
+	{0x1EE22, 16028, 2},
+	{0x1EE24, 16030, 2},
+	{0x1EE27, 16032, 2},
+	{0x1EE29, 16034, 2},
+	{0x1EE2A, 16036, 2},
+	{0x1EE2B, 16038, 2},
+	{0x1EE2C, 16040, 2},
+	{0x1EE2D, 16042, 2},
+	{0x1EE2E, 16044, 2},
+	{0x1EE2F, 16046, 2},
+	{0x1EE30, 16048, 2},
+	{0x1EE31, 16050, 2},
+	{0x1EE32, 16052, 2},
+	{0x1EE34, 16054, 2},
+	{0x1EE35, 16056, 2},
+	{0x1EE36, 16058, 2},
+	{0x1EE37, 16060, 2},
+	{0x1EE39, 16062, 2},
+	{0x1EE3B, 16064, 2},
+	{0x1EE42, 16066, 2},
+	{0x1EE47, 16068, 2},
+	{0x1EE49, 16070, 2},
+	{0x1EE4B, 16072, 2},
+	{0x1EE4D, 16074, 2},
+	{0x1EE4E, 16076, 2},
+	{0x1EE4F, 16078, 2},
+	{0x1EE51, 16080, 2},
+	{0x1EE52, 16082, 2},
+	{0x1EE54, 16084, 2},
+	{0x1EE57, 16086, 2},
+	{0x1EE59, 16088, 2},
+	{0x1EE5B, 16090, 2},
+	{0x1EE5D, 16092, 2},
+	{0x1EE5F, 16094, 2},
+	{0x1EE61, 16096, 2},
+	{0x1EE62, 16098, 2},
+	{0x1EE64, 16100, 2},
+	{0x1EE67, 16102, 2},
+	{0x1EE68, 16104, 2},
+	{0x1EE69, 16106, 2},
+	{0x1EE6A, 16108, 2},
+	{0x1EE6C, 16110, 2},
+	{0x1EE6D, 16112, 2},
+	{0x1EE6E, 16114, 2},
+	{0x1EE6F, 16116, 2},
+	{0x1EE70, 16118, 2},
+	{0x1EE71, 16120, 2},
+	{0x1EE72, 16122, 2},
+	{0x1EE74, 16124, 2},
+	{0x1EE75, 16126, 2},
+	{0x1EE76, 16128, 2},
+	{0x1EE77, 16130, 2},
+	{0x1EE79, 16132, 2},
+	{0x1EE7A, 16134, 2},
+	{0x1EE7B, 16136, 2},
+	{0x1EE7C, 16138, 2},
+	{0x1EE7E, 16140, 2},
+	{0x1EE80, 16142, 2},
+	{0x1EE81, 16144, 2},
+	{0x1EE82, 16146, 2},
+	{0x1EE83, 16148, 2},
+	{0x1EE84, 16150, 2},
+	{0x1EE85, 16152, 2},
+	{0x1EE86, 16154, 2},
+	{0x1EE87, 16156, 2},
+	{0x1EE88, 16158, 2},
+	{0x1EE89, 16160, 2},
+	{0x1EE8B, 16162, 2},
+	{0x1EE8C, 16164, 2},
+	{0x1EE8D, 16166, 2},
+	{0x1EE8E, 16168, 2},
+	{0x1EE8F, 16170, 2},
+	{0x1EE90, 16172, 2},
+	{0x1EE91, 16174, 2},
+	{0x1EE92, 16176, 2},
+	{0x1EE93, 16178, 2},
+	{0x1EE94, 16180, 2},
+	{0x1EE95, 16182, 2},
+	{0x1EE96, 16184, 2},
+	{0x1EE97, 16186, 2},
+	{0x1EE98, 16188, 2},
+	{0x1EE99, 16190, 2},
+	{0x1EE9A, 16192, 2},
+	{0x1EE9B, 16194, 2},
+	{0x1EEA1, 16196, 2},
+	{0x1EEA2, 16198, 2},
+	{0x1EEA3, 16200, 2},
+	{0x1EEA5, 16202, 2},
+	{0x1EEA6, 16204, 2},
+	{0x1EEA7, 16206, 2},
+	{0x1EEA8, 16208, 2},
+	{0x1EEA9, 16210, 2},
+	{0x1EEAB, 16212, 2},
+	{0x1EEAC, 16214, 2},
+	{0x1EEAD, 16216, 2},
+	{0x1EEAE, 16218, 2},
+	{0x1EEAF, 16220, 2},
+	{0x1EEB0, 16222, 2},
+	{0x1EEB1, 16224, 2},
+	{0x1EEB2, 16226, 2},
+	{0x1EEB3, 16228, 2},
+	{0x1EEB4, 16230, 2},
+	{0x1EEB5, 16232, 2},
+	{0x1EEB6, 16234, 2},
+	{0x1EEB7, 16236, 2},
+	{0x1EEB8, 16238, 2},
+	{0x1EEB9, 16240, 2},
+	{0x1EEBA, 16242, 2},
+	{0x1EEBB, 16244, 2},
+	{0x1F100, 16246, 2},
+	{0x1F101, 16248, 2},
+	{0x1F102, 16250, 2},
+	{0x1F103, 16252, 2},
+	{0x1F104, 16254, 2},
+	{0x1F105, 16256, 2},
+	{0x1F106, 16258, 2},
+	{0x1F107, 16260, 2},
+	{0x1F108, 16262, 2},
+	{0x1F109, 16264, 2},
+	{0x1F10A, 16266, 2},
+	{0x1F110, 16268, 3},
+	{0x1F111, 16271, 3},
+	{0x1F112, 16274, 3},
+	{0x1F113, 16277, 3},
+	{0x1F114, 16280, 3},
+	{0x1F115, 16283, 3},
+	{0x1F116, 16286, 3},
+	{0x1F117, 16289, 3},
+	{0x1F118, 16292, 3},
+	{0x1F119, 16295, 3},
+	{0x1F11A, 16298, 3},
+	{0x1F11B, 16301, 3},
+	{0x1F11C, 16304, 3},
+	{0x1F11D, 16307, 3},
+	{0x1F11E, 16310, 3},
+	{0x1F11F, 16313, 3},
+	{0x1F120, 16316, 3},
+	{0x1F121, 16319, 3},
+	{0x1F122, 16322, 3},
+	{0x1F123, 16325, 3},
+	{0x1F124, 16328, 3},
+	{0x1F125, 16331, 3},
+	{0x1F126, 16334, 3},
+	{0x1F127, 16337, 3},
+	{0x1F128, 16340, 3},
+	{0x1F129, 16343, 3},
+	{0x1F12A, 16346, 7},
+	{0x1F12B, 16353, 1},
+	{0x1F12C, 16354, 1},
+	{0x1F12D, 16355, 2},
+	{0x1F12E, 16357, 2},
+	{0x1F130, 16359, 1},
+	{0x1F131, 16360, 1},
+	{0x1F132, 16361, 1},
+	{0x1F133, 16362, 1},
+	{0x1F134, 16363, 1},
+	{0x1F135, 16364, 1},
+	{0x1F136, 16365, 1},
+	{0x1F137, 16366, 1},
+	{0x1F138, 16367, 1},
+	{0x1F139, 16368, 1},
+	{0x1F13A, 16369, 1},
+	{0x1F13B, 16370, 1},
+	{0x1F13C, 16371, 1},
+	{0x1F13D, 16372, 1},
+	{0x1F13E, 16373, 1},
+	{0x1F13F, 16374, 1},
+	{0x1F140, 16375, 1},
+	{0x1F141, 16376, 1},
+	{0x1F142, 16377, 1},
+	{0x1F143, 16378, 1},
+	{0x1F144, 16379, 1},
+	{0x1F145, 16380, 1},
+	{0x1F146, 16381, 1},
+	{0x1F147, 16382, 1},
+	{0x1F148, 16383, 1},
+	{0x1F149, 16384, 1},
+	{0x1F14A, 16385, 2},
+	{0x1F14B, 16387, 2},
+	{0x1F14C, 16389, 2},
+	{0x1F14D, 16391, 2},
+	{0x1F14E, 16393, 3},
+	{0x1F14F, 16396, 2},
+	{0x1F16A, 16398, 2},
+	{0x1F16B, 16400, 2},
+	{0x1F16C, 16402, 2},
+	{0x1F190, 16404, 2},
+	{0x1F200, 16406, 6},
+	{0x1F201, 16412, 6},
+	{0x1F202, 16418, 3},
+	{0x1F210, 16421, 3},
+	{0x1F211, 16424, 3},
+	{0x1F212, 16427, 3},
+	{0x1F213, 16430, 6},
+	{0x1F214, 16436, 3},
+	{0x1F215, 16439, 3},
+	{0x1F216, 16442, 3},
+	{0x1F217, 16445, 3},
+	{0x1F218, 16448, 3},
+	{0x1F219, 16451, 3},
+	{0x1F21A, 16454, 3},
+	{0x1F21B, 16457, 3},
+	{0x1F21C, 16460, 3},
+	{0x1F21D, 16463, 3},
+	{0x1F21E, 16466, 3},
+	{0x1F21F, 16469, 3},
+	{0x1F220, 16472, 3},
+	{0x1F221, 16475, 3},
+	{0x1F222, 16478, 3},
+	{0x1F223, 16481, 3},
+	{0x1F224, 16484, 3},
+	{0x1F225, 16487, 3},
+	{0x1F226, 16490, 3},
+	{0x1F227, 16493, 3},
+	{0x1F228, 16496, 3},
+	{0x1F229, 16499, 3},
+	{0x1F22A, 16502, 3},
+	{0x1F22B, 16505, 3},
+	{0x1F22C, 16508, 3},
+	{0x1F22D, 16511, 3},
+	{0x1F22E, 16514, 3},
+	{0x1F22F, 16517, 3},
+	{0x1F230, 16520, 3},
+	{0x1F231, 16523, 3},
+	{0x1F232, 16526, 3},
+	{0x1F233, 16529, 3},
+	{0x1F234, 16532, 3},
+	{0x1F235, 16535, 3},
+	{0x1F236, 16538, 3},
+	{0x1F237, 16541, 3},
+	{0x1F238, 16544, 3},
+	{0x1F239, 16547, 3},
+	{0x1F23A, 16550, 3},
+	{0x1F23B, 16553, 3},
+	{0x1F240, 16556, 9},
+	{0x1F241, 16565, 9},
+	{0x1F242, 16574, 9},
+	{0x1F243, 16583, 9},
+	{0x1F244, 16592, 9},
+	{0x1F245, 16601, 9},
+	{0x1F246, 16610, 9},
+	{0x1F247, 16619, 9},
+	{0x1F248, 16628, 9},
+	{0x1F250, 16637, 3},
+	{0x1F251, 16640, 3},
+	{0x1FBF0, 16643, 1},
+	{0x1FBF1, 16644, 1},
+	{0x1FBF2, 16645, 1},
+	{0x1FBF3, 16646, 1},
+	{0x1FBF4, 16647, 1},
+	{0x1FBF5, 16648, 1},
+	{0x1FBF6, 16649, 1},
+	{0x1FBF7, 16650, 1},
+	{0x1FBF8, 16651, 1},
+	{0x1FBF9, 16652, 1},
+	{0x2F800, 16653, 3},
+	{0x2F801, 16656, 3},
+	{0x2F802, 16659, 3},
+	{0x2F803, 16662, 4},
+	{0x2F804, 16666, 3},
+	{0x2F805, 16669, 3},
+	{0x2F806, 16672, 3},
+	{0x2F807, 16675, 3},
+	{0x2F808, 16678, 3},
+	{0x2F809, 16681, 3},
+	{0x2F80A, 16684, 3},
+	{0x2F80B, 16687, 3},
+	{0x2F80C, 16690, 3},
+	{0x2F80D, 16693, 4},
+	{0x2F80E, 16697, 3},
+	{0x2F80F, 16700, 3},
+	{0x2F810, 16703, 3},
+	{0x2F811, 16706, 3},
+	{0x2F812, 16709, 4},
+	{0x2F813, 16713, 3},
+	{0x2F814, 16716, 3},
+	{0x2F815, 16719, 3},
+	{0x2F816, 16722, 4},
+	{0x2F817, 16726, 3},
+	{0x2F818, 16729, 3},
+	{0x2F819, 16732, 3},
+	{0x2F81A, 16735, 3},
+	{0x2F81B, 16738, 3},
+	{0x2F81C, 16741, 4},
+	{0x2F81D, 16745, 3},
+	{0x2F81E, 16748, 3},
+	{0x2F81F, 16751, 3},
+	{0x2F820, 16754, 3},
+	{0x2F821, 16757, 3},
+	{0x2F822, 16760, 3},
+	{0x2F823, 16763, 3},
+	{0x2F824, 16766, 3},
+	{0x2F825, 16769, 3},
+	{0x2F826, 16772, 3},
+	{0x2F827, 16775, 3},
+	{0x2F828, 16778, 3},
+	{0x2F829, 16781, 3},
+	{0x2F82A, 16784, 3},
+	{0x2F82B, 16787, 3},
+	{0x2F82C, 16790, 3},
+	{0x2F82D, 16793, 3},
+	{0x2F82E, 16796, 3},
+	{0x2F82F, 16799, 3},
+	{0x2F830, 16802, 3},
+	{0x2F831, 16805, 3},
+	{0x2F832, 16808, 3},
+	{0x2F833, 16811, 3},
+	{0x2F834, 16814, 4},
+	{0x2F835, 16818, 3},
+	{0x2F836, 16821, 3},
+	{0x2F837, 16824, 3},
+	{0x2F838, 16827, 4},
+	{0x2F839, 16831, 3},
+	{0x2F83A, 16834, 3},
+	{0x2F83B, 16837, 3},
+	{0x2F83C, 16840, 3},
+	{0x2F83D, 16843, 3},
+	{0x2F83E, 16846, 3},
+	{0x2F83F, 16849, 3},
+	{0x2F840, 16852, 3},
+	{0x2F841, 16855, 3},
+	{0x2F842, 16858, 3},
+	{0x2F843, 16861, 3},
+	{0x2F844, 16864, 3},
+	{0x2F845, 16867, 3},
+	{0x2F846, 16870, 3},
+	{0x2F847, 16873, 3},
+	{0x2F848, 16876, 3},
+	{0x2F849, 16879, 3},
+	{0x2F84A, 16882, 3},
+	{0x2F84B, 16885, 3},
+	{0x2F84C, 16888, 3},
+	{0x2F84D, 16891, 3},
+	{0x2F84E, 16894, 3},
+	{0x2F84F, 16897, 3},
+	{0x2F850, 16900, 3},
+	{0x2F851, 16903, 3},
+	{0x2F852, 16906, 3},
+	{0x2F853, 16909, 3},
+	{0x2F854, 16912, 3},
+	{0x2F855, 16915, 3},
+	{0x2F856, 16918, 3},
+	{0x2F857, 16921, 3},
+	{0x2F858, 16924, 3},
+	{0x2F859, 16927, 4},
+	{0x2F85A, 16931, 3},
+	{0x2F85B, 16934, 3},
+	{0x2F85C, 16937, 3},
+	{0x2F85D, 16940, 3},
+	{0x2F85E, 16943, 3},
+	{0x2F85F, 16946, 3},
+	{0x2F860, 16949, 4},
+	{0x2F861, 16953, 4},
+	{0x2F862, 16957, 3},
+	{0x2F863, 16960, 3},
+	{0x2F864, 16963, 3},
+	{0x2F865, 16966, 3},
+	{0x2F866, 16969, 3},
+	{0x2F867, 16972, 3},
+	{0x2F868, 16975, 3},
+	{0x2F869, 16978, 3},
+	{0x2F86A, 16981, 3},
+	{0x2F86B, 16984, 3},
+	{0x2F86C, 16987, 4},
+	{0x2F86D, 16991, 3},
+	{0x2F86E, 16994, 3},
+	{0x2F86F, 16997, 3},
+	{0x2F870, 17000, 3},
+	{0x2F871, 17003, 4},
+	{0x2F872, 17007, 3},
+	{0x2F873, 17010, 3},
+	{0x2F874, 17013, 3},
+	{0x2F875, 17016, 3},
+	{0x2F876, 17019, 3},
+	{0x2F877, 17022, 3},
+	{0x2F878, 17025, 3},
+	{0x2F879, 17028, 3},
+	{0x2F87A, 17031, 3},
+	{0x2F87B, 17034, 4},
+	{0x2F87C, 17038, 3},
+	{0x2F87D, 17041, 4},
+	{0x2F87E, 17045, 3},
+	{0x2F87F, 17048, 3},
+	{0x2F880, 17051, 3},
+	{0x2F881, 17054, 3},
+	{0x2F882, 17057, 3},
+	{0x2F883, 17060, 3},
+	{0x2F884, 17063, 3},
+	{0x2F885, 17066, 3},
+	{0x2F886, 17069, 3},
+	{0x2F887, 17072, 3},
+	{0x2F888, 17075, 3},
+	{0x2F889, 17078, 4},
+	{0x2F88A, 17082, 3},
+	{0x2F88B, 17085, 3},
+	{0x2F88C, 17088, 3},
+	{0x2F88D, 17091, 3},
+	{0x2F88E, 17094, 3},
+	{0x2F88F, 17097, 4},
+	{0x2F890, 17101, 3},
+	{0x2F891, 17104, 4},
+	{0x2F892, 17108, 4},
+	{0x2F893, 17112, 3},
+	{0x2F894, 17115, 3},
+	{0x2F895, 17118, 3},
+	{0x2F896, 17121, 3},
+	{0x2F897, 17124, 4},
+	{0x2F898, 17128, 4},
+	{0x2F899, 17132, 3},
+	{0x2F89A, 17135, 3},
+	{0x2F89B, 17138, 3},
+	{0x2F89C, 17141, 3},
+	{0x2F89D, 17144, 3},
+	{0x2F89E, 17147, 3},
+	{0x2F89F, 17150, 3},
+	{0x2F8A0, 17153, 3},
+	{0x2F8A1, 17156, 3},
+	{0x2F8A2, 17159, 3},
+	{0x2F8A3, 17162, 3},
+	{0x2F8A4, 17165, 4},
+	{0x2F8A5, 17169, 3},
+	{0x2F8A6, 17172, 3},
+	{0x2F8A7, 17175, 3},
+	{0x2F8A8, 17178, 3},
+	{0x2F8A9, 17181, 3},
+	{0x2F8AA, 17184, 3},
+	{0x2F8AB, 17187, 3},
+	{0x2F8AC, 17190, 3},
+	{0x2F8AD, 17193, 3},
+	{0x2F8AE, 17196, 3},
+	{0x2F8AF, 17199, 3},
+	{0x2F8B0, 17202, 3},
+	{0x2F8B1, 17205, 3},
+	{0x2F8B2, 17208, 3},
+	{0x2F8B3, 17211, 3},
+	{0x2F8B4, 17214, 3},
+	{0x2F8B5, 17217, 3},
+	{0x2F8B6, 17220, 3},
+	{0x2F8B7, 17223, 3},
+	{0x2F8B8, 17226, 4},
+	{0x2F8B9, 17230, 3},
+	{0x2F8BA, 17233, 3},
+	{0x2F8BB, 17236, 3},
+	{0x2F8BC, 17239, 3},
+	{0x2F8BD, 17242, 3},
+	{0x2F8BE, 17245, 4},
+	{0x2F8BF, 17249, 3},
+	{0x2F8C0, 17252, 3},
+	{0x2F8C1, 17255, 3},
+	{0x2F8C2, 17258, 3},
+	{0x2F8C3, 17261, 3},
+	{0x2F8C4, 17264, 3},
+	{0x2F8C5, 17267, 3},
+	{0x2F8C6, 17270, 3},
+	{0x2F8C7, 17273, 3},
+	{0x2F8C8, 17276, 3},
+	{0x2F8C9, 17279, 3},
+	{0x2F8CA, 17282, 4},
+	{0x2F8CB, 17286, 3},
+	{0x2F8CC, 17289, 3},
+	{0x2F8CD, 17292, 3},
+	{0x2F8CE, 17295, 3},
+	{0x2F8CF, 17298, 3},
+	{0x2F8D0, 17301, 3},
+	{0x2F8D1, 17304, 3},
+	{0x2F8D2, 17307, 3},
+	{0x2F8D3, 17310, 3},
+	{0x2F8D4, 17313, 3},
+	{0x2F8D5, 17316, 3},
+	{0x2F8D6, 17319, 3},
+	{0x2F8D7, 17322, 3},
+	{0x2F8D8, 17325, 3},
+	{0x2F8D9, 17328, 3},
+	{0x2F8DA, 17331, 3},
+	{0x2F8DB, 17334, 3},
+	{0x2F8DC, 17337, 3},
+	{0x2F8DD, 17340, 4},
+	{0x2F8DE, 17344, 3},
+	{0x2F8DF, 17347, 3},
+	{0x2F8E0, 17350, 3},
+	{0x2F8E1, 17353, 3},
+	{0x2F8E2, 17356, 3},
+	{0x2F8E3, 17359, 4},
+	{0x2F8E4, 17363, 3},
+	{0x2F8E5, 17366, 3},
+	{0x2F8E6, 17369, 3},
+	{0x2F8E7, 17372, 3},
+	{0x2F8E8, 17375, 3},
+	{0x2F8E9, 17378, 3},
+	{0x2F8EA, 17381, 3},
+	{0x2F8EB, 17384, 3},
+	{0x2F8EC, 17387, 4},
+	{0x2F8ED, 17391, 3},
+	{0x2F8EE, 17394, 3},
+	{0x2F8EF, 17397, 3},
+	{0x2F8F0, 17400, 4},
+	{0x2F8F1, 17404, 3},
+	{0x2F8F2, 17407, 3},
+	{0x2F8F3, 17410, 3},
+	{0x2F8F4, 17413, 3},
+	{0x2F8F5, 17416, 3},
+	{0x2F8F6, 17419, 3},
+	{0x2F8F7, 17422, 4},
+	{0x2F8F8, 17426, 4},
+	{0x2F8F9, 17430, 4},
+	{0x2F8FA, 17434, 3},
+	{0x2F8FB, 17437, 4},
+	{0x2F8FC, 17441, 3},
+	{0x2F8FD, 17444, 3},
+	{0x2F8FE, 17447, 3},
+	{0x2F8FF, 17450, 3},
+	{0x2F900, 17453, 3},
+	{0x2F901, 17456, 3},
+	{0x2F902, 17459, 3},
+	{0x2F903, 17462, 3},
+	{0x2F904, 17465, 3},
+	{0x2F905, 17468, 3},
+	{0x2F906, 17471, 4},
+	{0x2F907, 17475, 3},
+	{0x2F908, 17478, 3},
+	{0x2F909, 17481, 3},
+	{0x2F90A, 17484, 3},
+	{0x2F90B, 17487, 3},
+	{0x2F90C, 17490, 3},
+	{0x2F90D, 17493, 4},
+	{0x2F90E, 17497, 3},
+	{0x2F90F, 17500, 3},
+	{0x2F910, 17503, 4},
+	{0x2F911, 17507, 4},
+	{0x2F912, 17511, 3},
+	{0x2F913, 17514, 3},
+	{0x2F914, 17517, 3},
+	{0x2F915, 17520, 3},
+	{0x2F916, 17523, 3},
+	{0x2F917, 17526, 3},
+	{0x2F918, 17529, 3},
+	{0x2F919, 17532, 3},
+	{0x2F91A, 17535, 3},
+	{0x2F91B, 17538, 4},
+	{0x2F91C, 17542, 3},
+	{0x2F91D, 17545, 4},
+	{0x2F91E, 17549, 3},
+	{0x2F91F, 17552, 4},
+	{0x2F920, 17556, 3},
+	{0x2F921, 17559, 3},
+	{0x2F922, 17562, 3},
+	{0x2F923, 17565, 4},
+	{0x2F924, 17569, 3},
+	{0x2F925, 17572, 3},
+	{0x2F926, 17575, 4},
+	{0x2F927, 17579, 4},
+	{0x2F928, 17583, 3},
+	{0x2F929, 17586, 3},
+	{0x2F92A, 17589, 3},
+	{0x2F92B, 17592, 3},
+	{0x2F92C, 17595, 3},
+	{0x2F92D, 17598, 3},
+	{0x2F92E, 17601, 3},
+	{0x2F92F, 17604, 3},
+	{0x2F930, 17607, 3},
+	{0x2F931, 17610, 3},
+	{0x2F932, 17613, 3},
+	{0x2F933, 17616, 3},
+	{0x2F934, 17619, 3},
+	{0x2F935, 17622, 4},
+	{0x2F936, 17626, 3},
+	{0x2F937, 17629, 4},
+	{0x2F938, 17633, 3},
+	{0x2F939, 17636, 4},
+	{0x2F93A, 17640, 3},
+	{0x2F93B, 17643, 4},
+	{0x2F93C, 17647, 4},
+	{0x2F93D, 17651, 4},
+	{0x2F93E, 17655, 3},
+	{0x2F93F, 17658, 3},
+	{0x2F940, 17661, 3},
+	{0x2F941, 17664, 4},
+	{0x2F942, 17668, 4},
+	{0x2F943, 17672, 4},
+	{0x2F944, 17676, 4},
+	{0x2F945, 17680, 3},
+	{0x2F946, 17683, 3},
+	{0x2F947, 17686, 3},
+	{0x2F948, 17689, 3},
+	{0x2F949, 17692, 3},
+	{0x2F94A, 17695, 3},
+	{0x2F94B, 17698, 3},
+	{0x2F94C, 17701, 3},
+	{0x2F94D, 17704, 4},
+	{0x2F94E, 17708, 3},
+	{0x2F94F, 17711, 3},
+	{0x2F950, 17714, 3},
+	{0x2F951, 17717, 3},
+	{0x2F952, 17720, 4},
+	{0x2F953, 17724, 3},
+	{0x2F954, 17727, 4},
+	{0x2F955, 17731, 4},
+	{0x2F956, 17735, 3},
+	{0x2F957, 17738, 3},
+	{0x2F958, 17741, 3},
+	{0x2F959, 17744, 3},
+	{0x2F95A, 17747, 3},
+	{0x2F95B, 17750, 3},
+	{0x2F95C, 17753, 4},
+	{0x2F95D, 17757, 4},
+	{0x2F95E, 17761, 4},
+	{0x2F95F, 17765, 3},
+	{0x2F960, 17768, 3},
+	{0x2F961, 17771, 4},
+	{0x2F962, 17775, 3},
+	{0x2F963, 17778, 3},
+	{0x2F964, 17781, 3},
+	{0x2F965, 17784, 4},
+	{0x2F966, 17788, 3},
+	{0x2F967, 17791, 3},
+	{0x2F968, 17794, 3},
+	{0x2F969, 17797, 3},
+	{0x2F96A, 17800, 3},
+	{0x2F96B, 17803, 4},
+	{0x2F96C, 17807, 3},
+	{0x2F96D, 17810, 3},
+	{0x2F96E, 17813, 3},
+	{0x2F96F, 17816, 3},
+	{0x2F970, 17819, 3},
+	{0x2F971, 17822, 3},
+	{0x2F972, 17825, 4},
+	{0x2F973, 17829, 4},
+	{0x2F974, 17833, 3},
+	{0x2F975, 17836, 4},
+	{0x2F976, 17840, 3},
+	{0x2F977, 17843, 4},
+	{0x2F978, 17847, 3},
+	{0x2F979, 17850, 3},
+	{0x2F97A, 17853, 3},
+	{0x2F97B, 17856, 4},
+	{0x2F97C, 17860, 4},
+	{0x2F97D, 17864, 3},
+	{0x2F97E, 17867, 4},
+	{0x2F97F, 17871, 3},
+	{0x2F980, 17874, 4},
+	{0x2F981, 17878, 3},
+	{0x2F982, 17881, 3},
+	{0x2F983, 17884, 3},
+	{0x2F984, 17887, 3},
+	{0x2F985, 17890, 3},
+	{0x2F986, 17893, 3},
+	{0x2F987, 17896, 4},
+	{0x2F988, 17900, 4},
+	{0x2F989, 17904, 4},
+	{0x2F98A, 17908, 4},
+	{0x2F98B, 17912, 3},
+	{0x2F98C, 17915, 3},
+	{0x2F98D, 17918, 3},
+	{0x2F98E, 17921, 3},
+	{0x2F98F, 17924, 3},
+	{0x2F990, 17927, 3},
+	{0x2F991, 17930, 3},
+	{0x2F992, 17933, 3},
+	{0x2F993, 17936, 3},
+	{0x2F994, 17939, 3},
+	{0x2F995, 17942, 3},
+	{0x2F996, 17945, 3},
+	{0x2F997, 17948, 4},
+	{0x2F998, 17952, 3},
+	{0x2F999, 17955, 3},
+	{0x2F99A, 17958, 3},
+	{0x2F99B, 17961, 3},
+	{0x2F99C, 17964, 3},
+	{0x2F99D, 17967, 3},
+	{0x2F99E, 17970, 3},
+	{0x2F99F, 17973, 3},
+	{0x2F9A0, 17976, 3},
+	{0x2F9A1, 17979, 3},
+	{0x2F9A2, 17982, 3},
+	{0x2F9A3, 17985, 3},
+	{0x2F9A4, 17988, 4},
+	{0x2F9A5, 17992, 4},
+	{0x2F9A6, 17996, 4},
+	{0x2F9A7, 18000, 3},
+	{0x2F9A8, 18003, 3},
+	{0x2F9A9, 18006, 3},
+	{0x2F9AA, 18009, 3},
+	{0x2F9AB, 18012, 4},
+	{0x2F9AC, 18016, 3},
+	{0x2F9AD, 18019, 4},
+	{0x2F9AE, 18023, 3},
+	{0x2F9AF, 18026, 3},
+	{0x2F9B0, 18029, 4},
+	{0x2F9B1, 18033, 4},
+	{0x2F9B2, 18037, 3},
+	{0x2F9B3, 18040, 3},
+	{0x2F9B4, 18043, 3},
+	{0x2F9B5, 18046, 3},
+	{0x2F9B6, 18049, 3},
+	{0x2F9B7, 18052, 3},
+	{0x2F9B8, 18055, 3},
+	{0x2F9B9, 18058, 3},
+	{0x2F9BA, 18061, 3},
+	{0x2F9BB, 18064, 3},
+	{0x2F9BC, 18067, 3},
+	{0x2F9BD, 18070, 3},
+	{0x2F9BE, 18073, 3},
+	{0x2F9BF, 18076, 3},
+	{0x2F9C0, 18079, 3},
+	{0x2F9C1, 18082, 3},
+	{0x2F9C2, 18085, 3},
+	{0x2F9C3, 18088, 3},
+	{0x2F9C4, 18091, 3},
+	{0x2F9C5, 18094, 4},
+	{0x2F9C6, 18098, 3},
+	{0x2F9C7, 18101, 3},
+	{0x2F9C8, 18104, 3},
+	{0x2F9C9, 18107, 3},
+	{0x2F9CA, 18110, 3},
+	{0x2F9CB, 18113, 4},
+	{0x2F9CC, 18117, 4},
+	{0x2F9CD, 18121, 3},
+	{0x2F9CE, 18124, 3},
+	{0x2F9CF, 18127, 3},
+	{0x2F9D0, 18130, 3},
+	{0x2F9D1, 18133, 3},
+	{0x2F9D2, 18136, 3},
+	{0x2F9D3, 18139, 4},
+	{0x2F9D4, 18143, 3},
+	{0x2F9D5, 18146, 3},
+	{0x2F9D6, 18149, 3},
+	{0x2F9D7, 18152, 3},
+	{0x2F9D8, 18155, 4},
+	{0x2F9D9, 18159, 4},
+	{0x2F9DA, 18163, 3},
+	{0x2F9DB, 18166, 3},
+	{0x2F9DC, 18169, 3},
+	{0x2F9DD, 18172, 4},
+	{0x2F9DE, 18176, 3},
+	{0x2F9DF, 18179, 3},
+	{0x2F9E0, 18182, 4},
+	{0x2F9E1, 18186, 4},
+	{0x2F9E2, 18190, 3},
+	{0x2F9E3, 18193, 3},
+	{0x2F9E4, 18196, 3},
+	{0x2F9E5, 18199, 4},
+	{0x2F9E6, 18203, 3},
+	{0x2F9E7, 18206, 3},
+	{0x2F9E8, 18209, 3},
+	{0x2F9E9, 18212, 3},
+	{0x2F9EA, 18215, 3},
+	{0x2F9EB, 18218, 3},
+	{0x2F9EC, 18221, 3},
+	{0x2F9ED, 18224, 4},
+	{0x2F9EE, 18228, 3},
+	{0x2F9EF, 18231, 3},
+	{0x2F9F0, 18234, 3},
+	{0x2F9F1, 18237, 4},
+	{0x2F9F2, 18241, 3},
+	{0x2F9F3, 18244, 3},
+	{0x2F9F4, 18247, 3},
+	{0x2F9F5, 18250, 3},
+	{0x2F9F6, 18253, 4},
+	{0x2F9F7, 18257, 4},
+	{0x2F9F8, 18261, 3},
+	{0x2F9F9, 18264, 3},
+	{0x2F9FA, 18267, 3},
+	{0x2F9FB, 18270, 4},
+	{0x2F9FC, 18274, 3},
+	{0x2F9FD, 18277, 4},
+	{0x2F9FE, 18281, 3},
+	{0x2F9FF, 18284, 3},
+	{0x2FA00, 18287, 3},
+	{0x2FA01, 18290, 4},
+	{0x2FA02, 18294, 3},
+	{0x2FA03, 18297, 3},
+	{0x2FA04, 18300, 3},
+	{0x2FA05, 18303, 3},
+	{0x2FA06, 18306, 3},
+	{0x2FA07, 18309, 3},
+	{0x2FA08, 18312, 3},
+	{0x2FA09, 18315, 4},
+	{0x2FA0A, 18319, 3},
+	{0x2FA0B, 18322, 3},
+	{0x2FA0C, 18325, 3},
+	{0x2FA0D, 18328, 3},
+	{0x2FA0E, 18331, 3},
+	{0x2FA0F, 18334, 3},
+	{0x2FA10, 18337, 4},
+	{0x2FA11, 18341, 3},
+	{0x2FA12, 18344, 4},
+	{0x2FA13, 18348, 4},
+	{0x2FA14, 18352, 4},
+	{0x2FA15, 18356, 3},
+	{0x2FA16, 18359, 3},
+	{0x2FA17, 18362, 3},
+	{0x2FA18, 18365, 3},
+	{0x2FA19, 18368, 3},
+	{0x2FA1A, 18371, 3},
+	{0x2FA1B, 18374, 3},
+	{0x2FA1C, 18377, 3},
+	{0x2FA1D, 18380, 4},
+}
+
+const nfkdData = "" +
+	"  ̈a ̄23 ́μ ̧1o1⁄41⁄23⁄4ÀÁÂÃA" +
+	"̈ÅÇÈÉÊËÌÍÎÏÑÒÓÔÕO" +
+	"̈ÙÚÛÜÝàáâãäåçèéêe" +
+	"̈ìíîïñòóôõöùúûüýy" +
+	"̈ĀāĂăĄąĆćĈĉĊċČčĎd" +
+	"̌ĒēĔĕĖėĘęĚěĜĝĞğĠg" +
+	"̇ĢģĤĥĨĩĪīĬĭĮįİIJijĴ" +
+	"ĵĶķĹĺĻļĽľL·l·ŃńŅņŇ" +
+	"ňʼnŌōŎŏŐőŔŕŖŗŘřŚś" +
+	"ŜŝŞşŠšŢţŤťŨũŪūŬŭ" +
+	"ŮůŰűŲųŴŵŶŷŸŹźŻżŽ" +
+	"žsƠơƯưDŽDždžLJLjljNJNjnjǍǎǏ" +
+	"ǐǑǒǓǔǕǖǗǘǙǚÜ" +
+	"̀ǜǞǟǠǡǢǣǦǧǨǩO" +
+	"̨ǫǬǭǮǯǰDZDzdzǴǵǸǹǺ" +
+	"ǻǼǽǾǿȀȁȂȃȄȅȆȇȈ" +
+	"ȉȊȋȌȍȎȏȐȑȒȓȔȕȖȗȘ" +
+	"șȚțȞȟȦȧȨȩȪȫȬȭO" +
+	"̇ȯȰȱȲȳhɦjrɹɻʁwy ̆ ̇ ̊ ̨ ̃" +
+	" ̋ɣlsxʕ̀́̓̈́ʹ ͅ; ́ ̈́Ά·ΈΉ" +
+	"ΊΌΎΏΐΪΫάέήίυ" +
+	"̈́ϊϋόύώβθΥΎΫφπκρς" +
+	"ΘεΣЀЁЃЇЌЍЎЙйѐе" +
+	"̈ѓїќѝўѶѷӁӂӐӑА" +
+	"̈ӓӖӗӚӛӜӝӞӟӢӣИ" +
+	"̈ӥӦӧӪӫӬӭӮӯӰӱУ" +
+	"̋ӳӴӵӸӹեւآأؤإئا" +
+	"ٴوٴۇٴيٴۀۂۓऩऱऴक़" +
+	"ख़ग़ज़ड़ढ़फ़य़ো" +
+	"ৌড়ঢ়য়ਲ਼ਸ਼ਖ਼ਗ਼" +
+	"ਜ਼ਫ਼ୈୋୌଡ଼ଢ଼ஔ" +
+	"ொோௌైೀೇೈೊ" +
+	"ೋൊോൌේොෝ" +
+	"ෞําໍາຫນຫມ་གྷཌྷད" +
+	"ྷབྷཛྷཀྵཱཱིུྲྀྲཱྀ" +
+	"ླྀླཱཱྀྀྒྷྜྷྡྷྦྷྫ" +
+	"ྷྐྵဦნᬆᬈᬊᬌᬎ" +
+	"ᬒᬻᬽᭀᭁᭃAÆBDEƎGHIJ" +
+	"KLMNOȢPRTUWaɐɑᴂbdeəɛɜgkmŋoɔᴖᴗptuᴝ" +
+	"ɯvᴥβγδφχiruvβγρφχнɒcɕðɜfɟɡɥ" +
+	"ɨɩɪᵻʝɭᶅʟɱɰɲɳɴɵɸʂʃƫʉʊᴜʋʌ" +
+	"zʐʑʒθḀḁḂḃḄḅḆḇḈḉḊḋ" +
+	"ḌḍḎḏḐḑḒḓḔḕḖḗḘe" +
+	"̭ḚḛḜḝḞḟḠḡḢḣḤḥḦḧ" +
+	"ḨḩḪḫḬḭḮḯḰḱḲḳḴḵḶ" +
+	"ḷḸḹḺḻḼḽḾḿṀṁṂṃṄṅ" +
+	"ṆṇṈṉṊṋṌṍṎṏṐṑ" +
+	"ṒṓṔṕṖṗṘṙṚṛṜṝṞr" +
+	"̱ṠṡṢṣṤṥṦṧṨṩṪt" +
+	"̇ṬṭṮṯṰṱṲṳṴṵṶṷṸṹ" +
+	"ṺṻṼṽṾṿẀẁẂẃẄẅẆẇẈ" +
+	"ẉẊẋẌẍẎẏẐẑẒẓẔẕẖẗẘ" +
+	"ẙaʾṡẠạẢảẤấẦầẨâ" +
+	"̉ẪẫẬậẮắẰằẲa" +
+	"̆̉ẴẵẶặẸẹẺẻẼẽẾe" +
+	"̂́ỀềỂểỄễỆệỈi" +
+	"̉ỊịỌọỎỏỐốỒồỔô" +
+	"̉ỖỗỘộỚớỜờỞo" +
+	"̛̉ỠỡỢợỤụỦủỨứƯ" +
+	"̀ừỬửỮữỰựỲỳỴỵ" +
+	"ỶỷỸỹἀἁἂἃἄἅἀ" +
+	"͂ἇἈἉἊἋἌἍἎΑ" +
+	"̔͂ἐἑἒἓἔἕἘἙἘ" +
+	"̀ἛἜἝἠἡἢἣἤη" +
+	"̔́ἦἧἨἩἪἫἬἭ" +
+	"ἮἯἰἱἲἳἴἵἰ" +
+	"͂ἷἸἹἺἻἼἽἾΙ" +
+	"̔͂ὀὁὂὃὄὅὈὉὈ" +
+	"̀ὋὌὍὐὑὒὓὔυ" +
+	"̔́ὖὗὙὛὝὟὠὡω" +
+	"̓̀ὣὤὥὦὧὨὩὪ" +
+	"ὫὬὭὮὯὰάὲέη" +
+	"̀ήὶίὸόὺύὼώᾀἁ" +
+	"ͅᾂᾃᾄᾅᾆἇ" +
+	"ͅᾈᾉᾊᾋᾌᾍΑ" +
+	"̓͂ͅᾏᾐᾑᾒᾓἤ" +
+	"ͅᾕᾖᾗᾘᾙᾚΗ" +
+	"̔̀ͅᾜᾝᾞᾟᾠὡ" +
+	"ͅᾢᾣᾤᾥᾦὧ" +
+	"ͅᾨᾩᾪᾫᾬᾭΩ" +
+	"̓͂ͅᾯᾰᾱᾲᾳᾴᾶᾷ" +
+	"ᾸᾹᾺΆᾼ ̓ι ̓ ͂ ̈͂ῂῃη" +
+	"́ͅῆῇῈΈῊΉῌ ̓̀ ̓́ ̓͂" +
+	"ῐῑῒΐῖῗῘῙῚΊ ̔" +
+	"̀ ̔́ ̔͂ῠῡῢΰῤῥῦϋ" +
+	"͂ῨῩῪΎῬ ̈̀ ̈́`ῲῳῴ" +
+	"ῶῷῸΌῺΏῼ ́ ̔           ‐" +
+	" ̳...... ′′′′′‵‵‵‵‵!! ̅???" +
+	"!!?′′′′ 0i456789+−=()n0123456789+−=(" +
+	")aeoxəhklmnpstRsa/ca/sC°Cc/oc/uƐ°FgHHHhħIIL" +
+	"lNNoPQRRRSMTELTMZΩZKÅBCeEFMoאבגדiFAXπγΓ" +
+	"Π∑Ddeij1⁄71⁄91⁄101⁄32⁄31⁄52⁄53⁄" +
+	"54⁄51⁄65⁄61⁄83⁄85⁄87⁄81⁄IIIIIIIV" +
+	"VVIVIIVIIIIXXXIXIILCDMiiiiiiivvviviiviiiixxxixii" +
+	"lcdm0⁄3↚↛↮⇍⇎⇏∄∉" +
+	"∌∤∦∫∫∫∫∫∮∮∮∮∮∼" +
+	"̸≄≇≉≠≢≭≮≯≰≱≲" +
+	"̸≵≸≹⊀⊁⊄⊅⊈⊉⊢" +
+	"̸⊭⊮⊯⋠⋡⋢⋣⋪⋫⊴" +
+	"̸⋭〈〉1234567891011121314151617181920(1)(" +
+	"2)(3)(4)(5)(6)(7)(8)(9)(10)(11)(12)(13)(14)(15)(" +
+	"16)(17)(18)(19)(20)1.2.3.4.5.6.7.8.9.10.11.12.13" +
+	".14.15.16.17.18.19.20.(a)(b)(c)(d)(e)(f)(g)(h)(i" +
+	")(j)(k)(l)(m)(n)(o)(p)(q)(r)(s)(t)(u)(v)(w)(x)(y" +
+	")(z)ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqr" +
+	"stuvwxyz0∫∫∫∫::======⫝̸jVⵡ母龟一" +
+	"丨丶丿乙亅二亠人儿入八冂冖冫几凵" +
+	"刀力勹匕匚匸十卜卩厂厶又口囗土士" +
+	"夂夊夕大女子宀寸小尢尸屮山巛工己" +
+	"巾干幺广廴廾弋弓彐彡彳心戈戶手支" +
+	"攴文斗斤方无日曰月木欠止歹殳毋比" +
+	"毛氏气水火爪父爻爿片牙牛犬玄玉瓜" +
+	"瓦甘生用田疋疒癶白皮皿目矛矢石示" +
+	"禸禾穴立竹米糸缶网羊羽老而耒耳聿" +
+	"肉臣自至臼舌舛舟艮色艸虍虫血行衣" +
+	"襾見角言谷豆豕豸貝赤走足身車辛辰" +
+	"辵邑酉釆里金長門阜隶隹雨靑非面革" +
+	"韋韭音頁風飛食首香馬骨高髟鬥鬯鬲" +
+	"鬼魚鳥鹵鹿麥麻黃黍黑黹黽鼎鼓鼠鼻" +
+	"齊齒龍龜龠 〒十卄卅がぎぐけ" +
+	"゙ござじずぜぞだち" +
+	"゙づでどばぱびぴふ" +
+	"゙ぷべぺぼぽゔ ゙ ゚ゝ" +
+	"゙よりガギグゲゴザシ" +
+	"゙ズゼゾダヂヅデト" +
+	"゙バパビピブプベヘ" +
+	"゚ボポヴヷヸヹヺヽ" +
+	"゙コトᄀᄁᆪᄂᆬᆭᄃᄄᄅᆰᆱᆲᆳ" +
+	"ᆴᆵᄚᄆᄇᄈᄡᄉᄊᄋᄌᄍᄎᄏᄐᄑ" +
+	"하ᅢᅣᅤᅥᅦᅧᅨᅩᅪᅫᅬᅭᅮᅯ" +
+	"ᅰᅱᅲᅳᅴᅵᅠᄔᄕᇇᇈᇌᇎᇓᇗᇙ" +
+	"ᄜᇝᇟᄝᄞᄠᄢᄣᄧᄩᄫᄬᄭᄮᄯᄲ" +
+	"ᄶᅀᅇᅌᇱᇲᅗᅘᅙᆄᆅᆈᆑᆒᆔᆞ" +
+	"ᆡ一二三四上中下甲乙丙丁天地人(ᄀ" +
+	")(ᄂ)(ᄃ)(ᄅ)(ᄆ)(ᄇ)(ᄉ)(ᄋ)(ᄌ)(ᄎ)(ᄏ" +
+	")(ᄐ)(ᄑ)(ᄒ)(가)(나)(다)(라)" +
+	"(마)(바)(사)(아)(자)(차)" +
+	"(카)(타)(파)(하)(주)(오ᄌ" +
+	"ᅥᆫ)(오후)(一)(二)(三)(四)(五)(六" +
+	")(七)(八)(九)(十)(月)(火)(水)(木)(金)(土" +
+	")(日)(株)(有)(社)(名)(特)(財)(祝)(労)(代" +
+	")(呼)(学)(監)(企)(資)(協)(祭)(休)(自)(至" +
+	")問幼文箏PTE212223242526272829303132333435ᄀ" +
+	"ᄂᄃᄅᄆᄇᄉᄋᄌᄎᄏᄐᄑᄒ가ᄂ" +
+	"ᅡ다라마바사아자ᄎ" +
+	"ᅡ카타파하참고주" +
+	"의우一二三四五六七八九十月火" +
+	"水木金土日株有社名特財祝労秘男女" +
+	"適優印注項休写正上中下左右医宗学" +
+	"監企資協夜3637383940414243444546474849501月" +
+	"2月3月4月5月6月7月8月9月10月11月12月H" +
+	"gergeVLTDアイウエオカキクケコサシス" +
+	"セソタチツテトナニヌネノハヒフヘ" +
+	"ホマミムメモヤユヨラリルレロワヰ" +
+	"ヱヲ令和アパートアルファアンヘ" +
+	"゚アアールイニングインチウォン" +
+	"エスクードエーカーオンスオーム" +
+	"カイリカラットカロリーガロンカ" +
+	"゙ンマギガギニーキュリーキ" +
+	"゙ルダーキロキログラムキロメ" +
+	"ートルキロワットグラムグラム" +
+	"トンクルゼイロクローネケースコ" +
+	"ルナコーポサイクルサンチームシ" +
+	"リングセンチセントダースデ" +
+	"シドルトンナノノットハイツパ" +
+	"ーセントパーツバーレルピア" +
+	"ストルピクルピコビルファラ" +
+	"ッドフィートブッシェルフラン" +
+	"ヘクタールペソペニヒヘルツヘ" +
+	"゚ンスページベータポイン" +
+	"トボルトホンポンドホールホ" +
+	"ーンマイクロマイルマッハマルクマ" +
+	"ンションミクロンミリミリバール" +
+	"メガメガトンメートルヤード" +
+	"ヤールユアンリットルリラルピー" +
+	"ルーブルレムレントゲンワット" +
+	"0点1点2点3点4点5点6点7点8点9点10点11点" +
+	"12点13点14点15点16点17点18点19点20点21点" +
+	"22点23点24点hPadaAUbaroVpcdmdm2dm3IU平成昭" +
+	"和大正明治株式会社pAnAμAmAkAKBMBGBcalk" +
+	"calpFnFμFμgmgkgHzkHzMHzGHzTHzμlmldlklfmnmμmm" +
+	"mcmkmmm2cm2m2km2mm3cm3m3km3m∕sm∕s2PakPaMPaGP" +
+	"aradrad∕srad∕s2psnsμsmspVnVμVmVkVMVpWnWμW" +
+	"mWkWMWkΩMΩa.m.BqcccdC∕kgCo.dBGyhaHPinKKKMktl" +
+	"mlnloglxmbmilmolPHp.m.PPMPRsrSvWbV∕mA∕m1日2" +
+	"日3日4日5日6日7日8日9日10日11日12日13" +
+	"日14日15日16日17日18日19日20日21日22日" +
+	"23日24日25日26日27日28日29日30日31日gal" +
+	"ъьꝯCFQĦœꜧꬷɫꭒʍ豈更車賈滑串句" +
+	"龜龜契金喇奈懶癩羅蘿螺裸邏樂洛烙" +
+	"珞落酪駱亂卵欄爛蘭鸞嵐濫藍襤拉臘" +
+	"蠟廊朗浪狼郎來冷勞擄櫓爐盧老蘆虜" +
+	"路露魯鷺碌祿綠菉錄鹿論壟弄籠聾牢" +
+	"磊賂雷壘屢樓淚漏累縷陋勒肋凜凌稜" +
+	"綾菱陵讀拏樂諾丹寧怒率異北磻便復" +
+	"不泌數索參塞省葉說殺辰沈拾若掠略" +
+	"亮兩凉梁糧良諒量勵呂女廬旅濾礪閭" +
+	"驪麗黎力曆歷轢年憐戀撚漣煉璉秊練" +
+	"聯輦蓮連鍊列劣咽烈裂說廉念捻殮簾" +
+	"獵令囹寧嶺怜玲瑩羚聆鈴零靈領例禮" +
+	"醴隸惡了僚寮尿料樂燎療蓼遼龍暈阮" +
+	"劉杻柳流溜琉留硫紐類六戮陸倫崙淪" +
+	"輪律慄栗率隆利吏履易李梨泥理痢罹" +
+	"裏裡里離匿溺吝燐璘藺隣鱗麟林淋臨" +
+	"立笠粒狀炙識什茶刺切度拓糖宅洞暴" +
+	"輻行降見廓兀嗀塚晴凞猪益礼神祥福" +
+	"靖精羽蘒諸逸都飯飼館鶴郞隷侮僧免" +
+	"勉勤卑喝嘆器塀墨層屮悔慨憎懲敏既" +
+	"暑梅海渚漢煮爫琢碑社祉祈祐祖祝禍" +
+	"禎穀突節練縉繁署者臭艹艹著褐視謁" +
+	"謹賓贈辶逸難響頻恵𤋮舘並况全侀充" +
+	"冀勇勺喝啕喙嗢塚墳奄奔婢嬨廒廙彩" +
+	"徭惘慎愈憎慠懲戴揄搜摒敖晴朗望杖" +
+	"歹殺流滛滋漢瀞煮瞧爵犯猪瑱甆画瘝" +
+	"瘟益盛直睊着磌窱節类絛練缾者荒華" +
+	"蝹襁覆視調諸請謁諾諭謹變贈輸遲醙" +
+	"鉶陼難靖韛響頋頻鬒龜𢡊𢡄𣏕㮝䀘" +
+	"䀹𥉉𥳐𧻓齃龎fffiflffifflststմնմեմի" +
+	"վնմխיִײַעאדהכלםרת+שׁשׂשּׁ" +
+	"שּׂאַאָאּבּגּדּהּוּזּטּי" +
+	"ּךּכּלּמּנּסּףּפּצּקּרּש" +
+	"ּתּוֹבֿכֿפֿאלٱٱٻٻٻٻپپپپڀ" +
+	"ڀڀڀٺٺٺٺٿٿٿٿٹٹٹٹڤڤڤڤڦڦڦڦڄ" +
+	"ڄڄڄڃڃڃڃچچچچڇڇڇڇڍڍڌڌڎڎڈڈژ" +
+	"ژڑڑککککگگگگڳڳڳڳڱڱڱڱںںڻڻڻ" +
+	"ڻۀۀہہہہھھھھےےۓۓڭڭڭڭۇ" +
+	"ۇۆۆۈۈۇٴۋۋۅۅۉۉېېېېىىئائ" +
+	"ائەئەئوئوئۇئۇئۆئ" +
+	"ۆئۈئۈئېئېئېئىئىئ" +
+	"ىییییئجئحئمئىئيبجبح" +
+	"بخبمبىبيتجتحتختمتىتيثجثم" +
+	"ثىثيجحجمحجحمخجخحخمسجسحسخ" +
+	"سمصحصمضجضحضخضمطحطمظمعجعم" +
+	"غجغمفجفحفخفمفىفيقحقمقىقي" +
+	"كاكجكحكخكلكمكىكيلجلحلخلم" +
+	"لىليمجمحمخمممىمينجنحنخنم" +
+	"نىنيهجهمهىهييجيحيخيميىيي" +
+	"ذٰرٰىٰ ٌّ ٍّ َّ ُّ ِّ ّٰئر" +
+	"ئزئمئنئىئيبربزبمبنب" +
+	"ىبيترتزتمتنتىتيثرثزثمثنث" +
+	"ىثيفىفيقىقيكاكلكمكىكيلمل" +
+	"ىليماممنرنزنمنننىنيىٰيري" +
+	"زيمينيىييئجئحئخئمئه" +
+	"بجبحبخبمبهتجتحتختمتهثمجح" +
+	"جمحجحمخجخمسجسحسخسمصحصخصم" +
+	"ضجضحضخضمطحظمعجعمغجغمفجفح" +
+	"فخفمقحقمكجكحكخكلكملجلحلخ" +
+	"لملهمجمحمخممنجنحنخنمنههج" +
+	"همهٰيجيحيخيميهئمئهبمبه" +
+	"تمتهثمثهسمسهشمشهكلكملمنم" +
+	"نهيميهـَّـُّـِّطىطيعىعيغ" +
+	"ىغيسىسيشىشيحىحيجىجيخىخيص" +
+	"ىصيضىضيشجشحشخشمشرسرصرضرط" +
+	"ىطيعىعيغىغيسىسيشىشيحىحيج" +
+	"ىجيخىخيصىصيضىضيشجشحشخشمش" +
+	"رسرصرضرشجشحشخشمسهشهطمسجس" +
+	"حسخشجشحشخطمظماًاًتجمتحجت" +
+	"حجتحمتخمتمجتمحتمخجمحجمحح" +
+	"ميحمىسحجسجحسجىسمحسمحسمجس" +
+	"ممسممصححصححصممشحمشحمشجيش" +
+	"مخشمخشممشممضحىضخمضخمطمحط" +
+	"محطممطميعجمعممعممعمىغممغ" +
+	"ميغمىفخمفخمقمحقمملحملحيل" +
+	"حىلججلججلخملخملمحلمحمحجم" +
+	"حممحيمجحمجممخجمخممجخهمجه" +
+	"ممنحمنحىنجمنجمنجىنمينمىي" +
+	"مميممبخيتجيتجىتخيتخىتميت" +
+	"مىجميجحىجمىسخىصحيشحيضحيل" +
+	"جيلمييحييجييميمميقمينحيق" +
+	"محلحمعميكمينجحمخيلجمكممل" +
+	"جمنجحجحيحجيمجيفميبحيكممع" +
+	"جمصممسخينجيصلےقلےاللهاكب" +
+	"رمحمدصلعمرسولعليهوسلمصلى" +
+	"صلى الله عليه وسلمجل جلاله" +
+	"ریال,、。:;!?〖〗.....—–__(){}〔〕" +
+	"【】《》〈〉「」『』[] ̅ ̅ ̅ ̅___," +
+	"、.;:?!—(){}〔〕#&*+-<>=\\$%@ ًـً ٌ ٍ َ" +
+	"ـَ ُـُ ِـِ ّـّ ْـْءآآأا" +
+	"ٔؤؤإإئئئئااببببة" +
+	"ةتتتتثثثثججججححححخخخخددذ" +
+	"ذررززسسسسششششصصصصضضضضططط" +
+	"طظظظظععععغغغغففففققققككك" +
+	"كللللممممننننههههووىىييي" +
+	"يلآلآلألألإلإلالا!\"" +
+	"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQR" +
+	"STUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~⦅⦆" +
+	"。「」、・ヲァィゥェォャュョッー" +
+	"アイウエオカキクケコサシスセソタ" +
+	"チツテトナニヌネノハヒフヘホマミ" +
+	"ムメモヤユヨラリルレロワン゙゚ᅠ" +
+	"ᄀᄁᆪᄂᆬᆭᄃᄄᄅᆰᆱᆲᆳᆴᆵᄚ" +
+	"ᄆᄇᄈᄡᄉᄊᄋᄌᄍᄎᄏᄐᄑ하ᅢ" +
+	"ᅣᅤᅥᅦᅧᅨᅩᅪᅫᅬᅭᅮᅯᅰᅱᅲ" +
+	"ᅳᅴᅵ¢£¬ ̄¦¥₩│←↑→↓■○ː" +
+	"ˑæʙɓʣꭦʥʤɖɗᶑɘɞʩɤɢɠʛħʜɧʄʪ" +
+	"ʫɬ𝼄ꞎɮ𝼅ʎ𝼆øɶɷqɺ𝼈ɽɾʀʨʦꭧ" +
+	"ʧʈⱱʏʡʢʘǀǁǂ𝼊𝼞𑂚𑂜𑂥" +
+	"𑂺𑄮𑄯𑍋𑍌𑒻𑒹" +
+	"𑒰𑒾𑖺𑖻𑤸𝅗𝅥𝅘" +
+	"𝅥𝅘𝅥𝅮𝅘𝅥𝅯𝅘𝅥𝅰𝅘𝅥" +
+	"𝅱𝅘𝅥𝅲𝆹𝅥𝆺𝅥𝆹𝅥𝅮𝆺" +
+	"𝅥𝅮𝆹𝅥𝅯𝆺𝅥𝅯ABCDEFGHIJKLMNOP" +
+	"QRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKL" +
+	"MNOPQRSTUVWXYZabcdefgijklmnopqrstuvwxyzABCDEFGHI" +
+	"JKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzACDGJ" +
+	"KNOPQSTUVWXYZabcdfhijklmnpqrstuvwxyzABCDEFGHIJKL" +
+	"MNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABDEFGJK" +
+	"LMNOPQSTUVWXYabcdefghijklmnopqrstuvwxyzABDEFGIJK" +
+	"LMOSTUVWXYabcdefghijklmnopqrstuvwxyzABCDEFGHIJKL" +
+	"MNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGH" +
+	"IJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCD" +
+	"EFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz" +
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuv" +
+	"wxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqr" +
+	"stuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmn" +
+	"opqrstuvwxyzıȷΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠ" +
+	"ΡΘΣΤΥΦΧΨΩ∇αβγδεζηθικλμνξ" +
+	"οπρςστυφχψω∂εθκφρπΑΒΓΔΕΖ" +
+	"ΗΘΙΚΛΜΝΞΟΠΡΘΣΤΥΦΧΨΩ∇αβγδ" +
+	"εζηθικλμνξοπρςστυφχψω∂εθ" +
+	"κφρπΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΘΣΤ" +
+	"ΥΦΧΨΩ∇αβγδεζηθικλμνξοπρς" +
+	"στυφχψω∂εθκφρπΑΒΓΔΕΖΗΘΙΚ" +
+	"ΛΜΝΞΟΠΡΘΣΤΥΦΧΨΩ∇αβγδεζηθ" +
+	"ικλμνξοπρςστυφχψω∂εθκφρπ" +
+	"ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΘΣΤΥΦΧΨ" +
+	"Ω∇αβγδεζηθικλμνξοπρςστυφ" +
+	"χψω∂εθκφρπϜϝ01234567890123456789012" +
+	"345678901234567890123456789абвгдежзикл" +
+	"мопрстуфхцчшыэюꚉәіјөүӏаб" +
+	"вгдежзиклопсуфхцчшъыґіѕџ" +
+	"ҫꙑұابجدوزحطيكلمنسعفصقرشت" +
+	"ثخذضظغٮںڡٯبجهحيكلمنسعفصق" +
+	"شتثخضغجحيلنسعصقشخضغںٯبجه" +
+	"حطيكمنسعفصقشتثخضظغٮڡابجد" +
+	"هوزحطيلمنسعفصقرشتثخذضظغب" +
+	"جدوزحطيلمنسعفصقرشتثخذضظغ" +
+	"0.0,1,2,3,4,5,6,7,8,9,(A)(B)(C)(D)(E)(F)(G)(H)(I" +
+	")(J)(K)(L)(M)(N)(O)(P)(Q)(R)(S)(T)(U)(V)(W)(X)(Y" +
+	")(Z)〔S〕CRCDWZABCDEFGHIJKLMNOPQRSTUVWXYZHVMVS" +
+	"DSSPPVWCMCMDMRDJほかココサ手字双デ二" +
+	"多解天交映無料前後再新初終生販声" +
+	"吹演投捕一三遊左中右指走打禁空合" +
+	"満有月申割営配〔本〕〔三〕〔二〕" +
+	"〔安〕〔点〕〔打〕〔盗〕〔勝〕〔" +
+	"敗〕得可0123456789丽丸乁𠄢你侮侻倂偺" +
+	"備僧像㒞𠘺免兔兤具𠔜㒹內再𠕋冗" +
+	"冤仌冬况𩇟凵刃㓟刻剆割剷㔕勇勉勤" +
+	"勺包匆北卉卑博即卽卿卿卿𠨬灰及叟" +
+	"𠭣叫叱吆咞吸呈周咢哶唐啓啣善善喙" +
+	"喫喳嗂圖嘆圗噑噴切壮城埴堍型堲報" +
+	"墬𡓤売壷夆多夢奢𡚨𡛪姬娛娧姘婦" +
+	"㛮㛼嬈嬾嬾𡧈寃寘寧寳𡬘寿将当尢㞁" +
+	"屠屮峀岍𡷤嵃𡷦嵮嵫嵼巡巢㠯巽帨帽" +
+	"幩㡢𢆃㡼庰庳庶廊𪎒廾𢌱𢌱舁弢弢" +
+	"㣇𣊸𦇚形彫㣣徚忍志忹悁㤺㤜悔𢛔" +
+	"惇慈慌慎慌慺憎憲憤憯懞懲懶成戛扝" +
+	"抱拔捐𢬌挽拼捨掃揤𢯱搢揅掩㨮摩摾" +
+	"撝摷㩬敏敬𣀊旣書晉㬙暑㬈㫤冒冕最" +
+	"暜肭䏙朗望朡杞杓𣏃㭉柺枅桒梅𣑭梎" +
+	"栟椔㮝楂榣槪檨𣚣櫛㰘次𣢧歔㱎歲殟" +
+	"殺殻𣪍𡴋𣫺汎𣲼沿泍汧洖派海流浩" +
+	"浸涅𣴞洴港湮㴳滋滇𣻑淹潮𣽞𣾎濆" +
+	"瀹瀞瀛㶖灊災灷炭𠔥煅𤉣熜𤎫爨爵" +
+	"牐𤘈犀犕𤜵𤠔獺王㺬玥㺸㺸瑇瑜瑱" +
+	"璅瓊㼛甤𤰶甾𤲒異𢆟瘐𤾡𤾸𥁄㿼" +
+	"䀈直𥃳𥃲𥄙𥄳眞真真睊䀹瞋䁆䂖𥐝" +
+	"硎碌磌䃣𥘦祖𥚚𥛅福秫䄯穀穊穏𥥼" +
+	"𥪧𥪧竮䈂𥮫篆築䈧𥲀糒䊠糨糣紀𥾆" +
+	"絣䌁緇縂繅䌴𦈨𦉇䍙𦋙罺𦌾羕翺者" +
+	"𦓚𦔣聠𦖨聰𣍟䏕育脃䐋脾媵𦞧𦞵" +
+	"𣎓𣎜舁舄辞䑫芑芋芝劳花芳芽苦𦬼" +
+	"若茝荣莭茣莽菧著荓菊菌菜𦰶𦵫𦳕" +
+	"䔫蓱蓳蔖𧏊蕤𦼬䕝䕡𦾱𧃒䕫虐虜虧" +
+	"虩蚩蚈蜎蛢蝹蜨蝫螆䗗蟡蠁䗹衠衣𧙧" +
+	"裗裞䘵裺㒻𧢮𧥦䚾䛇誠諭變豕𧲨貫" +
+	"賁贛起𧼯𠠄跋趼跰𠣞軔輸𨗒𨗭邔郱" +
+	"鄑𨜮鄛鈸鋗鋘鉼鏹鐕𨯺開䦕閷𨵷䧦" +
+	"雃嶲霣𩅅𩈚䩮䩶韠𩐊䪲𩒖頋頋頩𩖶" +
+	"飢䬳餩馧駂駾䯎𩬰鬒鱀鳽䳎䳭鵧𪃎䳸" +
+	"𪄅𪈎𪊑麻䵖黹黾鼅鼏鼖鼻𪘀" +
+	""
+
+// nfkcCompositions are the primary composites of pairs of runes, but Hangul
+// syllables, sorted by pair
+var nfkcCompositions = []runeComposition{
+	{0x003C, 0x0338, 0x226E},
+	{0x003D, 0x0338, 0x2260},
+	{0x003E, 0x0338, 0x226F},
+	{0x0041, 0x0300, 0x00C0},
+	{0x0041, 0x0301, 0x00C1},
+	{0x0041, 0x0302, 0x00C2},
+	{0x0041, 0x0303, 0x00C3},
+	{0x0041, 0x0304, 0x0100},
+	{0x0041, 0x0306, 0x0102},
+	{0x0041, 0x0307, 0x0226},
+	{0x0041, 0x0308, 0x00C4},
+	{0x0041, 0x0309, 0x1EA2},
+	{0x0041, 0x030A, 0x00C5},
+	{0x0041, 0x030C, 0x01CD},
+	{0x0041, 0x030F, 0x0200},
+	{0x0041, 0x0311, 0x0202},
+	{0x0041, 0x0323, 0x1EA0},
+	{0x0041, 0x0325, 0x1E00},
+	{0x0041, 0x0328, 0x0104},
+	{0x0042, 0x0307, 0x1E02},
+	{0x0042, 0x0323, 0x1E04},
+	{0x0042, 0x0331, 0x1E06},
+	{0x0043, 0x0301, 0x0106},
+	{0x0043, 0x0302, 0x0108},
+	{0x0043, 0x0307, 0x010A},
+	{0x0043, 0x030C, 0x010C},
+	{0x0043, 0x0327, 0x00C7},
+	{0x0044, 0x0307, 0x1E0A},
+	{0x0044, 0x030C, 0x010E},
+	{0x0044, 0x0323, 0x1E0C},
+	{0x0044, 0x0327, 0x1E10},
+	{0x0044, 0x032D, 0x1E12},
+	{0x0044, 0x0331, 0x1E0E},
+	{0x0045, 0x0300, 0x00C8},
+	{0x0045, 0x0301, 0x00C9},
+	{0x0045, 0x0302, 0x00CA},
+	{0x0045, 0x0303, 0x1EBC},
+	{0x0045, 0x0304, 0x0112},
+	{0x0045, 0x0306, 0x0114},
+	{0x0045, 0x0307, 0x0116},
+	{0x0045, 0x0308, 0x00CB},
+	{0x0045, 0x0309, 0x1EBA},
+	{0x0045, 0x030C, 0x011A},
+	{0x0045, 0x030F, 0x0204},
+	{0x0045, 0x0311, 0x0206},
+	{0x0045, 0x0323, 0x1EB8},
+	{0x0045, 0x0327, 0x0228},
+	{0x0045, 0x0328, 0x0118},
+	{0x0045, 0x032D, 0x1E18},
+	{0x0045, 0x0330, 0x1E1A},
+	{0x0046, 0x0307, 0x1E1E},
+	{0x0047, 0x0301, 0x01F4},
+	{0x0047, 0x0302, 0x011C},
+	{0x0047, 0x0304, 0x1E20},
+	{0x0047, 0x0306, 0x011E},
+	{0x0047, 0x0307, 0x0120},
+	{0x0047, 0x030C, 0x01E6},
+	{0x0047, 0x0327, 0x0122},
+	{0x0048, 0x0302, 0x0124},
+	{0x0048, 0x0307, 0x1E22},
+	{0x0048, 0x0308, 0x1E26},
+	{0x0048, 0x030C, 0x021E},
+	{0x0048, 0x0323, 0x1E24},
+	{0x0048, 0x0327, 0x1E28},
+	{0x0048, 0x032E, 0x1E2A},
+	{0x0049, 0x0300, 0x00CC},
+	{0x0049, 0x0301, 0x00CD},
+	{0x0049, 0x0302, 0x00CE},
+	{0x0049, 0x0303, 0x0128},
+	{0x0049, 0x0304, 0x012A},
+	{0x0049, 0x0306, 0x012C},
+	{0x0049, 0x0307, 0x0130},
+	{0x0049, 0x0308, 0x00CF},
+	{0x0049, 0x0309, 0x1EC8},
+	{0x0049, 0x030C, 0x01CF},
+	{0x0049, 0x030F, 0x0208},
+	{0x0049, 0x0311, 0x020A},
+	{0x0049, 0x0323, 0x1ECA},
+	{0x0049, 0x0328, 0x012E},
+	{0x0049, 0x0330, 0x1E2C},
+	{0x004A, 0x0302, 0x0134},
+	{0x004B, 0x0301, 0x1E30},
+	{0x004B, 0x030C, 0x01E8},
+	{0x004B, 0x0323, 0x1E32},
+	{0x004B, 0x0327, 0x0136},
+	{0x004B, 0x0331, 0x1E34},
+	{0x004C, 0x0301, 0x0139},
+	{0x004C, 0x030C, 0x013D},
+	{0x004C, 0x0323, 0x1E36},
+	{0x004C, 0x0327, 0x013B},
+	{0x004C, 0x032D, 0x1E3C},
+	{0x004C, 0x0331, 0x1E3A},
+	{0x004D, 0x0301, 0x1E3E},
+	{0x004D, 0x0307, 0x1E40},
+	{0x004D, 0x0323, 0x1E42},
+	{0x004E, 0x0300, 0x01F8},
+	{0x004E, 0x0301, 0x0143},
+	{0x004E, 0x0303, 0x00D1},
+	{0x004E, 0x0307, 0x1E44},
+	{0x004E, 0x030C, 0x0147},
+	{0x004E, 0x0323, 0x1E46},
+	{0x004E, 0x0327, 0x0145},
+	{0x004E, 0x032D, 0x1E4A},
+	{0x004E, 0x0331, 0x1E48},
+	{0x004F, 0x0300, 0x00D2},
+	{0x004F, 0x0301, 0x00D3},
+	{0x004F, 0x0302, 0x00D4},
+	{0x004F, 0x0303, 0x00D5},
+	{0x004F, 0x0304, 0x014C},
+	{0x004F, 0x0306, 0x014E},
+	{0x004F, 0x0307, 0x022E},
+	{0x004F, 0x0308, 0x00D6},
+	{0x004F, 0x0309, 0x1ECE},
+	{0x004F, 0x030B, 0x0150},
+	{0x004F, 0x030C, 0x01D1},
+	{0x004F, 0x030F, 0x020C},
+	{0x004F, 0x0311, 0x020E},
+	{0x004F, 0x031B, 0x01A0},
+	{0x004F, 0x0323, 0x1ECC},
+	{0x004F, 0x0328, 0x01EA},
+	{0x0050, 0x0301, 0x1E54},
+	{0x0050, 0x0307, 0x1E56},
+	{0x0052, 0x0301, 0x0154},
+	{0x0052, 0x0307, 0x1E58},
+	{0x0052, 0x030C, 0x0158},
+	{0x0052, 0x030F, 0x0210},
+	{0x0052, 0x0311, 0x0212},
+	{0x0052, 0x0323, 0x1E5A},
+	{0x0052, 0x0327, 0x0156},
+	{0x0052, 0x0331, 0x1E5E},
+	{0x0053, 0x0301, 0x015A},
+	{0x0053, 0x0302, 0x015C},
+	{0x0053, 0x0307, 0x1E60},
+	{0x0053, 0x030C, 0x0160},
+	{0x0053, 0x0323, 0x1E62},
+	{0x0053, 0x0326, 0x0218},
+	{0x0053, 0x0327, 0x015E},
+	{0x0054, 0x0307, 0x1E6A},
+	{0x0054, 0x030C, 0x0164},
+	{0x0054, 0x0323, 0x1E6C},
+	{0x0054, 0x0326, 0x021A},
+	{0x0054, 0x0327, 0x0162},
+	{0x0054, 0x032D, 0x1E70},
+	{0x0054, 0x0331, 0x1E6E},
+	{0x0055, 0x0300, 0x00D9},
+	{0x0055, 0x0301, 0x00DA},
+	{0x0055, 0x0302, 0x00DB},
+	{0x0055, 0x0303, 0x0168},
+	{0x0055, 0x0304, 0x016A},
+	{0x0055, 0x0306, 0x016C},
+	{0x0055, 0x0308, 0x00DC},
+	{0x0055, 0x0309, 0x1EE6},
+	{0x0055, 0x030A, 0x016E},
+	{0x0055, 0x030B, 0x0170},
+	{0x0055, 0x030C, 0x01D3},
+	{0x0055, 0x030F, 0x0214},
+	{0x0055, 0x0311, 0x0216},
+	{0x0055, 0x031B, 0x01AF},
+	{0x0055, 0x0323, 0x1EE4},
+	{0x0055, 0x0324, 0x1E72},
+	{0x0055, 0x0328, 0x0172},
+	{0x0055, 0x032D, 0x1E76},
+	{0x0055, 0x0330, 0x1E74},
+	{0x0056, 0x0303, 0x1E7C},
+	{0x0056, 0x0323, 0x1E7E},
+	{0x0057, 0x0300, 0x1E80},
+	{0x0057, 0x0301, 0x1E82},
+	{0x0057, 0x0302, 0x0174},
+	{0x0057, 0x0307, 0x1E86},
+	{0x0057, 0x0308, 0x1E84},
+	{0x0057, 0x0323, 0x1E88},
+	{0x0058, 0x0307, 0x1E8A},
+	{0x0058, 0x0308, 0x1E8C},
+	{0x0059, 0x0300, 0x1EF2},
+	{0x0059, 0x0301, 0x00DD},
+	{0x0059, 0x0302, 0x0176},
+	{0x0059, 0x0303, 0x1EF8},
+	{0x0059, 0x0304, 0x0232},
+	{0x0059, 0x0307, 0x1E8E},
+	{0x0059, 0x0308, 0x0178},
+	{0x0059, 0x0309, 0x1EF6},
+	{0x0059, 0x0323, 0x1EF4},
+	{0x005A, 0x0301, 0x0179},
+	{0x005A, 0x0302, 0x1E90},
+	{0x005A, 0x0307, 0x017B},
+	{0x005A, 0x030C, 0x017D},
+	{0x005A, 0x0323, 0x1E92},
+	{0x005A, 0x0331, 0x1E94},
+	{0x0061, 0x0300, 0x00E0},
+	{0x0061, 0x0301, 0x00E1},
+	{0x0061, 0x0302, 0x00E2},
+	{0x0061, 0x0303, 0x00E3},
+	{0x0061, 0x0304, 0x0101},
+	{0x0061, 0x0306, 0x0103},
+	{0x0061, 0x0307, 0x0227},
+	{0x0061, 0x0308, 0x00E4},
+	{0x0061, 0x0309, 0x1EA3},
+	{0x0061, 0x030A, 0x00E5},
+	{0x0061, 0x030C, 0x01CE},
+	{0x0061, 0x030F, 0x0201},
+	{0x0061, 0x0311, 0x0203},
+	{0x0061, 0x0323, 0x1EA1},
+	{0x0061, 0x0325, 0x1E01},
+	{0x0061, 0x0328, 0x0105},
+	{0x0062, 0x0307, 0x1E03},
+	{0x0062, 0x0323, 0x1E05},
+	{0x0062, 0x0331, 0x1E07},
+	{0x0063, 0x0301, 0x0107},
+	{0x0063, 0x0302, 0x0109},
+	{0x0063, 0x0307, 0x010B},
+	{0x0063, 0x030C, 0x010D},
+	{0x0063, 0x0327, 0x00E7},
+	{0x0064, 0x0307, 0x1E0B},
+	{0x0064, 0x030C, 0x010F},
+	{0x0064, 0x0323, 0x1E0D},
+	{0x0064, 0x0327, 0x1E11},
+	{0x0064, 0x032D, 0x1E13},
+	{0x0064, 0x0331, 0x1E0F},
+	{0x0065, 0x0300, 0x00E8},
+	{0x0065, 0x0301, 0x00E9},
+	{0x0065, 0x0302, 0x00EA},
+	{0x0065, 0x0303, 0x1EBD},
+	{0x0065, 0x0304, 0x0113},
+	{0x0065, 0x0306, 0x0115},
+	{0x0065, 0x0307, 0x0117},
+	{0x0065, 0x0308, 0x00EB},
+	{0x0065, 0x0309, 0x1EBB},
+	{0x0065, 0x030C, 0x011B},
+	{0x0065, 0x030F, 0x0205},
+	{0x0065, 0x0311, 0x0207},
+	{0x0065, 0x0323, 0x1EB9},
+	{0x0065, 0x0327, 0x0229},
+	{0x0065, 0x0328, 0x0119},
+	{0x0065, 0x032D, 0x1E19},
+	{0x0065, 0x0330, 0x1E1B},
+	{0x0066, 0x0307, 0x1E1F},
+	{0x0067, 0x0301, 0x01F5},
+	{0x0067, 0x0302, 0x011D},
+	{0x0067, 0x0304, 0x1E21},
+	{0x0067, 0x0306, 0x011F},
+	{0x0067, 0x0307, 0x0121},
+	{0x0067, 0x030C, 0x01E7},
+	{0x0067, 0x0327, 0x0123},
+	{0x0068, 0x0302, 0x0125},
+	{0x0068, 0x0307, 0x1E23},
+	{0x0068, 0x0308, 0x1E27},
+	{0x0068, 0x030C, 0x021F},
+	{0x0068, 0x0323, 0x1E25},
+	{0x0068, 0x0327, 0x1E29},
+	{0x0068, 0x032E, 0x1E2B},
+	{0x0068, 0x0331, 0x1E96},
+	{0x0069, 0x0300, 0x00EC},
+	{0x0069, 0x0301, 0x00ED},
+	{0x0069, 0x0302, 0x00EE},
+	{0x0069, 0x0303, 0x0129},
+	{0x0069, 0x0304, 0x012B},
+	{0x0069, 0x0306, 0x012D},
+	{0x0069, 0x0308, 0x00EF},
+	{0x0069, 0x0309, 0x1EC9},
+	{0x0069, 0x030C, 0x01D0},
+	{0x0069, 0x030F, 0x0209},
+	{0x0069, 0x0311, 0x020B},
+	{0x0069, 0x0323, 0x1ECB},
+	{0x0069, 0x0328, 0x012F},
+	{0x0069, 0x0330, 0x1E2D},
+	{0x006A, 0x0302, 0x0135},
+	{0x006A, 0x030C, 0x01F0},
+	{0x006B, 0x0301, 0x1E31},
+	{0x006B, 0x030C, 0x01E9},
+	{0x006B, 0x0323, 0x1E33},
+	{0x006B, 0x0327, 0x0137},
+	{0x006B, 0x0331, 0x1E35},
+	{0x006C, 0x0301, 0x013A},
+	{0x006C, 0x030C, 0x013E},
+	{0x006C, 0x0323, 0x1E37},
+	{0x006C, 0x0327, 0x013C},
+	{0x006C, 0x032D, 0x1E3D},
+	{0x006C, 0x0331, 0x1E3B},
+	{0x006D, 0x0301, 0x1E3F},
+	{0x006D, 0x0307, 0x1E41},
+	{0x006D, 0x0323, 0x1E43},
+	{0x006E, 0x0300, 0x01F9},
+	{0x006E, 0x0301, 0x0144},
+	{0x006E, 0x0303, 0x00F1},
+	{0x006E, 0x0307, 0x1E45},
+	{0x006E, 0x030C, 0x0148},
+	{0x006E, 0x0323, 0x1E47},
+	{0x006E, 0x0327, 0x0146},
+	{0x006E, 0x032D, 0x1E4B},
+	{0x006E, 0x0331, 0x1E49},
+	{0x006F, 0x0300, 0x00F2},
+	{0x006F, 0x0301, 0x00F3},
+	{0x006F, 0x0302, 0x00F4},
+	{0x006F, 0x0303, 0x00F5},
+	{0x006F, 0x0304, 0x014D},
+	{0x006F, 0x0306, 0x014F},
+	{0x006F, 0x0307, 0x022F},
+	{0x006F, 0x0308, 0x00F6},
+	{0x006F, 0x0309, 0x1ECF},
+	{0x006F, 0x030B, 0x0151},
+	{0x006F, 0x030C, 0x01D2},
+	{0x006F, 0x030F, 0x020D},
+	{0x006F, 0x0311, 0x020F},
+	{0x006F, 0x031B, 0x01A1},
+	{0x006F, 0x0323, 0x1ECD},
+	{0x006F, 0x0328, 0x01EB},
+	{0x0070, 0x0301, 0x1E55},
+	{0x0070, 0x0307, 0x1E57},
+	{0x0072, 0x0301, 0x0155},
+	{0x0072, 0x0307, 0x1E59},
+	{0x0072, 0x030C, 0x0159},
+	{0x0072, 0x030F, 0x0211},
+	{0x0072, 0x0311, 0x0213},
+	{0x0072, 0x0323, 0x1E5B},
+	{0x0072, 0x0327, 0x0157},
+	{0x0072, 0x0331, 0x1E5F},
+	{0x0073, 0x0301, 0x015B},
+	{0x0073, 0x0302, 0x015D},
+	{0x0073, 0x0307, 0x1E61},
+	{0x0073, 0x030C, 0x0161},
+	{0x0073, 0x0323, 0x1E63},
+	{0x0073, 0x0326, 0x0219},
+	{0x0073, 0x0327, 0x015F},
+	{0x0074, 0x0307, 0x1E6B},
+	{0x0074, 0x0308, 0x1E97},
+	{0x0074, 0x030C, 0x0165},
+	{0x0074, 0x0323, 0x1E6D},
+	{0x0074, 0x0326, 0x021B},
+	{0x0074, 0x0327, 0x0163},
+	{0x0074, 0x032D, 0x1E71},
+	{0x0074, 0x0331, 0x1E6F},
+	{0x0075, 0x0300, 0x00F9},
+	{0x0075, 0x0301, 0x00FA},
+	{0x0075, 0x0302, 0x00FB},
+	{0x0075, 0x0303, 0x0169},
+	{0x0075, 0x0304, 0x016B},
+	{0x0075, 0x0306, 0x016D},
+	{0x0075, 0x0308, 0x00FC},
+	{0x0075, 0x0309, 0x1EE7},
+	{0x0075, 0x030A, 0x016F},
+	{0x0075, 0x030B, 0x0171},
+	{0x0075, 0x030C, 0x01D4},
+	{0x0075, 0x030F, 0x0215},
+	{0x0075, 0x0311, 0x0217},
+	{0x0075, 0x031B, 0x01B0},
+	{0x0075, 0x0323, 0x1EE5},
+	{0x0075, 0x0324, 0x1E73},
+	{0x0075, 0x0328, 0x0173},
+	{0x0075, 0x032D, 0x1E77},
+	{0x0075, 0x0330, 0x1E75},
+	{0x0076, 0x0303, 0x1E7D},
+	{0x0076, 0x0323, 0x1E7F},
+	{0x0077, 0x0300, 0x1E81},
+	{0x0077, 0x0301, 0x1E83},
+	{0x0077, 0x0302, 0x0175},
+	{0x0077, 0x0307, 0x1E87},
+	{0x0077, 0x0308, 0x1E85},
+	{0x0077, 0x030A, 0x1E98},
+	{0x0077, 0x0323, 0x1E89},
+	{0x0078, 0x0307, 0x1E8B},
+	{0x0078, 0x0308, 0x1E8D},
+	{0x0079, 0x0300, 0x1EF3},
+	{0x0079, 0x0301, 0x00FD},
+	{0x0079, 0x0302, 0x0177},
+	{0x0079, 0x0303, 0x1EF9},
+	{0x0079, 0x0304, 0x0233},
+	{0x0079, 0x0307, 0x1E8F},
+	{0x0079, 0x0308, 0x00FF},
+	{0x0079, 0x0309, 0x1EF7},
+	{0x0079, 0x030A, 0x1E99},
+	{0x0079, 0x0323, 0x1EF5},
+	{0x007A, 0x0301, 0x017A},
+	{0x007A, 0x0302, 0x1E91},
+	{0x007A, 0x0307, 0x017C},
+	{0x007A, 0x030C, 0x017E},
+	{0x007A, 0x0323, 0x1E93},
+	{0x007A, 0x0331, 0x1E95},
+	{0x00A8, 0x0300, 0x1FED},
+	{0x00A8, 0x0301, 0x0385},
+	{0x00A8, 0x0342, 0x1FC1},
+	{0x00C2, 0x0300, 0x1EA6},
+	{0x00C2, 0x0301, 0x1EA4},
+	{0x00C2, 0x0303, 0x1EAA},
+	{0x00C2, 0x0309, 0x1EA8},
+	{0x00C4, 0x0304, 0x01DE},
+	{0x00C5, 0x0301, 0x01FA},
+	{0x00C6, 0x0301, 0x01FC},
+	{0x00C6, 0x0304, 0x01E2},
+	{0x00C7, 0x0301, 0x1E08},
+	{0x00CA, 0x0300, 0x1EC0},
+	{0x00CA, 0x0301, 0x1EBE},
+	{0x00CA, 0x0303, 0x1EC4},
+	{0x00CA, 0x0309, 0x1EC2},
+	{0x00CF, 0x0301, 0x1E2E},
+	{0x00D4, 0x0300, 0x1ED2},
+	{0x00D4, 0x0301, 0x1ED0},
+	{0x00D4, 0x0303, 0x1ED6},
+	{0x00D4, 0x0309, 0x1ED4},
+	{0x00D5, 0x0301, 0x1E4C},
+	{0x00D5, 0x0304, 0x022C},
+	{0x00D5, 0x0308, 0x1E4E},
+	{0x00D6, 0x0304, 0x022A},
+	{0x00D8, 0x0301, 0x01FE},
+	{0x00DC, 0x0300, 0x01DB},
+	{0x00DC, 0x0301, 0x01D7},
+	{0x00DC, 0x0304, 0x01D5},
+	{0x00DC, 0x030C, 0x01D9},
+	{0x00E2, 0x0300, 0x1EA7},
+	{0x00E2, 0x0301, 0x1EA5},
+	{0x00E2, 0x0303, 0x1EAB},
+	{0x00E2, 0x0309, 0x1EA9},
+	{0x00E4, 0x0304, 0x01DF},
+	{0x00E5, 0x0301, 0x01FB},
+	{0x00E6, 0x0301, 0x01FD},
+	{0x00E6, 0x0304, 0x01E3},
+	{0x00E7, 0x0301, 0x1E09},
+	{0x00EA, 0x0300, 0x1EC1},
+	{0x00EA, 0x0301, 0x1EBF},
+	{0x00EA, 0x0303, 0x1EC5},
+	{0x00EA, 0x0309, 0x1EC3},
+	{0x00EF, 0x0301, 0x1E2F},
+	{0x00F4, 0x0300, 0x1ED3},
+	{0x00F4, 0x0301, 0x1ED1},
+	{0x00F4, 0x0303, 0x1ED7},
+	{0x00F4, 0x0309, 0x1ED5},
+	{0x00F5, 0x0301, 0x1E4D},
+	{0x00F5, 0x0304, 0x022D},
+	{0x00F5, 0x0308, 0x1E4F},
+	{0x00F6, 0x0304, 0x022B},
+	{0x00F8, 0x0301, 0x01FF},
+	{0x00FC, 0x0300, 0x01DC},
+	{0x00FC, 0x0301, 0x01D8},
+	{0x00FC, 0x0304, 0x01D6},
+	{0x00FC, 0x030C, 0x01DA},
+	{0x0102, 0x0300, 0x1EB0},
+	{0x0102, 0x0301, 0x1EAE},
+	{0x0102, 0x0303, 0x1EB4},
+	{0x0102, 0x0309, 0x1EB2},
+	{0x0103, 0x0300, 0x1EB1},
+	{0x0103, 0x0301, 0x1EAF},
+	{0x0103, 0x0303, 0x1EB5},
+	{0x0103, 0x0309, 0x1EB3},
+	{0x0112, 0x0300, 0x1E14},
+	{0x0112, 0x0301, 0x1E16},
+	{0x0113, 0x0300, 0x1E15},
+	{0x0113, 0x0301, 0x1E17},
+	{0x014C, 0x0300, 0x1E50},
+	{0x014C, 0x0301, 0x1E52},
+	{0x014D, 0x0300, 0x1E51},
+	{0x014D, 0x0301, 0x1E53},
+	{0x015A, 0x0307, 0x1E64},
+	{0x015B, 0x0307, 0x1E65},
+	{0x0160, 0x0307, 0x1E66},
+	{0x0161, 0x0307, 0x1E67},
+	{0x0168, 0x0301, 0x1E78},
+	{0x0169, 0x0301, 0x1E79},
+	{0x016A, 0x0308, 0x1E7A},
+	{0x016B, 0x0308, 0x1E7B},
+	{0x017F, 0x0307, 0x1E9B},
+	{0x01A0, 0x0300, 0x1EDC},
+	{0x01A0, 0x0301, 0x1EDA},
+	{0x01A0, 0x0303, 0x1EE0},
+	{0x01A0, 0x0309, 0x1EDE},
+	{0x01A0, 0x0323, 0x1EE2},
+	{0x01A1, 0x0300, 0x1EDD},
+	{0x01A1, 0x0301, 0x1EDB},
+	{0x01A1, 0x0303, 0x1EE1},
+	{0x01A1, 0x0309, 0x1EDF},
+	{0x01A1, 0x0323, 0x1EE3},
+	{0x01AF, 0x0300, 0x1EEA},
+	{0x01AF, 0x0301, 0x1EE8},
+	{0x01AF, 0x0303, 0x1EEE},
+	{0x01AF, 0x0309, 0x1EEC},
+	{0x01AF, 0x0323, 0x1EF0},
+	{0x01B0, 0x0300, 0x1EEB},
+	{0x01B0, 0x0301, 0x1EE9},
+	{0x01B0, 0x0303, 0x1EEF},
+	{0x01B0, 0x0309, 0x1EED},
+	{0x01B0, 0x0323, 0x1EF1},
+	{0x01B7, 0x030C, 0x01EE},
+	{0x01EA, 0x0304, 0x01EC},
+	{0x01EB, 0x0304, 0x01ED},
+	{0x0226, 0x0304, 0x01E0},
+	{0x0227, 0x0304, 0x01E1},
+	{0x0228, 0x0306, 0x1E1C},
+	{0x0229, 0x0306, 0x1E1D},
+	{0x022E, 0x0304, 0x0230},
+	{0x022F, 0x0304, 0x0231},
+	{0x0292, 0x030C, 0x01EF},
+	{0x0391, 0x0300, 0x1FBA},
+	{0x0391, 0x0301, 0x0386},
+	{0x0391, 0x0304, 0x1FB9},
+	{0x0391, 0x0306, 0x1FB8},
+	{0x0391, 0x0313, 0x1F08},
+	{0x0391, 0x0314, 0x1F09},
+	{0x0391, 0x0345, 0x1FBC},
+	{0x0395, 0x0300, 0x1FC8},
+	{0x0395, 0x0301, 0x0388},
+	{0x0395, 0x0313, 0x1F18},
+	{0x0395, 0x0314, 0x1F19},
+	{0x0397, 0x0300, 0x1FCA},
+	{0x0397, 0x0301, 0x0389},
+	{0x0397, 0x0313, 0x1F28},
+	{0x0397, 0x0314, 0x1F29},
+	{0x0397, 0x0345, 0x1FCC},
+	{0x0399, 0x0300, 0x1FDA},
+	{0x0399, 0x0301, 0x038A},
+	{0x0399, 0x0304, 0x1FD9},
+	{0x0399, 0x0306, 0x1FD8},
+	{0x0399, 0x0308, 0x03AA},
+	{0x0399, 0x0313, 0x1F38},
+	{0x0399, 0x0314, 0x1F39},
+	{0x039F, 0x0300, 0x1FF8},
+	{0x039F, 0x0301, 0x038C},
+	{0x039F, 0x0313, 0x1F48},
+	{0x039F, 0x0314, 0x1F49},
+	{0x03A1, 0x0314, 0x1FEC},
+	{0x03A5, 0x0300, 0x1FEA},
+	{0x03A5, 0x0301, 0x038E},
+	{0x03A5, 0x0304, 0x1FE9},
+	{0x03A5, 0x0306, 0x1FE8},
+	{0x03A5, 0x0308, 0x03AB},
+	{0x03A5, 0x0314, 0x1F59},
+	{0x03A9, 0x0300, 0x1FFA},
+	{0x03A9, 0x0301, 0x038F},
+	{0x03A9, 0x0313, 0x1F68},
+	{0x03A9, 0x0314, 0x1F69},
+	{0x03A9, 0x0345, 0x1FFC},
+	{0x03AC, 0x0345, 0x1FB4},
+	{0x03AE, 0x0345, 0x1FC4},
+	{0x03B1, 0x0300, 0x1F70},
+	{0x03B1, 0x0301, 0x03AC},
+	{0x03B1, 0x0304, 0x1FB1},
+	{0x03B1, 0x0306, 0x1FB0},
+	{0x03B1, 0x0313, 0x1F00},
+	{0x03B1, 0x0314, 0x1F01},
+	{0x03B1, 0x0342, 0x1FB6},
+	{0x03B1, 0x0345, 0x1FB3},
+	{0x03B5, 0x0300, 0x1F72},
+	{0x03B5, 0x0301, 0x03AD},
+	{0x03B5, 0x0313, 0x1F10},
+	{0x03B5, 0x0314, 0x1F11},
+	{0x03B7, 0x0300, 0x1F74},
+	{0x03B7, 0x0301, 0x03AE},
+	{0x03B7, 0x0313, 0x1F20},
+	{0x03B7, 0x0314, 0x1F21},
+	{0x03B7, 0x0342, 0x1FC6},
+	{0x03B7, 0x0345, 0x1FC3},
+	{0x03B9, 0x0300, 0x1F76},
+	{0x03B9, 0x0301, 0x03AF},
+	{0x03B9, 0x0304, 0x1FD1},
+	{0x03B9, 0x0306, 0x1FD0},
+	{0x03B9, 0x0308, 0x03CA},
+	{0x03B9, 0x0313, 0x1F30},
+	{0x03B9, 0x0314, 0x1F31},
+	{0x03B9, 0x0342, 0x1FD6},
+	{0x03BF, 0x0300, 0x1F78},
+	{0x03BF, 0x0301, 0x03CC},
+	{0x03BF, 0x0313, 0x1F40},
+	{0x03BF, 0x0314, 0x1F41},
+	{0x03C1, 0x0313, 0x1FE4},
+	{0x03C1, 0x0314, 0x1FE5},
+	{0x03C5, 0x0300, 0x1F7A},
+	{0x03C5, 0x0301, 0x03CD},
+	{0x03C5, 0x0304, 0x1FE1},
+	{0x03C5, 0x0306, 0x1FE0},
+	{0x03C5, 0x0308, 0x03CB},
+	{0x03C5, 0x0313, 0x1F50},
+	{0x03C5, 0x0314, 0x1F51},
+	{0x03C5, 0x0342, 0x1FE6},
+	{0x03C9, 0x0300, 0x1F7C},
+	{0x03C9, 0x0301, 0x03CE},
+	{0x03C9, 0x0313, 0x1F60},
+	{0x03C9, 0x0314, 0x1F61},
+	{0x03C9, 0x0342, 0x1FF6},
+	{0x03C9, 0x0345, 0x1FF3},
+	{0x03CA, 0x0300, 0x1FD2},
+	{0x03CA, 0x0301, 0x0390},
+	{0x03CA, 0x0342, 0x1FD7},
+	{0x03CB, 0x0300, 0x1FE2},
+	{0x03CB, 0x0301, 0x03B0},
+	{0x03CB, 0x0342, 0x1FE7},
+	{0x03CE, 0x0345, 0x1FF4},
+	{0x03D2, 0x0301, 0x03D3},
+	{0x03D2, 0x0308, 0x03D4},
+	{0x0406, 0x0308, 0x0407},
+	{0x0410, 0x0306, 0x04D0},
+	{0x0410, 0x0308, 0x04D2},
+	{0x0413, 0x0301, 0x0403},
+	{0x0415, 0x0300, 0x0400},
+	{0x0415, 0x0306, 0x04D6},
+	{0x0415, 0x0308, 0x0401},
+	{0x0416, 0x0306, 0x04C1},
+	{0x0416, 0x0308, 0x04DC},
+	{0x0417, 0x0308, 0x04DE},
+	{0x0418, 0x0300, 0x040D},
+	{0x0418, 0x0304, 0x04E2},
+	{0x0418, 0x0306, 0x0419},
+	{0x0418, 0x0308, 0x04E4},
+	{0x041A, 0x0301, 0x040C},
+	{0x041E, 0x0308, 0x04E6},
+	{0x0423, 0x0304, 0x04EE},
+	{0x0423, 0x0306, 0x040E},
+	{0x0423, 0x0308, 0x04F0},
+	{0x0423, 0x030B, 0x04F2},
+	{0x0427, 0x0308, 0x04F4},
+	{0x042B, 0x0308, 0x04F8},
+	{0x042D, 0x0308, 0x04EC},
+	{0x0430, 0x0306, 0x04D1},
+	{0x0430, 0x0308, 0x04D3},
+	{0x0433, 0x0301, 0x0453},
+	{0x0435, 0x0300, 0x0450},
+	{0x0435, 0x0306, 0x04D7},
+	{0x0435, 0x0308, 0x0451},
+	{0x0436, 0x0306, 0x04C2},
+	{0x0436, 0x0308, 0x04DD},
+	{0x0437, 0x0308, 0x04DF},
+	{0x0438, 0x0300, 0x045D},
+	{0x0438, 0x0304, 0x04E3},
+	{0x0438, 0x0306, 0x0439},
+	{0x0438, 0x0308, 0x04E5},
+	{0x043A, 0x0301, 0x045C},
+	{0x043E, 0x0308, 0x04E7},
+	{0x0443, 0x0304, 0x04EF},
+	{0x0443, 0x0306, 0x045E},
+	{0x0443, 0x0308, 0x04F1},
+	{0x0443, 0x030B, 0x04F3},
+	{0x0447, 0x0308, 0x04F5},
+	{0x044B, 0x0308, 0x04F9},
+	{0x044D, 0x0308, 0x04ED},
+	{0x0456, 0x0308, 0x0457},
+	{0x0474, 0x030F, 0x0476},
+	{0x0475, 0x030F, 0x0477},
+	{0x04D8, 0x0308, 0x04DA},
+	{0x04D9, 0x0308, 0x04DB},
+	{0x04E8, 0x0308, 0x04EA},
+	{0x04E9, 0x0308, 0x04EB},
+	{0x0627, 0x0653, 0x0622},
+	{0x0627, 0x0654, 0x0623},
+	{0x0627, 0x0655, 0x0625},
+	{0x0648, 0x0654, 0x0624},
+	{0x064A, 0x0654, 0x0626},
+	{0x06C1, 0x0654, 0x06C2},
+	{0x06D2, 0x0654, 0x06D3},
+	{0x06D5, 0x0654, 0x06C0},
+	{0x0928, 0x093C, 0x0929},
+	{0x0930, 0x093C, 0x0931},
+	{0x0933, 0x093C, 0x0934},
+	{0x09C7, 0x09BE, 0x09CB},
+	{0x09C7, 0x09D7, 0x09CC},
+	{0x0B47, 0x0B3E, 0x0B4B},
+	{0x0B47, 0x0B56, 0x0B48},
+	{0x0B47, 0x0B57, 0x0B4C},
+	{0x0B92, 0x0BD7, 0x0B94},
+	{0x0BC6, 0x0BBE, 0x0BCA},
+	{0x0BC6, 0x0BD7, 0x0BCC},
+	{0x0BC7, 0x0BBE, 0x0BCB},
+	{0x0C46, 0x0C56, 0x0C48},
+	{0x0CBF, 0x0CD5, 0x0CC0},
+	{0x0CC6, 0x0CC2, 0x0CCA},
+	{0x0CC6, 0x0CD5, 0x0CC7},
+	{0x0CC6, 0x0CD6, 0x0CC8},
+	{0x0CCA, 0x0CD5, 0x0CCB},
+	{0x0D46, 0x0D3E, 0x0D4A},
+	{0x0D46, 0x0D57, 0x0D4C},
+	{0x0D47, 0x0D3E, 0x0D4B},
+	{0x0DD9, 0x0DCA, 0x0DDA},
+	{0x0DD9, 0x0DCF, 0x0DDC},
+	{0x0DD9, 0x0DDF, 0x0DDE},
+	{0x0DDC, 0x0DCA, 0x0DDD},
+	{0x1025, 0x102E, 0x1026},
+	{0x1B05, 0x1B35, 0x1B06},
+	{0x1B07, 0x1B35, 0x1B08},
+	{0x1B09, 0x1B35, 0x1B0A},
+	{0x1B0B, 0x1B35, 0x1B0C},
+	{0x1B0D, 0x1B35, 0x1B0E},
+	{0x1B11, 0x1B35, 0x1B12},
+	{0x1B3A, 0x1B35, 0x1B3B},
+	{0x1B3C, 0x1B35, 0x1B3D},
+	{0x1B3E, 0x1B35, 0x1B40},
+	{0x1B3F, 0x1B35, 0x1B41},
+	{0x1B42, 0x1B35, 0x1B43},
+	{0x1E36, 0x0304, 0x1E38},
+	{0x1E37, 0x0304, 0x1E39},
+	{0x1E5A, 0x0304, 0x1E5C},
+	{0x1E5B, 0x0304, 0x1E5D},
+	{0x1E62, 0x0307, 0x1E68},
+	{0x1E63, 0x0307, 0x1E69},
+	{0x1EA0, 0x0302, 0x1EAC},
+	{0x1EA0, 0x0306, 0x1EB6},
+	{0x1EA1, 0x0302, 0x1EAD},
+	{0x1EA1, 0x0306, 0x1EB7},
+	{0x1EB8, 0x0302, 0x1EC6},
+	{0x1EB9, 0x0302, 0x1EC7},
+	{0x1ECC, 0x0302, 0x1ED8},
+	{0x1ECD, 0x0302, 0x1ED9},
+	{0x1F00, 0x0300, 0x1F02},
+	{0x1F00, 0x0301, 0x1F04},
+	{0x1F00, 0x0342, 0x1F06},
+	{0x1F00, 0x0345, 0x1F80},
+	{0x1F01, 0x0300, 0x1F03},
+	{0x1F01, 0x0301, 0x1F05},
+	{0x1F01, 0x0342, 0x1F07},
+	{0x1F01, 0x0345, 0x1F81},
+	{0x1F02, 0x0345, 0x1F82},
+	{0x1F03, 0x0345, 0x1F83},
+	{0x1F04, 0x0345, 0x1F84},
+	{0x1F05, 0x0345, 0x1F85},
+	{0x1F06, 0x0345, 0x1F86},
+	{0x1F07, 0x0345, 0x1F87},
+	{0x1F08, 0x0300, 0x1F0A},
+	{0x1F08, 0x0301, 0x1F0C},
+	{0x1F08, 0x0342, 0x1F0E},
+	{0x1F08, 0x0345, 0x1F88},
+	{0x1F09, 0x0300, 0x1F0B},
+	{0x1F09, 0x0301, 0x1F0D},
+	{0x1F09, 0x0342, 0x1F0F},
+	{0x1F09, 0x0345, 0x1F89},
+	{0x1F0A, 0x0345, 0x1F8A},
+	{0x1F0B, 0x0345, 0x1F8B},
+	{0x1F0C, 0x0345, 0x1F8C},
+	{0x1F0D, 0x0345, 0x1F8D},
+	{0x1F0E, 0x0345, 0x1F8E},
+	{0x1F0F, 0x0345, 0x1F8F},
+	{0x1F10, 0x0300, 0x1F12},
+	{0x1F10, 0x0301, 0x1F14},
+	{0x1F11, 0x0300, 0x1F13},
+	{0x1F11, 0x0301, 0x1F15},
+	{0x1F18, 0x0300, 0x1F1A},
+	{0x1F18, 0x0301, 0x1F1C},
+	{0x1F19, 0x0300, 0x1F1B},
+	{0x1F19, 0x0301, 0x1F1D},
+	{0x1F20, 0x0300, 0x1F22},
+	{0x1F20, 0x0301, 0x1F24},
+	{0x1F20, 0x0342, 0x1F26},
+	{0x1F20, 0x0345, 0x1F90},
+	{0x1F21, 0x0300, 0x1F23},
+	{0x1F21, 0x0301, 0x1F25},
+	{0x1F21, 0x0342, 0x1F27},
+	{0x1F21, 0x0345, 0x1F91},
+	{0x1F22, 0x0345, 0x1F92},
+	{0x1F23, 0x0345, 0x1F93},
+	{0x1F24, 0x0345, 0x1F94},
+	{0x1F25, 0x0345, 0x1F95},
+	{0x1F26, 0x0345, 0x1F96},
+	{0x1F27, 0x0345, 0x1F97},
+	{0x1F28, 0x0300, 0x1F2A},
+	{0x1F28, 0x0301, 0x1F2C},
+	{0x1F28, 0x0342, 0x1F2E},
+	{0x1F28, 0x0345, 0x1F98},
+	{0x1F29, 0x0300, 0x1F2B},
+	{0x1F29, 0x0301, 0x1F2D},
+	{0x1F29, 0x0342, 0x1F2F},
+	{0x1F29, 0x0345, 0x1F99},
+	{0x1F2A, 0x0345, 0x1F9A},
+	{0x1F2B, 0x0345, 0x1F9B},
+	{0x1F2C, 0x0345, 0x1F9C},
+	{0x1F2D, 0x0345, 0x1F9D},
+	{0x1F2E, 0x0345, 0x1F9E},
+	{0x1F2F, 0x0345, 0x1F9F},
+	{0x1F30, 0x0300, 0x1F32},
+	{0x1F30, 0x0301, 0x1F34},
+	{0x1F30, 0x0342, 0x1F36},
+	{0x1F31, 0x0300, 0x1F33},
+	{0x1F31, 0x0301, 0x1F35},
+	{0x1F31, 0x0342, 0x1F37},
+	{0x1F38, 0x0300, 0x1F3A},
+	{0x1F38, 0x0301, 0x1F3C},
+	{0x1F38, 0x0342, 0x1F3E},
+	{0x1F39, 0x0300, 0x1F3B},
+	{0x1F39, 0x0301, 0x1F3D},
+	{0x1F39, 0x0342, 0x1F3F},
+	{0x1F40, 0x0300, 0x1F42},
+	{0x1F40, 0x0301, 0x1F44},
+	{0x1F41, 0x0300, 0x1F43},
+	{0x1F41, 0x0301, 0x1F45},
+	{0x1F48, 0x0300, 0x1F4A},
+	{0x1F48, 0x0301, 0x1F4C},
+	{0x1F49, 0x0300, 0x1F4B},
+	{0x1F49, 0x0301, 0x1F4D},
+	{0x1F50, 0x0300, 0x1F52},
+	{0x1F50, 0x0301, 0x1F54},
+	{0x1F50, 0x0342, 0x1F56},
+	{0x1F51, 0x0300, 0x1F53},
+	{0x1F51, 0x0301, 0x1F55},
+	{0x1F51, 0x0342, 0x1F57},
+	{0x1F59, 0x0300, 0x1F5B},
+	{0x1F59, 0x0301, 0x1F5D},
+	{0x1F59, 0x0342, 0x1F5F},
+	{0x1F60, 0x0300, 0x1F62},
+	{0x1F60, 0x0301, 0x1F64},
+	{0x1F60, 0x0342, 0x1F66},
+	{0x1F60, 0x0345, 0x1FA0},
+	{0x1F61, 0x0300, 0x1F63},
+	{0x1F61, 0x0301, 0x1F65},
+	{0x1F61, 0x0342, 0x1F67},
+	{0x1F61, 0x0345, 0x1FA1},
+	{0x1F62, 0x0345, 0x1FA2},
+	{0x1F63, 0x0345, 0x1FA3},
+	{0x1F64, 0x0345, 0x1FA4},
+	{0x1F65, 0x0345, 0x1FA5},
+	{0x1F66, 0x0345, 0x1FA6},
+	{0x1F67, 0x0345, 0x1FA7},
+	{0x1F68, 0x0300, 0x1F6A},
+	{0x1F68, 0x0301, 0x1F6C},
+	{0x1F68, 0x0342, 0x1F6E},
+	{0x1F68, 0x0345, 0x1FA8},
+	{0x1F69, 0x0300, 0x1F6B},
+	{0x1F69, 0x0301, 0x1F6D},
+	{0x1F69, 0x0342, 0x1F6F},
+	{0x1F69, 0x0345, 0x1FA9},
+	{0x1F6A, 0x0345, 0x1FAA},
+	{0x1F6B, 0x0345, 0x1FAB},
+	{0x1F6C, 0x0345, 0x1FAC},
+	{0x1F6D, 0x0345, 0x1FAD},
+	{0x1F6E, 0x0345, 0x1FAE},
+	{0x1F6F, 0x0345, 0x1FAF},
+	{0x1F70, 0x0345, 0x1FB2},
+	{0x1F74, 0x0345, 0x1FC2},
+	{0x1F7C, 0x0345, 0x1FF2},
+	{0x1FB6, 0x0345, 0x1FB7},
+	{0x1FBF, 0x0300, 0x1FCD},
+	{0x1FBF, 0x0301, 0x1FCE},
+	{0x1FBF, 0x0342, 0x1FCF},
+	{0x1FC6, 0x0345, 0x1FC7},
+	{0x1FF6, 0x0345, 0x1FF7},
+	{0x1FFE, 0x0300, 0x1FDD},
+	{0x1FFE, 0x0301, 0x1FDE},
+	{0x1FFE, 0x0342, 0x1FDF},
+	{0x2190, 0x0338, 0x219A},
+	{0x2192, 0x0338, 0x219B},
+	{0x2194, 0x0338, 0x21AE},
+	{0x21D0, 0x0338, 0x21CD},
+	{0x21D2, 0x0338, 0x21CF},
+	{0x21D4, 0x0338, 0x21CE},
+	{0x2203, 0x0338, 0x2204},
+	{0x2208, 0x0338, 0x2209},
+	{0x220B, 0x0338, 0x220C},
+	{0x2223, 0x0338, 0x2224},
+	{0x2225, 0x0338, 0x2226},
+	{0x223C, 0x0338, 0x2241},
+	{0x2243, 0x0338, 0x2244},
+	{0x2245, 0x0338, 0x2247},
+	{0x2248, 0x0338, 0x2249},
+	{0x224D, 0x0338, 0x226D},
+	{0x2261, 0x0338, 0x2262},
+	{0x2264, 0x0338, 0x2270},
+	{0x2265, 0x0338, 0x2271},
+	{0x2272, 0x0338, 0x2274},
+	{0x2273, 0x0338, 0x2275},
+	{0x2276, 0x0338, 0x2278},
+	{0x2277, 0x0338, 0x2279},
+	{0x227A, 0x0338, 0x2280},
+	{0x227B, 0x0338, 0x2281},
+	{0x227C, 0x0338, 0x22E0},
+	{0x227D, 0x0338, 0x22E1},
+	{0x2282, 0x0338, 0x2284},
+	{0x2283, 0x0338, 0x2285},
+	{0x2286, 0x0338, 0x2288},
+	{0x2287, 0x0338, 0x2289},
+	{0x2291, 0x0338, 0x22E2},
+	{0x2292, 0x0338, 0x22E3},
+	{0x22A2, 0x0338, 0x22AC},
+	{0x22A8, 0x0338, 0x22AD},
+	{0x22A9, 0x0338, 0x22AE},
+	{0x22AB, 0x0338, 0x22AF},
+	{0x22B2, 0x0338, 0x22EA},
+	{0x22B3, 0x0338, 0x22EB},
+	{0x22B4, 0x0338, 0x22EC},
+	{0x22B5, 0x0338, 0x22ED},
+	{0x3046, 0x3099, 0x3094},
+	{0x304B, 0x3099, 0x304C},
+	{0x304D, 0x3099, 0x304E},
+	{0x304F, 0x3099, 0x3050},
+	{0x3051, 0x3099, 0x3052},
+	{0x3053, 0x3099, 0x3054},
+	{0x3055, 0x3099, 0x3056},
+	{0x3057, 0x3099, 0x3058},
+	{0x3059, 0x3099, 0x305A},
+	{0x305B, 0x3099, 0x305C},
+	{0x305D, 0x3099, 0x305E},
+	{0x305F, 0x3099, 0x3060},
+	{0x3061, 0x3099, 0x3062},
+	{0x3064, 0x3099, 0x3065},
+	{0x3066, 0x3099, 0x3067},
+	{0x3068, 0x3099, 0x3069},
+	{0x306F, 0x3099, 0x3070},
+	{0x306F, 0x309A, 0x3071},
+	{0x3072, 0x3099, 0x3073},
+	{0x3072, 0x309A, 0x3074},
+	{0x3075, 0x3099, 0x3076},
+	{0x3075, 0x309A, 0x3077},
+	{0x3078, 0x3099, 0x3079},
+	{0x3078, 0x309A, 0x307A},
+	{0x307B, 0x3099, 0x307C},
+	{0x307B, 0x309A, 0x307D},
+	{0x309D, 0x3099, 0x309E},
+	{0x30A6, 0x3099, 0x30F4},
+	{0x30AB, 0x3099, 0x30AC},
+	{0x30AD, 0x3099, 0x30AE},
+	{0x30AF, 0x3099, 0x30B0},
+	{0x30B1, 0x3099, 0x30B2},
+	{0x30B3, 0x3099, 0x30B4},
+	{0x30B5, 0x3099, 0x30B6},
+	{0x30B7, 0x3099, 0x30B8},
+	{0x30B9, 0x3099, 0x30BA},
+	{0x30BB, 0x3099, 0x30BC},
+	{0x30BD, 0x3099, 0x30BE},
+	{0x30BF, 0x3099, 0x30C0},
+	{0x30C1, 0x3099, 0x30C2},
+	{0x30C4, 0x3099, 0x30C5},
+	{0x30C6, 0x3099, 0x30C7},
+	{0x30C8, 0x3099, 0x30C9},
+	{0x30CF, 0x3099, 0x30D0},
+	{0x30CF, 0x309A, 0x30D1},
+	{0x30D2, 0x3099, 0x30D3},
+	{0x30D2, 0x309A, 0x30D4},
+	{0x30D5, 0x3099, 0x30D6},
+	{0x30D5, 0x309A, 0x30D7},
+	{0x30D8, 0x3099, 0x30D9},
+	{0x30D8, 0x309A, 0x30DA},
+	{0x30DB, 0x3099, 0x30DC},
+	{0x30DB, 0x309A, 0x30DD},
+	{0x30EF, 0x3099, 0x30F7},
+	{0x30F0, 0x3099, 0x30F8},
+	{0x30F1, 0x3099, 0x30F9},
+	{0x30F2, 0x3099, 0x30FA},
+	{0x30FD, 0x3099, 0x30FE},
+	{0x11099, 0x110BA, 0x1109A},
+	{0x1109B, 0x110BA, 0x1109C},
+	{0x110A5, 0x110BA, 0x110AB},
+	{0x11131, 0x11127, 0x1112E},
+	{0x11132, 0x11127, 0x1112F},
+	{0x11347, 0x1133E, 0x1134B},
+	{0x11347, 0x11357, 0x1134C},
+	{0x114B9, 0x114B0, 0x114BC},
+	{0x114B9, 0x114BA, 0x114BB},
+	{0x114B9, 0x114BD, 0x114BE},
+	{0x115B8, 0x115AF, 0x115BA},
+	{0x115B9, 0x115AF, 0x115BB},
+	{0x11935, 0x11930, 0x11938},
+}
+
+// confusablesSkeletons are the ASCII skeletons of runes, as slices of
+// confusablesData
+var confusablesSkeletons = []runeMapping{
+	{0x00A0, 0, 1},
+	{0x00A8, 1, 1},
+	{0x00AA, 2, 1},
+	{0x00AD, 3, 0},
+	{0x00AF, 3, 1},
+	{0x00B2, 4, 1},
+	{0x00B3, 5, 1},
+	{0x00B4, 6, 1},
+	{0x00B8, 7, 1},
+	{0x00B9, 8, 1},
+	{0x00BA, 9, 1},
+	{0x00BC, 10, 3},
+	{0x00BD, 13, 3},
+	{0x00BE, 16, 3},
+	{0x00C0, 19, 1},
+	{0x00C1, 20, 1},
+	{0x00C2, 21, 1},
+	{0x00C3, 22, 1},
+	{0x00C4, 23, 1},
+	{0x00C5, 24, 1},
+	{0x00C6, 25, 2},
+	{0x00C7, 27, 1},
+	{0x00C8, 28, 1},
+	{0x00C9, 29, 1},
+	{0x00CA, 30, 1},
+	{0x00CB, 31, 1},
+	{0x00CC, 32, 1},
+	{0x00CD, 33, 1},
+	{0x00CE, 34, 1},
+	{0x00CF, 35, 1},
+	{0x00D1, 36, 1},
+	{0x00D2, 37, 1},
+	{0x00D3, 38, 1},
+	{0x00D4, 39, 1},
+	{0x00D5, 40, 1},
+	{0x00D6, 41, 1},
+	{0x00D7, 42, 1},
+	{0x00D9, 43, 1},
+	{0x00DA, 44, 1},
+	{0x00DB, 45, 1},
+	{0x00DC, 46, 1},
+	{0x00DD, 47, 1},
+	{0x00E0, 48, 1},
+	{0x00E1, 49, 1},
+	{0x00E2, 50, 1},
+	{0x00E3, 51, 1},
+	{0x00E4, 52, 1},
+	{0x00E5, 53, 1},
+	{0x00E6, 54, 2},
+	{0x00E7, 56, 1},
+	{0x00E8, 57, 1},
+	{0x00E9, 58, 1},
+	{0x00EA, 59, 1},
+	{0x00EB, 60, 1},
+	{0x00EC, 61, 1},
+	{0x00ED, 62, 1},
+	{0x00EE, 63, 1},
+	{0x00EF, 64, 1},
+	{0x00F1, 65, 1},
+	{0x00F2, 66, 1},
+	{0x00F3, 67, 1},
+	{0x00F4, 68, 1},
+	{0x00F5, 69, 1},
+	{0x00F6, 70, 1},
+	{0x00F9, 71, 1},
+	{0x00FA, 72, 1},
+	{0x00FB, 73, 1},
+	{0x00FC, 74, 1},
+	{0x00FD, 75, 1},
+	{0x00FF, 76, 1},
+	{0x0100, 77, 1},
+	{0x0101, 78, 1},
+	{0x0102, 79, 1},
+	{0x0103, 80, 1},
+	{0x0104, 81, 1},
+	{0x0105, 82, 1},
+	{0x0106, 83, 1},
+	{0x0107, 84, 1},
+	{0x0108, 85, 1},
+	{0x0109, 86, 1},
+	{0x010A, 87, 1},
+	{0x010B, 88, 1},
+	{0x010C, 89, 1},
+	{0x010D, 90, 1},
+	{0x010E, 91, 1},
+	{0x010F, 92, 1},
+	{0x0112, 93, 1},
+	{0x0113, 94, 1},
+	{0x0114, 95, 1},
+	{0x0115, 96, 1},
+	{0x0116, 97, 1},
+	{0x0117, 98, 1},
+	{0x0118, 99, 1},
+	{0x0119, 100, 1},
+	{0x011A, 101, 1},
+	{0x011B, 102, 1},
+	{0x011C, 103, 1},
+	{0x011D, 104, 1},
+	{0x011E, 105, 1},
+	{0x011F, 106, 1},
+	{0x0120, 107, 1},
+	{0x0121, 108, 1},
+	{0x0122, 109, 1},
+	{0x0123, 110, 1},
+	{0x0124, 111, 1},
+	{0x0125, 112, 1},
+	{0x0128, 113, 1},
+	{0x0129, 114, 1},
+	{0x012A, 115, 1},
+	{0x012B, 116, 1},
+	{0x012C, 117, 1},
+	{0x012D, 118, 1},
+	{0x012E, 119, 1},
+	{0x012F, 120, 1},
+	{0x0130, 121, 1},
+	{0x0131, 122, 1},
+	{0x0132, 123, 2},
+	{0x0133, 125, 2},
+	{0x0134, 127, 1},
+	{0x0135, 128, 1},
+	{0x0136, 129, 1},
+	{0x0137, 130, 1},
+	{0x0139, 131, 1},
+	{0x013A, 132, 1},
+	{0x013B, 133, 1},
+	{0x013C, 134, 1},
+	{0x013D, 135, 1},
+	{0x013E, 136, 1},
+	{0x0143, 137, 1},
+	{0x0144, 138, 1},
+	{0x0145, 139, 1},
+	{0x0146, 140, 1},
+	{0x0147, 141, 1},
+	{0x0148, 142, 1},
+	{0x0149, 143, 2},
+	{0x014C, 145, 1},
+	{0x014D, 146, 1},
+	{0x014E, 147, 1},
+	{0x014F, 148, 1},
+	{0x0150, 149, 1},
+	{0x0151, 150, 1},
+	{0x0152, 151, 2},
+	{0x0153, 153, 2},
+	{0x0154, 155, 1},
+	{0x0155, 156, 1},
+	{0x0156, 157, 1},
+	{0x0157, 158, 1},
+	{0x0158, 159, 1},
+	{0x0159, 160, 1},
+	{0x015A, 161, 1},
+	{0x015B, 162, 1},
+	{0x015C, 163, 1},
+	{0x015D, 164, 1},
+	{0x015E, 165, 1},
+	{0x015F, 166, 1},
+	{0x0160, 167, 1},
+	{0x0161, 168, 1},
+	{0x0162, 169, 1},
+	{0x0163, 170, 1},
+	{0x0164, 171, 1},
+	{0x0165, 172, 1},
+	{0x0168, 173, 1},
+	{0x0169, 174, 1},
+	{0x016A, 175, 1},
+	{0x016B, 176, 1},
+	{0x016C, 177, 1},
+	{0x016D, 178, 1},
+	{0x016E, 179, 1},
+	{0x016F, 180, 1},
+	{0x0170, 181, 1},
+	{0x0171, 182, 1},
+	{0x0172, 183, 1},
+	{0x0173, 184, 1},
+	{0x0174, 185, 1},
+	{0x0175, 186, 1},
+	{0x0176, 187, 1},
+	{0x0177, 188, 1},
+	{0x0178, 189, 1},
+	{0x0179, 190, 1},
+	{0x017A, 191, 1},
+	{0x017B, 192, 1},
+	{0x017C, 193, 1},
+	{0x017D, 194, 1},
+	{0x017E, 195, 1},
+	{0x017F, 196, 1},
+	{0x0181, 197, 2},
+	{0x0184, 199, 1},
+	{0x0187, 200, 2},
+	{0x018A, 202, 2},
+	{0x018D, 204, 1},
+	{0x0193, 205, 2},
+	{0x0196, 207, 1},
+	{0x0198, 208, 2},
+	{0x01A0, 210, 1},
+	{0x01A1, 211, 1},
+	{0x01A4, 212, 2},
+	{0x01A6, 214, 1},
+	{0x01A7, 215, 1},
+	{0x01AC, 216, 2},
+	{0x01AF, 218, 1},
+	{0x01B0, 219, 1},
+	{0x01B3, 220, 2},
+	{0x01B7, 222, 1},
+	{0x01BC, 223, 1},
+	{0x01BD, 224, 1},
+	{0x01C0, 225, 1},
+	{0x01C1, 226, 2},
+	{0x01C3, 228, 1},
+	{0x01C4, 229, 2},
+	{0x01C5, 231, 2},
+	{0x01C6, 233, 2},
+	{0x01C7, 235, 2},
+	{0x01C8, 237, 2},
+	{0x01C9, 239, 2},
+	{0x01CA, 241, 2},
+	{0x01CB, 243, 2},
+	{0x01CC, 245, 2},
+	{0x01CD, 247, 1},
+	{0x01CE, 248, 1},
+	{0x01CF, 249, 1},
+	{0x01D0, 250, 1},
+	{0x01D1, 251, 1},
+	{0x01D2, 252, 1},
+	{0x01D3, 253, 1},
+	{0x01D4, 254, 1},
+	{0x01D5, 255, 1},
+	{0x01D6, 256, 1},
+	{0x01D7, 257, 1},
+	{0x01D8, 258, 1},
+	{0x01D9, 259, 1},
+	{0x01DA, 260, 1},
+	{0x01DB, 261, 1},
+	{0x01DC, 262, 1},
+	{0x01DE, 263, 1},
+	{0x01DF, 264, 1},
+	{0x01E0, 265, 1},
+	{0x01E1, 266, 1},
+	{0x01E2, 267, 2},
+	{0x01E3, 269, 2},
+	{0x01E6, 271, 1},
+	{0x01E7, 272, 1},
+	{0x01E8, 273, 1},
+	{0x01E9, 274, 1},
+	{0x01EA, 275, 1},
+	{0x01EB, 276, 1},
+	{0x01EC, 277, 1},
+	{0x01ED, 278, 1},
+	{0x01EE, 279, 1},
+	{0x01F0, 280, 1},
+	{0x01F1, 281, 2},
+	{0x01F2, 283, 2},
+	{0x01F3, 285, 2},
+	{0x01F4, 287, 1},
+	{0x01F5, 288, 1},
+	{0x01F8, 289, 1},
+	{0x01F9, 290, 1},
+	{0x01FA, 291, 1},
+	{0x01FB, 292, 1},
+	{0x01FC, 293, 2},
+	{0x01FD, 295, 2},
+	{0x0200, 297, 1},
+	{0x0201, 298, 1},
+	{0x0202, 299, 1},
+	{0x0203, 300, 1},
+	{0x0204, 301, 1},
+	{0x0205, 302, 1},
+	{0x0206, 303, 1},
+	{0x0207, 304, 1},
+	{0x0208, 305, 1},
+	{0x0209, 306, 1},
+	{0x020A, 307, 1},
+	{0x020B, 308, 1},
+	{0x020C, 309, 1},
+	{0x020D, 310, 1},
+	{0x020E, 311, 1},
+	{0x020F, 312, 1},
+	{0x0210, 313, 1},
+	{0x0211, 314, 1},
+	{0x0212, 315, 1},
+	{0x0213, 316, 1},
+	{0x0214, 317, 1},
+	{0x0215, 318, 1},
+	{0x0216, 319, 1},
+	{0x0217, 320, 1},
+	{0x0218, 321, 1},
+	{0x0219, 322, 1},
+	{0x021A, 323, 1},
+	{0x021B, 324, 1},
+	{0x021C, 325, 1},
+	{0x021E, 326, 1},
+	{0x021F, 327, 1},
+	{0x0222, 328, 1},
+	{0x0223, 329, 1},
+	{0x0226, 330, 1},
+	{0x0227, 331, 1},
+	{0x0228, 332, 1},
+	{0x0229, 333, 1},
+	{0x022A, 334, 1},
+	{0x022B, 335, 1},
+	{0x022C, 336, 1},
+	{0x022D, 337, 1},
+	{0x022E, 338, 1},
+	{0x022F, 339, 1},
+	{0x0230, 340, 1},
+	{0x0231, 341, 1},
+	{0x0232, 342, 1},
+	{0x0233, 343, 1},
+	{0x0241, 344, 1},
+	{0x0251, 345, 1},
+	{0x0261, 346, 1},
+	{0x0263, 347, 1},
+	{0x0269, 348, 1},
+	{0x026A, 349, 1},
+	{0x026F, 350, 1},
+	{0x028B, 351, 1},
+	{0x028F, 352, 1},
+	{0x0294, 353, 1},
+	{0x02A3, 354, 2},
+	{0x02A6, 356, 2},
+	{0x02AA, 358, 2},
+	{0x02AB, 360, 2},
+	{0x02B0, 362, 1},
+	{0x02B2, 363, 1},
+	{0x02B3, 364, 1},
+	{0x02B7, 365, 1},
+	{0x02B8, 366, 1},
+	{0x02B9, 367, 1},
+	{0x02BA, 368, 2},
+	{0x02BB, 370, 1},
+	{0x02BC, 371, 1},
+	{0x02BD, 372, 1},
+	{0x02BE, 373, 1},
+	{0x02C2, 374, 1},
+	{0x02C3, 375, 1},
+	{0x02C4, 376, 1},
+	{0x02C6, 377, 1},
+	{0x02C8, 378, 1},
+	{0x02CA, 379, 1},
+	{0x02CB, 380, 1},
+	{0x02D0, 381, 1},
+	{0x02D7, 382, 1},
+	{0x02D8, 383, 1},
+	{0x02D9, 384, 1},
+	{0x02DA, 385, 1},
+	{0x02DB, 386, 1},
+	{0x02DC, 387, 1},
+	{0x02DD, 388, 2},
+	{0x02E0, 390, 1},
+	{0x02E1, 391, 1},
+	{0x02E2, 392, 1},
+	{0x02E3, 393, 1},
+	{0x02EE, 394, 2},
+	{0x02F4, 396, 1},
+	{0x02F6, 397, 2},
+	{0x02F8, 399, 1},
+	{0x0300, 400, 0},
+	{0x0301, 400, 0},
+	{0x0302, 400, 0},
+	{0x0303, 400, 0},
+	{0x0304, 400, 0},
+	{0x0305, 400, 0},
+	{0x0306, 400, 0},
+	{0x0307, 400, 0},
+	{0x0308, 400, 0},
+	{0x0309, 400, 0},
+	{0x030A, 400, 0},
+	{0x030B, 400, 0},
+	{0x030C, 400, 0},
+	{0x030D, 400, 0},
+	{0x030E, 400, 0},
+	{0x030F, 400, 0},
+	{0x0310, 400, 0},
+	{0x0311, 400, 0},
+	{0x0312, 400, 0},
+	{0x0313, 400, 0},
+	{0x0314, 400, 0},
+	{0x0315, 400, 0},
+	{0x0316, 400, 0},
+	{0x0317, 400, 0},
+	{0x0318, 400, 0},
+	{0x0319, 400, 0},
+	{0x031A, 400, 0},
+	{0x031B, 400, 0},
+	{0x031C, 400, 0},
+	{0x031D, 400, 0},
+	{0x031E, 400, 0},
+	{0x031F, 400, 0},
+	{0x0320, 400, 0},
+	{0x0321, 400, 0},
+	{0x0322, 400, 0},
+	{0x0323, 400, 0},
+	{0x0324, 400, 0},
+	{0x0325, 400, 0},
+	{0x0326, 400, 0},
+	{0x0327, 400, 0},
+	{0x0328, 400, 0},
+	{0x0329, 400, 0},
+	{0x032A, 400, 0},
+	{0x032B, 400, 0},
+	{0x032C, 400, 0},
+	{0x032D, 400, 0},
+	{0x032E, 400, 0},
+	{0x032F, 400, 0},
+	{0x0330, 400, 0},
+	{0x0331, 400, 0},
+	{0x0332, 400, 0},
+	{0x0333, 400, 0},
+	{0x0334, 400, 0},
+	{0x0335, 400, 0},
+	{0x0336, 400, 0},
+	{0x0337, 400, 0},
+	{0x0338, 400, 0},
+	{0x0339, 400, 0},
+	{0x033A, 400, 0},
+	{0x033B, 400, 0},
+	{0x033C, 400, 0},
+	{0x033D, 400, 0},
+	{0x033E, 400, 0},
+	{0x033F, 400, 0},
+	{0x0340, 400, 0},
+	{0x0341, 400, 0},
+	{0x0342, 400, 0},
+	{0x0343, 400, 0},
+	{0x0344, 400, 0},
+	{0x0345, 400, 0},
+	{0x0346, 400, 0},
+	{0x0347, 400, 0},
+	{0x0348, 400, 0},
+	{0x0349, 400, 0},
+	{0x034A, 400, 0},
+	{0x034B, 400, 0},
+	{0x034C, 400, 0},
+	{0x034D, 400, 0},
+	{0x034E, 400, 0},
+	{0x034F, 400, 0},
+	{0x0350, 400, 0},
+	{0x0351, 400, 0},
+	{0x0352, 400, 0},
+	{0x0353, 400, 0},
+	{0x0354, 400, 0},
+	{0x0355, 400, 0},
+	{0x0356, 400, 0},
+	{0x0357, 400, 0},
+	{0x0358, 400, 0},
+	{0x0359, 400, 0},
+	{0x035A, 400, 0},
+	{0x035B, 400, 0},
+	{0x035C, 400, 0},
+	{0x035D, 400, 0},
+	{0x035E, 400, 0},
+	{0x035F, 400, 0},
+	{0x0360, 400, 0},
+	{0x0361, 400, 0},
+	{0x0362, 400, 0},
+	{0x0363, 400, 0},
+	{0x0364, 400, 0},
+	{0x0365, 400, 0},
+	{0x0366, 400, 0},
+	{0x0367, 400, 0},
+	{0x0368, 400, 0},
+	{0x0369, 400, 0},
+	{0x036A, 400, 0},
+	{0x036B, 400, 0},
+	{0x036C, 400, 0},
+	{0x036D, 400, 0},
+	{0x036E, 400, 0},
+	{0x036F, 400, 0},
+	{0x0374, 400, 1},
+	{0x037A, 401, 1},
+	{0x037E, 402, 1},
+	{0x037F, 403, 1},
+	{0x0384, 404, 1},
+	{0x0385, 405, 1},
+	{0x0386, 406, 1},
+	{0x0388, 407, 1},
+	{0x0389, 408, 1},
+	{0x038A, 409, 1},
+	{0x038C, 410, 1},
+	{0x038E, 411, 1},
+	{0x0390, 412, 1},
+	{0x0391, 413, 1},
+	{0x0392, 414, 1},
+	{0x0395, 415, 1},
+	{0x0396, 416, 1},
+	{0x0397, 417, 1},
+	{0x0399, 418, 1},
+	{0x039A, 419, 1},
+	{0x039C, 420, 1},
+	{0x039D, 421, 1},
+	{0x039F, 422, 1},
+	{0x03A1, 423, 1},
+	{0x03A4, 424, 1},
+	{0x03A5, 425, 1},
+	{0x03A7, 426, 1},
+	{0x03AA, 427, 1},
+	{0x03AB, 428, 1},
+	{0x03AC, 429, 1},
+	{0x03AF, 430, 1},
+	{0x03B0, 431, 1},
+	{0x03B1, 432, 1},
+	{0x03B3, 433, 1},
+	{0x03B9, 434, 1},
+	{0x03BD, 435, 1},
+	{0x03BF, 436, 1},
+	{0x03C1, 437, 1},
+	{0x03C3, 438, 1},
+	{0x03C5, 439, 1},
+	{0x03CA, 440, 1},
+	{0x03CB, 441, 1},
+	{0x03CC, 442, 1},
+	{0x03CD, 443, 1},
+	{0x03D2, 444, 1},
+	{0x03D3, 445, 1},
+	{0x03D4, 446, 1},
+	{0x03DC, 447, 1},
+	{0x03E8, 448, 1},
+	{0x03F1, 449, 1},
+	{0x03F2, 450, 1},
+	{0x03F3, 451, 1},
+	{0x03F9, 452, 1},
+	{0x03FA, 453, 1},
+	{0x0400, 454, 1},
+	{0x0401, 455, 1},
+	{0x0405, 456, 1},
+	{0x0406, 457, 1},
+	{0x0407, 458, 1},
+	{0x0408, 459, 1},
+	{0x040C, 460, 1},
+	{0x040E, 461, 1},
+	{0x0410, 462, 1},
+	{0x0412, 463, 1},
+	{0x0415, 464, 1},
+	{0x0417, 465, 1},
+	{0x041A, 466, 1},
+	{0x041C, 467, 1},
+	{0x041D, 468, 1},
+	{0x041E, 469, 1},
+	{0x0420, 470, 1},
+	{0x0421, 471, 1},
+	{0x0422, 472, 1},
+	{0x0423, 473, 1},
+	{0x0425, 474, 1},
+	{0x042B, 475, 2},
+	{0x042C, 477, 1},
+	{0x042E, 478, 2},
+	{0x0430, 480, 1},
+	{0x0431, 481, 1},
+	{0x0433, 482, 1},
+	{0x0435, 483, 1},
+	{0x043E, 484, 1},
+	{0x0440, 485, 1},
+	{0x0441, 486, 1},
+	{0x0443, 487, 1},
+	{0x0445, 488, 1},
+	{0x0450, 489, 1},
+	{0x0451, 490, 1},
+	{0x0453, 491, 1},
+	{0x0455, 492, 1},
+	{0x0456, 493, 1},
+	{0x0457, 494, 1},
+	{0x0458, 495, 1},
+	{0x045E, 496, 1},
+	{0x0461, 497, 1},
+	{0x0474, 498, 1},
+	{0x0475, 499, 1},
+	{0x0476, 500, 1},
+	{0x0477, 501, 1},
+	{0x0483, 502, 0},
+	{0x0484, 502, 0},
+	{0x0485, 502, 0},
+	{0x0486, 502, 0},
+	{0x0487, 502, 0},
+	{0x0488, 502, 0},
+	{0x0489, 502, 0},
+	{0x0491, 502, 2},
+	{0x04AE, 504, 1},
+	{0x04AF, 505, 1},
+	{0x04BB, 506, 1},
+	{0x04BD, 507, 1},
+	{0x04C0, 508, 1},
+	{0x04CF, 509, 1},
+	{0x04D0, 510, 1},
+	{0x04D1, 511, 1},
+	{0x04D2, 512, 1},
+	{0x04D3, 513, 1},
+	{0x04D4, 514, 2},
+	{0x04D5, 516, 2},
+	{0x04D6, 518, 1},
+	{0x04D7, 519, 1},
+	{0x04DE, 520, 1},
+	{0x04E0, 521, 1},
+	{0x04E6, 522, 1},
+	{0x04E7, 523, 1},
+	{0x04EE, 524, 1},
+	{0x04EF, 525, 1},
+	{0x04F0, 526, 1},
+	{0x04F1, 527, 1},
+	{0x04F2, 528, 1},
+	{0x04F3, 529, 1},
+	{0x04F8, 530, 2},
+	{0x0501, 532, 1},
+	{0x050C, 533, 1},
+	{0x051B, 534, 1},
+	{0x051C, 535, 1},
+	{0x051D, 536, 1},
+	{0x054D, 537, 1},
+	{0x054F, 538, 1},
+	{0x0555, 539, 1},
+	{0x055A, 540, 1},
+	{0x055D, 541, 1},
+	{0x0561, 542, 1},
+	{0x0563, 543, 1},
+	{0x0566, 544, 1},
+	{0x0570, 545, 1},
+	{0x0578, 546, 1},
+	{0x057C, 547, 1},
+	{0x057D, 548, 1},
+	{0x0581, 549, 1},
+	{0x0584, 550, 1},
+	{0x0585, 551, 1},
+	{0x0589, 552, 1},
+	{0x0591, 553, 0},
+	{0x0592, 553, 0},
+	{0x0593, 553, 0},
+	{0x0594, 553, 0},
+	{0x0595, 553, 0},
+	{0x0596, 553, 0},
+	{0x0597, 553, 0},
+	{0x0598, 553, 0},
+	{0x0599, 553, 0},
+	{0x059A, 553, 0},
+	{0x059B, 553, 0},
+	{0x059C, 553, 0},
+	{0x059D, 553, 0},
+	{0x059E, 553, 0},
+	{0x059F, 553, 0},
+	{0x05A0, 553, 0},
+	{0x05A1, 553, 0},
+	{0x05A2, 553, 0},
+	{0x05A3, 553, 0},
+	{0x05A4, 553, 0},
+	{0x05A5, 553, 0},
+	{0x05A6, 553, 0},
+	{0x05A7, 553, 0},
+	{0x05A8, 553, 0},
+	{0x05A9, 553, 0},
+	{0x05AA, 553, 0},
+	{0x05AB, 553, 0},
+	{0x05AC, 553, 0},
+	{0x05AD, 553, 0},
+	{0x05AE, 553, 0},
+	{0x05AF, 553, 0},
+	{0x05B0, 553, 0},
+	{0x05B1, 553, 0},
+	{0x05B2, 553, 0},
+	{0x05B3, 553, 0},
+	{0x05B4, 553, 0},
+	{0x05B5, 553, 0},
+	{0x05B6, 553, 0},
+	{0x05B7, 553, 0},
+	{0x05B8, 553, 0},
+	{0x05B9, 553, 0},
+	{0x05BA, 553, 0},
+	{0x05BB, 553, 0},
+	{0x05BC, 553, 0},
+	{0x05BD, 553, 0},
+	{0x05BF, 553, 0},
+	{0x05C0, 553, 1},
+	{0x05C1, 554, 0},
+	{0x05C2, 554, 0},
+	{0x05C3, 554, 1},
+	{0x05C4, 555, 0},
+	{0x05C5, 555, 0},
+	{0x05C7, 555, 0},
+	{0x05D5, 555, 1},
+	{0x05D8, 556, 1},
+	{0x05D9, 557, 1},
+	{0x05DF, 558, 1},
+	{0x05E1, 559, 1},
+	{0x05F0, 560, 2},
+	{0x05F1, 562, 2},
+	{0x05F2, 564, 2},
+	{0x05F3, 566, 1},
+	{0x05F4, 567, 2},
+	{0x0600, 569, 0},
+	{0x0601, 569, 0},
+	{0x0602, 569, 0},
+	{0x0603, 569, 0},
+	{0x0604, 569, 0},
+	{0x0605, 569, 0},
+	{0x060D, 569, 1},
+	{0x0610, 570, 0},
+	{0x0611, 570, 0},
+	{0x0612, 570, 0},
+	{0x0613, 570, 0},
+	{0x0614, 570, 0},
+	{0x0615, 570, 0},
+	{0x0616, 570, 0},
+	{0x0617, 570, 0},
+	{0x0618, 570, 0},
+	{0x0619, 570, 0},
+	{0x061A, 570, 0},
+	{0x061C, 570, 0},
+	{0x0622, 570, 1},
+	{0x0623, 571, 1},
+	{0x0625, 572, 1},
+	{0x0627, 573, 1},
+	{0x0647, 574, 1},
+	{0x064B, 575, 0},
+	{0x064C, 575, 0},
+	{0x064D, 575, 0},
+	{0x064E, 575, 0},
+	{0x064F, 575, 0},
+	{0x0650, 575, 0},
+	{0x0651, 575, 0},
+	{0x0652, 575, 0},
+	{0x0653, 575, 0},
+	{0x0654, 575, 0},
+	{0x0655, 575, 0},
+	{0x0656, 575, 0},
+	{0x0657, 575, 0},
+	{0x0658, 575, 0},
+	{0x0659, 575, 0},
+	{0x065A, 575, 0},
+	{0x065B, 575, 0},
+	{0x065C, 575, 0},
+	{0x065D, 575, 0},
+	{0x065E, 575, 0},
+	{0x065F, 575, 0},
+	{0x0660, 575, 1},
+	{0x0661, 576, 1},
+	{0x0665, 577, 1},
+	{0x0667, 578, 1},
+	{0x066B, 579, 1},
+	{0x066D, 580, 1},
+	{0x0670, 581, 0},
+	{0x06BE, 581, 1},
+	{0x06C0, 582, 1},
+	{0x06C1, 583, 1},
+	{0x06C2, 584, 1},
+	{0x06D4, 585, 1},
+	{0x06D5, 586, 1},
+	{0x06D6, 587, 0},
+	{0x06D7, 587, 0},
+	{0x06D8, 587, 0},
+	{0x06D9, 587, 0},
+	{0x06DA, 587, 0},
+	{0x06DB, 587, 0},
+	{0x06DC, 587, 0},
+	{0x06DD, 587, 0},
+	{0x06DF, 587, 0},
+	{0x06E0, 587, 0},
+	{0x06E1, 587, 0},
+	{0x06E2, 587, 0},
+	{0x06E3, 587, 0},
+	{0x06E4, 587, 0},
+	{0x06E7, 587, 0},
+	{0x06E8, 587, 0},
+	{0x06EA, 587, 0},
+	{0x06EB, 587, 0},
+	{0x06EC, 587, 0},
+	{0x06ED, 587, 0},
+	{0x06F0, 587, 1},
+	{0x06F1, 588, 1},
+	{0x06F5, 589, 1},
+	{0x06F7, 590, 1},
+	{0x0701, 591, 1},
+	{0x0702, 592, 1},
+	{0x0703, 593, 1},
+	{0x0704, 594, 1},
+	{0x070F, 595, 0},
+	{0x0711, 595, 0},
+	{0x0730, 595, 0},
+	{0x0731, 595, 0},
+	{0x0732, 595, 0},
+	{0x0733, 595, 0},
+	{0x0734, 595, 0},
+	{0x0735, 595, 0},
+	{0x0736, 595, 0},
+	{0x0737, 595, 0},
+	{0x0738, 595, 0},
+	{0x0739, 595, 0},
+	{0x073A, 595, 0},
+	{0x073B, 595, 0},
+	{0x073C, 595, 0},
+	{0x073D, 595, 0},
+	{0x073E, 595, 0},
+	{0x073F, 595, 0},
+	{0x0740, 595, 0},
+	{0x0741, 595, 0},
+	{0x0742, 595, 0},
+	{0x0743, 595, 0},
+	{0x0744, 595, 0},
+	{0x0745, 595, 0},
+	{0x0746, 595, 0},
+	{0x0747, 595, 0},
+	{0x0748, 595, 0},
+	{0x0749, 595, 0},
+	{0x074A, 595, 0},
+	{0x07A6, 595, 0},
+	{0x07A7, 595, 0},
+	{0x07A8, 595, 0},
+	{0x07A9, 595, 0},
+	{0x07AA, 595, 0},
+	{0x07AB, 595, 0},
+	{0x07AC, 595, 0},
+	{0x07AD, 595, 0},
+	{0x07AE, 595, 0},
+	{0x07AF, 595, 0},
+	{0x07B0, 595, 0},
+	{0x07C0, 595, 1},
+	{0x07CA, 596, 1},
+	{0x07EB, 597, 0},
+	{0x07EC, 597, 0},
+	{0x07ED, 597, 0},
+	{0x07EE, 597, 0},
+	{0x07EF, 597, 0},
+	{0x07F0, 597, 0},
+	{0x07F1, 597, 0},
+	{0x07F2, 597, 0},
+	{0x07F3, 597, 0},
+	{0x07F4, 597, 1},
+	{0x07F5, 598, 1},
+	{0x07FA, 599, 1},
+	{0x07FD, 600, 0},
+	{0x0816, 600, 0},
+	{0x0817, 600, 0},
+	{0x0818, 600, 0},
+	{0x0819, 600, 0},
+	{0x081B, 600, 0},
+	{0x081C, 600, 0},
+	{0x081D, 600, 0},
+	{0x081E, 600, 0},
+	{0x081F, 600, 0},
+	{0x0820, 600, 0},
+	{0x0821, 600, 0},
+	{0x0822, 600, 0},
+	{0x0823, 600, 0},
+	{0x0825, 600, 0},
+	{0x0826, 600, 0},
+	{0x0827, 600, 0},
+	{0x0829, 600, 0},
+	{0x082A, 600, 0},
+	{0x082B, 600, 0},
+	{0x082C, 600, 0},
+	{0x082D, 600, 0},
+	{0x0859, 600, 0},
+	{0x085A, 600, 0},
+	{0x085B, 600, 0},
+	{0x0890, 600, 0},
+	{0x0891, 600, 0},
+	{0x0897, 600, 0},
+	{0x0898, 600, 0},
+	{0x0899, 600, 0},
+	{0x089A, 600, 0},
+	{0x089B, 600, 0},
+	{0x089C, 600, 0},
+	{0x089D, 600, 0},
+	{0x089E, 600, 0},
+	{0x089F, 600, 0},
+	{0x08CA, 600, 0},
+	{0x08CB, 600, 0},
+	{0x08CC, 600, 0},
+	{0x08CD, 600, 0},
+	{0x08CE, 600, 0},
+	{0x08CF, 600, 0},
+	{0x08D0, 600, 0},
+	{0x08D1, 600, 0},
+	{0x08D2, 600, 0},
+	{0x08D3, 600, 0},
+	{0x08D4, 600, 0},
+	{0x08D5, 600, 0},
+	{0x08D6, 600, 0},
+	{0x08D7, 600, 0},
+	{0x08D8, 600, 0},
+	{0x08D9, 600, 0},
+	{0x08DA, 600, 0},
+	{0x08DB, 600, 0},
+	{0x08DC, 600, 0},
+	{0x08DD, 600, 0},
+	{0x08DE, 600, 0},
+	{0x08DF, 600, 0},
+	{0x08E0, 600, 0},
+	{0x08E1, 600, 0},
+	{0x08E2, 600, 0},
+	{0x08E3, 600, 0},
+	{0x08E4, 600, 0},
+	{0x08E5, 600, 0},
+	{0x08E6, 600, 0},
+	{0x08E7, 600, 0},
+	{0x08E8, 600, 0},
+	{0x08E9, 600, 0},
+	{0x08EA, 600, 0},
+	{0x08EB, 600, 0},
+	{0x08EC, 600, 0},
+	{0x08ED, 600, 0},
+	{0x08EE, 600, 0},
+	{0x08EF, 600, 0},
+	{0x08F0, 600, 0},
+	{0x08F1, 600, 0},
+	{0x08F2, 600, 0},
+	{0x08F3, 600, 0},
+	{0x08F4, 600, 0},
+	{0x08F5, 600, 0},
+	{0x08F6, 600, 0},
+	{0x08F7, 600, 0},
+	{0x08F8, 600, 0},
+	{0x08F9, 600, 0},
+	{0x08FA, 600, 0},
+	{0x08FB, 600, 0},
+	{0x08FC, 600, 0},
+	{0x08FD, 600, 0},
+	{0x08FE, 600, 0},
+	{0x08FF, 600, 0},
+	{0x0900, 600, 0},
+	{0x0901, 600, 0},
+	{0x0902, 600, 0},
+	{0x0903, 600, 1},
+	{0x093A, 601, 0},
+	{0x093C, 601, 0},
+	{0x0941, 601, 0},
+	{0x0942, 601, 0},
+	{0x0943, 601, 0},
+	{0x0944, 601, 0},
+	{0x0945, 601, 0},
+	{0x0946, 601, 0},
+	{0x0947, 601, 0},
+	{0x0948, 601, 0},
+	{0x094D, 601, 0},
+	{0x0951, 601, 0},
+	{0x0952, 601, 0},
+	{0x0953, 601, 0},
+	{0x0954, 601, 0},
+	{0x0955, 601, 0},
+	{0x0956, 601, 0},
+	{0x0957, 601, 0},
+	{0x0962, 601, 0},
+	{0x0963, 601, 0},
+	{0x0966, 601, 1},
+	{0x097D, 602, 1},
+	{0x0981, 603, 0},
+	{0x09BC, 603, 0},
+	{0x09C1, 603, 0},
+	{0x09C2, 603, 0},
+	{0x09C3, 603, 0},
+	{0x09C4, 603, 0},
+	{0x09CD, 603, 0},
+	{0x09E2, 603, 0},
+	{0x09E3, 603, 0},
+	{0x09E6, 603, 1},
+	{0x09EA, 604, 1},
+	{0x09ED, 605, 1},
+	{0x09FE, 606, 0},
+	{0x0A01, 606, 0},
+	{0x0A02, 606, 0},
+	{0x0A3C, 606, 0},
+	{0x0A41, 606, 0},
+	{0x0A42, 606, 0},
+	{0x0A47, 606, 0},
+	{0x0A48, 606, 0},
+	{0x0A4B, 606, 0},
+	{0x0A4C, 606, 0},
+	{0x0A4D, 606, 0},
+	{0x0A51, 606, 0},
+	{0x0A66, 606, 1},
+	{0x0A67, 607, 1},
+	{0x0A6A, 608, 1},
+	{0x0A70, 609, 0},
+	{0x0A71, 609, 0},
+	{0x0A75, 609, 0},
+	{0x0A81, 609, 0},
+	{0x0A82, 609, 0},
+	{0x0A83, 609, 1},
+	{0x0ABC, 610, 0},
+	{0x0AC1, 610, 0},
+	{0x0AC2, 610, 0},
+	{0x0AC3, 610, 0},
+	{0x0AC4, 610, 0},
+	{0x0AC5, 610, 0},
+	{0x0AC7, 610, 0},
+	{0x0AC8, 610, 0},
+	{0x0ACD, 610, 0},
+	{0x0AE2, 610, 0},
+	{0x0AE3, 610, 0},
+	{0x0AE6, 610, 1},
+	{0x0AFA, 611, 0},
+	{0x0AFB, 611, 0},
+	{0x0AFC, 611, 0},
+	{0x0AFD, 611, 0},
+	{0x0AFE, 611, 0},
+	{0x0AFF, 611, 0},
+	{0x0B01, 611, 0},
+	{0x0B03, 611, 1},
+	{0x0B20, 612, 1},
+	{0x0B3C, 613, 0},
+	{0x0B3F, 613, 0},
+	{0x0B41, 613, 0},
+	{0x0B42, 613, 0},
+	{0x0B43, 613, 0},
+	{0x0B44, 613, 0},
+	{0x0B4D, 613, 0},
+	{0x0B55, 613, 0},
+	{0x0B56, 613, 0},
+	{0x0B62, 613, 0},
+	{0x0B63, 613, 0},
+	{0x0B66, 613, 1},
+	{0x0B68, 614, 1},
+	{0x0B82, 615, 0},
+	{0x0BC0, 615, 0},
+	{0x0BCD, 615, 0},
+	{0x0BE6, 615, 1},
+	{0x0C00, 616, 0},
+	{0x0C02, 616, 1},
+	{0x0C04, 617, 0},
+	{0x0C3C, 617, 0},
+	{0x0C3E, 617, 0},
+	{0x0C3F, 617, 0},
+	{0x0C40, 617, 0},
+	{0x0C46, 617, 0},
+	{0x0C47, 617, 0},
+	{0x0C48, 617, 0},
+	{0x0C4A, 617, 0},
+	{0x0C4B, 617, 0},
+	{0x0C4C, 617, 0},
+	{0x0C4D, 617, 0},
+	{0x0C55, 617, 0},
+	{0x0C56, 617, 0},
+	{0x0C62, 617, 0},
+	{0x0C63, 617, 0},
+	{0x0C66, 617, 1},
+	{0x0C81, 618, 0},
+	{0x0C82, 618, 1},
+	{0x0CBC, 619, 0},
+	{0x0CBF, 619, 0},
+	{0x0CC6, 619, 0},
+	{0x0CCC, 619, 0},
+	{0x0CCD, 619, 0},
+	{0x0CE2, 619, 0},
+	{0x0CE3, 619, 0},
+	{0x0CE6, 619, 1},
+	{0x0D00, 620, 0},
+	{0x0D01, 620, 0},
+	{0x0D02, 620, 1},
+	{0x0D20, 621, 1},
+	{0x0D3B, 622, 0},
+	{0x0D3C, 622, 0},
+	{0x0D41, 622, 0},
+	{0x0D42, 622, 0},
+	{0x0D43, 622, 0},
+	{0x0D44, 622, 0},
+	{0x0D4D, 622, 0},
+	{0x0D62, 622, 0},
+	{0x0D63, 622, 0},
+	{0x0D66, 622, 1},
+	{0x0D6D, 623, 1},
+	{0x0D81, 624, 0},
+	{0x0D82, 624, 1},
+	{0x0DCA, 625, 0},
+	{0x0DD2, 625, 0},
+	{0x0DD3, 625, 0},
+	{0x0DD4, 625, 0},
+	{0x0DD6, 625, 0},
+	{0x0E31, 625, 0},
+	{0x0E34, 625, 0},
+	{0x0E35, 625, 0},
+	{0x0E36, 625, 0},
+	{0x0E37, 625, 0},
+	{0x0E38, 625, 0},
+	{0x0E39, 625, 0},
+	{0x0E3A, 625, 0},
+	{0x0E47, 625, 0},
+	{0x0E48, 625, 0},
+	{0x0E49, 625, 0},
+	{0x0E4A, 625, 0},
+	{0x0E4B, 625, 0},
+	{0x0E4C, 625, 0},
+	{0x0E4D, 625, 0},
+	{0x0E4E, 625, 0},
+	{0x0E50, 625, 1},
+	{0x0EB1, 626, 0},
+	{0x0EB4, 626, 0},
+	{0x0EB5, 626, 0},
+	{0x0EB6, 626, 0},
+	{0x0EB7, 626, 0},
+	{0x0EB8, 626, 0},
+	{0x0EB9, 626, 0},
+	{0x0EBA, 626, 0},
+	{0x0EBB, 626, 0},
+	{0x0EBC, 626, 0},
+	{0x0EC8, 626, 0},
+	{0x0EC9, 626, 0},
+	{0x0ECA, 626, 0},
+	{0x0ECB, 626, 0},
+	{0x0ECC, 626, 0},
+	{0x0ECD, 626, 0},
+	{0x0ECE, 626, 0},
+	{0x0ED0, 626, 1},
+	{0x0F18, 627, 0},
+	{0x0F19, 627, 0},
+	{0x0F35, 627, 0},
+	{0x0F37, 627, 0},
+	{0x0F39, 627, 0},
+	{0x0F71, 627, 0},
+	{0x0F72, 627, 0},
+	{0x0F73, 627, 0},
+	{0x0F74, 627, 0},
+	{0x0F75, 627, 0},
+	{0x0F76, 627, 0},
+	{0x0F77, 627, 0},
+	{0x0F78, 627, 0},
+	{0x0F79, 627, 0},
+	{0x0F7A, 627, 0},
+	{0x0F7B, 627, 0},
+	{0x0F7C, 627, 0},
+	{0x0F7D, 627, 0},
+	{0x0F7E, 627, 0},
+	{0x0F80, 627, 0},
+	{0x0F81, 627, 0},
+	{0x0F82, 627, 0},
+	{0x0F83, 627, 0},
+	{0x0F84, 627, 0},
+	{0x0F86, 627, 0},
+	{0x0F87, 627, 0},
+	{0x0F8D, 627, 0},
+	{0x0F8E, 627, 0},
+	{0x0F8F, 627, 0},
+	{0x0F90, 627, 0},
+	{0x0F91, 627, 0},
+	{0x0F92, 627, 0},
+	{0x0F93, 627, 0},
+	{0x0F94, 627, 0},
+	{0x0F95, 627, 0},
+	{0x0F96, 627, 0},
+	{0x0F97, 627, 0},
+	{0x0F99, 627, 0},
+	{0x0F9A, 627, 0},
+	{0x0F9B, 627, 0},
+	{0x0F9C, 627, 0},
+	{0x0F9D, 627, 0},
+	{0x0F9E, 627, 0},
+	{0x0F9F, 627, 0},
+	{0x0FA0, 627, 0},
+	{0x0FA1, 627, 0},
+	{0x0FA2, 627, 0},
+	{0x0FA3, 627, 0},
+	{0x0FA4, 627, 0},
+	{0x0FA5, 627, 0},
+	{0x0FA6, 627, 0},
+	{0x0FA7, 627, 0},
+	{0x0FA8, 627, 0},
+	{0x0FA9, 627, 0},
+	{0x0FAA, 627, 0},
+	{0x0FAB, 627, 0},
+	{0x0FAC, 627, 0},
+	{0x0FAD, 627, 0},
+	{0x0FAE, 627, 0},
+	{0x0FAF, 627, 0},
+	{0x0FB0, 627, 0},
+	{0x0FB1, 627, 0},
+	{0x0FB2, 627, 0},
+	{0x0FB3, 627, 0},
+	{0x0FB4, 627, 0},
+	{0x0FB5, 627, 0},
+	{0x0FB6, 627, 0},
+	{0x0FB7, 627, 0},
+	{0x0FB8, 627, 0},
+	{0x0FB9, 627, 0},
+	{0x0FBA, 627, 0},
+	{0x0FBB, 627, 0},
+	{0x0FBC, 627, 0},
+	{0x0FC6, 627, 0},
+	{0x101D, 627, 1},
+	{0x102D, 628, 0},
+	{0x102E, 628, 0},
+	{0x102F, 628, 0},
+	{0x1030, 628, 0},
+	{0x1032, 628, 0},
+	{0x1033, 628, 0},
+	{0x1034, 628, 0},
+	{0x1035, 628, 0},
+	{0x1036, 628, 0},
+	{0x1037, 628, 0},
+	{0x1039, 628, 0},
+	{0x103A, 628, 0},
+	{0x103D, 628, 0},
+	{0x103E, 628, 0},
+	{0x1040, 628, 1},
+	{0x1058, 629, 0},
+	{0x1059, 629, 0},
+	{0x105E, 629, 0},
+	{0x105F, 629, 0},
+	{0x1060, 629, 0},
+	{0x1071, 629, 0},
+	{0x1072, 629, 0},
+	{0x1073, 629, 0},
+	{0x1074, 629, 0},
+	{0x1082, 629, 0},
+	{0x1085, 629, 0},
+	{0x1086, 629, 0},
+	{0x108D, 629, 0},
+	{0x109D, 629, 0},
+	{0x10E7, 629, 1},
+	{0x10FF, 630, 1},
+	{0x1200, 631, 1},
+	{0x12D0, 632, 1},
+	{0x135D, 633, 0},
+	{0x135E, 633, 0},
+	{0x135F, 633, 0},
+	{0x13A0, 633, 1},
+	{0x13A1, 634, 1},
+	{0x13A2, 635, 1},
+	{0x13A4, 636, 2},
+	{0x13A5, 638, 1},
+	{0x13A9, 639, 1},
+	{0x13AA, 640, 1},
+	{0x13AB, 641, 1},
+	{0x13AC, 642, 1},
+	{0x13AE, 643, 1},
+	{0x13B3, 644, 1},
+	{0x13B7, 645, 1},
+	{0x13BB, 646, 1},
+	{0x13BD, 647, 1},
+	{0x13C0, 648, 1},
+	{0x13C2, 649, 1},
+	{0x13C3, 650, 1},
+	{0x13CE, 651, 1},
+	{0x13CF, 652, 1},
+	{0x13D2, 653, 1},
+	{0x13D4, 654, 1},
+	{0x13D5, 655, 1},
+	{0x13D9, 656, 1},
+	{0x13DA, 657, 1},
+	{0x13DE, 658, 1},
+	{0x13DF, 659, 1},
+	{0x13E2, 660, 1},
+	{0x13E6, 661, 1},
+	{0x13E7, 662, 1},
+	{0x13EE, 663, 1},
+	{0x13F3, 664, 1},
+	{0x13F4, 665, 1},
+	{0x1400, 666, 1},
+	{0x142F, 667, 1},
+	{0x1433, 668, 1},
+	{0x1438, 669, 1},
+	{0x144A, 670, 1},
+	{0x144C, 671, 1},
+	{0x1467, 672, 2},
+	{0x146D, 674, 1},
+	{0x146F, 675, 1},
+	{0x1472, 676, 1},
+	{0x1486, 677, 2},
+	{0x1487, 679, 2},
+	{0x1488, 681, 2},
+	{0x148D, 683, 1},
+	{0x14AA, 684, 1},
+	{0x14BF, 685, 1},
+	{0x1541, 686, 1},
+	{0x157C, 687, 1},
+	{0x157D, 688, 1},
+	{0x1587, 689, 1},
+	{0x15AF, 690, 1},
+	{0x15B4, 691, 1},
+	{0x15C5, 692, 1},
+	{0x15DE, 693, 1},
+	{0x15EA, 694, 1},
+	{0x15F0, 695, 1},
+	{0x15F7, 696, 1},
+	{0x166D, 697, 1},
+	{0x166E, 698, 1},
+	{0x1680, 699, 1},
+	{0x16B2, 700, 1},
+	{0x16B7, 701, 1},
+	{0x16C1, 702, 1},
+	{0x16CC, 703, 1},
+	{0x16D5, 704, 1},
+	{0x16D6, 705, 1},
+	{0x16EC, 706, 1},
+	{0x16ED, 707, 1},
+	{0x1712, 708, 0},
+	{0x1713, 708, 0},
+	{0x1714, 708, 0},
+	{0x1732, 708, 0},
+	{0x1733, 708, 0},
+	{0x1735, 708, 1},
+	{0x1752, 709, 0},
+	{0x1753, 709, 0},
+	{0x1772, 709, 0},
+	{0x1773, 709, 0},
+	{0x17B4, 709, 0},
+	{0x17B5, 709, 0},
+	{0x17B7, 709, 0},
+	{0x17B8, 709, 0},
+	{0x17B9, 709, 0},
+	{0x17BA, 709, 0},
+	{0x17BB, 709, 0},
+	{0x17BC, 709, 0},
+	{0x17BD, 709, 0},
+	{0x17C6, 709, 0},
+	{0x17C9, 709, 0},
+	{0x17CA, 709, 0},
+	{0x17CB, 709, 0},
+	{0x17CC, 709, 0},
+	{0x17CD, 709, 0},
+	{0x17CE, 709, 0},
+	{0x17CF, 709, 0},
+	{0x17D0, 709, 0},
+	{0x17D1, 709, 0},
+	{0x17D2, 709, 0},
+	{0x17D3, 709, 0},
+	{0x17DD, 709, 0},
+	{0x1803, 709, 1},
+	{0x1809, 710, 1},
+	{0x180B, 711, 0},
+	{0x180C, 711, 0},
+	{0x180D, 711, 0},
+	{0x180E, 711, 0},
+	{0x180F, 711, 0},
+	{0x1885, 711, 0},
+	{0x1886, 711, 0},
+	{0x18A9, 711, 0},
+	{0x1920, 711, 0},
+	{0x1921, 711, 0},
+	{0x1922, 711, 0},
+	{0x1927, 711, 0},
+	{0x1928, 711, 0},
+	{0x1932, 711, 0},
+	{0x1939, 711, 0},
+	{0x193A, 711, 0},
+	{0x193B, 711, 0},
+	{0x1A17, 711, 0},
+	{0x1A18, 711, 0},
+	{0x1A1B, 711, 0},
+	{0x1A56, 711, 0},
+	{0x1A58, 711, 0},
+	{0x1A59, 711, 0},
+	{0x1A5A, 711, 0},
+	{0x1A5B, 711, 0},
+	{0x1A5C, 711, 0},
+	{0x1A5D, 711, 0},
+	{0x1A5E, 711, 0},
+	{0x1A60, 711, 0},
+	{0x1A62, 711, 0},
+	{0x1A65, 711, 0},
+	{0x1A66, 711, 0},
+	{0x1A67, 711, 0},
+	{0x1A68, 711, 0},
+	{0x1A69, 711, 0},
+	{0x1A6A, 711, 0},
+	{0x1A6B, 711, 0},
+	{0x1A6C, 711, 0},
+	{0x1A73, 711, 0},
+	{0x1A74, 711, 0},
+	{0x1A75, 711, 0},
+	{0x1A76, 711, 0},
+	{0x1A77, 711, 0},
+	{0x1A78, 711, 0},
+	{0x1A79, 711, 0},
+	{0x1A7A, 711, 0},
+	{0x1A7B, 711, 0},
+	{0x1A7C, 711, 0},
+	{0x1A7F, 711, 0},
+	{0x1AB0, 711, 0},
+	{0x1AB1, 711, 0},
+	{0x1AB2, 711, 0},
+	{0x1AB3, 711, 0},
+	{0x1AB4, 711, 0},
+	{0x1AB5, 711, 0},
+	{0x1AB6, 711, 0},
+	{0x1AB7, 711, 0},
+	{0x1AB8, 711, 0},
+	{0x1AB9, 711, 0},
+	{0x1ABA, 711, 0},
+	{0x1ABB, 711, 0},
+	{0x1ABC, 711, 0},
+	{0x1ABD, 711, 0},
+	{0x1ABE, 711, 0},
+	{0x1ABF, 711, 0},
+	{0x1AC0, 711, 0},
+	{0x1AC1, 711, 0},
+	{0x1AC2, 711, 0},
+	{0x1AC3, 711, 0},
+	{0x1AC4, 711, 0},
+	{0x1AC5, 711, 0},
+	{0x1AC6, 711, 0},
+	{0x1AC7, 711, 0},
+	{0x1AC8, 711, 0},
+	{0x1AC9, 711, 0},
+	{0x1ACA, 711, 0},
+	{0x1ACB, 711, 0},
+	{0x1ACC, 711, 0},
+	{0x1ACD, 711, 0},
+	{0x1ACE, 711, 0},
+	{0x1ACF, 711, 0},
+	{0x1AD0, 711, 0},
+	{0x1AD1, 711, 0},
+	{0x1AD2, 711, 0},
+	{0x1AD3, 711, 0},
+	{0x1AD4, 711, 0},
+	{0x1AD5, 711, 0},
+	{0x1AD6, 711, 0},
+	{0x1AD7, 711, 0},
+	{0x1AD8, 711, 0},
+	{0x1AD9, 711, 0},
+	{0x1ADA, 711, 0},
+	{0x1ADB, 711, 0},
+	{0x1ADC, 711, 0},
+	{0x1ADD, 711, 0},
+	{0x1AE0, 711, 0},
+	{0x1AE1, 711, 0},
+	{0x1AE2, 711, 0},
+	{0x1AE3, 711, 0},
+	{0x1AE4, 711, 0},
+	{0x1AE5, 711, 0},
+	{0x1AE6, 711, 0},
+	{0x1AE7, 711, 0},
+	{0x1AE8, 711, 0},
+	{0x1AE9, 711, 0},
+	{0x1AEA, 711, 0},
+	{0x1AEB, 711, 0},
+	{0x1B00, 711, 0},
+	{0x1B01, 711, 0},
+	{0x1B02, 711, 0},
+	{0x1B03, 711, 0},
+	{0x1B34, 711, 0},
+	{0x1B36, 711, 0},
+	{0x1B37, 711, 0},
+	{0x1B38, 711, 0},
+	{0x1B39, 711, 0},
+	{0x1B3A, 711, 0},
+	{0x1B3C, 711, 0},
+	{0x1B42, 711, 0},
+	{0x1B6B, 711, 0},
+	{0x1B6C, 711, 0},
+	{0x1B6D, 711, 0},
+	{0x1B6E, 711, 0},
+	{0x1B6F, 711, 0},
+	{0x1B70, 711, 0},
+	{0x1B71, 711, 0},
+	{0x1B72, 711, 0},
+	{0x1B73, 711, 0},
+	{0x1B80, 711, 0},
+	{0x1B81, 711, 0},
+	{0x1BA2, 711, 0},
+	{0x1BA3, 711, 0},
+	{0x1BA4, 711, 0},
+	{0x1BA5, 711, 0},
+	{0x1BA8, 711, 0},
+	{0x1BA9, 711, 0},
+	{0x1BAB, 711, 0},
+	{0x1BAC, 711, 0},
+	{0x1BAD, 711, 0},
+	{0x1BE6, 711, 0},
+	{0x1BE8, 711, 0},
+	{0x1BE9, 711, 0},
+	{0x1BED, 711, 0},
+	{0x1BEF, 711, 0},
+	{0x1BF0, 711, 0},
+	{0x1BF1, 711, 0},
+	{0x1C2C, 711, 0},
+	{0x1C2D, 711, 0},
+	{0x1C2E, 711, 0},
+	{0x1C2F, 711, 0},
+	{0x1C30, 711, 0},
+	{0x1C31, 711, 0},
+	{0x1C32, 711, 0},
+	{0x1C33, 711, 0},
+	{0x1C36, 711, 0},
+	{0x1C37, 711, 0},
+	{0x1CD0, 711, 0},
+	{0x1CD1, 711, 0},
+	{0x1CD2, 711, 0},
+	{0x1CD3, 711, 2},
+	{0x1CD4, 713, 0},
+	{0x1CD5, 713, 0},
+	{0x1CD6, 713, 0},
+	{0x1CD7, 713, 0},
+	{0x1CD8, 713, 0},
+	{0x1CD9, 713, 0},
+	{0x1CDA, 713, 0},
+	{0x1CDB, 713, 0},
+	{0x1CDC, 713, 0},
+	{0x1CDD, 713, 0},
+	{0x1CDE, 713, 0},
+	{0x1CDF, 713, 0},
+	{0x1CE0, 713, 0},
+	{0x1CE2, 713, 0},
+	{0x1CE3, 713, 0},
+	{0x1CE4, 713, 0},
+	{0x1CE5, 713, 0},
+	{0x1CE6, 713, 0},
+	{0x1CE7, 713, 0},
+	{0x1CE8, 713, 0},
+	{0x1CED, 713, 0},
+	{0x1CF4, 713, 0},
+	{0x1CF8, 713, 0},
+	{0x1CF9, 713, 0},
+	{0x1D04, 713, 1},
+	{0x1D0F, 714, 1},
+	{0x1D11, 715, 1},
+	{0x1D1C, 716, 1},
+	{0x1D20, 717, 1},
+	{0x1D21, 718, 1},
+	{0x1D22, 719, 1},
+	{0x1D26, 720, 1},
+	{0x1D2C, 721, 1},
+	{0x1D2D, 722, 2},
+	{0x1D2E, 724, 1},
+	{0x1D30, 725, 1},
+	{0x1D31, 726, 1},
+	{0x1D33, 727, 1},
+	{0x1D34, 728, 1},
+	{0x1D35, 729, 1},
+	{0x1D36, 730, 1},
+	{0x1D37, 731, 1},
+	{0x1D38, 732, 1},
+	{0x1D39, 733, 1},
+	{0x1D3A, 734, 1},
+	{0x1D3C, 735, 1},
+	{0x1D3D, 736, 1},
+	{0x1D3E, 737, 1},
+	{0x1D3F, 738, 1},
+	{0x1D40, 739, 1},
+	{0x1D41, 740, 1},
+	{0x1D42, 741, 1},
+	{0x1D43, 742, 1},
+	{0x1D45, 743, 1},
+	{0x1D47, 744, 1},
+	{0x1D48, 745, 1},
+	{0x1D49, 746, 1},
+	{0x1D4D, 747, 1},
+	{0x1D4F, 748, 1},
+	{0x1D50, 749, 1},
+	{0x1D52, 750, 1},
+	{0x1D56, 751, 1},
+	{0x1D57, 752, 1},
+	{0x1D58, 753, 1},
+	{0x1D5A, 754, 1},
+	{0x1D5B, 755, 1},
+	{0x1D5E, 756, 1},
+	{0x1D62, 757, 1},
+	{0x1D63, 758, 1},
+	{0x1D64, 759, 1},
+	{0x1D65, 760, 1},
+	{0x1D67, 761, 1},
+	{0x1D68, 762, 1},
+	{0x1D6B, 763, 2},
+	{0x1D83, 765, 1},
+	{0x1D8C, 766, 1},
+	{0x1D9C, 767, 1},
+	{0x1DA0, 768, 1},
+	{0x1DA2, 769, 1},
+	{0x1DA5, 770, 1},
+	{0x1DA6, 771, 1},
+	{0x1DB8, 772, 1},
+	{0x1DB9, 773, 1},
+	{0x1DBB, 774, 1},
+	{0x1DC0, 775, 0},
+	{0x1DC1, 775, 0},
+	{0x1DC2, 775, 0},
+	{0x1DC3, 775, 0},
+	{0x1DC4, 775, 0},
+	{0x1DC5, 775, 0},
+	{0x1DC6, 775, 0},
+	{0x1DC7, 775, 0},
+	{0x1DC8, 775, 0},
+	{0x1DC9, 775, 0},
+	{0x1DCA, 775, 0},
+	{0x1DCB, 775, 0},
+	{0x1DCC, 775, 0},
+	{0x1DCD, 775, 0},
+	{0x1DCE, 775, 0},
+	{0x1DCF, 775, 0},
+	{0x1DD0, 775, 0},
+	{0x1DD1, 775, 0},
+	{0x1DD2, 775, 0},
+	{0x1DD3, 775, 0},
+	{0x1DD4, 775, 0},
+	{0x1DD5, 775, 0},
+	{0x1DD6, 775, 0},
+	{0x1DD7, 775, 0},
+	{0x1DD8, 775, 0},
+	{0x1DD9, 775, 0},
+	{0x1DDA, 775, 0},
+	{0x1DDB, 775, 0},
+	{0x1DDC, 775, 0},
+	{0x1DDD, 775, 0},
+	{0x1DDE, 775, 0},
+	{0x1DDF, 775, 0},
+	{0x1DE0, 775, 0},
+	{0x1DE1, 775, 0},
+	{0x1DE2, 775, 0},
+	{0x1DE3, 775, 0},
+	{0x1DE4, 775, 0},
+	{0x1DE5, 775, 0},
+	{0x1DE6, 775, 0},
+	{0x1DE7, 775, 0},
+	{0x1DE8, 775, 0},
+	{0x1DE9, 775, 0},
+	{0x1DEA, 775, 0},
+	{0x1DEB, 775, 0},
+	{0x1DEC, 775, 0},
+	{0x1DED, 775, 0},
+	{0x1DEE, 775, 0},
+	{0x1DEF, 775, 0},
+	{0x1DF0, 775, 0},
+	{0x1DF1, 775, 0},
+	{0x1DF2, 775, 0},
+	{0x1DF3, 775, 0},
+	{0x1DF4, 775, 0},
+	{0x1DF5, 775, 0},
+	{0x1DF6, 775, 0},
+	{0x1DF7, 775, 0},
+	{0x1DF8, 775, 0},
+	{0x1DF9, 775, 0},
+	{0x1DFA, 775, 0},
+	{0x1DFB, 775, 0},
+	{0x1DFC, 775, 0},
+	{0x1DFD, 775, 0},
+	{0x1DFE, 775, 0},
+	{0x1DFF, 775, 0},
+	{0x1E00, 775, 1},
+	{0x1E01, 776, 1},
+	{0x1E02, 777, 1},
+	{0x1E03, 778, 1},
+	{0x1E04, 779, 1},
+	{0x1E05, 780, 1},
+	{0x1E06, 781, 1},
+	{0x1E07, 782, 1},
+	{0x1E08, 783, 1},
+	{0x1E09, 784, 1},
+	{0x1E0A, 785, 1},
+	{0x1E0B, 786, 1},
+	{0x1E0C, 787, 1},
+	{0x1E0D, 788, 1},
+	{0x1E0E, 789, 1},
+	{0x1E0F, 790, 1},
+	{0x1E10, 791, 1},
+	{0x1E11, 792, 1},
+	{0x1E12, 793, 1},
+	{0x1E13, 794, 1},
+	{0x1E14, 795, 1},
+	{0x1E15, 796, 1},
+	{0x1E16, 797, 1},
+	{0x1E17, 798, 1},
+	{0x1E18, 799, 1},
+	{0x1E19, 800, 1},
+	{0x1E1A, 801, 1},
+	{0x1E1B, 802, 1},
+	{0x1E1C, 803, 1},
+	{0x1E1D, 804, 1},
+	{0x1E1E, 805, 1},
+	{0x1E1F, 806, 1},
+	{0x1E20, 807, 1},
+	{0x1E21, 808, 1},
+	{0x1E22, 809, 1},
+	{0x1E23, 810, 1},
+	{0x1E24, 811, 1},
+	{0x1E25, 812, 1},
+	{0x1E26, 813, 1},
+	{0x1E27, 814, 1},
+	{0x1E28, 815, 1},
+	{0x1E29, 816, 1},
+	{0x1E2A, 817, 1},
+	{0x1E2B, 818, 1},
+	{0x1E2C, 819, 1},
+	{0x1E2D, 820, 1},
+	{0x1E2E, 821, 1},
+	{0x1E2F, 822, 1},
+	{0x1E30, 823, 1},
+	{0x1E31, 824, 1},
+	{0x1E32, 825, 1},
+	{0x1E33, 826, 1},
+	{0x1E34, 827, 1},
+	{0x1E35, 828, 1},
+	{0x1E36, 829, 1},
+	{0x1E37, 830, 1},
+	{0x1E38, 831, 1},
+	{0x1E39, 832, 1},
+	{0x1E3A, 833, 1},
+	{0x1E3B, 834, 1},
+	{0x1E3C, 835, 1},
+	{0x1E3D, 836, 1},
+	{0x1E3E, 837, 1},
+	{0x1E3F, 838, 1},
+	{0x1E40, 839, 1},
+	{0x1E41, 840, 1},
+	{0x1E42, 841, 1},
+	{0x1E43, 842, 1},
+	{0x1E44, 843, 1},
+	{0x1E45, 844, 1},
+	{0x1E46, 845, 1},
+	{0x1E47, 846, 1},
+	{0x1E48, 847, 1},
+	{0x1E49, 848, 1},
+	{0x1E4A, 849, 1},
+	{0x1E4B, 850, 1},
+	{0x1E4C, 851, 1},
+	{0x1E4D, 852, 1},
+	{0x1E4E, 853, 1},
+	{0x1E4F, 854, 1},
+	{0x1E50, 855, 1},
+	{0x1E51, 856, 1},
+	{0x1E52, 857, 1},
+	{0x1E53, 858, 1},
+	{0x1E54, 859, 1},
+	{0x1E55, 860, 1},
+	{0x1E56, 861, 1},
+	{0x1E57, 862, 1},
+	{0x1E58, 863, 1},
+	{0x1E59, 864, 1},
+	{0x1E5A, 865, 1},
+	{0x1E5B, 866, 1},
+	{0x1E5C, 867, 1},
+	{0x1E5D, 868, 1},
+	{0x1E5E, 869, 1},
+	{0x1E5F, 870, 1},
+	{0x1E60, 871, 1},
+	{0x1E61, 872, 1},
+	{0x1E62, 873, 1},
+	{0x1E63, 874, 1},
+	{0x1E64, 875, 1},
+	{0x1E65, 876, 1},
+	{0x1E66, 877, 1},
+	{0x1E67, 878, 1},
+	{0x1E68, 879, 1},
+	{0x1E69, 880, 1},
+	{0x1E6A, 881, 1},
+	{0x1E6B, 882, 1},
+	{0x1E6C, 883, 1},
+	{0x1E6D, 884, 1},
+	{0x1E6E, 885, 1},
+	{0x1E6F, 886, 1},
+	{0x1E70, 887, 1},
+	{0x1E71, 888, 1},
+	{0x1E72, 889, 1},
+	{0x1E73, 890, 1},
+	{0x1E74, 891, 1},
+	{0x1E75, 892, 1},
+	{0x1E76, 893, 1},
+	{0x1E77, 894, 1},
+	{0x1E78, 895, 1},
+	{0x1E79, 896, 1},
+	{0x1E7A, 897, 1},
+	{0x1E7B, 898, 1},
+	{0x1E7C, 899, 1},
+	{0x1E7D, 900, 1},
+	{0x1E7E, 901, 1},
+	{0x1E7F, 902, 1},
+	{0x1E80, 903, 1},
+	{0x1E81, 904, 1},
+	{0x1E82, 905, 1},
+	{0x1E83, 906, 1},
+	{0x1E84, 907, 1},
+	{0x1E85, 908, 1},
+	{0x1E86, 909, 1},
+	{0x1E87, 910, 1},
+	{0x1E88, 911, 1},
+	{0x1E89, 912, 1},
+	{0x1E8A, 913, 1},
+	{0x1E8B, 914, 1},
+	{0x1E8C, 915, 1},
+	{0x1E8D, 916, 1},
+	{0x1E8E, 917, 1},
+	{0x1E8F, 918, 1},
+	{0x1E90, 919, 1},
+	{0x1E91, 920, 1},
+	{0x1E92, 921, 1},
+	{0x1E93, 922, 1},
+	{0x1E94, 923, 1},
+	{0x1E95, 924, 1},
+	{0x1E96, 925, 1},
+	{0x1E97, 926, 1},
+	{0x1E98, 927, 1},
+	{0x1E99, 928, 1},
+	{0x1E9A, 929, 2},
+	{0x1E9B, 931, 1},
+	{0x1E9D, 932, 1},
+	{0x1EA0, 933, 1},
+	{0x1EA1, 934, 1},
+	{0x1EA2, 935, 1},
+	{0x1EA3, 936, 1},
+	{0x1EA4, 937, 1},
+	{0x1EA5, 938, 1},
+	{0x1EA6, 939, 1},
+	{0x1EA7, 940, 1},
+	{0x1EA8, 941, 1},
+	{0x1EA9, 942, 1},
+	{0x1EAA, 943, 1},
+	{0x1EAB, 944, 1},
+	{0x1EAC, 945, 1},
+	{0x1EAD, 946, 1},
+	{0x1EAE, 947, 1},
+	{0x1EAF, 948, 1},
+	{0x1EB0, 949, 1},
+	{0x1EB1, 950, 1},
+	{0x1EB2, 951, 1},
+	{0x1EB3, 952, 1},
+	{0x1EB4, 953, 1},
+	{0x1EB5, 954, 1},
+	{0x1EB6, 955, 1},
+	{0x1EB7, 956, 1},
+	{0x1EB8, 957, 1},
+	{0x1EB9, 958, 1},
+	{0x1EBA, 959, 1},
+	{0x1EBB, 960, 1},
+	{0x1EBC, 961, 1},
+	{0x1EBD, 962, 1},
+	{0x1EBE, 963, 1},
+	{0x1EBF, 964, 1},
+	{0x1EC0, 965, 1},
+	{0x1EC1, 966, 1},
+	{0x1EC2, 967, 1},
+	{0x1EC3, 968, 1},
+	{0x1EC4, 969, 1},
+	{0x1EC5, 970, 1},
+	{0x1EC6, 971, 1},
+	{0x1EC7, 972, 1},
+	{0x1EC8, 973, 1},
+	{0x1EC9, 974, 1},
+	{0x1ECA, 975, 1},
+	{0x1ECB, 976, 1},
+	{0x1ECC, 977, 1},
+	{0x1ECD, 978, 1},
+	{0x1ECE, 979, 1},
+	{0x1ECF, 980, 1},
+	{0x1ED0, 981, 1},
+	{0x1ED1, 982, 1},
+	{0x1ED2, 983, 1},
+	{0x1ED3, 984, 1},
+	{0x1ED4, 985, 1},
+	{0x1ED5, 986, 1},
+	{0x1ED6, 987, 1},
+	{0x1ED7, 988, 1},
+	{0x1ED8, 989, 1},
+	{0x1ED9, 990, 1},
+	{0x1EDA, 991, 1},
+	{0x1EDB, 992, 1},
+	{0x1EDC, 993, 1},
+	{0x1EDD, 994, 1},
+	{0x1EDE, 995, 1},
+	{0x1EDF, 996, 1},
+	{0x1EE0, 997, 1},
+	{0x1EE1, 998, 1},
+	{0x1EE2, 999, 1},
+	{0x1EE3, 1000, 1},
+	{0x1EE4, 1001, 1},
+	{0x1EE5, 1002, 1},
+	{0x1EE6, 1003, 1},
+	{0x1EE7, 1004, 1},
+	{0x1EE8, 1005, 1},
+	{0x1EE9, 1006, 1},
+	{0x1EEA, 1007, 1},
+	{0x1EEB, 1008, 1},
+	{0x1EEC, 1009, 1},
+	{0x1EED, 1010, 1},
+	{0x1EEE, 1011, 1},
+	{0x1EEF, 1012, 1},
+	{0x1EF0, 1013, 1},
+	{0x1EF1, 1014, 1},
+	{0x1EF2, 1015, 1},
+	{0x1EF3, 1016, 1},
+	{0x1EF4, 1017, 1},
+	{0x1EF5, 1018, 1},
+	{0x1EF6, 1019, 1},
+	{0x1EF7, 1020, 1},
+	{0x1EF8, 1021, 1},
+	{0x1EF9, 1022, 1},
+	{0x1EFF, 1023, 1},
+	{0x1F00, 1024, 1},
+	{0x1F01, 1025, 1},
+	{0x1F02, 1026, 1},
+	{0x1F03, 1027, 1},
+	{0x1F04, 1028, 1},
+	{0x1F05, 1029, 1},
+	{0x1F06, 1030, 1},
+	{0x1F07, 1031, 1},
+	{0x1F08, 1032, 1},
+	{0x1F09, 1033, 1},
+	{0x1F0A, 1034, 1},
+	{0x1F0B, 1035, 1},
+	{0x1F0C, 1036, 1},
+	{0x1F0D, 1037, 1},
+	{0x1F0E, 1038, 1},
+	{0x1F0F, 1039, 1},
+	{0x1F18, 1040, 1},
+	{0x1F19, 1041, 1},
+	{0x1F1A, 1042, 1},
+	{0x1F1B, 1043, 1},
+	{0x1F1C, 1044, 1},
+	{0x1F1D, 1045, 1},
+	{0x1F28, 1046, 1},
+	{0x1F29, 1047, 1},
+	{0x1F2A, 1048, 1},
+	{0x1F2B, 1049, 1},
+	{0x1F2C, 1050, 1},
+	{0x1F2D, 1051, 1},
+	{0x1F2E, 1052, 1},
+	{0x1F2F, 1053, 1},
+	{0x1F30, 1054, 1},
+	{0x1F31, 1055, 1},
+	{0x1F32, 1056, 1},
+	{0x1F33, 1057, 1},
+	{0x1F34, 1058, 1},
+	{0x1F35, 1059, 1},
+	{0x1F36, 1060, 1},
+	{0x1F37, 1061, 1},
+	{0x1F38, 1062, 1},
+	{0x1F39, 1063, 1},
+	{0x1F3A, 1064, 1},
+	{0x1F3B, 1065, 1},
+	{0x1F3C, 1066, 1},
+	{0x1F3D, 1067, 1},
+	{0x1F3E, 1068, 1},
+	{0x1F3F, 1069, 1},
+	{0x1F40, 1070, 1},
+	{0x1F41, 1071, 1},
+	{0x1F42, 1072, 1},
+	{0x1F43, 1073, 1},
+	{0x1F44, 1074, 1},
+	{0x1F45, 1075, 1},
+	{0x1F48, 1076, 1},
+	{0x1F49, 1077, 1},
+	{0x1F4A, 1078, 1},
+	{0x1F4B, 1079, 1},
+	{0x1F4C, 1080, 1},
+	{0x1F4D, 1081, 1},
+	{0x1F50, 1082, 1},
+	{0x1F51, 1083, 1},
+	{0x1F52, 1084, 1},
+	{0x1F53, 1085, 1},
+	{0x1F54, 1086, 1},
+	{0x1F55, 1087, 1},
+	{0x1F56, 1088, 1},
+	{0x1F57, 1089, 1},
+	{0x1F59, 1090, 1},
+	{0x1F5B, 1091, 1},
+	{0x1F5D, 1092, 1},
+	{0x1F5F, 1093, 1},
+	{0x1F70, 1094, 1},
+	{0x1F71, 1095, 1},
+	{0x1F76, 1096, 1},
+	{0x1F77, 1097, 1},
+	{0x1F78, 1098, 1},
+	{0x1F79, 1099, 1},
+	{0x1F7A, 1100, 1},
+	{0x1F7B, 1101, 1},
+	{0x1F80, 1102, 1},
+	{0x1F81, 1103, 1},
+	{0x1F82, 1104, 1},
+	{0x1F83, 1105, 1},
+	{0x1F84, 1106, 1},
+	{0x1F85, 1107, 1},
+	{0x1F86, 1108, 1},
+	{0x1F87, 1109, 1},
+	{0x1F88, 1110, 1},
+	{0x1F89, 1111, 1},
+	{0x1F8A, 1112, 1},
+	{0x1F8B, 1113, 1},
+	{0x1F8C, 1114, 1},
+	{0x1F8D, 1115, 1},
+	{0x1F8E, 1116, 1},
+	{0x1F8F, 1117, 1},
+	{0x1F98, 1118, 1},
+	{0x1F99, 1119, 1},
+	{0x1F9A, 1120, 1},
+	{0x1F9B, 1121, 1},
+	{0x1F9C, 1122, 1},
+	{0x1F9D, 1123, 1},
+	{0x1F9E, 1124, 1},
+	{0x1F9F, 1125, 1},
+	{0x1FB0, 1126, 1},
+	{0x1FB1, 1127, 1},
+	{0x1FB2, 1128, 1},
+	{0x1FB3, 1129, 1},
+	{0x1FB4, 1130, 1},
+	{0x1FB6, 1131, 1},
+	{0x1FB7, 1132, 1},
+	{0x1FB8, 1133, 1},
+	{0x1FB9, 1134, 1},
+	{0x1FBA, 1135, 1},
+	{0x1FBB, 1136, 1},
+	{0x1FBC, 1137, 1},
+	{0x1FBD, 1138, 1},
+	{0x1FBE, 1139, 1},
+	{0x1FBF, 1140, 1},
+	{0x1FC0, 1141, 1},
+	{0x1FC1, 1142, 1},
+	{0x1FC8, 1143, 1},
+	{0x1FC9, 1144, 1},
+	{0x1FCA, 1145, 1},
+	{0x1FCB, 1146, 1},
+	{0x1FCC, 1147, 1},
+	{0x1FCD, 1148, 1},
+	{0x1FCE, 1149, 1},
+	{0x1FCF, 1150, 1},
+	{0x1FD0, 1151, 1},
+	{0x1FD1, 1152, 1},
+	{0x1FD2, 1153, 1},
+	{0x1FD3, 1154, 1},
+	{0x1FD6, 1155, 1},
+	{0x1FD7, 1156, 1},
+	{0x1FD8, 1157, 1},
+	{0x1FD9, 1158, 1},
+	{0x1FDA, 1159, 1},
+	{0x1FDB, 1160, 1},
+	{0x1FDD, 1161, 1},
+	{0x1FDE, 1162, 1},
+	{0x1FDF, 1163, 1},
+	{0x1FE0, 1164, 1},
+	{0x1FE1, 1165, 1},
+	{0x1FE2, 1166, 1},
+	{0x1FE3, 1167, 1},
+	{0x1FE4, 1168, 1},
+	{0x1FE5, 1169, 1},
+	{0x1FE6, 1170, 1},
+	{0x1FE7, 1171, 1},
+	{0x1FE8, 1172, 1},
+	{0x1FE9, 1173, 1},
+	{0x1FEA, 1174, 1},
+	{0x1FEB, 1175, 1},
+	{0x1FEC, 1176, 1},
+	{0x1FED, 1177, 1},
+	{0x1FEE, 1178, 1},
+	{0x1FEF, 1179, 1},
+	{0x1FF8, 1180, 1},
+	{0x1FF9, 1181, 1},
+	{0x1FFD, 1182, 1},
+	{0x1FFE, 1183, 1},
+	{0x2000, 1184, 1},
+	{0x2001, 1185, 1},
+	{0x2002, 1186, 1},
+	{0x2003, 1187, 1},
+	{0x2004, 1188, 1},
+	{0x2005, 1189, 1},
+	{0x2006, 1190, 1},
+	{0x2007, 1191, 1},
+	{0x2008, 1192, 1},
+	{0x2009, 1193, 1},
+	{0x200A, 1194, 1},
+	{0x200B, 1195, 0},
+	{0x200C, 1195, 0},
+	{0x200D, 1195, 0},
+	{0x200E, 1195, 0},
+	{0x200F, 1195, 0},
+	{0x2010, 1195, 1},
+	{0x2011, 1196, 1},
+	{0x2012, 1197, 1},
+	{0x2013, 1198, 1},
+	{0x2016, 1199, 2},
+	{0x2017, 1201, 1},
+	{0x2018, 1202, 1},
+	{0x2019, 1203, 1},
+	{0x201A, 1204, 1},
+	{0x201B, 1205, 1},
+	{0x201C, 1206, 2},
+	{0x201D, 1208, 2},
+	{0x201F, 1210, 2},
+	{0x2024, 1212, 1},
+	{0x2025, 1213, 2},
+	{0x2026, 1215, 3},
+	{0x2028, 1218, 1},
+	{0x2029, 1219, 1},
+	{0x202A, 1220, 0},
+	{0x202B, 1220, 0},
+	{0x202C, 1220, 0},
+	{0x202D, 1220, 0},
+	{0x202E, 1220, 0},
+	{0x202F, 1220, 1},
+	{0x2032, 1221, 1},
+	{0x2033, 1222, 2},
+	{0x2034, 1224, 3},
+	{0x2035, 1227, 1},
+	{0x2036, 1228, 2},
+	{0x2037, 1230, 3},
+	{0x2039, 1233, 1},
+	{0x203A, 1234, 1},
+	{0x203C, 1235, 2},
+	{0x203E, 1237, 1},
+	{0x2041, 1238, 1},
+	{0x2043, 1239, 1},
+	{0x2044, 1240, 1},
+	{0x2047, 1241, 2},
+	{0x2048, 1243, 2},
+	{0x2049, 1245, 2},
+	{0x204E, 1247, 1},
+	{0x2053, 1248, 1},
+	{0x2057, 1249, 4},
+	{0x205A, 1253, 1},
+	{0x205F, 1254, 1},
+	{0x2060, 1255, 0},
+	{0x2061, 1255, 0},
+	{0x2062, 1255, 0},
+	{0x2063, 1255, 0},
+	{0x2064, 1255, 0},
+	{0x2066, 1255, 0},
+	{0x2067, 1255, 0},
+	{0x2068, 1255, 0},
+	{0x2069, 1255, 0},
+	{0x206A, 1255, 0},
+	{0x206B, 1255, 0},
+	{0x206C, 1255, 0},
+	{0x206D, 1255, 0},
+	{0x206E, 1255, 0},
+	{0x206F, 1255, 0},
+	{0x2070, 1255, 1},
+	{0x2071, 1256, 1},
+	{0x2074, 1257, 1},
+	{0x2075, 1258, 1},
+	{0x2076, 1259, 1},
+	{0x2077, 1260, 1},
+	{0x2078, 1261, 1},
+	{0x2079, 1262, 1},
+	{0x207A, 1263, 1},
+	{0x207B, 1264, 1},
+	{0x207C, 1265, 1},
+	{0x207D, 1266, 1},
+	{0x207E, 1267, 1},
+	{0x207F, 1268, 1},
+	{0x2080, 1269, 1},
+	{0x2081, 1270, 1},
+	{0x2082, 1271, 1},
+	{0x2083, 1272, 1},
+	{0x2084, 1273, 1},
+	{0x2085, 1274, 1},
+	{0x2086, 1275, 1},
+	{0x2087, 1276, 1},
+	{0x2088, 1277, 1},
+	{0x2089, 1278, 1},
+	{0x208A, 1279, 1},
+	{0x208B, 1280, 1},
+	{0x208C, 1281, 1},
+	{0x208D, 1282, 1},
+	{0x208E, 1283, 1},
+	{0x2090, 1284, 1},
+	{0x2091, 1285, 1},
+	{0x2092, 1286, 1},
+	{0x2093, 1287, 1},
+	{0x2095, 1288, 1},
+	{0x2096, 1289, 1},
+	{0x2097, 1290, 1},
+	{0x2098, 1291, 1},
+	{0x2099, 1292, 1},
+	{0x209A, 1293, 1},
+	{0x209B, 1294, 1},
+	{0x209C, 1295, 1},
+	{0x20A8, 1296, 2},
+	{0x20B6, 1298, 2},
+	{0x20D0, 1300, 0},
+	{0x20D1, 1300, 0},
+	{0x20D2, 1300, 0},
+	{0x20D3, 1300, 0},
+	{0x20D4, 1300, 0},
+	{0x20D5, 1300, 0},
+	{0x20D6, 1300, 0},
+	{0x20D7, 1300, 0},
+	{0x20D8, 1300, 0},
+	{0x20D9, 1300, 0},
+	{0x20DA, 1300, 0},
+	{0x20DB, 1300, 0},
+	{0x20DC, 1300, 0},
+	{0x20DD, 1300, 0},
+	{0x20DE, 1300, 0},
+	{0x20DF, 1300, 0},
+	{0x20E0, 1300, 0},
+	{0x20E1, 1300, 0},
+	{0x20E2, 1300, 0},
+	{0x20E3, 1300, 0},
+	{0x20E4, 1300, 0},
+	{0x20E5, 1300, 0},
+	{0x20E6, 1300, 0},
+	{0x20E7, 1300, 0},
+	{0x20E8, 1300, 0},
+	{0x20E9, 1300, 0},
+	{0x20EA, 1300, 0},
+	{0x20EB, 1300, 0},
+	{0x20EC, 1300, 0},
+	{0x20ED, 1300, 0},
+	{0x20EE, 1300, 0},
+	{0x20EF, 1300, 0},
+	{0x20F0, 1300, 0},
+	{0x2100, 1300, 3},
+	{0x2101, 1303, 3},
+	{0x2102, 1306, 1},
+	{0x2105, 1307, 3},
+	{0x2106, 1310, 3},
+	{0x210A, 1313, 1},
+	{0x210B, 1314, 1},
+	{0x210C, 1315, 1},
+	{0x210D, 1316, 1},
+	{0x210E, 1317, 1},
+	{0x2110, 1318, 1},
+	{0x2111, 1319, 1},
+	{0x2112, 1320, 1},
+	{0x2113, 1321, 1},
+	{0x2115, 1322, 1},
+	{0x2116, 1323, 2},
+	{0x2119, 1325, 1},
+	{0x211A, 1326, 1},
+	{0x211B, 1327, 1},
+	{0x211C, 1328, 1},
+	{0x211D, 1329, 1},
+	{0x2120, 1330, 2},
+	{0x2121, 1332, 3},
+	{0x2122, 1335, 2},
+	{0x2124, 1337, 1},
+	{0x2128, 1338, 1},
+	{0x212A, 1339, 1},
+	{0x212B, 1340, 1},
+	{0x212C, 1341, 1},
+	{0x212D, 1342, 1},
+	{0x212E, 1343, 1},
+	{0x212F, 1344, 1},
+	{0x2130, 1345, 1},
+	{0x2131, 1346, 1},
+	{0x2133, 1347, 1},
+	{0x2134, 1348, 1},
+	{0x2139, 1349, 1},
+	{0x213B, 1350, 3},
+	{0x213D, 1353, 1},
+	{0x2145, 1354, 1},
+	{0x2146, 1355, 1},
+	{0x2147, 1356, 1},
+	{0x2148, 1357, 1},
+	{0x2149, 1358, 1},
+	{0x2150, 1359, 3},
+	{0x2151, 1362, 3},
+	{0x2152, 1365, 4},
+	{0x2153, 1369, 3},
+	{0x2154, 1372, 3},
+	{0x2155, 1375, 3},
+	{0x2156, 1378, 3},
+	{0x2157, 1381, 3},
+	{0x2158, 1384, 3},
+	{0x2159, 1387, 3},
+	{0x215A, 1390, 3},
+	{0x215B, 1393, 3},
+	{0x215C, 1396, 3},
+	{0x215D, 1399, 3},
+	{0x215E, 1402, 3},
+	{0x215F, 1405, 2},
+	{0x2160, 1407, 1},
+	{0x2161, 1408, 2},
+	{0x2162, 1410, 3},
+	{0x2163, 1413, 2},
+	{0x2164, 1415, 1},
+	{0x2165, 1416, 2},
+	{0x2166, 1418, 3},
+	{0x2167, 1421, 4},
+	{0x2168, 1425, 2},
+	{0x2169, 1427, 1},
+	{0x216A, 1428, 2},
+	{0x216B, 1430, 3},
+	{0x216C, 1433, 1},
+	{0x216D, 1434, 1},
+	{0x216E, 1435, 1},
+	{0x216F, 1436, 1},
+	{0x2170, 1437, 1},
+	{0x2171, 1438, 2},
+	{0x2172, 1440, 3},
+	{0x2173, 1443, 2},
+	{0x2174, 1445, 1},
+	{0x2175, 1446, 2},
+	{0x2176, 1448, 3},
+	{0x2177, 1451, 4},
+	{0x2178, 1455, 2},
+	{0x2179, 1457, 1},
+	{0x217A, 1458, 2},
+	{0x217B, 1460, 3},
+	{0x217C, 1463, 1},
+	{0x217D, 1464, 1},
+	{0x217E, 1465, 1},
+	{0x217F, 1466, 2},
+	{0x2189, 1468, 3},
+	{0x2212, 1471, 1},
+	{0x2215, 1472, 1},
+	{0x2216, 1473, 1},
+	{0x2217, 1474, 1},
+	{0x221E, 1475, 2},
+	{0x2223, 1477, 1},
+	{0x2224, 1478, 1},
+	{0x2225, 1479, 2},
+	{0x2226, 1481, 2},
+	{0x2228, 1483, 1},
+	{0x222A, 1484, 1},
+	{0x2236, 1485, 1},
+	{0x223C, 1486, 1},
+	{0x2241, 1487, 1},
+	{0x2260, 1488, 1},
+	{0x226A, 1489, 2},
+	{0x226B, 1491, 2},
+	{0x226E, 1493, 1},
+	{0x226F, 1494, 1},
+	{0x22A4, 1495, 1},
+	{0x22C1, 1496, 1},
+	{0x22C3, 1497, 1},
+	{0x22D8, 1498, 3},
+	{0x22D9, 1501, 3},
+	{0x22FF, 1504, 1},
+	{0x2373, 1505, 1},
+	{0x2374, 1506, 1},
+	{0x237A, 1507, 1},
+	{0x23FD, 1508, 1},
+	{0x244A, 1509, 2},
+	{0x2460, 1511, 1},
+	{0x2461, 1512, 1},
+	{0x2462, 1513, 1},
+	{0x2463, 1514, 1},
+	{0x2464, 1515, 1},
+	{0x2465, 1516, 1},
+	{0x2466, 1517, 1},
+	{0x2467, 1518, 1},
+	{0x2468, 1519, 1},
+	{0x2469, 1520, 2},
+	{0x246A, 1522, 2},
+	{0x246B, 1524, 2},
+	{0x246C, 1526, 2},
+	{0x246D, 1528, 2},
+	{0x246E, 1530, 2},
+	{0x246F, 1532, 2},
+	{0x2470, 1534, 2},
+	{0x2471, 1536, 2},
+	{0x2472, 1538, 2},
+	{0x2473, 1540, 2},
+	{0x2474, 1542, 3},
+	{0x2475, 1545, 3},
+	{0x2476, 1548, 3},
+	{0x2477, 1551, 3},
+	{0x2478, 1554, 3},
+	{0x2479, 1557, 3},
+	{0x247A, 1560, 3},
+	{0x247B, 1563, 3},
+	{0x247C, 1566, 3},
+	{0x247D, 1569, 4},
+	{0x247E, 1573, 4},
+	{0x247F, 1577, 4},
+	{0x2480, 1581, 4},
+	{0x2481, 1585, 4},
+	{0x2482, 1589, 4},
+	{0x2483, 1593, 4},
+	{0x2484, 1597, 4},
+	{0x2485, 1601, 4},
+	{0x2486, 1605, 4},
+	{0x2487, 1609, 4},
+	{0x2488, 1613, 2},
+	{0x2489, 1615, 2},
+	{0x248A, 1617, 2},
+	{0x248B, 1619, 2},
+	{0x248C, 1621, 2},
+	{0x248D, 1623, 2},
+	{0x248E, 1625, 2},
+	{0x248F, 1627, 2},
+	{0x2490, 1629, 2},
+	{0x2491, 1631, 3},
+	{0x2492, 1634, 3},
+	{0x2493, 1637, 3},
+	{0x2494, 1640, 3},
+	{0x2495, 1643, 3},
+	{0x2496, 1646, 3},
+	{0x2497, 1649, 3},
+	{0x2498, 1652, 3},
+	{0x2499, 1655, 3},
+	{0x249A, 1658, 3},
+	{0x249B, 1661, 3},
+	{0x249C, 1664, 3},
+	{0x249D, 1667, 3},
+	{0x249E, 1670, 3},
+	{0x249F, 1673, 3},
+	{0x24A0, 1676, 3},
+	{0x24A1, 1679, 3},
+	{0x24A2, 1682, 3},
+	{0x24A3, 1685, 3},
+	{0x24A4, 1688, 3},
+	{0x24A5, 1691, 3},
+	{0x24A6, 1694, 3},
+	{0x24A7, 1697, 3},
+	{0x24A8, 1700, 4},
+	{0x24A9, 1704, 3},
+	{0x24AA, 1707, 3},
+	{0x24AB, 1710, 3},
+	{0x24AC, 1713, 3},
+	{0x24AD, 1716, 3},
+	{0x24AE, 1719, 3},
+	{0x24AF, 1722, 3},
+	{0x24B0, 1725, 3},
+	{0x24B1, 1728, 3},
+	{0x24B2, 1731, 3},
+	{0x24B3, 1734, 3},
+	{0x24B4, 1737, 3},
+	{0x24B5, 1740, 3},
+	{0x24B6, 1743, 1},
+	{0x24B7, 1744, 1},
+	{0x24B8, 1745, 1},
+	{0x24B9, 1746, 1},
+	{0x24BA, 1747, 1},
+	{0x24BB, 1748, 1},
+	{0x24BC, 1749, 1},
+	{0x24BD, 1750, 1},
+	{0x24BE, 1751, 1},
+	{0x24BF, 1752, 1},
+	{0x24C0, 1753, 1},
+	{0x24C1, 1754, 1},
+	{0x24C2, 1755, 1},
+	{0x24C3, 1756, 1},
+	{0x24C4, 1757, 1},
+	{0x24C5, 1758, 1},
+	{0x24C6, 1759, 1},
+	{0x24C7, 1760, 1},
+	{0x24C8, 1761, 1},
+	{0x24C9, 1762, 1},
+	{0x24CA, 1763, 1},
+	{0x24CB, 1764, 1},
+	{0x24CC, 1765, 1},
+	{0x24CD, 1766, 1},
+	{0x24CE, 1767, 1},
+	{0x24CF, 1768, 1},
+	{0x24D0, 1769, 1},
+	{0x24D1, 1770, 1},
+	{0x24D2, 1771, 1},
+	{0x24D3, 1772, 1},
+	{0x24D4, 1773, 1},
+	{0x24D5, 1774, 1},
+	{0x24D6, 1775, 1},
+	{0x24D7, 1776, 1},
+	{0x24D8, 1777, 1},
+	{0x24D9, 1778, 1},
+	{0x24DA, 1779, 1},
+	{0x24DB, 1780, 1},
+	{0x24DC, 1781, 1},
+	{0x24DD, 1782, 1},
+	{0x24DE, 1783, 1},
+	{0x24DF, 1784, 1},
+	{0x24E0, 1785, 1},
+	{0x24E1, 1786, 1},
+	{0x24E2, 1787, 1},
+	{0x24E3, 1788, 1},
+	{0x24E4, 1789, 1},
+	{0x24E5, 1790, 1},
+	{0x24E6, 1791, 1},
+	{0x24E7, 1792, 1},
+	{0x24E8, 1793, 1},
+	{0x24E9, 1794, 1},
+	{0x24EA, 1795, 1},
+	{0x2571, 1796, 1},
+	{0x2573, 1797, 1},
+	{0x2768, 1798, 1},
+	{0x2769, 1799, 1},
+	{0x276E, 1800, 1},
+	{0x276F, 1801, 1},
+	{0x2772, 1802, 1},
+	{0x2773, 1803, 1},
+	{0x2774, 1804, 1},
+	{0x2775, 1805, 1},
+	{0x2795, 1806, 1},
+	{0x2796, 1807, 1},
+	{0x27CB, 1808, 1},
+	{0x27CD, 1809, 1},
+	{0x27D9, 1810, 1},
+	{0x292B, 1811, 1},
+	{0x292C, 1812, 1},
+	{0x29F5, 1813, 1},
+	{0x29F8, 1814, 1},
+	{0x29F9, 1815, 1},
+	{0x2A20, 1816, 2},
+	{0x2A2F, 1818, 1},
+	{0x2A74, 1819, 3},
+	{0x2A75, 1822, 2},
+	{0x2A76, 1824, 3},
+	{0x2AA5, 1827, 2},
+	{0x2AFB, 1829, 3},
+	{0x2AFD, 1832, 2},
+	{0x2C7C, 1834, 1},
+	{0x2C7D, 1835, 1},
+	{0x2C85, 1836, 1},
+	{0x2C8E, 1837, 1},
+	{0x2C92, 1838, 1},
+	{0x2C94, 1839, 1},
+	{0x2C98, 1840, 1},
+	{0x2C9A, 1841, 1},
+	{0x2C9E, 1842, 1},
+	{0x2C9F, 1843, 1},
+	{0x2CA2, 1844, 1},
+	{0x2CA3, 1845, 1},
+	{0x2CA4, 1846, 1},
+	{0x2CA5, 1847, 1},
+	{0x2CA6, 1848, 1},
+	{0x2CA8, 1849, 1},
+	{0x2CAC, 1850, 1},
+	{0x2CBA, 1851, 1},
+	{0x2CC6, 1852, 1},
+	{0x2CCA, 1853, 1},
+	{0x2CCC, 1854, 1},
+	{0x2CD0, 1855, 1},
+	{0x2CD2, 1856, 1},
+	{0x2CEF, 1857, 0},
+	{0x2CF0, 1857, 0},
+	{0x2CF1, 1857, 0},
+	{0x2CF9, 1857, 2},
+	{0x2D38, 1859, 1},
+	{0x2D39, 1860, 1},
+	{0x2D4F, 1861, 1},
+	{0x2D51, 1862, 1},
+	{0x2D54, 1863, 1},
+	{0x2D55, 1864, 1},
+	{0x2D5D, 1865, 1},
+	{0x2D7F, 1866, 0},
+	{0x2DE0, 1866, 0},
+	{0x2DE1, 1866, 0},
+	{0x2DE2, 1866, 0},
+	{0x2DE3, 1866, 0},
+	{0x2DE4, 1866, 0},
+	{0x2DE5, 1866, 0},
+	{0x2DE6, 1866, 0},
+	{0x2DE7, 1866, 0},
+	{0x2DE8, 1866, 0},
+	{0x2DE9, 1866, 0},
+	{0x2DEA, 1866, 0},
+	{0x2DEB, 1866, 0},
+	{0x2DEC, 1866, 0},
+	{0x2DED, 1866, 0},
+	{0x2DEE, 1866, 0},
+	{0x2DEF, 1866, 0},
+	{0x2DF0, 1866, 0},
+	{0x2DF1, 1866, 0},
+	{0x2DF2, 1866, 0},
+	{0x2DF3, 1866, 0},
+	{0x2DF4, 1866, 0},
+	{0x2DF5, 1866, 0},
+	{0x2DF6, 1866, 0},
+	{0x2DF7, 1866, 0},
+	{0x2DF8, 1866, 0},
+	{0x2DF9, 1866, 0},
+	{0x2DFA, 1866, 0},
+	{0x2DFB, 1866, 0},
+	{0x2DFC, 1866, 0},
+	{0x2DFD, 1866, 0},
+	{0x2DFE, 1866, 0},
+	{0x2DFF, 1866, 0},
+	{0x2E28, 1866, 2},
+	{0x2E29, 1868, 2},
+	{0x2E40, 1870, 1},
+	{0x2F02, 1871, 1},
+	{0x2F03, 1872, 1},
+	{0x3000, 1873, 1},
+	{0x3003, 1874, 2},
+	{0x3007, 1876, 1},
+	{0x3014, 1877, 1},
+	{0x3015, 1878, 1},
+	{0x302A, 1879, 0},
+	{0x302B, 1879, 0},
+	{0x302C, 1879, 0},
+	{0x302D, 1879, 0},
+	{0x3033, 1879, 1},
+	{0x3099, 1880, 0},
+	{0x309A, 1880, 0},
+	{0x309B, 1880, 1},
+	{0x309C, 1881, 1},
+	{0x30A0, 1882, 1},
+	{0x30CE, 1883, 1},
+	{0x31D3, 1884, 1},
+	{0x31D4, 1885, 1},
+	{0x3250, 1886, 3},
+	{0x3251, 1889, 2},
+	{0x3252, 1891, 2},
+	{0x3253, 1893, 2},
+	{0x3254, 1895, 2},
+	{0x3255, 1897, 2},
+	{0x3256, 1899, 2},
+	{0x3257, 1901, 2},
+	{0x3258, 1903, 2},
+	{0x3259, 1905, 2},
+	{0x325A, 1907, 2},
+	{0x325B, 1909, 2},
+	{0x325C, 1911, 2},
+	{0x325D, 1913, 2},
+	{0x325E, 1915, 2},
+	{0x325F, 1917, 2},
+	{0x32B1, 1919, 2},
+	{0x32B2, 1921, 2},
+	{0x32B3, 1923, 2},
+	{0x32B4, 1925, 2},
+	{0x32B5, 1927, 2},
+	{0x32B6, 1929, 2},
+	{0x32B7, 1931, 2},
+	{0x32B8, 1933, 2},
+	{0x32B9, 1935, 2},
+	{0x32BA, 1937, 2},
+	{0x32BB, 1939, 2},
+	{0x32BC, 1941, 2},
+	{0x32BD, 1943, 2},
+	{0x32BE, 1945, 2},
+	{0x32BF, 1947, 2},
+	{0x32CC, 1949, 2},
+	{0x32CD, 1951, 3},
+	{0x32CE, 1954, 2},
+	{0x32CF, 1956, 3},
+	{0x32E8, 1959, 1},
+	{0x3371, 1960, 3},
+	{0x3372, 1963, 2},
+	{0x3373, 1965, 2},
+	{0x3374, 1967, 3},
+	{0x3375, 1970, 2},
+	{0x3376, 1972, 2},
+	{0x3377, 1974, 2},
+	{0x3378, 1976, 3},
+	{0x3379, 1979, 3},
+	{0x337A, 1982, 2},
+	{0x3380, 1984, 2},
+	{0x3381, 1986, 2},
+	{0x3383, 1988, 2},
+	{0x3384, 1990, 2},
+	{0x3385, 1992, 2},
+	{0x3386, 1994, 2},
+	{0x3387, 1996, 2},
+	{0x3388, 1998, 3},
+	{0x3389, 2001, 4},
+	{0x338A, 2005, 2},
+	{0x338B, 2007, 2},
+	{0x338E, 2009, 2},
+	{0x338F, 2011, 2},
+	{0x3390, 2013, 2},
+	{0x3391, 2015, 3},
+	{0x3392, 2018, 3},
+	{0x3393, 2021, 3},
+	{0x3394, 2024, 3},
+	{0x3396, 2027, 2},
+	{0x3397, 2029, 2},
+	{0x3398, 2031, 2},
+	{0x3399, 2033, 2},
+	{0x339A, 2035, 2},
+	{0x339C, 2037, 2},
+	{0x339D, 2039, 2},
+	{0x339E, 2041, 2},
+	{0x339F, 2043, 3},
+	{0x33A0, 2046, 3},
+	{0x33A1, 2049, 2},
+	{0x33A2, 2051, 3},
+	{0x33A3, 2054, 3},
+	{0x33A4, 2057, 3},
+	{0x33A5, 2060, 2},
+	{0x33A6, 2062, 3},
+	{0x33A7, 2065, 3},
+	{0x33A8, 2068, 4},
+	{0x33A9, 2072, 2},
+	{0x33AA, 2074, 3},
+	{0x33AB, 2077, 3},
+	{0x33AC, 2080, 3},
+	{0x33AD, 2083, 3},
+	{0x33AE, 2086, 5},
+	{0x33AF, 2091, 6},
+	{0x33B0, 2097, 2},
+	{0x33B1, 2099, 2},
+	{0x33B3, 2101, 2},
+	{0x33B4, 2103, 2},
+	{0x33B5, 2105, 2},
+	{0x33B7, 2107, 2},
+	{0x33B8, 2109, 2},
+	{0x33B9, 2111, 2},
+	{0x33BA, 2113, 2},
+	{0x33BB, 2115, 2},
+	{0x33BD, 2117, 2},
+	{0x33BE, 2119, 2},
+	{0x33BF, 2121, 2},
+	{0x33C2, 2123, 4},
+	{0x33C3, 2127, 2},
+	{0x33C4, 2129, 2},
+	{0x33C5, 2131, 2},
+	{0x33C6, 2133, 4},
+	{0x33C7, 2137, 3},
+	{0x33C8, 2140, 2},
+	{0x33C9, 2142, 2},
+	{0x33CA, 2144, 2},
+	{0x33CB, 2146, 2},
+	{0x33CC, 2148, 2},
+	{0x33CD, 2150, 2},
+	{0x33CE, 2152, 2},
+	{0x33CF, 2154, 2},
+	{0x33D0, 2156, 2},
+	{0x33D1, 2158, 2},
+	{0x33D2, 2160, 3},
+	{0x33D3, 2163, 2},
+	{0x33D4, 2165, 2},
+	{0x33D5, 2167, 3},
+	{0x33D6, 2170, 3},
+	{0x33D7, 2173, 2},
+	{0x33D8, 2175, 4},
+	{0x33D9, 2179, 3},
+	{0x33DA, 2182, 2},
+	{0x33DB, 2184, 2},
+	{0x33DC, 2186, 2},
+	{0x33DD, 2188, 2},
+	{0x33DE, 2190, 3},
+	{0x33DF, 2193, 3},
+	{0x33FF, 2196, 3},
+	{0x4E36, 2199, 1},
+	{0x4E3F, 2200, 1},
+	{0xA4D0, 2201, 1},
+	{0xA4D1, 2202, 1},
+	{0xA4D2, 2203, 1},
+	{0xA4D3, 2204, 1},
+	{0xA4D4, 2205, 1},
+	{0xA4D6, 2206, 1},
+	{0xA4D7, 2207, 1},
+	{0xA4D9, 2208, 1},
+	{0xA4DA, 2209, 1},
+	{0xA4DC, 2210, 1},
+	{0xA4DD, 2211, 1},
+	{0xA4DF, 2212, 1},
+	{0xA4E0, 2213, 1},
+	{0xA4E1, 2214, 1},
+	{0xA4E2, 2215, 1},
+	{0xA4E3, 2216, 1},
+	{0xA4E6, 2217, 1},
+	{0xA4E7, 2218, 1},
+	{0xA4EA, 2219, 1},
+	{0xA4EB, 2220, 1},
+	{0xA4EC, 2221, 1},
+	{0xA4EE, 2222, 1},
+	{0xA4F0, 2223, 1},
+	{0xA4F2, 2224, 1},
+	{0xA4F3, 2225, 1},
+	{0xA4F4, 2226, 1},
+	{0xA4F8, 2227, 1},
+	{0xA4F9, 2228, 1},
+	{0xA4FA, 2229, 2},
+	{0xA4FB, 2231, 2},
+	{0xA4FD, 2233, 1},
+	{0xA4FE, 2234, 2},
+	{0xA4FF, 2236, 1},
+	{0xA60E, 2237, 1},
+	{0xA644, 2238, 1},
+	{0xA647, 2239, 1},
+	{0xA66F, 2240, 0},
+	{0xA670, 2240, 0},
+	{0xA671, 2240, 0},
+	{0xA672, 2240, 0},
+	{0xA674, 2240, 0},
+	{0xA675, 2240, 0},
+	{0xA676, 2240, 0},
+	{0xA677, 2240, 0},
+	{0xA678, 2240, 0},
+	{0xA679, 2240, 0},
+	{0xA67A, 2240, 0},
+	{0xA67B, 2240, 0},
+	{0xA67C, 2240, 0},
+	{0xA67D, 2240, 0},
+	{0xA698, 2240, 2},
+	{0xA699, 2242, 2},
+	{0xA69E, 2244, 0},
+	{0xA69F, 2244, 0},
+	{0xA6DF, 2244, 1},
+	{0xA6EB, 2245, 1},
+	{0xA6EF, 2246, 1},
+	{0xA6F0, 2247, 0},
+	{0xA6F1, 2247, 0},
+	{0xA728, 2247, 2},
+	{0xA731, 2249, 1},
+	{0xA732, 2250, 2},
+	{0xA733, 2252, 2},
+	{0xA734, 2254, 2},
+	{0xA735, 2256, 2},
+	{0xA736, 2258, 2},
+	{0xA737, 2260, 2},
+	{0xA738, 2262, 2},
+	{0xA739, 2264, 2},
+	{0xA73A, 2266, 2},
+	{0xA73B, 2268, 2},
+	{0xA73C, 2270, 2},
+	{0xA73D, 2272, 2},
+	{0xA74E, 2274, 2},
+	{0xA74F, 2276, 2},
+	{0xA75A, 2278, 1},
+	{0xA76A, 2279, 1},
+	{0xA76E, 2280, 1},
+	{0xA777, 2281, 2},
+	{0xA778, 2283, 1},
+	{0xA789, 2284, 1},
+	{0xA78C, 2285, 1},
+	{0xA798, 2286, 1},
+	{0xA799, 2287, 1},
+	{0xA79F, 2288, 1},
+	{0xA7AB, 2289, 1},
+	{0xA7B2, 2290, 1},
+	{0xA7B3, 2291, 1},
+	{0xA7B4, 2292, 1},
+	{0xA7F2, 2293, 1},
+	{0xA7F3, 2294, 1},
+	{0xA7F4, 2295, 1},
+	{0xA7F9, 2296, 2},
+	{0xA802, 2298, 0},
+	{0xA806, 2298, 0},
+	{0xA80B, 2298, 0},
+	{0xA825, 2298, 0},
+	{0xA826, 2298, 0},
+	{0xA82C, 2298, 0},
+	{0xA8C4, 2298, 0},
+	{0xA8C5, 2298, 0},
+	{0xA8E0, 2298, 0},
+	{0xA8E1, 2298, 0},
+	{0xA8E2, 2298, 0},
+	{0xA8E3, 2298, 0},
+	{0xA8E4, 2298, 0},
+	{0xA8E5, 2298, 0},
+	{0xA8E6, 2298, 0},
+	{0xA8E7, 2298, 0},
+	{0xA8E8, 2298, 0},
+	{0xA8E9, 2298, 0},
+	{0xA8EA, 2298, 0},
+	{0xA8EB, 2298, 0},
+	{0xA8EC, 2298, 0},
+	{0xA8ED, 2298, 0},
+	{0xA8EE, 2298, 0},
+	{0xA8EF, 2298, 0},
+	{0xA8F0, 2298, 0},
+	{0xA8F1, 2298, 0},
+	{0xA8FF, 2298, 0},
+	{0xA926, 2298, 0},
+	{0xA927, 2298, 0},
+	{0xA928, 2298, 0},
+	{0xA929, 2298, 0},
+	{0xA92A, 2298, 0},
+	{0xA92B, 2298, 0},
+	{0xA92C, 2298, 0},
+	{0xA92D, 2298, 0},
+	{0xA947, 2298, 0},
+	{0xA948, 2298, 0},
+	{0xA949, 2298, 0},
+	{0xA94A, 2298, 0},
+	{0xA94B, 2298, 0},
+	{0xA94C, 2298, 0},
+	{0xA94D, 2298, 0},
+	{0xA94E, 2298, 0},
+	{0xA94F, 2298, 0},
+	{0xA950, 2298, 0},
+	{0xA951, 2298, 0},
+	{0xA980, 2298, 0},
+	{0xA981, 2298, 0},
+	{0xA982, 2298, 0},
+	{0xA9B3, 2298, 0},
+	{0xA9B6, 2298, 0},
+	{0xA9B7, 2298, 0},
+	{0xA9B8, 2298, 0},
+	{0xA9B9, 2298, 0},
+	{0xA9BC, 2298, 0},
+	{0xA9BD, 2298, 0},
+	{0xA9E5, 2298, 0},
+	{0xAA29, 2298, 0},
+	{0xAA2A, 2298, 0},
+	{0xAA2B, 2298, 0},
+	{0xAA2C, 2298, 0},
+	{0xAA2D, 2298, 0},
+	{0xAA2E, 2298, 0},
+	{0xAA31, 2298, 0},
+	{0xAA32, 2298, 0},
+	{0xAA35, 2298, 0},
+	{0xAA36, 2298, 0},
+	{0xAA43, 2298, 0},
+	{0xAA4C, 2298, 0},
+	{0xAA7C, 2298, 0},
+	{0xAAB0, 2298, 0},
+	{0xAAB2, 2298, 0},
+	{0xAAB3, 2298, 0},
+	{0xAAB4, 2298, 0},
+	{0xAAB7, 2298, 0},
+	{0xAAB8, 2298, 0},
+	{0xAABE, 2298, 0},
+	{0xAABF, 2298, 0},
+	{0xAAC1, 2298, 0},
+	{0xAAEC, 2298, 0},
+	{0xAAED, 2298, 0},
+	{0xAAF6, 2298, 0},
+	{0xAB32, 2298, 1},
+	{0xAB35, 2299, 1},
+	{0xAB3D, 2300, 1},
+	{0xAB47, 2301, 1},
+	{0xAB48, 2302, 1},
+	{0xAB4E, 2303, 1},
+	{0xAB52, 2304, 1},
+	{0xAB5A, 2305, 1},
+	{0xAB5F, 2306, 1},
+	{0xAB63, 2307, 2},
+	{0xAB75, 2309, 1},
+	{0xAB81, 2310, 1},
+	{0xAB83, 2311, 1},
+	{0xAB93, 2312, 1},
+	{0xABA9, 2313, 1},
+	{0xABAA, 2314, 1},
+	{0xABAF, 2315, 1},
+	{0xABE5, 2316, 0},
+	{0xABE8, 2316, 0},
+	{0xABED, 2316, 0},
+	{0xFB00, 2316, 2},
+	{0xFB01, 2318, 2},
+	{0xFB02, 2320, 2},
+	{0xFB03, 2322, 3},
+	{0xFB04, 2325, 3},
+	{0xFB05, 2328, 2},
+	{0xFB06, 2330, 2},
+	{0xFB1D, 2332, 1},
+	{0xFB1E, 2333, 0},
+	{0xFB1F, 2333, 2},
+	{0xFB29, 2335, 1},
+	{0xFB35, 2336, 1},
+	{0xFB38, 2337, 1},
+	{0xFB39, 2338, 1},
+	{0xFB41, 2339, 1},
+	{0xFB4B, 2340, 1},
+	{0xFBA4, 2341, 1},
+	{0xFBA5, 2342, 1},
+	{0xFBA6, 2343, 1},
+	{0xFBA7, 2344, 1},
+	{0xFBA8, 2345, 1},
+	{0xFBA9, 2346, 1},
+	{0xFBAA, 2347, 1},
+	{0xFBAB, 2348, 1},
+	{0xFBAC, 2349, 1},
+	{0xFBAD, 2350, 1},
+	{0xFC5E, 2351, 1},
+	{0xFC5F, 2352, 1},
+	{0xFC60, 2353, 1},
+	{0xFC61, 2354, 1},
+	{0xFC62, 2355, 1},
+	{0xFC63, 2356, 1},
+	{0xFCD9, 2357, 1},
+	{0xFD3C, 2358, 1},
+	{0xFD3D, 2359, 1},
+	{0xFD3E, 2360, 1},
+	{0xFD3F, 2361, 1},
+	{0xFE00, 2362, 0},
+	{0xFE01, 2362, 0},
+	{0xFE02, 2362, 0},
+	{0xFE03, 2362, 0},
+	{0xFE04, 2362, 0},
+	{0xFE05, 2362, 0},
+	{0xFE06, 2362, 0},
+	{0xFE07, 2362, 0},
+	{0xFE08, 2362, 0},
+	{0xFE09, 2362, 0},
+	{0xFE0A, 2362, 0},
+	{0xFE0B, 2362, 0},
+	{0xFE0C, 2362, 0},
+	{0xFE0D, 2362, 0},
+	{0xFE0E, 2362, 0},
+	{0xFE0F, 2362, 0},
+	{0xFE10, 2362, 1},
+	{0xFE13, 2363, 1},
+	{0xFE14, 2364, 1},
+	{0xFE15, 2365, 1},
+	{0xFE16, 2366, 1},
+	{0xFE19, 2367, 3},
+	{0xFE20, 2370, 0},
+	{0xFE21, 2370, 0},
+	{0xFE22, 2370, 0},
+	{0xFE23, 2370, 0},
+	{0xFE24, 2370, 0},
+	{0xFE25, 2370, 0},
+	{0xFE26, 2370, 0},
+	{0xFE27, 2370, 0},
+	{0xFE28, 2370, 0},
+	{0xFE29, 2370, 0},
+	{0xFE2A, 2370, 0},
+	{0xFE2B, 2370, 0},
+	{0xFE2C, 2370, 0},
+	{0xFE2D, 2370, 0},
+	{0xFE2E, 2370, 0},
+	{0xFE2F, 2370, 0},
+	{0xFE30, 2370, 1},
+	{0xFE32, 2371, 1},
+	{0xFE33, 2372, 1},
+	{0xFE34, 2373, 1},
+	{0xFE35, 2374, 1},
+	{0xFE36, 2375, 1},
+	{0xFE37, 2376, 1},
+	{0xFE38, 2377, 1},
+	{0xFE39, 2378, 1},
+	{0xFE3A, 2379, 1},
+	{0xFE47, 2380, 1},
+	{0xFE48, 2381, 1},
+	{0xFE49, 2382, 1},
+	{0xFE4A, 2383, 1},
+	{0xFE4B, 2384, 1},
+	{0xFE4C, 2385, 1},
+	{0xFE4D, 2386, 1},
+	{0xFE4E, 2387, 1},
+	{0xFE4F, 2388, 1},
+	{0xFE50, 2389, 1},
+	{0xFE52, 2390, 1},
+	{0xFE54, 2391, 1},
+	{0xFE55, 2392, 1},
+	{0xFE56, 2393, 1},
+	{0xFE57, 2394, 1},
+	{0xFE58, 2395, 1},
+	{0xFE59, 2396, 1},
+	{0xFE5A, 2397, 1},
+	{0xFE5B, 2398, 1},
+	{0xFE5C, 2399, 1},
+	{0xFE5D, 2400, 1},
+	{0xFE5E, 2401, 1},
+	{0xFE5F, 2402, 1},
+	{0xFE60, 2403, 1},
+	{0xFE61, 2404, 1},
+	{0xFE62, 2405, 1},
+	{0xFE63, 2406, 1},
+	{0xFE64, 2407, 1},
+	{0xFE65, 2408, 1},
+	{0xFE66, 2409, 1},
+	{0xFE68, 2410, 1},
+	{0xFE69, 2411, 1},
+	{0xFE6A, 2412, 1},
+	{0xFE6B, 2413, 1},
+	{0xFE70, 2414, 1},
+	{0xFE72, 2415, 1},
+	{0xFE74, 2416, 1},
+	{0xFE76, 2417, 1},
+	{0xFE78, 2418, 1},
+	{0xFE7A, 2419, 1},
+	{0xFE7C, 2420, 1},
+	{0xFE7E, 2421, 1},
+	{0xFE81, 2422, 1},
+	{0xFE82, 2423, 1},
+	{0xFE83, 2424, 1},
+	{0xFE84, 2425, 1},
+	{0xFE87, 2426, 1},
+	{0xFE88, 2427, 1},
+	{0xFE8D, 2428, 1},
+	{0xFE8E, 2429, 1},
+	{0xFEE9, 2430, 1},
+	{0xFEEA, 2431, 1},
+	{0xFEEB, 2432, 1},
+	{0xFEEC, 2433, 1},
+	{0xFEFF, 2434, 0},
+	{0xFF01, 2434, 1},
+	{0xFF02, 2435, 2},
+	{0xFF03, 2437, 1},
+	{0xFF04, 2438, 1},
+	{0xFF05, 2439, 1},
+	{0xFF06, 2440, 1},
+	{0xFF07, 2441, 1},
+	{0xFF08, 2442, 1},
+	{0xFF09, 2443, 1},
+	{0xFF0A, 2444, 1},
+	{0xFF0B, 2445, 1},
+	{0xFF0C, 2446, 1},
+	{0xFF0D, 2447, 1},
+	{0xFF0E, 2448, 1},
+	{0xFF0F, 2449, 1},
+	{0xFF10, 2450, 1},
+	{0xFF11, 2451, 1},
+	{0xFF12, 2452, 1},
+	{0xFF13, 2453, 1},
+	{0xFF14, 2454, 1},
+	{0xFF15, 2455, 1},
+	{0xFF16, 2456, 1},
+	{0xFF17, 2457, 1},
+	{0xFF18, 2458, 1},
+	{0xFF19, 2459, 1},
+	{0xFF1A, 2460, 1},
+	{0xFF1B, 2461, 1},
+	{0xFF1C, 2462, 1},
+	{0xFF1D, 2463, 1},
+	{0xFF1E, 2464, 1},
+	{0xFF1F, 2465, 1},
+	{0xFF20, 2466, 1},
+	{0xFF21, 2467, 1},
+	{0xFF22, 2468, 1},
+	{0xFF23, 2469, 1},
+	{0xFF24, 2470, 1},
+	{0xFF25, 2471, 1},
+	{0xFF26, 2472, 1},
+	{0xFF27, 2473, 1},
+	{0xFF28, 2474, 1},
+	{0xFF29, 2475, 1},
+	{0xFF2A, 2476, 1},
+	{0xFF2B, 2477, 1},
+	{0xFF2C, 2478, 1},
+	{0xFF2D, 2479, 1},
+	{0xFF2E, 2480, 1},
+	{0xFF2F, 2481, 1},
+	{0xFF30, 2482, 1},
+	{0xFF31, 2483, 1},
+	{0xFF32, 2484, 1},
+	{0xFF33, 2485, 1},
+	{0xFF34, 2486, 1},
+	{0xFF35, 2487, 1},
+	{0xFF36, 2488, 1},
+	{0xFF37, 2489, 1},
+	{0xFF38, 2490, 1},
+	{0xFF39, 2491, 1},
+	{0xFF3A, 2492, 1},
+	{0xFF3B, 2493, 1},
+	{0xFF3C, 2494, 1},
+	{0xFF3D, 2495, 1},
+	{0xFF3E, 2496, 1},
+	{0xFF3F, 2497, 1},
+	{0xFF40, 2498, 1},
+	{0xFF41, 2499, 1},
+	{0xFF42, 2500, 1},
+	{0xFF43, 2501, 1},
+	{0xFF44, 2502, 1},
+	{0xFF45, 2503, 1},
+	{0xFF46, 2504, 1},
+	{0xFF47, 2505, 1},
+	{0xFF48, 2506, 1},
+	{0xFF49, 2507, 1},
+	{0xFF4A, 2508, 1},
+	{0xFF4B, 2509, 1},
+	{0xFF4C, 2510, 1},
+	{0xFF4D, 2511, 1},
+	{0xFF4E, 2512, 1},
+	{0xFF4F, 2513, 1},
+	{0xFF50, 2514, 1},
+	{0xFF51, 2515, 1},
+	{0xFF52, 2516, 1},
+	{0xFF53, 2517, 1},
+	{0xFF54, 2518, 1},
+	{0xFF55, 2519, 1},
+	{0xFF56, 2520, 1},
+	{0xFF57, 2521, 1},
+	{0xFF58, 2522, 1},
+	{0xFF59, 2523, 1},
+	{0xFF5A, 2524, 1},
+	{0xFF5B, 2525, 1},
+	{0xFF5C, 2526, 1},
+	{0xFF5D, 2527, 1},
+	{0xFF5E, 2528, 1},
+	{0xFF89, 2529, 1},
+	{0xFF9E, 2530, 0},
+	{0xFF9F, 2530, 0},
+	{0xFFE3, 2530, 1},
+	{0xFFE8, 2531, 1},
+	{0xFFF9, 2532, 0},
+	{0xFFFA, 2532, 0},
+	{0xFFFB, 2532, 0},
+	{0x101FD, 2532, 0},
+	{0x10282, 2532, 1},
+	{0x10286, 2533, 1},
+	{0x10287, 2534, 1},
+	{0x1028A, 2535, 1},
+	{0x10290, 2536, 1},
+	{0x10292, 2537, 1},
+	{0x10295, 2538, 1},
+	{0x10296, 2539, 1},
+	{0x10297, 2540, 1},
+	{0x1029B, 2541, 1},
+	{0x102A0, 2542, 1},
+	{0x102A1, 2543, 1},
+	{0x102A2, 2544, 1},
+	{0x102A5, 2545, 1},
+	{0x102AB, 2546, 1},
+	{0x102B0, 2547, 1},
+	{0x102B1, 2548, 1},
+	{0x102B2, 2549, 1},
+	{0x102B4, 2550, 1},
+	{0x102CF, 2551, 1},
+	{0x102E0, 2552, 0},
+	{0x102F5, 2552, 1},
+	{0x10301, 2553, 1},
+	{0x10302, 2554, 1},
+	{0x10309, 2555, 1},
+	{0x10311, 2556, 1},
+	{0x10315, 2557, 1},
+	{0x10317, 2558, 1},
+	{0x1031A, 2559, 1},
+	{0x1031F, 2560, 1},
+	{0x10320, 2561, 1},
+	{0x10322, 2562, 1},
+	{0x10376, 2563, 0},
+	{0x10377, 2563, 0},
+	{0x10378, 2563, 0},
+	{0x10379, 2563, 0},
+	{0x1037A, 2563, 0},
+	{0x10404, 2563, 1},
+	{0x10415, 2564, 1},
+	{0x1041B, 2565, 1},
+	{0x10420, 2566, 1},
+	{0x1042C, 2567, 1},
+	{0x1043D, 2568, 1},
+	{0x10448, 2569, 1},
+	{0x104B4, 2570, 1},
+	{0x104C2, 2571, 1},
+	{0x104CE, 2572, 1},
+	{0x104D2, 2573, 1},
+	{0x104EA, 2574, 1},
+	{0x104F6, 2575, 1},
+	{0x10513, 2576, 1},
+	{0x10516, 2577, 1},
+	{0x10518, 2578, 1},
+	{0x1051C, 2579, 1},
+	{0x1051D, 2580, 1},
+	{0x10525, 2581, 1},
+	{0x10526, 2582, 1},
+	{0x10527, 2583, 1},
+	{0x10781, 2584, 1},
+	{0x10783, 2585, 2},
+	{0x10787, 2587, 2},
+	{0x10799, 2589, 2},
+	{0x1079A, 2591, 2},
+	{0x107A5, 2593, 1},
+	{0x107AC, 2594, 2},
+	{0x107B2, 2596, 1},
+	{0x107B6, 2597, 1},
+	{0x107B7, 2598, 2},
+	{0x10A01, 2600, 0},
+	{0x10A02, 2600, 0},
+	{0x10A03, 2600, 0},
+	{0x10A05, 2600, 0},
+	{0x10A06, 2600, 0},
+	{0x10A0C, 2600, 0},
+	{0x10A0D, 2600, 0},
+	{0x10A0E, 2600, 0},
+	{0x10A0F, 2600, 0},
+	{0x10A38, 2600, 0},
+	{0x10A39, 2600, 0},
+	{0x10A3A, 2600, 0},
+	{0x10A3F, 2600, 0},
+	{0x10A50, 2600, 1},
+	{0x10AE5, 2601, 0},
+	{0x10AE6, 2601, 0},
+	{0x10D24, 2601, 0},
+	{0x10D25, 2601, 0},
+	{0x10D26, 2601, 0},
+	{0x10D27, 2601, 0},
+	{0x10D69, 2601, 0},
+	{0x10D6A, 2601, 0},
+	{0x10D6B, 2601, 0},
+	{0x10D6C, 2601, 0},
+	{0x10D6D, 2601, 0},
+	{0x10EAB, 2601, 0},
+	{0x10EAC, 2601, 0},
+	{0x10EFA, 2601, 0},
+	{0x10EFB, 2601, 0},
+	{0x10EFC, 2601, 0},
+	{0x10EFD, 2601, 0},
+	{0x10EFE, 2601, 0},
+	{0x10EFF, 2601, 0},
+	{0x10F46, 2601, 0},
+	{0x10F47, 2601, 0},
+	{0x10F48, 2601, 0},
+	{0x10F49, 2601, 0},
+	{0x10F4A, 2601, 0},
+	{0x10F4B, 2601, 0},
+	{0x10F4C, 2601, 0},
+	{0x10F4D, 2601, 0},
+	{0x10F4E, 2601, 0},
+	{0x10F4F, 2601, 0},
+	{0x10F50, 2601, 0},
+	{0x10F82, 2601, 0},
+	{0x10F83, 2601, 0},
+	{0x10F84, 2601, 0},
+	{0x10F85, 2601, 0},
+	{0x11001, 2601, 0},
+	{0x11038, 2601, 0},
+	{0x11039, 2601, 0},
+	{0x1103A, 2601, 0},
+	{0x1103B, 2601, 0},
+	{0x1103C, 2601, 0},
+	{0x1103D, 2601, 0},
+	{0x1103E, 2601, 0},
+	{0x1103F, 2601, 0},
+	{0x11040, 2601, 0},
+	{0x11041, 2601, 0},
+	{0x11042, 2601, 0},
+	{0x11043, 2601, 0},
+	{0x11044, 2601, 0},
+	{0x11045, 2601, 0},
+	{0x11046, 2601, 0},
+	{0x11070, 2601, 0},
+	{0x11073, 2601, 0},
+	{0x11074, 2601, 0},
+	{0x1107F, 2601, 0},
+	{0x11080, 2601, 0},
+	{0x11081, 2601, 0},
+	{0x110B3, 2601, 0},
+	{0x110B4, 2601, 0},
+	{0x110B5, 2601, 0},
+	{0x110B6, 2601, 0},
+	{0x110B9, 2601, 0},
+	{0x110BA, 2601, 0},
+	{0x110BD, 2601, 0},
+	{0x110C2, 2601, 0},
+	{0x110CD, 2601, 0},
+	{0x11100, 2601, 0},
+	{0x11101, 2601, 0},
+	{0x11102, 2601, 0},
+	{0x11127, 2601, 0},
+	{0x11128, 2601, 0},
+	{0x11129, 2601, 0},
+	{0x1112A, 2601, 0},
+	{0x1112B, 2601, 0},
+	{0x1112D, 2601, 0},
+	{0x1112E, 2601, 0},
+	{0x1112F, 2601, 0},
+	{0x11130, 2601, 0},
+	{0x11131, 2601, 0},
+	{0x11132, 2601, 0},
+	{0x11133, 2601, 0},
+	{0x11134, 2601, 0},
+	{0x11173, 2601, 0},
+	{0x11180, 2601, 0},
+	{0x11181, 2601, 0},
+	{0x111B6, 2601, 0},
+	{0x111B7, 2601, 0},
+	{0x111B8, 2601, 0},
+	{0x111B9, 2601, 0},
+	{0x111BA, 2601, 0},
+	{0x111BB, 2601, 0},
+	{0x111BC, 2601, 0},
+	{0x111BD, 2601, 0},
+	{0x111BE, 2601, 0},
+	{0x111C9, 2601, 0},
+	{0x111CA, 2601, 0},
+	{0x111CB, 2601, 0},
+	{0x111CC, 2601, 0},
+	{0x111CF, 2601, 0},
+	{0x1122F, 2601, 0},
+	{0x11230, 2601, 0},
+	{0x11231, 2601, 0},
+	{0x11234, 2601, 0},
+	{0x11236, 2601, 0},
+	{0x11237, 2601, 0},
+	{0x1123E, 2601, 0},
+	{0x11241, 2601, 0},
+	{0x112DF, 2601, 0},
+	{0x112E3, 2601, 0},
+	{0x112E4, 2601, 0},
+	{0x112E5, 2601, 0},
+	{0x112E6, 2601, 0},
+	{0x112E7, 2601, 0},
+	{0x112E8, 2601, 0},
+	{0x112E9, 2601, 0},
+	{0x112EA, 2601, 0},
+	{0x11300, 2601, 0},
+	{0x11301, 2601, 0},
+	{0x1133B, 2601, 0},
+	{0x1133C, 2601, 0},
+	{0x11340, 2601, 0},
+	{0x11366, 2601, 0},
+	{0x11367, 2601, 0},
+	{0x11368, 2601, 0},
+	{0x11369, 2601, 0},
+	{0x1136A, 2601, 0},
+	{0x1136B, 2601, 0},
+	{0x1136C, 2601, 0},
+	{0x11370, 2601, 0},
+	{0x11371, 2601, 0},
+	{0x11372, 2601, 0},
+	{0x11373, 2601, 0},
+	{0x11374, 2601, 0},
+	{0x113BB, 2601, 0},
+	{0x113BC, 2601, 0},
+	{0x113BD, 2601, 0},
+	{0x113BE, 2601, 0},
+	{0x113BF, 2601, 0},
+	{0x113C0, 2601, 0},
+	{0x113CE, 2601, 0},
+	{0x113D0, 2601, 0},
+	{0x113D2, 2601, 0},
+	{0x113E1, 2601, 0},
+	{0x113E2, 2601, 0},
+	{0x11438, 2601, 0},
+	{0x11439, 2601, 0},
+	{0x1143A, 2601, 0},
+	{0x1143B, 2601, 0},
+	{0x1143C, 2601, 0},
+	{0x1143D, 2601, 0},
+	{0x1143E, 2601, 0},
+	{0x1143F, 2601, 0},
+	{0x11442, 2601, 0},
+	{0x11443, 2601, 0},
+	{0x11444, 2601, 0},
+	{0x11446, 2601, 0},
+	{0x1145E, 2601, 0},
+	{0x114B3, 2601, 0},
+	{0x114B4, 2601, 0},
+	{0x114B5, 2601, 0},
+	{0x114B6, 2601, 0},
+	{0x114B7, 2601, 0},
+	{0x114B8, 2601, 0},
+	{0x114BA, 2601, 0},
+	{0x114BF, 2601, 0},
+	{0x114C0, 2601, 0},
+	{0x114C2, 2601, 0},
+	{0x114C3, 2601, 0},
+	{0x114D0, 2601, 1},
+	{0x115B2, 2602, 0},
+	{0x115B3, 2602, 0},
+	{0x115B4, 2602, 0},
+	{0x115B5, 2602, 0},
+	{0x115BC, 2602, 0},
+	{0x115BD, 2602, 0},
+	{0x115BF, 2602, 0},
+	{0x115C0, 2602, 0},
+	{0x115DC, 2602, 0},
+	{0x115DD, 2602, 0},
+	{0x11633, 2602, 0},
+	{0x11634, 2602, 0},
+	{0x11635, 2602, 0},
+	{0x11636, 2602, 0},
+	{0x11637, 2602, 0},
+	{0x11638, 2602, 0},
+	{0x11639, 2602, 0},
+	{0x1163A, 2602, 0},
+	{0x1163D, 2602, 0},
+	{0x1163F, 2602, 0},
+	{0x11640, 2602, 0},
+	{0x116AB, 2602, 0},
+	{0x116AD, 2602, 0},
+	{0x116B0, 2602, 0},
+	{0x116B1, 2602, 0},
+	{0x116B2, 2602, 0},
+	{0x116B3, 2602, 0},
+	{0x116B4, 2602, 0},
+	{0x116B5, 2602, 0},
+	{0x116B7, 2602, 0},
+	{0x11700, 2602, 2},
+	{0x11706, 2604, 1},
+	{0x1170A, 2605, 1},
+	{0x1170E, 2606, 1},
+	{0x1170F, 2607, 1},
+	{0x1171D, 2608, 0},
+	{0x1171F, 2608, 0},
+	{0x11722, 2608, 0},
+	{0x11723, 2608, 0},
+	{0x11724, 2608, 0},
+	{0x11725, 2608, 0},
+	{0x11727, 2608, 0},
+	{0x11728, 2608, 0},
+	{0x11729, 2608, 0},
+	{0x1172A, 2608, 0},
+	{0x1172B, 2608, 0},
+	{0x1182F, 2608, 0},
+	{0x11830, 2608, 0},
+	{0x11831, 2608, 0},
+	{0x11832, 2608, 0},
+	{0x11833, 2608, 0},
+	{0x11834, 2608, 0},
+	{0x11835, 2608, 0},
+	{0x11836, 2608, 0},
+	{0x11837, 2608, 0},
+	{0x11839, 2608, 0},
+	{0x1183A, 2608, 0},
+	{0x118A0, 2608, 1},
+	{0x118A2, 2609, 1},
+	{0x118A3, 2610, 1},
+	{0x118A4, 2611, 1},
+	{0x118A6, 2612, 1},
+	{0x118A9, 2613, 1},
+	{0x118AC, 2614, 1},
+	{0x118AE, 2615, 1},
+	{0x118AF, 2616, 1},
+	{0x118B2, 2617, 1},
+	{0x118B5, 2618, 1},
+	{0x118B8, 2619, 1},
+	{0x118BB, 2620, 1},
+	{0x118BC, 2621, 1},
+	{0x118C0, 2622, 1},
+	{0x118C1, 2623, 1},
+	{0x118C2, 2624, 1},
+	{0x118C3, 2625, 1},
+	{0x118C4, 2626, 1},
+	{0x118C6, 2627, 1},
+	{0x118C8, 2628, 1},
+	{0x118CA, 2629, 1},
+	{0x118CC, 2630, 1},
+	{0x118D5, 2631, 1},
+	{0x118D6, 2632, 1},
+	{0x118D7, 2633, 1},
+	{0x118D8, 2634, 1},
+	{0x118DC, 2635, 1},
+	{0x118E0, 2636, 1},
+	{0x118E3, 2637, 2},
+	{0x118E5, 2639, 1},
+	{0x118E6, 2640, 1},
+	{0x118E9, 2641, 1},
+	{0x118EC, 2642, 1},
+	{0x118EF, 2643, 1},
+	{0x118F2, 2644, 1},
+	{0x1193B, 2645, 0},
+	{0x1193C, 2645, 0},
+	{0x1193E, 2645, 0},
+	{0x11943, 2645, 0},
+	{0x119D4, 2645, 0},
+	{0x119D5, 2645, 0},
+	{0x119D6, 2645, 0},
+	{0x119D7, 2645, 0},
+	{0x119DA, 2645, 0},
+	{0x119DB, 2645, 0},
+	{0x119E0, 2645, 0},
+	{0x11A01, 2645, 0},
+	{0x11A02, 2645, 0},
+	{0x11A03, 2645, 0},
+	{0x11A04, 2645, 0},
+	{0x11A05, 2645, 0},
+	{0x11A06, 2645, 0},
+	{0x11A07, 2645, 0},
+	{0x11A08, 2645, 0},
+	{0x11A09, 2645, 0},
+	{0x11A0A, 2645, 0},
+	{0x11A33, 2645, 0},
+	{0x11A34, 2645, 0},
+	{0x11A35, 2645, 0},
+	{0x11A36, 2645, 0},
+	{0x11A37, 2645, 0},
+	{0x11A38, 2645, 0},
+	{0x11A3B, 2645, 0},
+	{0x11A3C, 2645, 0},
+	{0x11A3D, 2645, 0},
+	{0x11A3E, 2645, 0},
+	{0x11A47, 2645, 0},
+	{0x11A51, 2645, 0},
+	{0x11A52, 2645, 0},
+	{0x11A53, 2645, 0},
+	{0x11A54, 2645, 0},
+	{0x11A55, 2645, 0},
+	{0x11A56, 2645, 0},
+	{0x11A59, 2645, 0},
+	{0x11A5A, 2645, 0},
+	{0x11A5B, 2645, 0},
+	{0x11A8A, 2645, 0},
+	{0x11A8B, 2645, 0},
+	{0x11A8C, 2645, 0},
+	{0x11A8D, 2645, 0},
+	{0x11A8E, 2645, 0},
+	{0x11A8F, 2645, 0},
+	{0x11A90, 2645, 0},
+	{0x11A91, 2645, 0},
+	{0x11A92, 2645, 0},
+	{0x11A93, 2645, 0},
+	{0x11A94, 2645, 0},
+	{0x11A95, 2645, 0},
+	{0x11A96, 2645, 0},
+	{0x11A98, 2645, 0},
+	{0x11A99, 2645, 0},
+	{0x11B60, 2645, 0},
+	{0x11B62, 2645, 0},
+	{0x11B63, 2645, 0},
+	{0x11B64, 2645, 0},
+	{0x11B66, 2645, 0},
+	{0x11C30, 2645, 0},
+	{0x11C31, 2645, 0},
+	{0x11C32, 2645, 0},
+	{0x11C33, 2645, 0},
+	{0x11C34, 2645, 0},
+	{0x11C35, 2645, 0},
+	{0x11C36, 2645, 0},
+	{0x11C38, 2645, 0},
+	{0x11C39, 2645, 0},
+	{0x11C3A, 2645, 0},
+	{0x11C3B, 2645, 0},
+	{0x11C3C, 2645, 0},
+	{0x11C3D, 2645, 0},
+	{0x11C3F, 2645, 0},
+	{0x11C92, 2645, 0},
+	{0x11C93, 2645, 0},
+	{0x11C94, 2645, 0},
+	{0x11C95, 2645, 0},
+	{0x11C96, 2645, 0},
+	{0x11C97, 2645, 0},
+	{0x11C98, 2645, 0},
+	{0x11C99, 2645, 0},
+	{0x11C9A, 2645, 0},
+	{0x11C9B, 2645, 0},
+	{0x11C9C, 2645, 0},
+	{0x11C9D, 2645, 0},
+	{0x11C9E, 2645, 0},
+	{0x11C9F, 2645, 0},
+	{0x11CA0, 2645, 0},
+	{0x11CA1, 2645, 0},
+	{0x11CA2, 2645, 0},
+	{0x11CA3, 2645, 0},
+	{0x11CA4, 2645, 0},
+	{0x11CA5, 2645, 0},
+	{0x11CA6, 2645, 0},
+	{0x11CA7, 2645, 0},
+	{0x11CAA, 2645, 0},
+	{0x11CAB, 2645, 0},
+	{0x11CAC, 2645, 0},
+	{0x11CAD, 2645, 0},
+	{0x11CAE, 2645, 0},
+	{0x11CAF, 2645, 0},
+	{0x11CB0, 2645, 0},
+	{0x11CB2, 2645, 0},
+	{0x11CB3, 2645, 0},
+	{0x11CB5, 2645, 0},
+	{0x11CB6, 2645, 0},
+	{0x11D31, 2645, 0},
+	{0x11D32, 2645, 0},
+	{0x11D33, 2645, 0},
+	{0x11D34, 2645, 0},
+	{0x11D35, 2645, 0},
+	{0x11D36, 2645, 0},
+	{0x11D3A, 2645, 0},
+	{0x11D3C, 2645, 0},
+	{0x11D3D, 2645, 0},
+	{0x11D3F, 2645, 0},
+	{0x11D40, 2645, 0},
+	{0x11D41, 2645, 0},
+	{0x11D42, 2645, 0},
+	{0x11D43, 2645, 0},
+	{0x11D44, 2645, 0},
+	{0x11D45, 2645, 0},
+	{0x11D47, 2645, 0},
+	{0x11D90, 2645, 0},
+	{0x11D91, 2645, 0},
+	{0x11D95, 2645, 0},
+	{0x11D97, 2645, 0},
+	{0x11EF3, 2645, 0},
+	{0x11EF4, 2645, 0},
+	{0x11F00, 2645, 0},
+	{0x11F01, 2645, 0},
+	{0x11F36, 2645, 0},
+	{0x11F37, 2645, 0},
+	{0x11F38, 2645, 0},
+	{0x11F39, 2645, 0},
+	{0x11F3A, 2645, 0},
+	{0x11F40, 2645, 0},
+	{0x11F42, 2645, 0},
+	{0x11F5A, 2645, 0},
+	{0x13430, 2645, 0},
+	{0x13431, 2645, 0},
+	{0x13432, 2645, 0},
+	{0x13433, 2645, 0},
+	{0x13434, 2645, 0},
+	{0x13435, 2645, 0},
+	{0x13436, 2645, 0},
+	{0x13437, 2645, 0},
+	{0x13438, 2645, 0},
+	{0x13439, 2645, 0},
+	{0x1343A, 2645, 0},
+	{0x1343B, 2645, 0},
+	{0x1343C, 2645, 0},
+	{0x1343D, 2645, 0},
+	{0x1343E, 2645, 0},
+	{0x1343F, 2645, 0},
+	{0x13440, 2645, 0},
+	{0x13447, 2645, 0},
+	{0x13448, 2645, 0},
+	{0x13449, 2645, 0},
+	{0x1344A, 2645, 0},
+	{0x1344B, 2645, 0},
+	{0x1344C, 2645, 0},
+	{0x1344D, 2645, 0},
+	{0x1344E, 2645, 0},
+	{0x1344F, 2645, 0},
+	{0x13450, 2645, 0},
+	{0x13451, 2645, 0},
+	{0x13452, 2645, 0},
+	{0x13453, 2645, 0},
+	{0x13454, 2645, 0},
+	{0x13455, 2645, 0},
+	{0x1611E, 2645, 0},
+	{0x1611F, 2645, 0},
+	{0x16120, 2645, 0},
+	{0x16121, 2645, 0},
+	{0x16122, 2645, 0},
+	{0x16123, 2645, 0},
+	{0x16124, 2645, 0},
+	{0x16125, 2645, 0},
+	{0x16126, 2645, 0},
+	{0x16127, 2645, 0},
+	{0x16128, 2645, 0},
+	{0x16129, 2645, 0},
+	{0x1612D, 2645, 0},
+	{0x1612E, 2645, 0},
+	{0x1612F, 2645, 0},
+	{0x16AF0, 2645, 0},
+	{0x16AF1, 2645, 0},
+	{0x16AF2, 2645, 0},
+	{0x16AF3, 2645, 0},
+	{0x16AF4, 2645, 0},
+	{0x16B30, 2645, 0},
+	{0x16B31, 2645, 0},
+	{0x16B32, 2645, 0},
+	{0x16B33, 2645, 0},
+	{0x16B34, 2645, 0},
+	{0x16B35, 2645, 0},
+	{0x16B36, 2645, 0},
+	{0x16F08, 2645, 1},
+	{0x16F0A, 2646, 1},
+	{0x16F16, 2647, 1},
+	{0x16F28, 2648, 1},
+	{0x16F35, 2649, 1},
+	{0x16F3A, 2650, 1},
+	{0x16F3B, 2651, 1},
+	{0x16F3F, 2652, 1},
+	{0x16F40, 2653, 1},
+	{0x16F42, 2654, 1},
+	{0x16F43, 2655, 1},
+	{0x16F4F, 2656, 0},
+	{0x16F51, 2656, 1},
+	{0x16F52, 2657, 1},
+	{0x16F8F, 2658, 0},
+	{0x16F90, 2658, 0},
+	{0x16F91, 2658, 0},
+	{0x16F92, 2658, 0},
+	{0x16FE4, 2658, 0},
+	{0x1BC9D, 2658, 0},
+	{0x1BC9E, 2658, 0},
+	{0x1BCA0, 2658, 0},
+	{0x1BCA1, 2658, 0},
+	{0x1BCA2, 2658, 0},
+	{0x1BCA3, 2658, 0},
+	{0x1CF00, 2658, 0},
+	{0x1CF01, 2658, 0},
+	{0x1CF02, 2658, 0},
+	{0x1CF03, 2658, 0},
+	{0x1CF04, 2658, 0},
+	{0x1CF05, 2658, 0},
+	{0x1CF06, 2658, 0},
+	{0x1CF07, 2658, 0},
+	{0x1CF08, 2658, 0},
+	{0x1CF09, 2658, 0},
+	{0x1CF0A, 2658, 0},
+	{0x1CF0B, 2658, 0},
+	{0x1CF0C, 2658, 0},
+	{0x1CF0D, 2658, 0},
+	{0x1CF0E, 2658, 0},
+	{0x1CF0F, 2658, 0},
+	{0x1CF10, 2658, 0},
+	{0x1CF11, 2658, 0},
+	{0x1CF12, 2658, 0},
+	{0x1CF13, 2658, 0},
+	{0x1CF14, 2658, 0},
+	{0x1CF15, 2658, 0},
+	{0x1CF16, 2658, 0},
+	{0x1CF17, 2658, 0},
+	{0x1CF18, 2658, 0},
+	{0x1CF19, 2658, 0},
+	{0x1CF1A, 2658, 0},
+	{0x1CF1B, 2658, 0},
+	{0x1CF1C, 2658, 0},
+	{0x1CF1D, 2658, 0},
+	{0x1CF1E, 2658, 0},
+	{0x1CF1F, 2658, 0},
+	{0x1CF20, 2658, 0},
+	{0x1CF21, 2658, 0},
+	{0x1CF22, 2658, 0},
+	{0x1CF23, 2658, 0},
+	{0x1CF24, 2658, 0},
+	{0x1CF25, 2658, 0},
+	{0x1CF26, 2658, 0},
+	{0x1CF27, 2658, 0},
+	{0x1CF28, 2658, 0},
+	{0x1CF29, 2658, 0},
+	{0x1CF2A, 2658, 0},
+	{0x1CF2B, 2658, 0},
+	{0x1CF2C, 2658, 0},
+	{0x1CF2D, 2658, 0},
+	{0x1CF30, 2658, 0},
+	{0x1CF31, 2658, 0},
+	{0x1CF32, 2658, 0},
+	{0x1CF33, 2658, 0},
+	{0x1CF34, 2658, 0},
+	{0x1CF35, 2658, 0},
+	{0x1CF36, 2658, 0},
+	{0x1CF37, 2658, 0},
+	{0x1CF38, 2658, 0},
+	{0x1CF39, 2658, 0},
+	{0x1CF3A, 2658, 0},
+	{0x1CF3B, 2658, 0},
+	{0x1CF3C, 2658, 0},
+	{0x1CF3D, 2658, 0},
+	{0x1CF3E, 2658, 0},
+	{0x1CF3F, 2658, 0},
+	{0x1CF40, 2658, 0},
+	{0x1CF41, 2658, 0},
+	{0x1CF42, 2658, 0},
+	{0x1CF43, 2658, 0},
+	{0x1CF44, 2658, 0},
+	{0x1CF45, 2658, 0},
+	{0x1CF46, 2658, 0},
+	{0x1D114, 2658, 1},
+	{0x1D167, 2659, 0},
+	{0x1D168, 2659, 0},
+	{0x1D169, 2659, 0},
+	{0x1D16D, 2659, 1},
+	{0x1D173, 2660, 0},
+	{0x1D174, 2660, 0},
+	{0x1D175, 2660, 0},
+	{0x1D176, 2660, 0},
+	{0x1D177, 2660, 0},
+	{0x1D178, 2660, 0},
+	{0x1D179, 2660, 0},
+	{0x1D17A, 2660, 0},
+	{0x1D17B, 2660, 0},
+	{0x1D17C, 2660, 0},
+	{0x1D17D, 2660, 0},
+	{0x1D17E, 2660, 0},
+	{0x1D17F, 2660, 0},
+	{0x1D180, 2660, 0},
+	{0x1D181, 2660, 0},
+	{0x1D182, 2660, 0},
+	{0x1D185, 2660, 0},
+	{0x1D186, 2660, 0},
+	{0x1D187, 2660, 0},
+	{0x1D188, 2660, 0},
+	{0x1D189, 2660, 0},
+	{0x1D18A, 2660, 0},
+	{0x1D18B, 2660, 0},
+	{0x1D1AA, 2660, 0},
+	{0x1D1AB, 2660, 0},
+	{0x1D1AC, 2660, 0},
+	{0x1D1AD, 2660, 0},
+	{0x1D206, 2660, 1},
+	{0x1D20D, 2661, 1},
+	{0x1D20F, 2662, 1},
+	{0x1D212, 2663, 1},
+	{0x1D213, 2664, 1},
+	{0x1D216, 2665, 1},
+	{0x1D22A, 2666, 1},
+	{0x1D236, 2667, 1},
+	{0x1D237, 2668, 1},
+	{0x1D23A, 2669, 1},
+	{0x1D23B, 2670, 1},
+	{0x1D242, 2671, 0},
+	{0x1D243, 2671, 0},
+	{0x1D244, 2671, 0},
+	{0x1D400, 2671, 1},
+	{0x1D401, 2672, 1},
+	{0x1D402, 2673, 1},
+	{0x1D403, 2674, 1},
+	{0x1D404, 2675, 1},
+	{0x1D405, 2676, 1},
+	{0x1D406, 2677, 1},
+	{0x1D407, 2678, 1},
+	{0x1D408, 2679, 1},
+	{0x1D409, 2680, 1},
+	{0x1D40A, 2681, 1},
+	{0x1D40B, 2682, 1},
+	{0x1D40C, 2683, 1},
+	{0x1D40D, 2684, 1},
+	{0x1D40E, 2685, 1},
+	{0x1D40F, 2686, 1},
+	{0x1D410, 2687, 1},
+	{0x1D411, 2688, 1},
+	{0x1D412, 2689, 1},
+	{0x1D413, 2690, 1},
+	{0x1D414, 2691, 1},
+	{0x1D415, 2692, 1},
+	{0x1D416, 2693, 1},
+	{0x1D417, 2694, 1},
+	{0x1D418, 2695, 1},
+	{0x1D419, 2696, 1},
+	{0x1D41A, 2697, 1},
+	{0x1D41B, 2698, 1},
+	{0x1D41C, 2699, 1},
+	{0x1D41D, 2700, 1},
+	{0x1D41E, 2701, 1},
+	{0x1D41F, 2702, 1},
+	{0x1D420, 2703, 1},
+	{0x1D421, 2704, 1},
+	{0x1D422, 2705, 1},
+	{0x1D423, 2706, 1},
+	{0x1D424, 2707, 1},
+	{0x1D425, 2708, 1},
+	{0x1D426, 2709, 2},
+	{0x1D427, 2711, 1},
+	{0x1D428, 2712, 1},
+	{0x1D429, 2713, 1},
+	{0x1D42A, 2714, 1},
+	{0x1D42B, 2715, 1},
+	{0x1D42C, 2716, 1},
+	{0x1D42D, 2717, 1},
+	{0x1D42E, 2718, 1},
+	{0x1D42F, 2719, 1},
+	{0x1D430, 2720, 1},
+	{0x1D431, 2721, 1},
+	{0x1D432, 2722, 1},
+	{0x1D433, 2723, 1},
+	{0x1D434, 2724, 1},
+	{0x1D435, 2725, 1},
+	{0x1D436, 2726, 1},
+	{0x1D437, 2727, 1},
+	{0x1D438, 2728, 1},
+	{0x1D439, 2729, 1},
+	{0x1D43A, 2730, 1},
+	{0x1D43B, 2731, 1},
+	{0x1D43C, 2732, 1},
+	{0x1D43D, 2733, 1},
+	{0x1D43E, 2734, 1},
+	{0x1D43F, 2735, 1},
+	{0x1D440, 2736, 1},
+	{0x1D441, 2737, 1},
+	{0x1D442, 2738, 1},
+	{0x1D443, 2739, 1},
+	{0x1D444, 2740, 1},
+	{0x1D445, 2741, 1},
+	{0x1D446, 2742, 1},
+	{0x1D447, 2743, 1},
+	{0x1D448, 2744, 1},
+	{0x1D449, 2745, 1},
+	{0x1D44A, 2746, 1},
+	{0x1D44B, 2747, 1},
+	{0x1D44C, 2748, 1},
+	{0x1D44D, 2749, 1},
+	{0x1D44E, 2750, 1},
+	{0x1D44F, 2751, 1},
+	{0x1D450, 2752, 1},
+	{0x1D451, 2753, 1},
+	{0x1D452, 2754, 1},
+	{0x1D453, 2755, 1},
+	{0x1D454, 2756, 1},
+	{0x1D456, 2757, 1},
+	{0x1D457, 2758, 1},
+	{0x1D458, 2759, 1},
+	{0x1D459, 2760, 1},
+	{0x1D45A, 2761, 2},
+	{0x1D45B, 2763, 1},
+	{0x1D45C, 2764, 1},
+	{0x1D45D, 2765, 1},
+	{0x1D45E, 2766, 1},
+	{0x1D45F, 2767, 1},
+	{0x1D460, 2768, 1},
+	{0x1D461, 2769, 1},
+	{0x1D462, 2770, 1},
+	{0x1D463, 2771, 1},
+	{0x1D464, 2772, 1},
+	{0x1D465, 2773, 1},
+	{0x1D466, 2774, 1},
+	{0x1D467, 2775, 1},
+	{0x1D468, 2776, 1},
+	{0x1D469, 2777, 1},
+	{0x1D46A, 2778, 1},
+	{0x1D46B, 2779, 1},
+	{0x1D46C, 2780, 1},
+	{0x1D46D, 2781, 1},
+	{0x1D46E, 2782, 1},
+	{0x1D46F, 2783, 1},
+	{0x1D470, 2784, 1},
+	{0x1D471, 2785, 1},
+	{0x1D472, 2786, 1},
+	{0x1D473, 2787, 1},
+	{0x1D474, 2788, 1},
+	{0x1D475, 2789, 1},
+	{0x1D476, 2790, 1},
+	{0x1D477, 2791, 1},
+	{0x1D478, 2792, 1},
+	{0x1D479, 2793, 1},
+	{0x1D47A, 2794, 1},
+	{0x1D47B, 2795, 1},
+	{0x1D47C, 2796, 1},
+	{0x1D47D, 2797, 1},
+	{0x1D47E, 2798, 1},
+	{0x1D47F, 2799, 1},
+	{0x1D480, 2800, 1},
+	{0x1D481, 2801, 1},
+	{0x1D482, 2802, 1},
+	{0x1D483, 2803, 1},
+	{0x1D484, 2804, 1},
+	{0x1D485, 2805, 1},
+	{0x1D486, 2806, 1},
+	{0x1D487, 2807, 1},
+	{0x1D488, 2808, 1},
+	{0x1D489, 2809, 1},
+	{0x1D48A, 2810, 1},
+	{0x1D48B, 2811, 1},
+	{0x1D48C, 2812, 1},
+	{0x1D48D, 2813, 1},
+	{0x1D48E, 2814, 2},
+	{0x1D48F, 2816, 1},
+	{0x1D490, 2817, 1},
+	{0x1D491, 2818, 1},
+	{0x1D492, 2819, 1},
+	{0x1D493, 2820, 1},
+	{0x1D494, 2821, 1},
+	{0x1D495, 2822, 1},
+	{0x1D496, 2823, 1},
+	{0x1D497, 2824, 1},
+	{0x1D498, 2825, 1},
+	{0x1D499, 2826, 1},
+	{0x1D49A, 2827, 1},
+	{0x1D49B, 2828, 1},
+	{0x1D49C, 2829, 1},
+	{0x1D49E, 2830, 1},
+	{0x1D49F, 2831, 1},
+	{0x1D4A2, 2832, 1},
+	{0x1D4A5, 2833, 1},
+	{0x1D4A6, 2834, 1},
+	{0x1D4A9, 2835, 1},
+	{0x1D4AA, 2836, 1},
+	{0x1D4AB, 2837, 1},
+	{0x1D4AC, 2838, 1},
+	{0x1D4AE, 2839, 1},
+	{0x1D4AF, 2840, 1},
+	{0x1D4B0, 2841, 1},
+	{0x1D4B1, 2842, 1},
+	{0x1D4B2, 2843, 1},
+	{0x1D4B3, 2844, 1},
+	{0x1D4B4, 2845, 1},
+	{0x1D4B5, 2846, 1},
+	{0x1D4B6, 2847, 1},
+	{0x1D4B7, 2848, 1},
+	{0x1D4B8, 2849, 1},
+	{0x1D4B9, 2850, 1},
+	{0x1D4BB, 2851, 1},
+	{0x1D4BD, 2852, 1},
+	{0x1D4BE, 2853, 1},
+	{0x1D4BF, 2854, 1},
+	{0x1D4C0, 2855, 1},
+	{0x1D4C1, 2856, 1},
+	{0x1D4C2, 2857, 2},
+	{0x1D4C3, 2859, 1},
+	{0x1D4C5, 2860, 1},
+	{0x1D4C6, 2861, 1},
+	{0x1D4C7, 2862, 1},
+	{0x1D4C8, 2863, 1},
+	{0x1D4C9, 2864, 1},
+	{0x1D4CA, 2865, 1},
+	{0x1D4CB, 2866, 1},
+	{0x1D4CC, 2867, 1},
+	{0x1D4CD, 2868, 1},
+	{0x1D4CE, 2869, 1},
+	{0x1D4CF, 2870, 1},
+	{0x1D4D0, 2871, 1},
+	{0x1D4D1, 2872, 1},
+	{0x1D4D2, 2873, 1},
+	{0x1D4D3, 2874, 1},
+	{0x1D4D4, 2875, 1},
+	{0x1D4D5, 2876, 1},
+	{0x1D4D6, 2877, 1},
+	{0x1D4D7, 2878, 1},
+	{0x1D4D8, 2879, 1},
+	{0x1D4D9, 2880, 1},
+	{0x1D4DA, 2881, 1},
+	{0x1D4DB, 2882, 1},
+	{0x1D4DC, 2883, 1},
+	{0x1D4DD, 2884, 1},
+	{0x1D4DE, 2885, 1},
+	{0x1D4DF, 2886, 1},
+	{0x1D4E0, 2887, 1},
+	{0x1D4E1, 2888, 1},
+	{0x1D4E2, 2889, 1},
+	{0x1D4E3, 2890, 1},
+	{0x1D4E4, 2891, 1},
+	{0x1D4E5, 2892, 1},
+	{0x1D4E6, 2893, 1},
+	{0x1D4E7, 2894, 1},
+	{0x1D4E8, 2895, 1},
+	{0x1D4E9, 2896, 1},
+	{0x1D4EA, 2897, 1},
+	{0x1D4EB, 2898, 1},
+	{0x1D4EC, 2899, 1},
+	{0x1D4ED, 2900, 1},
+	{0x1D4EE, 2901, 1},
+	{0x1D4EF, 2902, 1},
+	{0x1D4F0, 2903, 1},
+	{0x1D4F1, 2904, 1},
+	{0x1D4F2, 2905, 1},
+	{0x1D4F3, 2906, 1},
+	{0x1D4F4, 2907, 1},
+	{0x1D4F5, 2908, 1},
+	{0x1D4F6, 2909, 2},
+	{0x1D4F7, 2911, 1},
+	{0x1D4F8, 2912, 1},
+	{0x1D4F9, 2913, 1},
+	{0x1D4FA, 2914, 1},
+	{0x1D4FB, 2915, 1},
+	{0x1D4FC, 2916, 1},
+	{0x1D4FD, 2917, 1},
+	{0x1D4FE, 2918, 1},
+	{0x1D4FF, 2919, 1},
+	{0x1D500, 2920, 1},
+	{0x1D501, 2921, 1},
+	{0x1D502, 2922, 1},
+	{0x1D503, 2923, 1},
+	{0x1D504, 2924, 1},
+	{0x1D505, 2925, 1},
+	{0x1D507, 2926, 1},
+	{0x1D508, 2927, 1},
+	{0x1D509, 2928, 1},
+	{0x1D50A, 2929, 1},
+	{0x1D50D, 2930, 1},
+	{0x1D50E, 2931, 1},
+	{0x1D50F, 2932, 1},
+	{0x1D510, 2933, 1},
+	{0x1D511, 2934, 1},
+	{0x1D512, 2935, 1},
+	{0x1D513, 2936, 1},
+	{0x1D514, 2937, 1},
+	{0x1D516, 2938, 1},
+	{0x1D517, 2939, 1},
+	{0x1D518, 2940, 1},
+	{0x1D519, 2941, 1},
+	{0x1D51A, 2942, 1},
+	{0x1D51B, 2943, 1},
+	{0x1D51C, 2944, 1},
+	{0x1D51E, 2945, 1},
+	{0x1D51F, 2946, 1},
+	{0x1D520, 2947, 1},
+	{0x1D521, 2948, 1},
+	{0x1D522, 2949, 1},
+	{0x1D523, 2950, 1},
+	{0x1D524, 2951, 1},
+	{0x1D525, 2952, 1},
+	{0x1D526, 2953, 1},
+	{0x1D527, 2954, 1},
+	{0x1D528, 2955, 1},
+	{0x1D529, 2956, 1},
+	{0x1D52A, 2957, 2},
+	{0x1D52B, 2959, 1},
+	{0x1D52C, 2960, 1},
+	{0x1D52D, 2961, 1},
+	{0x1D52E, 2962, 1},
+	{0x1D52F, 2963, 1},
+	{0x1D530, 2964, 1},
+	{0x1D531, 2965, 1},
+	{0x1D532, 2966, 1},
+	{0x1D533, 2967, 1},
+	{0x1D534, 2968, 1},
+	{0x1D535, 2969, 1},
+	{0x1D536, 2970, 1},
+	{0x1D537, 2971, 1},
+	{0x1D538, 2972, 1},
+	{0x1D539, 2973, 1},
+	{0x1D53B, 2974, 1},
+	{0x1D53C, 2975, 1},
+	{0x1D53D, 2976, 1},
+	{0x1D53E, 2977, 1},
+	{0x1D540, 2978, 1},
+	{0x1D541, 2979, 1},
+	{0x1D542, 2980, 1},
+	{0x1D543, 2981, 1},
+	{0x1D544, 2982, 1},
+	{0x1D546, 2983, 1},
+	{0x1D54A, 2984, 1},
+	{0x1D54B, 2985, 1},
+	{0x1D54C, 2986, 1},
+	{0x1D54D, 2987, 1},
+	{0x1D54E, 2988, 1},
+	{0x1D54F, 2989, 1},
+	{0x1D550, 2990, 1},
+	{0x1D552, 2991, 1},
+	{0x1D553, 2992, 1},
+	{0x1D554, 2993, 1},
+	{0x1D555, 2994, 1},
+	{0x1D556, 2995, 1},
+	{0x1D557, 2996, 1},
+	{0x1D558, 2997, 1},
+	{0x1D559, 2998, 1},
+	{0x1D55A, 2999, 1},
+	{0x1D55B, 3000, 1},
+	{0x1D55C, 3001, 1},
+	{0x1D55D, 3002, 1},
+	{0x1D55E, 3003, 2},
+	{0x1D55F, 3005, 1},
+	{0x1D560, 3006, 1},
+	{0x1D561, 3007, 1},
+	{0x1D562, 3008, 1},
+	{0x1D563, 3009, 1},
+	{0x1D564, 3010, 1},
+	{0x1D565, 3011, 1},
+	{0x1D566, 3012, 1},
+	{0x1D567, 3013, 1},
+	{0x1D568, 3014, 1},
+	{0x1D569, 3015, 1},
+	{0x1D56A, 3016, 1},
+	{0x1D56B, 3017, 1},
+	{0x1D56C, 3018, 1},
+	{0x1D56D, 3019, 1},
+	{0x1D56E, 3020, 1},
+	{0x1D56F, 3021, 1},
+	{0x1D570, 3022, 1},
+	{0x1D571, 3023, 1},
+	{0x1D572, 3024, 1},
+	{0x1D573, 3025, 1},
+	{0x1D574, 3026, 1},
+	{0x1D575, 3027, 1},
+	{0x1D576, 3028, 1},
+	{0x1D577, 3029, 1},
+	{0x1D578, 3030, 1},
+	{0x1D579, 3031, 1},
+	{0x1D57A, 3032, 1},
+	{0x1D57B, 3033, 1},
+	{0x1D57C, 3034, 1},
+	{0x1D57D, 3035, 1},
+	{0x1D57E, 3036, 1},
+	{0x1D57F, 3037, 1},
+	{0x1D580, 3038, 1},
+	{0x1D581, 3039, 1},
+	{0x1D582, 3040, 1},
+	{0x1D583, 3041, 1},
+	{0x1D584, 3042, 1},
+	{0x1D585, 3043, 1},
+	{0x1D586, 3044, 1},
+	{0x1D587, 3045, 1},
+	{0x1D588, 3046, 1},
+	{0x1D589, 3047, 1},
+	{0x1D58A, 3048, 1},
+	{0x1D58B, 3049, 1},
+	{0x1D58C, 3050, 1},
+	{0x1D58D, 3051, 1},
+	{0x1D58E, 3052, 1},
+	{0x1D58F, 3053, 1},
+	{0x1D590, 3054, 1},
+	{0x1D591, 3055, 1},
+	{0x1D592, 3056, 2},
+	{0x1D593, 3058, 1},
+	{0x1D594, 3059, 1},
+	{0x1D595, 3060, 1},
+	{0x1D596, 3061, 1},
+	{0x1D597, 3062, 1},
+	{0x1D598, 3063, 1},
+	{0x1D599, 3064, 1},
+	{0x1D59A, 3065, 1},
+	{0x1D59B, 3066, 1},
+	{0x1D59C, 3067, 1},
+	{0x1D59D, 3068, 1},
+	{0x1D59E, 3069, 1},
+	{0x1D59F, 3070, 1},
+	{0x1D5A0, 3071, 1},
+	{0x1D5A1, 3072, 1},
+	{0x1D5A2, 3073, 1},
+	{0x1D5A3, 3074, 1},
+	{0x1D5A4, 3075, 1},
+	{0x1D5A5, 3076, 1},
+	{0x1D5A6, 3077, 1},
+	{0x1D5A7, 3078, 1},
+	{0x1D5A8, 3079, 1},
+	{0x1D5A9, 3080, 1},
+	{0x1D5AA, 3081, 1},
+	{0x1D5AB, 3082, 1},
+	{0x1D5AC, 3083, 1},
+	{0x1D5AD, 3084, 1},
+	{0x1D5AE, 3085, 1},
+	{0x1D5AF, 3086, 1},
+	{0x1D5B0, 3087, 1},
+	{0x1D5B1, 3088, 1},
+	{0x1D5B2, 3089, 1},
+	{0x1D5B3, 3090, 1},
+	{0x1D5B4, 3091, 1},
+	{0x1D5B5, 3092, 1},
+	{0x1D5B6, 3093, 1},
+	{0x1D5B7, 3094, 1},
+	{0x1D5B8, 3095, 1},
+	{0x1D5B9, 3096, 1},
+	{0x1D5BA, 3097, 1},
+	{0x1D5BB, 3098, 1},
+	{0x1D5BC, 3099, 1},
+	{0x1D5BD, 3100, 1},
+	{0x1D5BE, 3101, 1},
+	{0x1D5BF, 3102, 1},
+	{0x1D5C0, 3103, 1},
+	{0x1D5C1, 3104, 1},
+	{0x1D5C2, 3105, 1},
+	{0x1D5C3, 3106, 1},
+	{0x1D5C4, 3107, 1},
+	{0x1D5C5, 3108, 1},
+	{0x1D5C6, 3109, 2},
+	{0x1D5C7, 3111, 1},
+	{0x1D5C8, 3112, 1},
+	{0x1D5C9, 3113, 1},
+	{0x1D5CA, 3114, 1},
+	{0x1D5CB, 3115, 1},
+	{0x1D5CC, 3116, 1},
+	{0x1D5CD, 3117, 1},
+	{0x1D5CE, 3118, 1},
+	{0x1D5CF, 3119, 1},
+	{0x1D5D0, 3120, 1},
+	{0x1D5D1, 3121, 1},
+	{0x1D5D2, 3122, 1},
+	{0x1D5D3, 3123, 1},
+	{0x1D5D4, 3124, 1},
+	{0x1D5D5, 3125, 1},
+	{0x1D5D6, 3126, 1},
+	{0x1D5D7, 3127, 1},
+	{0x1D5D8, 3128, 1},
+	{0x1D5D9, 3129, 1},
+	{0x1D5DA, 3130, 1},
+	{0x1D5DB, 3131, 1},
+	{0x1D5DC, 3132, 1},
+	{0x1D5DD, 3133, 1},
+	{0x1D5DE, 3134, 1},
+	{0x1D5DF, 3135, 1},
+	{0x1D5E0, 3136, 1},
+	{0x1D5E1, 3137, 1},
+	{0x1D5E2, 3138, 1},
+	{0x1D5E3, 3139, 1},
+	{0x1D5E4, 3140, 1},
+	{0x1D5E5, 3141, 1},
+	{0x1D5E6, 3142, 1},
+	{0x1D5E7, 3143, 1},
+	{0x1D5E8, 3144, 1},
+	{0x1D5E9, 3145, 1},
+	{0x1D5EA, 3146, 1},
+	{0x1D5EB, 3147, 1},
+	{0x1D5EC, 3148, 1},
+	{0x1D5ED, 3149, 1},
+	{0x1D5EE, 3150, 1},
+	{0x1D5EF, 3151, 1},
+	{0x1D5F0, 3152, 1},
+	{0x1D5F1, 3153, 1},
+	{0x1D5F2, 3154, 1},
+	{0x1D5F3, 3155, 1},
+	{0x1D5F4, 3156, 1},
+	{0x1D5F5, 3157, 1},
+	{0x1D5F6, 3158, 1},
+	{0x1D5F7, 3159, 1},
+	{0x1D5F8, 3160, 1},
+	{0x1D5F9, 3161, 1},
+	{0x1D5FA, 3162, 2},
+	{0x1D5FB, 3164, 1},
+	{0x1D5FC, 3165, 1},
+	{0x1D5FD, 3166, 1},
+	{0x1D5FE, 3167, 1},
+	{0x1D5FF, 3168, 1},
+	{0x1D600, 3169, 1},
+	{0x1D601, 3170, 1},
+	{0x1D602, 3171, 1},
+	{0x1D603, 3172, 1},
+	{0x1D604, 3173, 1},
+	{0x1D605, 3174, 1},
+	{0x1D606, 3175, 1},
+	{0x1D607, 3176, 1},
+	{0x1D608, 3177, 1},
+	{0x1D609, 3178, 1},
+	{0x1D60A, 3179, 1},
+	{0x1D60B, 3180, 1},
+	{0x1D60C, 3181, 1},
+	{0x1D60D, 3182, 1},
+	{0x1D60E, 3183, 1},
+	{0x1D60F, 3184, 1},
+	{0x1D610, 3185, 1},
+	{0x1D611, 3186, 1},
+	{0x1D612, 3187, 1},
+	{0x1D613, 3188, 1},
+	{0x1D614, 3189, 1},
+	{0x1D615, 3190, 1},
+	{0x1D616, 3191, 1},
+	{0x1D617, 3192, 1},
+	{0x1D618, 3193, 1},
+	{0x1D619, 3194, 1},
+	{0x1D61A, 3195, 1},
+	{0x1D61B, 3196, 1},
+	{0x1D61C, 3197, 1},
+	{0x1D61D, 3198, 1},
+	{0x1D61E, 3199, 1},
+	{0x1D61F, 3200, 1},
+	{0x1D620, 3201, 1},
+	{0x1D621, 3202, 1},
+	{0x1D622, 3203, 1},
+	{0x1D623, 3204, 1},
+	{0x1D624, 3205, 1},
+	{0x1D625, 3206, 1},
+	{0x1D626, 3207, 1},
+	{0x1D627, 3208, 1},
+	{0x1D628, 3209, 1},
+	{0x1D629, 3210, 1},
+	{0x1D62A, 3211, 1},
+	{0x1D62B, 3212, 1},
+	{0x1D62C, 3213, 1},
+	{0x1D62D, 3214, 1},
+	{0x1D62E, 3215, 2},
+	{0x1D62F, 3217, 1},
+	{0x1D630, 3218, 1},
+	{0x1D631, 3219, 1},
+	{0x1D632, 3220, 1},
+	{0x1D633, 3221, 1},
+	{0x1D634, 3222, 1},
+	{0x1D635, 3223, 1},
+	{0x1D636, 3224, 1},
+	{0x1D637, 3225, 1},
+	{0x1D638, 3226, 1},
+	{0x1D639, 3227, 1},
+	{0x1D63A, 3228, 1},
+	{0x1D63B, 3229, 1},
+	{0x1D63C, 3230, 1},
+	{0x1D63D, 3231, 1},
+	{0x1D63E, 3232, 1},
+	{0x1D63F, 3233, 1},
+	{0x1D640, 3234, 1},
+	{0x1D641, 3235, 1},
+	{0x1D642, 3236, 1},
+	{0x1D643, 3237, 1},
+	{0x1D644, 3238, 1},
+	{0x1D645, 3239, 1},
+	{0x1D646, 3240, 1},
+	{0x1D647, 3241, 1},
+	{0x1D648, 3242, 1},
+	{0x1D649, 3243, 1},
+	{0x1D64A, 3244, 1},
+	{0x1D64B, 3245, 1},
+	{0x1D64C, 3246, 1},
+	{0x1D64D, 3247, 1},
+	{0x1D64E, 3248, 1},
+	{0x1D64F, 3249, 1},
+	{0x1D650, 3250, 1},
+	{0x1D651, 3251, 1},
+	{0x1D652, 3252, 1},
+	{0x1D653, 3253, 1},
+	{0x1D654, 3254, 1},
+	{0x1D655, 3255, 1},
+	{0x1D656, 3256, 1},
+	{0x1D657, 3257, 1},
+	{0x1D658, 3258, 1},
+	{0x1D659, 3259, 1},
+	{0x1D65A, 3260, 1},
+	{0x1D65B, 3261, 1},
+	{0x1D65C, 3262, 1},
+	{0x1D65D, 3263, 1},
+	{0x1D65E, 3264, 1},
+	{0x1D65F, 3265, 1},
+	{0x1D660, 3266, 1},
+	{0x1D661, 3267, 1},
+	{0x1D662, 3268, 2},
+	{0x1D663, 3270, 1},
+	{0x1D664, 3271, 1},
+	{0x1D665, 3272, 1},
+	{0x1D666, 3273, 1},
+	{0x1D667, 3274, 1},
+	{0x1D668, 3275, 1},
+	{0x1D669, 3276, 1},
+	{0x1D66A, 3277, 1},
+	{0x1D66B, 3278, 1},
+	{0x1D66C, 3279, 1},
+	{0x1D66D, 3280, 1},
+	{0x1D66E, 3281, 1},
+	{0x1D66F, 3282, 1},
+	{0x1D670, 3283, 1},
+	{0x1D671, 3284, 1},
+	{0x1D672, 3285, 1},
+	{0x1D673, 3286, 1},
+	{0x1D674, 3287, 1},
+	{0x1D675, 3288, 1},
+	{0x1D676, 3289, 1},
+	{0x1D677, 3290, 1},
+	{0x1D678, 3291, 1},
+	{0x1D679, 3292, 1},
+	{0x1D67A, 3293, 1},
+	{0x1D67B, 3294, 1},
+	{0x1D67C, 3295, 1},
+	{0x1D67D, 3296, 1},
+	{0x1D67E, 3297, 1},
+	{0x1D67F, 3298, 1},
+	{0x1D680, 3299, 1},
+	{0x1D681, 3300, 1},
+	{0x1D682, 3301, 1},
+	{0x1D683, 3302, 1},
+	{0x1D684, 3303, 1},
+	{0x1D685, 3304, 1},
+	{0x1D686, 3305, 1},
+	{0x1D687, 3306, 1},
+	{0x1D688, 3307, 1},
+	{0x1D689, 3308, 1},
+	{0x1D68A, 3309, 1},
+	{0x1D68B, 3310, 1},
+	{0x1D68C, 3311, 1},
+	{0x1D68D, 3312, 1},
+	{0x1D68E, 3313, 1},
+	{0x1D68F, 3314, 1},
+	{0x1D690, 3315, 1},
+	{0x1D691, 3316, 1},
+	{0x1D692, 3317, 1},
+	{0x1D693, 3318, 1},
+	{0x1D694, 3319, 1},
+	{0x1D695, 3320, 1},
+	{0x1D696, 3321, 2},
+	{0x1D697, 3323, 1},
+	{0x1D698, 3324, 1},
+	{0x1D699, 3325, 1},
+	{0x1D69A, 3326, 1},
+	{0x1D69B, 3327, 1},
+	{0x1D69C, 3328, 1},
+	{0x1D69D, 3329, 1},
+	{0x1D69E, 3330, 1},
+	{0x1D69F, 3331, 1},
+	{0x1D6A0, 3332, 1},
+	{0x1D6A1, 3333, 1},
+	{0x1D6A2, 3334, 1},
+	{0x1D6A3, 3335, 1},
+	{0x1D6A4, 3336, 1},
+	{0x1D6A8, 3337, 1},
+	{0x1D6A9, 3338, 1},
+	{0x1D6AC, 3339, 1},
+	{0x1D6AD, 3340, 1},
+	{0x1D6AE, 3341, 1},
+	{0x1D6B0, 3342, 1},
+	{0x1D6B1, 3343, 1},
+	{0x1D6B3, 3344, 1},
+	{0x1D6B4, 3345, 1},
+	{0x1D6B6, 3346, 1},
+	{0x1D6B8, 3347, 1},
+	{0x1D6BB, 3348, 1},
+	{0x1D6BC, 3349, 1},
+	{0x1D6BE, 3350, 1},
+	{0x1D6C2, 3351, 1},
+	{0x1D6C4, 3352, 1},
+	{0x1D6CA, 3353, 1},
+	{0x1D6CE, 3354, 1},
+	{0x1D6D0, 3355, 1},
+	{0x1D6D2, 3356, 1},
+	{0x1D6D4, 3357, 1},
+	{0x1D6D6, 3358, 1},
+	{0x1D6E0, 3359, 1},
+	{0x1D6E2, 3360, 1},
+	{0x1D6E3, 3361, 1},
+	{0x1D6E6, 3362, 1},
+	{0x1D6E7, 3363, 1},
+	{0x1D6E8, 3364, 1},
+	{0x1D6EA, 3365, 1},
+	{0x1D6EB, 3366, 1},
+	{0x1D6ED, 3367, 1},
+	{0x1D6EE, 3368, 1},
+	{0x1D6F0, 3369, 1},
+	{0x1D6F2, 3370, 1},
+	{0x1D6F5, 3371, 1},
+	{0x1D6F6, 3372, 1},
+	{0x1D6F8, 3373, 1},
+	{0x1D6FC, 3374, 1},
+	{0x1D6FE, 3375, 1},
+	{0x1D704, 3376, 1},
+	{0x1D708, 3377, 1},
+	{0x1D70A, 3378, 1},
+	{0x1D70C, 3379, 1},
+	{0x1D70E, 3380, 1},
+	{0x1D710, 3381, 1},
+	{0x1D71A, 3382, 1},
+	{0x1D71C, 3383, 1},
+	{0x1D71D, 3384, 1},
+	{0x1D720, 3385, 1},
+	{0x1D721, 3386, 1},
+	{0x1D722, 3387, 1},
+	{0x1D724, 3388, 1},
+	{0x1D725, 3389, 1},
+	{0x1D727, 3390, 1},
+	{0x1D728, 3391, 1},
+	{0x1D72A, 3392, 1},
+	{0x1D72C, 3393, 1},
+	{0x1D72F, 3394, 1},
+	{0x1D730, 3395, 1},
+	{0x1D732, 3396, 1},
+	{0x1D736, 3397, 1},
+	{0x1D738, 3398, 1},
+	{0x1D73E, 3399, 1},
+	{0x1D742, 3400, 1},
+	{0x1D744, 3401, 1},
+	{0x1D746, 3402, 1},
+	{0x1D748, 3403, 1},
+	{0x1D74A, 3404, 1},
+	{0x1D754, 3405, 1},
+	{0x1D756, 3406, 1},
+	{0x1D757, 3407, 1},
+	{0x1D75A, 3408, 1},
+	{0x1D75B, 3409, 1},
+	{0x1D75C, 3410, 1},
+	{0x1D75E, 3411, 1},
+	{0x1D75F, 3412, 1},
+	{0x1D761, 3413, 1},
+	{0x1D762, 3414, 1},
+	{0x1D764, 3415, 1},
+	{0x1D766, 3416, 1},
+	{0x1D769, 3417, 1},
+	{0x1D76A, 3418, 1},
+	{0x1D76C, 3419, 1},
+	{0x1D770, 3420, 1},
+	{0x1D772, 3421, 1},
+	{0x1D778, 3422, 1},
+	{0x1D77C, 3423, 1},
+	{0x1D77E, 3424, 1},
+	{0x1D780, 3425, 1},
+	{0x1D782, 3426, 1},
+	{0x1D784, 3427, 1},
+	{0x1D78E, 3428, 1},
+	{0x1D790, 3429, 1},
+	{0x1D791, 3430, 1},
+	{0x1D794, 3431, 1},
+	{0x1D795, 3432, 1},
+	{0x1D796, 3433, 1},
+	{0x1D798, 3434, 1},
+	{0x1D799, 3435, 1},
+	{0x1D79B, 3436, 1},
+	{0x1D79C, 3437, 1},
+	{0x1D79E, 3438, 1},
+	{0x1D7A0, 3439, 1},
+	{0x1D7A3, 3440, 1},
+	{0x1D7A4, 3441, 1},
+	{0x1D7A6, 3442, 1},
+	{0x1D7AA, 3443, 1},
+	{0x1D7AC, 3444, 1},
+	{0x1D7B2, 3445, 1},
+	{0x1D7B6, 3446, 1},
+	{0x1D7B8, 3447, 1},
+	{0x1D7BA, 3448, 1},
+	{0x1D7BC, 3449, 1},
+	{0x1D7BE, 3450, 1},
+	{0x1D7C8, 3451, 1},
+	{0x1D7CA, 3452, 1},
+	{0x1D7CE, 3453, 1},
+	{0x1D7CF, 3454, 1},
+	{0x1D7D0, 3455, 1},
+	{0x1D7D1, 3456, 1},
+	{0x1D7D2, 3457, 1},
+	{0x1D7D3, 3458, 1},
+	{0x1D7D4, 3459, 1},
+	{0x1D7D5, 3460, 1},
+	{0x1D7D6, 3461, 1},
+	{0x1D7D7, 3462, 1},
+	{0x1D7D8, 3463, 1},
+	{0x1D7D9, 3464, 1},
+	{0x1D7DA, 3465, 1},
+	{0x1D7DB, 3466, 1},
+	{0x1D7DC, 3467, 1},
+	{0x1D7DD, 3468, 1},
+	{0x1D7DE, 3469, 1},
+	{0x1D7DF, 3470, 1},
+	{0x1D7E0, 3471, 1},
+	{0x1D7E1, 3472, 1},
+	{0x1D7E2, 3473, 1},
+	{0x1D7E3, 3474, 1},
+	{0x1D7E4, 3475, 1},
+	{0x1D7E5, 3476, 1},
+	{0x1D7E6, 3477, 1},
+	{0x1D7E7, 3478, 1},
+	{0x1D7E8, 3479, 1},
+	{0x1D7E9, 3480, 1},
+	{0x1D7EA, 3481, 1},
+	{0x1D7EB, 3482, 1},
+	{0x1D7EC, 3483, 1},
+	{0x1D7ED, 3484, 1},
+	{0x1D7EE, 3485, 1},
+	{0x1D7EF, 3486, 1},
+	{0x1D7F0, 3487, 1},
+	{0x1D7F1, 3488, 1},
+	{0x1D7F2, 3489, 1},
+	{0x1D7F3, 3490, 1},
+	{0x1D7F4, 3491, 1},
+	{0x1D7F5, 3492, 1},
+	{0x1D7F6, 3493, 1},
+	{0x1D7F7, 3494, 1},
+	{0x1D7F8, 3495, 1},
+	{0x1D7F9, 3496, 1},
+	{0x1D7FA, 3497, 1},
+	{0x1D7FB, 3498, 1},
+	{0x1D7FC, 3499, 1},
+	{0x1D7FD, 3500, 1},
+	{0x1D7FE, 3501, 1},
+	{0x1D7FF, 3502, 1},
+	{0x1DA00, 3503, 0},
+	{0x1DA01, 3503, 0},
+	{0x1DA02, 3503, 0},
+	{0x1DA03, 3503, 0},
+	{0x1DA04, 3503, 0},
+	{0x1DA05, 3503, 0},
+	{0x1DA06, 3503, 0},
+	{0x1DA07, 3503, 0},
+	{0x1DA08, 3503, 0},
+	{0x1DA09, 3503, 0},
+	{0x1DA0A, 3503, 0},
+	{0x1DA0B, 3503, 0},
+	{0x1DA0C, 3503, 0},
+	{0x1DA0D, 3503, 0},
+	{0x1DA0E, 3503, 0},
+	{0x1DA0F, 3503, 0},
+	{0x1DA10, 3503, 0},
+	{0x1DA11, 3503, 0},
+	{0x1DA12, 3503, 0},
+	{0x1DA13, 3503, 0},
+	{0x1DA14, 3503, 0},
+	{0x1DA15, 3503, 0},
+	{0x1DA16, 3503, 0},
+	{0x1DA17, 3503, 0},
+	{0x1DA18, 3503, 0},
+	{0x1DA19, 3503, 0},
+	{0x1DA1A, 3503, 0},
+	{0x1DA1B, 3503, 0},
+	{0x1DA1C, 3503, 0},
+	{0x1DA1D, 3503, 0},
+	{0x1DA1E, 3503, 0},
+	{0x1DA1F, 3503, 0},
+	{0x1DA20, 3503, 0},
+	{0x1DA21, 3503, 0},
+	{0x1DA22, 3503, 0},
+	{0x1DA23, 3503, 0},
+	{0x1DA24, 3503, 0},
+	{0x1DA25, 3503, 0},
+	{0x1DA26, 3503, 0},
+	{0x1DA27, 3503, 0},
+	{0x1DA28, 3503, 0},
+	{0x1DA29, 3503, 0},
+	{0x1DA2A, 3503, 0},
+	{0x1DA2B, 3503, 0},
+	{0x1DA2C, 3503, 0},
+	{0x1DA2D, 3503, 0},
+	{0x1DA2E, 3503, 0},
+	{0x1DA2F, 3503, 0},
+	{0x1DA30, 3503, 0},
+	{0x1DA31, 3503, 0},
+	{0x1DA32, 3503, 0},
+	{0x1DA33, 3503, 0},
+	{0x1DA34, 3503, 0},
+	{0x1DA35, 3503, 0},
+	{0x1DA36, 3503, 0},
+	{0x1DA3B, 3503, 0},
+	{0x1DA3C, 3503, 0},
+	{0x1DA3D, 3503, 0},
+	{0x1DA3E, 3503, 0},
+	{0x1DA3F, 3503, 0},
+	{0x1DA40, 3503, 0},
+	{0x1DA41, 3503, 0},
+	{0x1DA42, 3503, 0},
+	{0x1DA43, 3503, 0},
+	{0x1DA44, 3503, 0},
+	{0x1DA45, 3503, 0},
+	{0x1DA46, 3503, 0},
+	{0x1DA47, 3503, 0},
+	{0x1DA48, 3503, 0},
+	{0x1DA49, 3503, 0},
+	{0x1DA4A, 3503, 0},
+	{0x1DA4B, 3503, 0},
+	{0x1DA4C, 3503, 0},
+	{0x1DA4D, 3503, 0},
+	{0x1DA4E, 3503, 0},
+	{0x1DA4F, 3503, 0},
+	{0x1DA50, 3503, 0},
+	{0x1DA51, 3503, 0},
+	{0x1DA52, 3503, 0},
+	{0x1DA53, 3503, 0},
+	{0x1DA54, 3503, 0},
+	{0x1DA55, 3503, 0},
+	{0x1DA56, 3503, 0},
+	{0x1DA57, 3503, 0},
+	{0x1DA58, 3503, 0},
+	{0x1DA59, 3503, 0},
+	{0x1DA5A, 3503, 0},
+	{0x1DA5B, 3503, 0},
+	{0x1DA5C, 3503, 0},
+	{0x1DA5D, 3503, 0},
+	{0x1DA5E, 3503, 0},
+	{0x1DA5F, 3503, 0},
+	{0x1DA60, 3503, 0},
+	{0x1DA61, 3503, 0},
+	{0x1DA62, 3503, 0},
+	{0x1DA63, 3503, 0},
+	{0x1DA64, 3503, 0},
+	{0x1DA65, 3503, 0},
+	{0x1DA66, 3503, 0},
+	{0x1DA67, 3503, 0},
+	{0x1DA68, 3503, 0},
+	{0x1DA69, 3503, 0},
+	{0x1DA6A, 3503, 0},
+	{0x1DA6B, 3503, 0},
+	{0x1DA6C, 3503, 0},
+	{0x1DA75, 3503, 0},
+	{0x1DA84, 3503, 0},
+	{0x1DA9B, 3503, 0},
+	{0x1DA9C, 3503, 0},
+	{0x1DA9D, 3503, 0},
+	{0x1DA9E, 3503, 0},
+	{0x1DA9F, 3503, 0},
+	{0x1DAA1, 3503, 0},
+	{0x1DAA2, 3503, 0},
+	{0x1DAA3, 3503, 0},
+	{0x1DAA4, 3503, 0},
+	{0x1DAA5, 3503, 0},
+	{0x1DAA6, 3503, 0},
+	{0x1DAA7, 3503, 0},
+	{0x1DAA8, 3503, 0},
+	{0x1DAA9, 3503, 0},
+	{0x1DAAA, 3503, 0},
+	{0x1DAAB, 3503, 0},
+	{0x1DAAC, 3503, 0},
+	{0x1DAAD, 3503, 0},
+	{0x1DAAE, 3503, 0},
+	{0x1DAAF, 3503, 0},
+	{0x1E000, 3503, 0},
+	{0x1E001, 3503, 0},
+	{0x1E002, 3503, 0},
+	{0x1E003, 3503, 0},
+	{0x1E004, 3503, 0},
+	{0x1E005, 3503, 0},
+	{0x1E006, 3503, 0},
+	{0x1E008, 3503, 0},
+	{0x1E009, 3503, 0},
+	{0x1E00A, 3503, 0},
+	{0x1E00B, 3503, 0},
+	{0x1E00C, 3503, 0},
+	{0x1E00D, 3503, 0},
+	{0x1E00E, 3503, 0},
+	{0x1E00F, 3503, 0},
+	{0x1E010, 3503, 0},
+	{0x1E011, 3503, 0},
+	{0x1E012, 3503, 0},
+	{0x1E013, 3503, 0},
+	{0x1E014, 3503, 0},
+	{0x1E015, 3503, 0},
+	{0x1E016, 3503, 0},
+	{0x1E017, 3503, 0},
+	{0x1E018, 3503, 0},
+	{0x1E01B, 3503, 0},
+	{0x1E01C, 3503, 0},
+	{0x1E01D, 3503, 0},
+	{0x1E01E, 3503, 0},
+	{0x1E01F, 3503, 0},
+	{0x1E020, 3503, 0},
+	{0x1E021, 3503, 0},
+	{0x1E023, 3503, 0},
+	{0x1E024, 3503, 0},
+	{0x1E026, 3503, 0},
+	{0x1E027, 3503, 0},
+	{0x1E028, 3503, 0},
+	{0x1E029, 3503, 0},
+	{0x1E02A, 3503, 0},
+	{0x1E030, 3503, 1},
+	{0x1E031, 3504, 1},
+	{0x1E033, 3505, 1},
+	{0x1E035, 3506, 1},
+	{0x1E03C, 3507, 1},
+	{0x1E03E, 3508, 1},
+	{0x1E03F, 3509, 1},
+	{0x1E041, 3510, 1},
+	{0x1E043, 3511, 1},
+	{0x1E04C, 3512, 1},
+	{0x1E04D, 3513, 1},
+	{0x1E04F, 3514, 1},
+	{0x1E050, 3515, 1},
+	{0x1E051, 3516, 1},
+	{0x1E052, 3517, 1},
+	{0x1E054, 3518, 1},
+	{0x1E056, 3519, 1},
+	{0x1E05C, 3520, 1},
+	{0x1E05E, 3521, 1},
+	{0x1E05F, 3522, 1},
+	{0x1E061, 3523, 1},
+	{0x1E067, 3524, 2},
+	{0x1E068, 3526, 1},
+	{0x1E069, 3527, 1},
+	{0x1E08F, 3528, 0},
+	{0x1E130, 3528, 0},
+	{0x1E131, 3528, 0},
+	{0x1E132, 3528, 0},
+	{0x1E133, 3528, 0},
+	{0x1E134, 3528, 0},
+	{0x1E135, 3528, 0},
+	{0x1E136, 3528, 0},
+	{0x1E2AE, 3528, 0},
+	{0x1E2EC, 3528, 0},
+	{0x1E2ED, 3528, 0},
+	{0x1E2EE, 3528, 0},
+	{0x1E2EF, 3528, 0},
+	{0x1E4EC, 3528, 0},
+	{0x1E4ED, 3528, 0},
+	{0x1E4EE, 3528, 0},
+	{0x1E4EF, 3528, 0},
+	{0x1E5EE, 3528, 0},
+	{0x1E5EF, 3528, 0},
+	{0x1E6E3, 3528, 0},
+	{0x1E6E6, 3528, 0},
+	{0x1E6EE, 3528, 0},
+	{0x1E6EF, 3528, 0},
+	{0x1E6F5, 3528, 0},
+	{0x1E8C7, 3528, 1},
+	{0x1E8CB, 3529, 1},
+	{0x1E8D0, 3530, 0},
+	{0x1E8D1, 3530, 0},
+	{0x1E8D2, 3530, 0},
+	{0x1E8D3, 3530, 0},
+	{0x1E8D4, 3530, 0},
+	{0x1E8D5, 3530, 0},
+	{0x1E8D6, 3530, 0},
+	{0x1E944, 3530, 0},
+	{0x1E945, 3530, 0},
+	{0x1E946, 3530, 0},
+	{0x1E947, 3530, 0},
+	{0x1E948, 3530, 0},
+	{0x1E949, 3530, 0},
+	{0x1E94A, 3530, 0},
+	{0x1EE00, 3530, 1},
+	{0x1EE24, 3531, 1},
+	{0x1EE64, 3532, 1},
+	{0x1EE80, 3533, 1},
+	{0x1EE84, 3534, 1},
+	{0x1F100, 3535, 2},
+	{0x1F101, 3537, 2},
+	{0x1F102, 3539, 2},
+	{0x1F103, 3541, 2},
+	{0x1F104, 3543, 2},
+	{0x1F105, 3545, 2},
+	{0x1F106, 3547, 2},
+	{0x1F107, 3549, 2},
+	{0x1F108, 3551, 2},
+	{0x1F109, 3553, 2},
+	{0x1F10A, 3555, 2},
+	{0x1F110, 3557, 3},
+	{0x1F111, 3560, 3},
+	{0x1F112, 3563, 3},
+	{0x1F113, 3566, 3},
+	{0x1F114, 3569, 3},
+	{0x1F115, 3572, 3},
+	{0x1F116, 3575, 3},
+	{0x1F117, 3578, 3},
+	{0x1F118, 3581, 3},
+	{0x1F119, 3584, 3},
+	{0x1F11A, 3587, 3},
+	{0x1F11B, 3590, 3},
+	{0x1F11C, 3593, 3},
+	{0x1F11D, 3596, 3},
+	{0x1F11E, 3599, 3},
+	{0x1F11F, 3602, 3},
+	{0x1F120, 3605, 3},
+	{0x1F121, 3608, 3},
+	{0x1F122, 3611, 3},
+	{0x1F123, 3614, 3},
+	{0x1F124, 3617, 3},
+	{0x1F125, 3620, 3},
+	{0x1F126, 3623, 3},
+	{0x1F127, 3626, 3},
+	{0x1F128, 3629, 3},
+	{0x1F129, 3632, 3},
+	{0x1F12A, 3635, 3},
+	{0x1F12B, 3638, 1},
+	{0x1F12C, 3639, 1},
+	{0x1F12D, 3640, 2},
+	{0x1F12E, 3642, 2},
+	{0x1F130, 3644, 1},
+	{0x1F131, 3645, 1},
+	{0x1F132, 3646, 1},
+	{0x1F133, 3647, 1},
+	{0x1F134, 3648, 1},
+	{0x1F135, 3649, 1},
+	{0x1F136, 3650, 1},
+	{0x1F137, 3651, 1},
+	{0x1F138, 3652, 1},
+	{0x1F139, 3653, 1},
+	{0x1F13A, 3654, 1},
+	{0x1F13B, 3655, 1},
+	{0x1F13C, 3656, 1},
+	{0x1F13D, 3657, 1},
+	{0x1F13E, 3658, 1},
+	{0x1F13F, 3659, 1},
+	{0x1F140, 3660, 1},
+	{0x1F141, 3661, 1},
+	{0x1F142, 3662, 1},
+	{0x1F143, 3663, 1},
+	{0x1F144, 3664, 1},
+	{0x1F145, 3665, 1},
+	{0x1F146, 3666, 1},
+	{0x1F147, 3667, 1},
+	{0x1F148, 3668, 1},
+	{0x1F149, 3669, 1},
+	{0x1F14A, 3670, 2},
+	{0x1F14B, 3672, 2},
+	{0x1F14C, 3674, 2},
+	{0x1F14D, 3676, 2},
+	{0x1F14E, 3678, 3},
+	{0x1F14F, 3681, 2},
+	{0x1F16A, 3683, 2},
+	{0x1F16B, 3685, 2},
+	{0x1F16C, 3687, 2},
+	{0x1F190, 3689, 2},
+	{0x1F700, 3691, 2},
+	{0x1F707, 3693, 2},
+	{0x1F74C, 3695, 1},
+	{0x1F75C, 3696, 3},
+	{0x1F768, 3699, 1},
+	{0x1F76B, 3700, 2},
+	{0x1F76C, 3702, 2},
+	{0x1FBF0, 3704, 1},
+	{0x1FBF1, 3705, 1},
+	{0x1FBF2, 3706, 1},
+	{0x1FBF3, 3707, 1},
+	{0x1FBF4, 3708, 1},
+	{0x1FBF5, 3709, 1},
+	{0x1FBF6, 3710, 1},
+	{0x1FBF7, 3711, 1},
+	{0x1FBF8, 3712, 1},
+	{0x1FBF9, 3713, 1},
+	{0xE0001, 3714, 0},
+	{0xE0020, 3714, 0},
+	{0xE0021, 3714, 0},
+	{0xE0022, 3714, 0},
+	{0xE0023, 3714, 0},
+	{0xE0024, 3714, 0},
+	{0xE0025, 3714, 0},
+	{0xE0026, 3714, 0},
+	{0xE0027, 3714, 0},
+	{0xE0028, 3714, 0},
+	{0xE0029, 3714, 0},
+	{0xE002A, 3714, 0},
+	{0xE002B, 3714, 0},
+	{0xE002C, 3714, 0},
+	{0xE002D, 3714, 0},
+	{0xE002E, 3714, 0},
+	{0xE002F, 3714, 0},
+	{0xE0030, 3714, 0},
+	{0xE0031, 3714, 0},
+	{0xE0032, 3714, 0},
+	{0xE0033, 3714, 0},
+	{0xE0034, 3714, 0},
+	{0xE0035, 3714, 0},
+	{0xE0036, 3714, 0},
+	{0xE0037, 3714, 0},
+	{0xE0038, 3714, 0},
+	{0xE0039, 3714, 0},
+	{0xE003A, 3714, 0},
+	{0xE003B, 3714, 0},
+	{0xE003C, 3714, 0},
+	{0xE003D, 3714, 0},
+	{0xE003E, 3714, 0},
+	{0xE003F, 3714, 0},
+	{0xE0040, 3714, 0},
+	{0xE0041, 3714, 0},
+	{0xE0042, 3714, 0},
+	{0xE0043, 3714, 0},
+	{0xE0044, 3714, 0},
+	{0xE0045, 3714, 0},
+	{0xE0046, 3714, 0},
+	{0xE0047, 3714, 0},
+	{0xE0048, 3714, 0},
+	{0xE0049, 3714, 0},
+	{0xE004A, 3714, 0},
+	{0xE004B, 3714, 0},
+	{0xE004C, 3714, 0},
+	{0xE004D, 3714, 0},
+	{0xE004E, 3714, 0},
+	{0xE004F, 3714, 0},
+	{0xE0050, 3714, 0},
+	{0xE0051, 3714, 0},
+	{0xE0052, 3714, 0},
+	{0xE0053, 3714, 0},
+	{0xE0054, 3714, 0},
+	{0xE0055, 3714, 0},
+	{0xE0056, 3714, 0},
+	{0xE0057, 3714, 0},
+	{0xE0058, 3714, 0},
+	{0xE0059, 3714, 0},
+	{0xE005A, 3714, 0},
+	{0xE005B, 3714, 0},
+	{0xE005C, 3714, 0},
+	{0xE005D, 3714, 0},
+	{0xE005E, 3714, 0},
+	{0xE005F, 3714, 0},
+	{0xE0060, 3714, 0},
+	{0xE0061, 3714, 0},
+	{0xE0062, 3714, 0},
+	{0xE0063, 3714, 0},
+	{0xE0064, 3714, 0},
+	{0xE0065, 3714, 0},
+	{0xE0066, 3714, 0},
+	{0xE0067, 3714, 0},
+	{0xE0068, 3714, 0},
+	{0xE0069, 3714, 0},
+	{0xE006A, 3714, 0},
+	{0xE006B, 3714, 0},
+	{0xE006C, 3714, 0},
+	{0xE006D, 3714, 0},
+	{0xE006E, 3714, 0},
+	{0xE006F, 3714, 0},
+	{0xE0070, 3714, 0},
+	{0xE0071, 3714, 0},
+	{0xE0072, 3714, 0},
+	{0xE0073, 3714, 0},
+	{0xE0074, 3714, 0},
+	{0xE0075, 3714, 0},
+	{0xE0076, 3714, 0},
+	{0xE0077, 3714, 0},
+	{0xE0078, 3714, 0},
+	{0xE0079, 3714, 0},
+	{0xE007A, 3714, 0},
+	{0xE007B, 3714, 0},
+	{0xE007C, 3714, 0},
+	{0xE007D, 3714, 0},
+	{0xE007E, 3714, 0},
+	{0xE007F, 3714, 0},
+	{0xE0100, 3714, 0},
+	{0xE0101, 3714, 0},
+	{0xE0102, 3714, 0},
+	{0xE0103, 3714, 0},
+	{0xE0104, 3714, 0},
+	{0xE0105, 3714, 0},
+	{0xE0106, 3714, 0},
+	{0xE0107, 3714, 0},
+	{0xE0108, 3714, 0},
+	{0xE0109, 3714, 0},
+	{0xE010A, 3714, 0},
+	{0xE010B, 3714, 0},
+	{0xE010C, 3714, 0},
+	{0xE010D, 3714, 0},
+	{0xE010E, 3714, 0},
+	{0xE010F, 3714, 0},
+	{0xE0110, 3714, 0},
+	{0xE0111, 3714, 0},
+	{0xE0112, 3714, 0},
+	{0xE0113, 3714, 0},
+	{0xE0114, 3714, 0},
+	{0xE0115, 3714, 0},
+	{0xE0116, 3714, 0},
+	{0xE0117, 3714, 0},
+	{0xE0118, 3714, 0},
+	{0xE0119, 3714, 0},
+	{0xE011A, 3714, 0},
+	{0xE011B, 3714, 0},
+	{0xE011C, 3714, 0},
+	{0xE011D, 3714, 0},
+	{0xE011E, 3714, 0},
+	{0xE011F, 3714, 0},
+	{0xE0120, 3714, 0},
+	{0xE0121, 3714, 0},
+	{0xE0122, 3714, 0},
+	{0xE0123, 3714, 0},
+	{0xE0124, 3714, 0},
+	{0xE0125, 3714, 0},
+	{0xE0126, 3714, 0},
+	{0xE0127, 3714, 0},
+	{0xE0128, 3714, 0},
+	{0xE0129, 3714, 0},
+	{0xE012A, 3714, 0},
+	{0xE012B, 3714, 0},
+	{0xE012C, 3714, 0},
+	{0xE012D, 3714, 0},
+	{0xE012E, 3714, 0},
+	{0xE012F, 3714, 0},
+	{0xE0130, 3714, 0},
+	{0xE0131, 3714, 0},
+	{0xE0132, 3714, 0},
+	{0xE0133, 3714, 0},
+	{0xE0134, 3714, 0},
+	{0xE0135, 3714, 0},
+	{0xE0136, 3714, 0},
+	{0xE0137, 3714, 0},
+	{0xE0138, 3714, 0},
+	{0xE0139, 3714, 0},
+	{0xE013A, 3714, 0},
+	{0xE013B, 3714, 0},
+	{0xE013C, 3714, 0},
+	{0xE013D, 3714, 0},
+	{0xE013E, 3714, 0},
+	{0xE013F, 3714, 0},
+	{0xE0140, 3714, 0},
+	{0xE0141, 3714, 0},
+	{0xE0142, 3714, 0},
+	{0xE0143, 3714, 0},
+	{0xE0144, 3714, 0},
+	{0xE0145, 3714, 0},
+	{0xE0146, 3714, 0},
+	{0xE0147, 3714, 0},
+	{0xE0148, 3714, 0},
+	{0xE0149, 3714, 0},
+	{0xE014A, 3714, 0},
+	{0xE014B, 3714, 0},
+	{0xE014C, 3714, 0},
+	{0xE014D, 3714, 0},
+	{0xE014E, 3714, 0},
+	{0xE014F, 3714, 0},
+	{0xE0150, 3714, 0},
+	{0xE0151, 3714, 0},
+	{0xE0152, 3714, 0},
+	{0xE0153, 3714, 0},
+	{0xE0154, 3714, 0},
+	{0xE0155, 3714, 0},
+	{0xE0156, 3714, 0},
+	{0xE0157, 3714, 0},
+	{0xE0158, 3714, 0},
+	{0xE0159, 3714, 0},
+	{0xE015A, 3714, 0},
+	{0xE015B, 3714, 0},
+	{0xE015C, 3714, 0},
+	{0xE015D, 3714, 0},
+	{0xE015E, 3714, 0},
+	{0xE015F, 3714, 0},
+	{0xE0160, 3714, 0},
+	{0xE0161, 3714, 0},
+	{0xE0162, 3714, 0},
+	{0xE0163, 3714, 0},
+	{0xE0164, 3714, 0},
+	{0xE0165, 3714, 0},
+	{0xE0166, 3714, 0},
+	{0xE0167, 3714, 0},
+	{0xE0168, 3714, 0},
+	{0xE0169, 3714, 0},
+	{0xE016A, 3714, 0},
+	{0xE016B, 3714, 0},
+	{0xE016C, 3714, 0},
+	{0xE016D, 3714, 0},
+	{0xE016E, 3714, 0},
+	{0xE016F, 3714, 0},
+	{0xE0170, 3714, 0},
+	{0xE0171, 3714, 0},
+	{0xE0172, 3714, 0},
+	{0xE0173, 3714, 0},
+	{0xE0174, 3714, 0},
+	{0xE0175, 3714, 0},
+	{0xE0176, 3714, 0},
+	{0xE0177, 3714, 0},
+	{0xE0178, 3714, 0},
+	{0xE0179, 3714, 0},
+	{0xE017A, 3714, 0},
+	{0xE017B, 3714, 0},
+	{0xE017C, 3714, 0},
+	{0xE017D, 3714, 0},
+	{0xE017E, 3714, 0},
+	{0xE017F, 3714, 0},
+	{0xE0180, 3714, 0},
+	{0xE0181, 3714, 0},
+	{0xE0182, 3714, 0},
+	{0xE0183, 3714, 0},
+	{0xE0184, 3714, 0},
+	{0xE0185, 3714, 0},
+	{0xE0186, 3714, 0},
+	{0xE0187, 3714, 0},
+	{0xE0188, 3714, 0},
+	{0xE0189, 3714, 0},
+	{0xE018A, 3714, 0},
+	{0xE018B, 3714, 0},
+	{0xE018C, 3714, 0},
+	{0xE018D, 3714, 0},
+	{0xE018E, 3714, 0},
+	{0xE018F, 3714, 0},
+	{0xE0190, 3714, 0},
+	{0xE0191, 3714, 0},
+	{0xE0192, 3714, 0},
+	{0xE0193, 3714, 0},
+	{0xE0194, 3714, 0},
+	{0xE0195, 3714, 0},
+	{0xE0196, 3714, 0},
+	{0xE0197, 3714, 0},
+	{0xE0198, 3714, 0},
+	{0xE0199, 3714, 0},
+	{0xE019A, 3714, 0},
+	{0xE019B, 3714, 0},
+	{0xE019C, 3714, 0},
+	{0xE019D, 3714, 0},
+	{0xE019E, 3714, 0},
+	{0xE019F, 3714, 0},
+	{0xE01A0, 3714, 0},
+	{0xE01A1, 3714, 0},
+	{0xE01A2, 3714, 0},
+	{0xE01A3, 3714, 0},
+	{0xE01A4, 3714, 0},
+	{0xE01A5, 3714, 0},
+	{0xE01A6, 3714, 0},
+	{0xE01A7, 3714, 0},
+	{0xE01A8, 3714, 0},
+	{0xE01A9, 3714, 0},
+	{0xE01AA, 3714, 0},
+	{0xE01AB, 3714, 0},
+	{0xE01AC, 3714, 0},
+	{0xE01AD, 3714, 0},
+	{0xE01AE, 3714, 0},
+	{0xE01AF, 3714, 0},
+	{0xE01B0, 3714, 0},
+	{0xE01B1, 3714, 0},
+	{0xE01B2, 3714, 0},
+	{0xE01B3, 3714, 0},
+	{0xE01B4, 3714, 0},
+	{0xE01B5, 3714, 0},
+	{0xE01B6, 3714, 0},
+	{0xE01B7, 3714, 0},
+	{0xE01B8, 3714, 0},
+	{0xE01B9, 3714, 0},
+	{0xE01BA, 3714, 0},
+	{0xE01BB, 3714, 0},
+	{0xE01BC, 3714, 0},
+	{0xE01BD, 3714, 0},
+	{0xE01BE, 3714, 0},
+	{0xE01BF, 3714, 0},
+	{0xE01C0, 3714, 0},
+	{0xE01C1, 3714, 0},
+	{0xE01C2, 3714, 0},
+	{0xE01C3, 3714, 0},
+	{0xE01C4, 3714, 0},
+	{0xE01C5, 3714, 0},
+	{0xE01C6, 3714, 0},
+	{0xE01C7, 3714, 0},
+	{0xE01C8, 3714, 0},
+	{0xE01C9, 3714, 0},
+	{0xE01CA, 3714, 0},
+	{0xE01CB, 3714, 0},
+	{0xE01CC, 3714, 0},
+	{0xE01CD, 3714, 0},
+	{0xE01CE, 3714, 0},
+	{0xE01CF, 3714, 0},
+	{0xE01D0, 3714, 0},
+	{0xE01D1, 3714, 0},
+	{0xE01D2, 3714, 0},
+	{0xE01D3, 3714, 0},
+	{0xE01D4, 3714, 0},
+	{0xE01D5, 3714, 0},
+	{0xE01D6, 3714, 0},
+	{0xE01D7, 3714, 0},
+	{0xE01D8, 3714, 0},
+	{0xE01D9, 3714, 0},
+	{0xE01DA, 3714, 0},
+	{0xE01DB, 3714, 0},
+	{0xE01DC, 3714, 0},
+	{0xE01DD, 3714, 0},
+	{0xE01DE, 3714, 0},
+	{0xE01DF, 3714, 0},
+	{0xE01E0, 3714, 0},
+	{0xE01E1, 3714, 0},
+	{0xE01E2, 3714, 0},
+	{0xE01E3, 3714, 0},
+	{0xE01E4, 3714, 0},
+	{0xE01E5, 3714, 0},
+	{0xE01E6, 3714, 0},
+	{0xE01E7, 3714, 0},
+	{0xE01E8, 3714, 0},
+	{0xE01E9, 3714, 0},
+	{0xE01EA, 3714, 0},
+	{0xE01EB, 3714, 0},
+	{0xE01EC, 3714, 0},
+	{0xE01ED, 3714, 0},
+	{0xE01EE, 3714, 0},
+	{0xE01EF, 3714, 0},
+}
+
+const confusablesData = "" +
+	"  a 23',1o1/41/23/4AAAAAAAECEEEEIIIINOOOOOxUUUUY" +
+	"aaaaaaaeceeeeiiiinooooouuuuyyAaAaAaCcCcCcCcDdEeE" +
+	"eEeEeEeGgGgGgGgHhIiIiIiIiIilJijJjKkLlLlLlNnNnNn'" +
+	"nOoOoOoOEoeRrRrRrSsSsSsSsTtTtUuUuUuUuUuUuWwYyYZz" +
+	"ZzZzf'BbC''DgG'lK'Oo'PR2'TUu'Y35slll!DZDzdzLJLjl" +
+	"jNJNjnjAaIiOoUuUuUuUuUuAaAaAEaeGgKkOoOo3jDZDzdzG" +
+	"gNnAaAEaeAaAaEeEeIiIiOoOoRrRrUuUuSsTt3Hh88AaEeOo" +
+	"OoOoOoYy?agyiiwuy?dztslslzhjrwy'''''''<>^^''':- " +
+	"  i~''ylsx''''':'i;J' AEHlOYiABEZHlKMNOPTYXlYaiu" +
+	"ayivopouiuouYYYF2pcjCMEESllJKYABE3KMHOPCTYXblblO" +
+	"a6reopcyxeersiijywVvVvr'YyheliAaAaAEaeEe33OoYyYy" +
+	"YybldGqWwUSO''wqqhnnugfo:l:lv'lolll'''''',llllo." +
+	"loV,*oooo-o.loV..::Ol''_:o?O89o98:o8OO9oooooooo9" +
+	"oooooyoUODRTO'iYAJE?WMHYGhZ4bRWSVSLCPKd6GB=V><'U" +
+	"U'PdbP'd'b'JL2xHxRbFADDMBXx <Xl'KM:+/::''coouvwz" +
+	"rAAEBDEGHIJKLMNO8PRTUWaabdegkmoptuwvyiruvypuegyc" +
+	"fgiiuuzAaBbBbBbCcDdDdDdDdDdEeEeEeEeEeFfGgHhHhHhH" +
+	"hHhIiIiKkKkKkLlLlLlLlMmMmMmNnNnNnNnOoOoOoOoPpPpR" +
+	"rRrRrRrSsSsSsSsSsTtTtTtTtUuUuUuUuUuVvVvWwWwWwWwW" +
+	"wXxXxYyZzZzZzhtwya'sfAaAaAaAaAaAaAaAaAaAaAaAaEeE" +
+	"eEeEeEeEeEeEeIiIiOoOoOoOoOoOoOoOoOoOoOoOoUuUuUuU" +
+	"uUuUuUuYyYyYyYyyaaaaaaaaAAAAAAAAEEEEEEHHHHHHHHii" +
+	"iiiiiillllllllooooooOOOOOOuuuuuuuuYYYYaaiioouuaa" +
+	"aaaaaaAAAAAAAAHHHHHHHHaaaaaaaAAAAA'i'~ EEHHH   i" +
+	"iiiiillll   uuuuppuuYYYYP  `OO '           ----l" +
+	"l '','''''''......   ''''''''''''<>!! /-/???!!?*" +
+	"~'''': 0i456789+-=()n0123456789+-=()aeoxhklmnpst" +
+	"Rslta/ca/sCc/oc/ugHHHhllLlNNoPQRRRSMTELTMZZKABCe" +
+	"eEFMoiFAXyDdeij1/71/91/101/32/31/52/53/54/51/65/" +
+	"61/83/85/87/81/lllllllVVVlVllVllllXXXlXllLCDMiii" +
+	"iiiivvviviiviiiixxxixiilcdrn0/3-/\\*oollllllvU:~~" +
+	"=<<>><>TvU<<<>>>Eipal\\\\1234567891011121314151617" +
+	"181920(l)(2)(3)(4)(5)(6)(7)(8)(9)(lO)(ll)(l2)(l3" +
+	")(l4)(l5)(l6)(l7)(l8)(l9)(2O)l.2.3.4.5.6.7.8.9.l" +
+	"O.ll.l2.l3.l4.l5.l6.l7.l8.l9.2O.(a)(b)(c)(d)(e)(" +
+	"f)(g)(h)(i)(j)(k)(l)(rn)(n)(o)(p)(q)(r)(s)(t)(u)" +
+	"(v)(w)(x)(y)(z)ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefg" +
+	"hijklmnopqrstuvwxyz0/X()<>(){}+-/\\Txx\\/\\>>x::===" +
+	"===></////jVrHlKMNOoPpCcTYX-/93L6\\\\VEl!OQX(())=\\" +
+	"/ ''O()/  =//\\PTE2122232425262728293031323334353" +
+	"63738394041424344454647484950HgergeVLTD/hPadaAUb" +
+	"aroVpcdmdm2dm3IUpAnAmAkAKBMBGBcalkcalpFnFmgkgHzk" +
+	"HzMHzGHzTHzmldlklfmnmmmcmkmmm2cm2m2km2mm3cm3m3km" +
+	"3m/sm/s2PakPaMPaGParadrad/srad/s2psnsmspVnVmVkVM" +
+	"VpWnWmWkWMWa.m.BqcccdC/kgCo.dBGyhaHPinKKKMktlmln" +
+	"loglxmbmilmolPHp.m.PPMPRsrSvWbV/mA/mgal\\/BPdDTGK" +
+	"JCZFMNLSRVHWXYAElOU.,...,:-.=.2iOOooV?2T3sAAaaAO" +
+	"aoAUauAVavAVavAYayOOoo239tf&:'Ffu3JXBCFQoeeforru" +
+	"uyuuoirwzvscfffiflffifflstst'''+lv'oloooooooooo " +
+	"     oll(),:;!?...:-__(){}()[]    ___,.;:?!-(){}" +
+	"()#&*+-<>=\\$%@        lllllllloooo!''#$%&'()*+,-" +
+	"./0123456789:;<=>?@ABCDEFGHlJKLMNOPQRSTUVWXYZ(\\)" +
+	"^_'abcdefghijklmnopqrstuvwxyz{|}~/ lBEFlXOPST+AB" +
+	"CFOMTYXHZBClMTX8*lXOCLSocsROU7ouNOKCVFLX:aedzlsl" +
+	"zqtsylll.OrnvwwwVFLYEZ9E4LOU5TvsFiz7o3969ouyOrnZ" +
+	"WCXWCVTLlRS3>AUY''{.3V\\7FRL<>/\\ABCDEFGHlJKLMNOPQ" +
+	"RSTUVWXYZabcdefghijklrnnopqrstuvwxyzABCDEFGHlJKL" +
+	"MNOPQRSTUVWXYZabcdefgijklrnnopqrstuvwxyzABCDEFGH" +
+	"lJKLMNOPQRSTUVWXYZabcdefghijklrnnopqrstuvwxyzACD" +
+	"GJKNOPQSTUVWXYZabcdfhijklrnnpqrstuvwxyzABCDEFGHl" +
+	"JKLMNOPQRSTUVWXYZabcdefghijklrnnopqrstuvwxyzABDE" +
+	"FGJKLMNOPQSTUVWXYabcdefghijklrnnopqrstuvwxyzABDE" +
+	"FGlJKLMOSTUVWXYabcdefghijklrnnopqrstuvwxyzABCDEF" +
+	"GHlJKLMNOPQRSTUVWXYZabcdefghijklrnnopqrstuvwxyzA" +
+	"BCDEFGHlJKLMNOPQRSTUVWXYZabcdefghijklrnnopqrstuv" +
+	"wxyzABCDEFGHlJKLMNOPQRSTUVWXYZabcdefghijklrnnopq" +
+	"rstuvwxyzABCDEFGHlJKLMNOPQRSTUVWXYZabcdefghijklr" +
+	"nnopqrstuvwxyzABCDEFGHlJKLMNOPQRSTUVWXYZabcdefgh" +
+	"ijklrnnopqrstuvwxyzABCDEFGHlJKLMNOPQRSTUVWXYZabc" +
+	"defghijklrnnopqrstuvwxyziABEZHlKMNOPTYXayivopoup" +
+	"ABEZHlKMNOPTYXayivopoupABEZHlKMNOPTYXayivopoupAB" +
+	"EZHlKMNOPTYXayivopoupABEZHlKMNOPTYXayivopoupFOl2" +
+	"3456789Ol23456789Ol23456789Ol23456789Ol23456789a" +
+	"6reopcyxijyia6reocyxr'isl8looloO.O,l,2,3,4,5,6,7" +
+	",8,9,(A)(B)(C)(D)(E)(F)(G)(H)(l)(J)(K)(L)(M)(N)(" +
+	"O)(P)(Q)(R)(S)(T)(U)(V)(W)(X)(Y)(Z)(S)CRCDWZABCD" +
+	"EFGHIJKLMNOPQRSTUVWXYZHVMVSDSSPPVWCMCMDMRDJQEARC" +
+	"sssTMBVBOl23456789" +
+	""
@@ -5,6 +5,7 @@ package transformations
 
 import (
 	"strings"
+	"unicode/utf8"
 )
 
 func upperCase(data string) (string, bool, error) {
@@ -13,3 +14,30 @@ func upperCase(data string) (string, bool, error) {
 	transformedData := strings.ToUpper(data)
 	return transformedData, data != transformedData, nil
 }
+
+// upperCaseBuffer only handles ASCII input natively, other input goes through
+// strings.ToUpper as the case mapping may change its length.
+func upperCaseBuffer(dst, src []byte) ([]byte, bool, error) {
+	changed := false
+	for _, c := range src {
+		if c >= utf8.RuneSelf {
+			return unicodeUpperCaseBuffer(dst, src)
+		}
+		if 'a' <= c && c <= 'z' {
+			changed = true
+		}
+	}
+	if !changed {
+		return dst, false, nil
+	}
+	dst = dst[:0]
+	for _, c := range src {
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst, true, nil
+}
+
+var unicodeUpperCaseBuffer = bufferOf(upperCase)
@@ -11,14 +11,23 @@ func urlDecode(data string) (string, bool, error) {
 	for i := 0; i < len(data); i++ {
 		if data[i] == '%' || data[i] == '+' {
 			// TODO add error?
-			return doURLDecode(data, []byte(data), i), true, nil
+			return strings.WrapUnsafe(doURLDecode(data, []byte(data), i)), true, nil
 		}
 	}
 	return data, false, nil
 }
 
+func urlDecodeBuffer(dst, src []byte) ([]byte, bool, error) {
+	for i := 0; i < len(src); i++ {
+		if src[i] == '%' || src[i] == '+' {
+			return doURLDecode(strings.WrapUnsafe(src), append(dst[:0], src...), i), true, nil
+		}
+	}
+	return dst, false, nil
+}
+
 // extracted from https://github.com/senghoo/modsecurity-go/blob/master/utils/urlencode.go
-func doURLDecode(input string, d []byte, pos int) string {
+func doURLDecode(input string, d []byte, pos int) []byte {
 	inputLen := len(d)
 	i := pos
 	c := pos
@@ -62,6 +71,5 @@ func doURLDecode(input string, d []byte, pos int) string {
 		}
 	}
 
-	return strings.WrapUnsafe(d[0:c])
-
+	return d[0:c]
 }
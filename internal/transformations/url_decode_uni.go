@@ -10,13 +10,22 @@ import (
 func urlDecodeUni(data string) (string, bool, error) {
 	for i := 0; i < len(data); i++ {
 		if data[i] == '%' || data[i] == '+' {
-			return inplaceUniDecode(data, []byte(data), i), true, nil
+			return strings.WrapUnsafe(inplaceUniDecode(data, []byte(data), i)), true, nil
 		}
 	}
 	return data, false, nil
 }
 
-func inplaceUniDecode(input string, d []byte, pos int) string {
+func urlDecodeUniBuffer(dst, src []byte) ([]byte, bool, error) {
+	for i := 0; i < len(src); i++ {
+		if src[i] == '%' || src[i] == '+' {
+			return inplaceUniDecode(strings.WrapUnsafe(src), append(dst[:0], src...), i), true, nil
+		}
+	}
+	return dst, false, nil
+}
+
+func inplaceUniDecode(input string, d []byte, pos int) []byte {
 	inputLen := len(d)
 	i := pos
 	c := pos
@@ -128,5 +137,5 @@ func inplaceUniDecode(input string, d []byte, pos int) string {
 		}
 	}
 
-	return strings.WrapUnsafe(d[0:c])
+	return d[0:c]
 }
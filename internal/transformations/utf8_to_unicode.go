@@ -24,17 +24,26 @@ import (
 func utf8ToUnicode(str string) (string, bool, error) {
 	for i, c := range str {
 		if c >= utf8.RuneSelf {
-			return doUTF8ToUnicode(str, i), true, nil
+			// Preallocate to length of input, the encoded string will be at least
+			// as long.
+			return strings.WrapUnsafe(doUTF8ToUnicode(make([]byte, 0, len(str)), str, i)), true, nil
 		}
 	}
 	return str, false, nil
 }
 
-func doUTF8ToUnicode(input string, pos int) string {
-	// Preallocate to length of input, the encoded string will be at least
-	// as long.
-	res := make([]byte, pos, len(input))
-	copy(res, input[0:pos])
+func utf8ToUnicodeBuffer(dst, src []byte) ([]byte, bool, error) {
+	str := strings.WrapUnsafe(src)
+	for i, c := range str {
+		if c >= utf8.RuneSelf {
+			return doUTF8ToUnicode(dst[:0], str, i), true, nil
+		}
+	}
+	return dst, false, nil
+}
+
+func doUTF8ToUnicode(res []byte, input string, pos int) []byte {
+	res = append(res, input[:pos]...)
 
 	for _, c := range input[pos:] {
 		if c < utf8.RuneSelf {
@@ -50,7 +59,7 @@ func doUTF8ToUnicode(input string, pos int) string {
 		res = strconv.AppendUint(res, uint64(c), 16)
 	}
 
-	return strings.WrapUnsafe(res)
+	return res
 }
 
 func numHexDigits(c rune) int {
@@ -0,0 +1,215 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package xmlvalidate validates XML documents against XML Schemas (XSD) and
+// Document Type Definitions (DTD) without resolving external entities.
+package xmlvalidate
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxDepth limits the nesting of the documents, so that the recursive
+// validation cannot be abused.
+const maxDepth = 256
+
+const (
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+	xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+	xmlnsPrefix  = "xmlns"
+)
+
+// element is an element of a parsed document
+type element struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*element
+	// text holds the character data directly contained by the element
+	text string
+	// namespaces maps the prefixes in scope to their namespace, it is used to
+	// resolve the QName values of the schema documents.
+	namespaces map[string]string
+	line       int
+}
+
+// hasText reports whether the element contains character data other than
+// whitespace.
+func (e *element) hasText() bool {
+	return strings.TrimSpace(e.text) != ""
+}
+
+func (e *element) attr(space, local string) (string, bool) {
+	for _, a := range e.attrs {
+		if a.Name.Space == space && a.Name.Local == local {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// parseOptions configures parseDocument
+type parseOptions struct {
+	// raw keeps the prefixes of the names instead of resolving their
+	// namespaces, as DTDs are not namespace aware.
+	raw bool
+	// entities holds the replacement text of the internal general entities
+	entities map[string]string
+}
+
+// parseDocument parses r into a tree of elements. DOCTYPE declarations are
+// ignored, so neither internal subsets nor external entities are processed.
+func parseDocument(r io.Reader, opts parseOptions) (*element, error) {
+	dec := xml.NewDecoder(r)
+	dec.Strict = true
+	dec.Entity = opts.entities
+
+	var (
+		root  *element
+		stack []*element
+		text  []*strings.Builder
+	)
+	for {
+		var (
+			tok xml.Token
+			err error
+		)
+		if opts.raw {
+			tok, err = dec.RawToken()
+		} else {
+			tok, err = dec.Token()
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if len(stack) == maxDepth {
+				return nil, fmt.Errorf("maximum depth of %d exceeded", maxDepth)
+			}
+			line, _ := dec.InputPos()
+			e := &element{
+				name:  tok.Name,
+				attrs: append([]xml.Attr(nil), tok.Attr...),
+				line:  line,
+			}
+			if opts.raw {
+				e.name = xml.Name{Local: rawName(tok.Name)}
+				for i, a := range e.attrs {
+					e.attrs[i].Name = xml.Name{Local: rawName(a.Name)}
+				}
+			}
+			var parent map[string]string
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, errors.New("more than one root element")
+				}
+				root = e
+			} else {
+				p := stack[len(stack)-1]
+				p.children = append(p.children, e)
+				parent = p.namespaces
+			}
+			e.namespaces = scopeNamespaces(parent, tok.Attr)
+			stack = append(stack, e)
+			text = append(text, &strings.Builder{})
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("unexpected end element %s", rawName(tok.Name))
+			}
+			e := stack[len(stack)-1]
+			// RawToken does not verify that the elements are balanced
+			if opts.raw && e.name.Local != rawName(tok.Name) {
+				return nil, fmt.Errorf("element %s closed by %s", e.name.Local, rawName(tok.Name))
+			}
+			e.text = text[len(text)-1].String()
+			stack = stack[:len(stack)-1]
+			text = text[:len(text)-1]
+		case xml.CharData:
+			if len(stack) == 0 {
+				if len(strings.TrimSpace(string(tok))) != 0 {
+					return nil, errors.New("character data outside of the root element")
+				}
+				continue
+			}
+			text[len(text)-1].Write(tok)
+		}
+	}
+
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("element %s is not closed", stack[len(stack)-1].name.Local)
+	}
+	if root == nil {
+		return nil, errors.New("no root element")
+	}
+	return root, nil
+}
+
+// scopeNamespaces returns the namespaces in scope of an element given the ones
+// of its parent and its attributes. The parent map is reused when the element
+// does not declare any namespace.
+func scopeNamespaces(parent map[string]string, attrs []xml.Attr) map[string]string {
+	var ns map[string]string
+	for _, a := range attrs {
+		var prefix string
+		switch {
+		case a.Name.Space == xmlnsPrefix:
+			prefix = a.Name.Local
+		case a.Name.Space == "" && a.Name.Local == xmlnsPrefix:
+		default:
+			continue
+		}
+		if ns == nil {
+			ns = make(map[string]string, len(parent)+1)
+			for k, v := range parent {
+				ns[k] = v
+			}
+		}
+		ns[prefix] = a.Value
+	}
+	if ns == nil {
+		return parent
+	}
+	return ns
+}
+
+func isNamespaceDeclaration(n xml.Name) bool {
+	return n.Space == xmlnsPrefix || (n.Space == "" && n.Local == xmlnsPrefix)
+}
+
+func rawName(n xml.Name) string {
+	if n.Space == "" {
+		return n.Local
+	}
+	return n.Space + ":" + n.Local
+}
+
+// formatName formats n in the {namespace}local notation
+func formatName(n xml.Name) string {
+	if n.Space == "" {
+		return n.Local
+	}
+	return "{" + n.Space + "}" + n.Local
+}
+
+// validationError is an error found while validating a document
+type validationError struct {
+	line int
+	msg  string
+}
+
+func (e *validationError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.msg)
+}
+
+func errorf(e *element, format string, args ...interface{}) error {
+	return &validationError{line: e.line, msg: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,699 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmlvalidate
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxEntityExpansion bounds the text produced by the expansion of parameter
+// entities, so that recursive definitions cannot exhaust the memory.
+const maxEntityExpansion = 1 << 20
+
+var errExternalEntity = errors.New("external entities are disabled")
+
+type contentKind int
+
+const (
+	contentEmpty contentKind = iota
+	contentAny
+	contentMixed
+	contentChildren
+)
+
+// DTD is a parsed Document Type Definition. External entities and external
+// parameter entities are never resolved, the documents referring to them are
+// not valid.
+type DTD struct {
+	elements map[string]*dtdElement
+	// entities holds the replacement text of the internal general entities
+	entities map[string]string
+}
+
+type dtdElement struct {
+	declared bool
+	kind     contentKind
+	model    *particle
+	mixed    map[string]bool
+	// attributes can be declared before the element
+	attributes map[string]*dtdAttribute
+}
+
+type dtdAttribute struct {
+	typ      string
+	values   []string
+	required bool
+	fixed    *string
+}
+
+// NewDTD parses the Document Type Definition data
+func NewDTD(data []byte) (*DTD, error) {
+	p := &dtdParser{
+		s:         string(data),
+		params:    map[string]string{},
+		externals: map[string]bool{},
+		dtd: &DTD{
+			elements: map[string]*dtdElement{},
+			entities: map[string]string{},
+		},
+	}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.dtd, nil
+}
+
+type dtdParser struct {
+	s   string
+	pos int
+	// params holds the replacement text of the internal parameter entities
+	params    map[string]string
+	externals map[string]bool
+	expanded  int
+	dtd       *DTD
+}
+
+func (p *dtdParser) errorf(format string, args ...interface{}) error {
+	line := 1 + strings.Count(p.s[:min(p.pos, len(p.s))], "\n")
+	return fmt.Errorf("invalid DTD at line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+// expandParam replaces the parameter entity reference at pos with its
+// replacement text, surrounded by spaces as required by the specification.
+func (p *dtdParser) expandParam(s string, pos int) (string, error) {
+	end := strings.IndexByte(s[pos:], ';')
+	if end < 0 {
+		return "", p.errorf("unterminated parameter entity reference")
+	}
+	name := s[pos+1 : pos+end]
+	v, ok := p.params[name]
+	if !ok {
+		if p.externals[name] {
+			return "", p.errorf("parameter entity %s: %s", name, errExternalEntity.Error())
+		}
+		return "", p.errorf("undeclared parameter entity %s", name)
+	}
+	p.expanded += len(v)
+	if p.expanded > maxEntityExpansion {
+		return "", p.errorf("too many entity expansions")
+	}
+	return s[:pos] + " " + v + " " + s[pos+end+1:], nil
+}
+
+func (p *dtdParser) parse() error {
+	for {
+		for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+			p.pos++
+		}
+		if p.pos == len(p.s) {
+			return nil
+		}
+
+		rest := p.s[p.pos:]
+		switch {
+		case rest[0] == '%':
+			s, err := p.expandParam(p.s, p.pos)
+			if err != nil {
+				return err
+			}
+			p.s = s
+		case strings.HasPrefix(rest, "<!--"):
+			end := strings.Index(rest, "-->")
+			if end < 0 {
+				return p.errorf("unterminated comment")
+			}
+			p.pos += end + 3
+		case strings.HasPrefix(rest, "<?"):
+			end := strings.Index(rest, "?>")
+			if end < 0 {
+				return p.errorf("unterminated processing instruction")
+			}
+			p.pos += end + 2
+		case strings.HasPrefix(rest, "<!["):
+			return p.errorf("conditional sections are not supported")
+		case strings.HasPrefix(rest, "<!"):
+			end := declarationEnd(rest)
+			if end < 0 {
+				return p.errorf("unterminated declaration")
+			}
+			if err := p.declaration(rest[2:end]); err != nil {
+				return err
+			}
+			p.pos += end + 1
+		default:
+			return p.errorf("unexpected %q", rest[:min(len(rest), 10)])
+		}
+	}
+}
+
+// declarationEnd returns the position of the > closing the declaration at
+// the beginning of s, ignoring the quoted literals.
+func declarationEnd(s string) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *dtdParser) declaration(decl string) error {
+	// parameter entities are expanded outside of the literals
+	var quote byte
+	for i := 0; i < len(decl); i++ {
+		switch c := decl[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '%' && i+1 < len(decl) && !isSpace(decl[i+1]):
+			s, err := p.expandParam(decl, i)
+			if err != nil {
+				return err
+			}
+			decl = s
+			i--
+		}
+	}
+
+	sc := &dtdScanner{s: decl}
+	keyword := sc.token()
+	var err error
+	switch keyword {
+	case "ELEMENT":
+		err = p.elementDecl(sc)
+	case "ATTLIST":
+		err = p.attlistDecl(sc)
+	case "ENTITY":
+		err = p.entityDecl(sc)
+	case "NOTATION":
+		return nil
+	default:
+		return p.errorf("unknown declaration %q", keyword)
+	}
+	if err != nil {
+		return p.errorf("%s", err.Error())
+	}
+	return nil
+}
+
+func (p *dtdParser) element(name string) *dtdElement {
+	e, ok := p.dtd.elements[name]
+	if !ok {
+		e = &dtdElement{attributes: map[string]*dtdAttribute{}}
+		p.dtd.elements[name] = e
+	}
+	return e
+}
+
+func (p *dtdParser) elementDecl(sc *dtdScanner) error {
+	name := sc.token()
+	if name == "" {
+		return errors.New("element declaration without name")
+	}
+	e := p.element(name)
+	if e.declared {
+		return fmt.Errorf("element %s declared twice", name)
+	}
+	e.declared = true
+
+	switch {
+	case sc.consume("EMPTY"):
+		e.kind = contentEmpty
+	case sc.consume("ANY"):
+		e.kind = contentAny
+	case sc.peek() == '(':
+		save := sc.pos
+		sc.pos++
+		if sc.consume("#PCDATA") {
+			e.kind = contentMixed
+			e.mixed = map[string]bool{}
+			for sc.consume("|") {
+				n := sc.token()
+				if n == "" {
+					return fmt.Errorf("invalid content of element %s", name)
+				}
+				e.mixed[n] = true
+			}
+			if !sc.consume(")") {
+				return fmt.Errorf("invalid content of element %s", name)
+			}
+			if !sc.consume("*") && len(e.mixed) > 0 {
+				return fmt.Errorf("mixed content of element %s must be repeatable", name)
+			}
+			break
+		}
+		sc.pos = save
+		e.kind = contentChildren
+		model, err := sc.contentParticle()
+		if err != nil {
+			return fmt.Errorf("invalid content of element %s: %s", name, err.Error())
+		}
+		e.model = model
+	default:
+		return fmt.Errorf("invalid content of element %s", name)
+	}
+	if !sc.end() {
+		return fmt.Errorf("unexpected content in declaration of element %s", name)
+	}
+	return nil
+}
+
+func (p *dtdParser) attlistDecl(sc *dtdScanner) error {
+	e := p.element(sc.token())
+	for !sc.end() {
+		name := sc.token()
+		if name == "" {
+			return errors.New("invalid attribute list declaration")
+		}
+		a := &dtdAttribute{}
+		switch {
+		case sc.peek() == '(':
+			a.typ = "enumeration"
+		default:
+			a.typ = sc.token()
+		}
+		switch a.typ {
+		case "CDATA", "ID", "IDREF", "IDREFS", "ENTITY", "ENTITIES", "NMTOKEN", "NMTOKENS":
+		case "NOTATION", "enumeration":
+			if !sc.consume("(") {
+				return fmt.Errorf("invalid type of attribute %s", name)
+			}
+			for {
+				v := sc.token()
+				if v == "" {
+					return fmt.Errorf("invalid type of attribute %s", name)
+				}
+				a.values = append(a.values, v)
+				if sc.consume(")") {
+					break
+				}
+				if !sc.consume("|") {
+					return fmt.Errorf("invalid type of attribute %s", name)
+				}
+			}
+		default:
+			return fmt.Errorf("invalid type %q of attribute %s", a.typ, name)
+		}
+
+		switch {
+		case sc.consume("#REQUIRED"):
+			a.required = true
+		case sc.consume("#IMPLIED"):
+		default:
+			fixed := sc.consume("#FIXED")
+			v, ok := sc.literal()
+			if !ok {
+				return fmt.Errorf("invalid default value of attribute %s", name)
+			}
+			if fixed {
+				a.fixed = &v
+			}
+		}
+
+		// the first declaration is binding
+		if _, ok := e.attributes[name]; !ok {
+			e.attributes[name] = a
+		}
+	}
+	return nil
+}
+
+func (p *dtdParser) entityDecl(sc *dtdScanner) error {
+	param := sc.consume("%")
+	name := sc.token()
+	if name == "" {
+		return errors.New("entity declaration without name")
+	}
+
+	if v, ok := sc.literal(); ok {
+		v, err := p.expandLiteral(v)
+		if err != nil {
+			return err
+		}
+		if param {
+			if _, ok := p.params[name]; !ok {
+				p.params[name] = v
+			}
+		} else if _, ok := p.dtd.entities[name]; !ok {
+			p.dtd.entities[name] = v
+		}
+		return nil
+	}
+
+	if !sc.consume("SYSTEM") && !sc.consume("PUBLIC") {
+		return fmt.Errorf("invalid declaration of entity %s", name)
+	}
+	// external entities are recorded to report them, but never loaded
+	if param {
+		p.externals[name] = true
+	}
+	return nil
+}
+
+// expandLiteral expands the character and parameter entity references of an
+// entity value.
+func (p *dtdParser) expandLiteral(v string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '%':
+			end := strings.IndexByte(v[i:], ';')
+			if end < 0 {
+				return "", errors.New("unterminated parameter entity reference")
+			}
+			name := v[i+1 : i+end]
+			r, ok := p.params[name]
+			if !ok {
+				if p.externals[name] {
+					return "", fmt.Errorf("parameter entity %s: %s", name, errExternalEntity.Error())
+				}
+				return "", fmt.Errorf("undeclared parameter entity %s", name)
+			}
+			p.expanded += len(r)
+			if p.expanded > maxEntityExpansion {
+				return "", errors.New("too many entity expansions")
+			}
+			b.WriteString(r)
+			i += end
+		case '&':
+			if !strings.HasPrefix(v[i:], "&#") {
+				b.WriteByte('&')
+				continue
+			}
+			end := strings.IndexByte(v[i:], ';')
+			if end < 0 {
+				return "", errors.New("unterminated character reference")
+			}
+			ref := v[i+2 : i+end]
+			var (
+				n   uint64
+				err error
+			)
+			if strings.HasPrefix(ref, "x") {
+				n, err = strconv.ParseUint(ref[1:], 16, 32)
+			} else {
+				n, err = strconv.ParseUint(ref, 10, 32)
+			}
+			if err != nil {
+				return "", fmt.Errorf("invalid character reference &#%s;", ref)
+			}
+			b.WriteRune(rune(n))
+			i += end
+		default:
+			b.WriteByte(v[i])
+		}
+	}
+	return b.String(), nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// dtdScanner scans the tokens of a declaration
+type dtdScanner struct {
+	s   string
+	pos int
+}
+
+func (sc *dtdScanner) skipSpace() {
+	for sc.pos < len(sc.s) && isSpace(sc.s[sc.pos]) {
+		sc.pos++
+	}
+}
+
+func (sc *dtdScanner) end() bool {
+	sc.skipSpace()
+	return sc.pos == len(sc.s)
+}
+
+func (sc *dtdScanner) peek() byte {
+	sc.skipSpace()
+	if sc.pos == len(sc.s) {
+		return 0
+	}
+	return sc.s[sc.pos]
+}
+
+func (sc *dtdScanner) consume(tok string) bool {
+	sc.skipSpace()
+	if strings.HasPrefix(sc.s[sc.pos:], tok) {
+		sc.pos += len(tok)
+		return true
+	}
+	return false
+}
+
+// token returns the next run of characters up to a delimiter
+func (sc *dtdScanner) token() string {
+	sc.skipSpace()
+	start := sc.pos
+	for sc.pos < len(sc.s) && !isSpace(sc.s[sc.pos]) && !strings.ContainsRune("()|,?*+\"'", rune(sc.s[sc.pos])) {
+		sc.pos++
+	}
+	return sc.s[start:sc.pos]
+}
+
+func (sc *dtdScanner) literal() (string, bool) {
+	sc.skipSpace()
+	if sc.pos == len(sc.s) || (sc.s[sc.pos] != '"' && sc.s[sc.pos] != '\'') {
+		return "", false
+	}
+	quote := sc.s[sc.pos]
+	end := strings.IndexByte(sc.s[sc.pos+1:], quote)
+	if end < 0 {
+		return "", false
+	}
+	v := sc.s[sc.pos+1 : sc.pos+1+end]
+	sc.pos += end + 2
+	return v, true
+}
+
+// contentParticle parses a content particle: a name or a choice or sequence
+// of particles, with an optional occurrence indicator.
+func (sc *dtdScanner) contentParticle() (*particle, error) {
+	p := &particle{min: 1, max: 1}
+	if sc.consume("(") {
+		var sep string
+		for {
+			c, err := sc.contentParticle()
+			if err != nil {
+				return nil, err
+			}
+			p.children = append(p.children, c)
+			if sc.consume(")") {
+				break
+			}
+			s := string(sc.peek())
+			if (s != "," && s != "|") || (sep != "" && s != sep) {
+				return nil, errors.New("invalid separator")
+			}
+			sep = s
+			sc.pos++
+		}
+		p.kind = particleSequence
+		if sep == "|" {
+			p.kind = particleChoice
+		}
+	} else {
+		n := sc.token()
+		if n == "" || n[0] == '#' {
+			return nil, fmt.Errorf("invalid name %q", n)
+		}
+		p.kind = particleElement
+		p.name = xml.Name{Local: n}
+	}
+
+	// occurrence indicators follow immediately
+	if sc.pos < len(sc.s) {
+		switch sc.s[sc.pos] {
+		case '?':
+			p.min = 0
+		case '*':
+			p.min, p.max = 0, unbounded
+		case '+':
+			p.max = unbounded
+		default:
+			return p, nil
+		}
+		sc.pos++
+	}
+	return p, nil
+}
+
+// Validate validates the document read from r against the DTD. The DOCTYPE
+// declaration of the document, if any, is ignored.
+func (d *DTD) Validate(r io.Reader) error {
+	root, err := parseDocument(r, parseOptions{raw: true, entities: d.entities})
+	if err != nil {
+		return err
+	}
+	v := &dtdValidator{dtd: d, ids: map[string]bool{}}
+	if err := v.validate(root); err != nil {
+		return err
+	}
+	for _, ref := range v.refs {
+		if !v.ids[ref.id] {
+			return errorf(ref.e, "IDREF %q of element %s does not match any ID", ref.id, ref.e.name.Local)
+		}
+	}
+	return nil
+}
+
+type idRef struct {
+	id string
+	e  *element
+}
+
+type dtdValidator struct {
+	dtd  *DTD
+	ids  map[string]bool
+	refs []idRef
+}
+
+func (v *dtdValidator) validate(e *element) error {
+	name := e.name.Local
+	decl, ok := v.dtd.elements[name]
+	if !ok || !decl.declared {
+		return errorf(e, "no declaration for element %s", name)
+	}
+	if err := v.validateAttributes(e, decl); err != nil {
+		return err
+	}
+
+	switch decl.kind {
+	case contentEmpty:
+		if len(e.children) > 0 || e.text != "" {
+			return errorf(e, "element %s must be empty", name)
+		}
+	case contentMixed:
+		for _, c := range e.children {
+			if !decl.mixed[c.name.Local] {
+				return errorf(c, "element %s is not allowed in element %s", c.name.Local, name)
+			}
+		}
+	case contentChildren:
+		if e.hasText() {
+			return errorf(e, "element %s cannot have character content", name)
+		}
+		names := make([]xml.Name, len(e.children))
+		for i, c := range e.children {
+			names[i] = c.name
+		}
+		if err := matchContent(decl.model, names); err != nil {
+			var cerr *contentError
+			if errors.As(err, &cerr) && cerr.unexpected != nil {
+				return errorf(e.children[cerr.index], "element %s: %s", name, err.Error())
+			}
+			return errorf(e, "element %s: %s", name, err.Error())
+		}
+	}
+
+	for _, c := range e.children {
+		if err := v.validate(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *dtdValidator) validateAttributes(e *element, decl *dtdElement) error {
+	seen := map[string]bool{}
+	for _, a := range e.attrs {
+		name := a.Name.Local
+		ad, ok := decl.attributes[name]
+		if !ok {
+			// namespace declarations are tolerated, DTDs are not namespace aware
+			if name == xmlnsPrefix || strings.HasPrefix(name, xmlnsPrefix+":") {
+				continue
+			}
+			return errorf(e, "no declaration for attribute %s of element %s", name, e.name.Local)
+		}
+		seen[name] = true
+
+		value := a.Value
+		if ad.typ != "CDATA" {
+			value = normalize(value, whitespaceCollapse)
+		}
+		if ad.fixed != nil && value != *ad.fixed {
+			return errorf(e, "attribute %s of element %s must be %q", name, e.name.Local, *ad.fixed)
+		}
+		if err := v.validateAttributeValue(e, ad, value); err != nil {
+			return errorf(e, "attribute %s of element %s: %q %s", name, e.name.Local, value, err.Error())
+		}
+	}
+
+	var missing []string
+	for name, ad := range decl.attributes {
+		if ad.required && !seen[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return errorf(e, "missing required attribute %s in element %s", missing[0], e.name.Local)
+	}
+	return nil
+}
+
+func (v *dtdValidator) validateAttributeValue(e *element, ad *dtdAttribute, value string) error {
+	switch ad.typ {
+	case "ID":
+		if checkName(value) != nil {
+			return errors.New("is not a valid ID")
+		}
+		if v.ids[value] {
+			return errors.New("is a duplicated ID")
+		}
+		v.ids[value] = true
+	case "IDREF", "IDREFS", "ENTITY", "ENTITIES":
+		values := strings.Fields(value)
+		if len(values) == 0 || (len(values) > 1 && !strings.HasSuffix(ad.typ, "S")) {
+			return fmt.Errorf("is not a valid %s", ad.typ)
+		}
+		for _, n := range values {
+			if checkName(n) != nil {
+				return fmt.Errorf("is not a valid %s", ad.typ)
+			}
+			if strings.HasPrefix(ad.typ, "IDREF") {
+				v.refs = append(v.refs, idRef{id: n, e: e})
+			}
+		}
+	case "NMTOKEN", "NMTOKENS":
+		values := strings.Fields(value)
+		if len(values) == 0 || (len(values) > 1 && ad.typ == "NMTOKEN") {
+			return fmt.Errorf("is not a valid %s", ad.typ)
+		}
+		for _, n := range values {
+			if checkNMTOKEN(n) != nil {
+				return fmt.Errorf("is not a valid %s", ad.typ)
+			}
+		}
+	case "NOTATION", "enumeration":
+		for _, allowed := range ad.values {
+			if value == allowed {
+				return nil
+			}
+		}
+		return errors.New("is not an allowed value")
+	}
+	return nil
+}
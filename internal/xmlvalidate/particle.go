@@ -0,0 +1,248 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmlvalidate
+
+import (
+	"encoding/xml"
+	"errors"
+	"sort"
+)
+
+// unbounded is the maximum number of occurrences of maxOccurs="unbounded"
+const unbounded = -1
+
+// maxMatchSteps bounds the work spent matching the children of an element,
+// as ambiguous content models can be quadratic.
+const maxMatchSteps = 1 << 20
+
+var errTooComplex = errors.New("content model too complex to validate")
+
+type particleKind int
+
+const (
+	particleElement particleKind = iota
+	particleWildcard
+	particleSequence
+	particleChoice
+	particleAll
+)
+
+// particle is a content model shared by XSD and DTD: a name, a wildcard or a
+// group of particles, repeated between min and max times.
+type particle struct {
+	kind     particleKind
+	min, max int
+	name     xml.Name
+	wildcard *wildcard
+	children []*particle
+}
+
+// matchState tracks the progress of the matching of the children of an
+// element.
+type matchState struct {
+	names []xml.Name
+	// furthest is the furthest position consumed, it points to the first
+	// child that could not be matched.
+	furthest int
+	steps    int
+}
+
+// matchContent matches names against the content model p, returning an error
+// describing the first mismatch.
+func matchContent(p *particle, names []xml.Name) error {
+	st := &matchState{names: names}
+	ends, err := p.match(st, 0)
+	if err != nil {
+		return err
+	}
+	for _, e := range ends {
+		if e == len(names) {
+			return nil
+		}
+	}
+	if st.furthest < len(names) {
+		return &contentError{unexpected: &names[st.furthest], index: st.furthest}
+	}
+	return &contentError{index: len(names)}
+}
+
+// contentError is returned when the children do not match the content model.
+// unexpected is nil when the children are valid but incomplete.
+type contentError struct {
+	unexpected *xml.Name
+	index      int
+}
+
+func (e *contentError) Error() string {
+	if e.unexpected == nil {
+		return "missing child elements"
+	}
+	return "unexpected element " + formatName(*e.unexpected)
+}
+
+// match returns the sorted positions where a match of p starting at start
+// ends.
+func (p *particle) match(st *matchState, start int) ([]int, error) {
+	seen := map[int]bool{}
+	var ends []int
+	if p.min == 0 {
+		seen[start] = true
+		ends = append(ends, start)
+	}
+
+	frontier := []int{start}
+	for i := 1; (p.max == unbounded || i <= p.max) && len(frontier) > 0; i++ {
+		var next []int
+		nextSeen := map[int]bool{}
+		for _, s := range frontier {
+			once, err := p.matchOnce(st, s)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range once {
+				// once the minimum is reached, positions already found cannot
+				// lead anywhere new, this guarantees termination for
+				// particles matching the empty sequence.
+				if nextSeen[e] || (i >= p.min && seen[e]) {
+					continue
+				}
+				nextSeen[e] = true
+				next = append(next, e)
+			}
+		}
+		if i >= p.min {
+			for _, e := range next {
+				seen[e] = true
+				ends = append(ends, e)
+			}
+		}
+		frontier = next
+	}
+	sort.Ints(ends)
+	return ends, nil
+}
+
+// matchOnce returns the positions where a single occurrence of p starting at
+// start ends.
+func (p *particle) matchOnce(st *matchState, start int) ([]int, error) {
+	st.steps++
+	if st.steps > maxMatchSteps {
+		return nil, errTooComplex
+	}
+
+	switch p.kind {
+	case particleElement, particleWildcard:
+		if start < len(st.names) && p.accepts(st.names[start]) {
+			if start+1 > st.furthest {
+				st.furthest = start + 1
+			}
+			return []int{start + 1}, nil
+		}
+		return nil, nil
+	case particleSequence:
+		positions := []int{start}
+		for _, c := range p.children {
+			seen := map[int]bool{}
+			var next []int
+			for _, s := range positions {
+				ends, err := c.match(st, s)
+				if err != nil {
+					return nil, err
+				}
+				for _, e := range ends {
+					if !seen[e] {
+						seen[e] = true
+						next = append(next, e)
+					}
+				}
+			}
+			if len(next) == 0 {
+				return nil, nil
+			}
+			positions = next
+		}
+		return positions, nil
+	case particleChoice:
+		seen := map[int]bool{}
+		var positions []int
+		for _, c := range p.children {
+			ends, err := c.match(st, start)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range ends {
+				if !seen[e] {
+					seen[e] = true
+					positions = append(positions, e)
+				}
+			}
+		}
+		return positions, nil
+	case particleAll:
+		// the children of an all group are elements that can appear at most
+		// once in any order, so they are consumed greedily.
+		used := make([]bool, len(p.children))
+		pos := start
+	consume:
+		for pos < len(st.names) {
+			for i, c := range p.children {
+				if !used[i] && c.accepts(st.names[pos]) {
+					used[i] = true
+					pos++
+					if pos > st.furthest {
+						st.furthest = pos
+					}
+					continue consume
+				}
+			}
+			break
+		}
+		for i, c := range p.children {
+			if !used[i] && c.min > 0 {
+				return nil, nil
+			}
+		}
+		return []int{pos}, nil
+	}
+	return nil, nil
+}
+
+func (p *particle) accepts(n xml.Name) bool {
+	switch p.kind {
+	case particleElement:
+		return p.name == n
+	case particleWildcard:
+		return p.wildcard.allows(n.Space)
+	}
+	return false
+}
+
+type processContents int
+
+const (
+	processStrict processContents = iota
+	processLax
+	processSkip
+)
+
+// wildcard is the namespace constraint of xs:any and xs:anyAttribute
+type wildcard struct {
+	any bool
+	// not excludes a namespace (##other), the absent namespace is always
+	// excluded too.
+	not        *string
+	namespaces map[string]bool
+	process    processContents
+}
+
+func (w *wildcard) allows(ns string) bool {
+	switch {
+	case w.any:
+		return true
+	case w.not != nil:
+		return ns != "" && ns != *w.not
+	default:
+		return w.namespaces[ns]
+	}
+}
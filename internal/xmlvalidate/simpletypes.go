@@ -0,0 +1,554 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmlvalidate
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type whitespace int
+
+const (
+	whitespacePreserve whitespace = iota
+	whitespaceReplace
+	whitespaceCollapse
+)
+
+// simpleType is a built-in or user defined simple type. Values are checked
+// against the base type first and then against the facets of the type.
+type simpleType struct {
+	name xml.Name
+	base *simpleType
+	// check validates the lexical space of primitive types
+	check func(string) error
+	// compare orders the values of primitive types with an order
+	compare func(a, b string) int
+	// length returns the length of a value for the length facets, it
+	// defaults to the number of characters.
+	length     func(string) int
+	whitespace whitespace
+
+	// item is the type of the items of list types
+	item *simpleType
+	// members are the types of union types
+	members []*simpleType
+
+	enumeration    []string
+	patterns       []*regexp.Regexp
+	minLength      *int
+	maxLength      *int
+	minInclusive   *string
+	maxInclusive   *string
+	minExclusive   *string
+	maxExclusive   *string
+	totalDigits    *int
+	fractionDigits *int
+}
+
+// derive returns a restriction of t
+func (t *simpleType) derive(name xml.Name) *simpleType {
+	return &simpleType{name: name, base: t, whitespace: t.whitespace}
+}
+
+// comparer returns the order of the values of t, nil if they are not ordered
+func (t *simpleType) comparer() func(a, b string) int {
+	for ; t != nil; t = t.base {
+		if t.compare != nil {
+			return t.compare
+		}
+	}
+	return nil
+}
+
+func (t *simpleType) isList() bool {
+	for ; t != nil; t = t.base {
+		if t.item != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *simpleType) String() string {
+	if t.name.Local == "" {
+		return "anonymous type"
+	}
+	return formatName(t.name)
+}
+
+func normalize(v string, ws whitespace) string {
+	switch ws {
+	case whitespaceReplace:
+		return strings.Map(func(r rune) rune {
+			if r == '\t' || r == '\n' || r == '\r' {
+				return ' '
+			}
+			return r
+		}, v)
+	case whitespaceCollapse:
+		return strings.Join(strings.Fields(v), " ")
+	}
+	return v
+}
+
+// validate validates v, which is normalized according to the whitespace facet
+func (t *simpleType) validate(v string) error {
+	return t.validateNormalized(normalize(v, t.whitespace))
+}
+
+func (t *simpleType) validateNormalized(v string) error {
+	switch {
+	case t.members != nil:
+		for _, m := range t.members {
+			if m.validate(v) == nil {
+				return t.checkFacets(v)
+			}
+		}
+		return fmt.Errorf("%q is not a valid value of any member of %s", v, t)
+	case t.item != nil:
+		for _, i := range strings.Fields(v) {
+			if err := t.item.validate(i); err != nil {
+				return err
+			}
+		}
+	default:
+		if t.base != nil {
+			if err := t.base.validateNormalized(v); err != nil {
+				return err
+			}
+		}
+		if t.check != nil {
+			if err := t.check(v); err != nil {
+				return fmt.Errorf("%q is not a valid value of %s: %s", v, t, err.Error())
+			}
+		}
+	}
+	return t.checkFacets(v)
+}
+
+func (t *simpleType) checkFacets(v string) error {
+	if len(t.enumeration) > 0 {
+		found := false
+		for _, e := range t.enumeration {
+			if e == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%q is not an allowed value of %s", v, t)
+		}
+	}
+
+	if len(t.patterns) > 0 {
+		// patterns of the same step are ORed
+		found := false
+		for _, p := range t.patterns {
+			if p.MatchString(v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%q does not match the pattern of %s", v, t)
+		}
+	}
+
+	if t.minLength != nil || t.maxLength != nil {
+		l := t.valueLength(v)
+		if t.minLength != nil && l < *t.minLength {
+			return fmt.Errorf("%q is shorter than the minimum length %d of %s", v, *t.minLength, t)
+		}
+		if t.maxLength != nil && l > *t.maxLength {
+			return fmt.Errorf("%q is longer than the maximum length %d of %s", v, *t.maxLength, t)
+		}
+	}
+
+	if t.minInclusive != nil || t.maxInclusive != nil || t.minExclusive != nil || t.maxExclusive != nil {
+		compare := t.comparer()
+		if compare == nil {
+			return nil
+		}
+		if t.minInclusive != nil && compare(v, *t.minInclusive) < 0 {
+			return fmt.Errorf("%q is less than the minimum %s of %s", v, *t.minInclusive, t)
+		}
+		if t.maxInclusive != nil && compare(v, *t.maxInclusive) > 0 {
+			return fmt.Errorf("%q is greater than the maximum %s of %s", v, *t.maxInclusive, t)
+		}
+		if t.minExclusive != nil && compare(v, *t.minExclusive) <= 0 {
+			return fmt.Errorf("%q is not greater than %s as required by %s", v, *t.minExclusive, t)
+		}
+		if t.maxExclusive != nil && compare(v, *t.maxExclusive) >= 0 {
+			return fmt.Errorf("%q is not less than %s as required by %s", v, *t.maxExclusive, t)
+		}
+	}
+
+	if t.totalDigits != nil || t.fractionDigits != nil {
+		total, fraction := decimalDigits(v)
+		if t.totalDigits != nil && total > *t.totalDigits {
+			return fmt.Errorf("%q has more than %d digits as allowed by %s", v, *t.totalDigits, t)
+		}
+		if t.fractionDigits != nil && fraction > *t.fractionDigits {
+			return fmt.Errorf("%q has more than %d fraction digits as allowed by %s", v, *t.fractionDigits, t)
+		}
+	}
+	return nil
+}
+
+func (t *simpleType) valueLength(v string) int {
+	if t.isList() {
+		return len(strings.Fields(v))
+	}
+	for b := t; b != nil; b = b.base {
+		if b.length != nil {
+			return b.length(v)
+		}
+	}
+	return utf8.RuneCountInString(v)
+}
+
+// decimalDigits returns the significant digits of a decimal value and the
+// digits of its fraction.
+func decimalDigits(v string) (int, int) {
+	v = strings.TrimLeft(v, "+-")
+	integer, fraction, _ := strings.Cut(v, ".")
+	integer = strings.TrimLeft(integer, "0")
+	fraction = strings.TrimRight(fraction, "0")
+	return len(integer) + len(fraction), len(fraction)
+}
+
+var (
+	reDecimal  = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+	reInteger  = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	reFloat    = regexp.MustCompile(`^([+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?|-?INF|NaN)$`)
+	reDuration = regexp.MustCompile(`^-?P(([0-9]+Y)?([0-9]+M)?([0-9]+D)?(T([0-9]+H)?([0-9]+M)?([0-9]+(\.[0-9]+)?S)?)?)$`)
+	reLanguage = regexp.MustCompile(`^[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*$`)
+	reTimezone = `(Z|[+-][0-9]{2}:[0-9]{2})?`
+	reDate     = regexp.MustCompile(`^-?([0-9]{4,})-([0-9]{2})-([0-9]{2})` + reTimezone + `$`)
+	reTime     = regexp.MustCompile(`^([0-9]{2}):([0-9]{2}):([0-9]{2})(\.[0-9]+)?` + reTimezone + `$`)
+	reDateTime = regexp.MustCompile(`^-?([0-9]{4,})-([0-9]{2})-([0-9]{2})T([0-9]{2}):([0-9]{2}):([0-9]{2})(\.[0-9]+)?` + reTimezone + `$`)
+	reGYear    = regexp.MustCompile(`^-?[0-9]{4,}` + reTimezone + `$`)
+	reGYM      = regexp.MustCompile(`^-?[0-9]{4,}-(0[1-9]|1[0-2])` + reTimezone + `$`)
+	reGMonth   = regexp.MustCompile(`^--(0[1-9]|1[0-2])` + reTimezone + `$`)
+	reGMonthD  = regexp.MustCompile(`^--(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])` + reTimezone + `$`)
+	reGDay     = regexp.MustCompile(`^---(0[1-9]|[12][0-9]|3[01])` + reTimezone + `$`)
+)
+
+var errInvalidLexical = errors.New("invalid lexical representation")
+
+func checkRegexp(re *regexp.Regexp) func(string) error {
+	return func(v string) error {
+		if !re.MatchString(v) {
+			return errInvalidLexical
+		}
+		return nil
+	}
+}
+
+func compareDecimal(a, b string) int {
+	ra, ok1 := new(big.Rat).SetString(a)
+	rb, ok2 := new(big.Rat).SetString(b)
+	if !ok1 || !ok2 {
+		return strings.Compare(a, b)
+	}
+	return ra.Cmp(rb)
+}
+
+func parseFloat(v string) float64 {
+	switch v {
+	case "INF":
+		return math.Inf(1)
+	case "-INF":
+		return math.Inf(-1)
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return f
+}
+
+func compareFloat(a, b string) int {
+	fa, fb := parseFloat(a), parseFloat(b)
+	switch {
+	case fa < fb:
+		return -1
+	case fa > fb:
+		return 1
+	}
+	return 0
+}
+
+func checkFloat(v string) error {
+	if !reFloat.MatchString(v) {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkDate(v string) error {
+	m := reDate.FindStringSubmatch(v)
+	if m == nil {
+		return errInvalidLexical
+	}
+	return checkYMD(m[1], m[2], m[3])
+}
+
+func checkYMD(y, m, d string) error {
+	year, _ := strconv.Atoi(y)
+	month, _ := strconv.Atoi(m)
+	day, _ := strconv.Atoi(d)
+	if month < 1 || month > 12 || day < 1 {
+		return errInvalidLexical
+	}
+	// the year is kept within the range supported by time for leap years
+	if day > time.Date(2000+year%400, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkHMS(h, m, s string) error {
+	hour, _ := strconv.Atoi(h)
+	minute, _ := strconv.Atoi(m)
+	second, _ := strconv.Atoi(s)
+	if minute > 59 || second > 59 || hour > 24 || (hour == 24 && (minute != 0 || second != 0)) {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkTime(v string) error {
+	m := reTime.FindStringSubmatch(v)
+	if m == nil {
+		return errInvalidLexical
+	}
+	return checkHMS(m[1], m[2], m[3])
+}
+
+func checkDateTime(v string) error {
+	m := reDateTime.FindStringSubmatch(v)
+	if m == nil {
+		return errInvalidLexical
+	}
+	if err := checkYMD(m[1], m[2], m[3]); err != nil {
+		return err
+	}
+	return checkHMS(m[4], m[5], m[6])
+}
+
+// compareTime orders dates and times, values without timezone are
+// considered UTC.
+func compareTime(layout string) func(a, b string) int {
+	parse := func(v string) (time.Time, bool) {
+		for _, l := range []string{layout + "Z07:00", layout} {
+			if t, err := time.Parse(l, v); err == nil {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+	return func(a, b string) int {
+		ta, ok1 := parse(a)
+		tb, ok2 := parse(b)
+		if !ok1 || !ok2 {
+			return strings.Compare(a, b)
+		}
+		return ta.Compare(tb)
+	}
+}
+
+func checkBoolean(v string) error {
+	switch v {
+	case "true", "false", "1", "0":
+		return nil
+	}
+	return errInvalidLexical
+}
+
+func checkHexBinary(v string) error {
+	if _, err := hex.DecodeString(v); err != nil {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkBase64Binary(v string) error {
+	if _, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(v, " ", "")); err != nil {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkAnyURI(v string) error {
+	if _, err := url.Parse(v); err != nil {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func isNameStart(r rune) bool {
+	return r == '_' || r == ':' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= 0xC0 && r != 0xD7 && r != 0xF7
+}
+
+func isNameChar(r rune) bool {
+	return isNameStart(r) || r == '-' || r == '.' || r >= '0' && r <= '9' || r == 0xB7
+}
+
+func checkName(v string) error {
+	for i, r := range v {
+		if (i == 0 && !isNameStart(r)) || !isNameChar(r) {
+			return errInvalidLexical
+		}
+	}
+	if v == "" {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkNCName(v string) error {
+	if strings.Contains(v, ":") {
+		return errInvalidLexical
+	}
+	return checkName(v)
+}
+
+func checkNMTOKEN(v string) error {
+	for _, r := range v {
+		if !isNameChar(r) {
+			return errInvalidLexical
+		}
+	}
+	if v == "" {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkQName(v string) error {
+	prefix, local, found := strings.Cut(v, ":")
+	if !found {
+		return checkNCName(v)
+	}
+	if checkNCName(prefix) != nil || checkNCName(local) != nil {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+func checkDuration(v string) error {
+	// at least one component is required and T cannot be empty
+	if !reDuration.MatchString(v) || strings.HasSuffix(v, "P") || strings.HasSuffix(v, "T") {
+		return errInvalidLexical
+	}
+	return nil
+}
+
+// builtinTypes holds the built-in simple types of XML Schema
+var builtinTypes = map[string]*simpleType{}
+
+func builtin(name string, t *simpleType) *simpleType {
+	t.name = xml.Name{Space: xsdNamespace, Local: name}
+	builtinTypes[name] = t
+	return t
+}
+
+func deriveBuiltin(name string, base *simpleType, fn func(t *simpleType)) *simpleType {
+	t := base.derive(xml.Name{})
+	if fn != nil {
+		fn(t)
+	}
+	return builtin(name, t)
+}
+
+func integerRange(min, max string) func(t *simpleType) {
+	return func(t *simpleType) {
+		if min != "" {
+			t.minInclusive = &min
+		}
+		if max != "" {
+			t.maxInclusive = &max
+		}
+	}
+}
+
+func primitiveType(name string, check func(string) error) *simpleType {
+	return builtin(name, &simpleType{check: check, whitespace: whitespaceCollapse})
+}
+
+var anySimpleType = builtin("anySimpleType", &simpleType{check: func(string) error { return nil }})
+
+func init() {
+	str := builtin("string", &simpleType{check: func(string) error { return nil }})
+	normalizedString := deriveBuiltin("normalizedString", str, func(t *simpleType) { t.whitespace = whitespaceReplace })
+	token := deriveBuiltin("token", normalizedString, func(t *simpleType) { t.whitespace = whitespaceCollapse })
+	deriveBuiltin("language", token, func(t *simpleType) { t.patterns = []*regexp.Regexp{reLanguage} })
+	nmtoken := deriveBuiltin("NMTOKEN", token, func(t *simpleType) { t.check = checkNMTOKEN })
+	name := deriveBuiltin("Name", token, func(t *simpleType) { t.check = checkName })
+	ncname := deriveBuiltin("NCName", name, func(t *simpleType) { t.check = checkNCName })
+	deriveBuiltin("ID", ncname, nil)
+	idref := deriveBuiltin("IDREF", ncname, nil)
+	entity := deriveBuiltin("ENTITY", ncname, nil)
+	one := 1
+	for n, item := range map[string]*simpleType{"NMTOKENS": nmtoken, "IDREFS": idref, "ENTITIES": entity} {
+		builtin(n, &simpleType{item: item, whitespace: whitespaceCollapse, minLength: &one})
+	}
+
+	primitiveType("boolean", checkBoolean)
+	fl := primitiveType("float", checkFloat)
+	fl.compare = compareFloat
+	double := primitiveType("double", checkFloat)
+	double.compare = compareFloat
+	primitiveType("duration", checkDuration)
+	dateTime := primitiveType("dateTime", checkDateTime)
+	dateTime.compare = compareTime("2006-01-02T15:04:05.999999999")
+	tm := primitiveType("time", checkTime)
+	tm.compare = compareTime("15:04:05.999999999")
+	date := primitiveType("date", checkDate)
+	date.compare = compareTime("2006-01-02")
+	primitiveType("gYearMonth", checkRegexp(reGYM))
+	primitiveType("gYear", checkRegexp(reGYear))
+	primitiveType("gMonthDay", checkRegexp(reGMonthD))
+	primitiveType("gDay", checkRegexp(reGDay))
+	primitiveType("gMonth", checkRegexp(reGMonth))
+	hexBinary := primitiveType("hexBinary", checkHexBinary)
+	hexBinary.length = func(v string) int { return len(v) / 2 }
+	base64Binary := primitiveType("base64Binary", checkBase64Binary)
+	base64Binary.length = func(v string) int {
+		b, _ := base64.StdEncoding.DecodeString(strings.ReplaceAll(v, " ", ""))
+		return len(b)
+	}
+	primitiveType("anyURI", checkAnyURI)
+	primitiveType("QName", checkQName)
+	primitiveType("NOTATION", checkQName)
+
+	decimal := primitiveType("decimal", checkRegexp(reDecimal))
+	decimal.compare = compareDecimal
+	integer := deriveBuiltin("integer", decimal, func(t *simpleType) { t.check = checkRegexp(reInteger) })
+	nonPositive := deriveBuiltin("nonPositiveInteger", integer, integerRange("", "0"))
+	deriveBuiltin("negativeInteger", nonPositive, integerRange("", "-1"))
+	long := deriveBuiltin("long", integer, integerRange("-9223372036854775808", "9223372036854775807"))
+	integer32 := deriveBuiltin("int", long, integerRange("-2147483648", "2147483647"))
+	short := deriveBuiltin("short", integer32, integerRange("-32768", "32767"))
+	deriveBuiltin("byte", short, integerRange("-128", "127"))
+	nonNegative := deriveBuiltin("nonNegativeInteger", integer, integerRange("0", ""))
+	unsignedLong := deriveBuiltin("unsignedLong", nonNegative, integerRange("", "18446744073709551615"))
+	unsignedInt := deriveBuiltin("unsignedInt", unsignedLong, integerRange("", "4294967295"))
+	unsignedShort := deriveBuiltin("unsignedShort", unsignedInt, integerRange("", "65535"))
+	deriveBuiltin("unsignedByte", unsignedShort, integerRange("", "255"))
+	deriveBuiltin("positiveInteger", nonNegative, integerRange("1", ""))
+}
@@ -0,0 +1,1232 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmlvalidate
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxGroupDepth bounds the nesting of model and attribute group references
+const maxGroupDepth = 32
+
+// Loader returns the content of the schema documents included or imported by
+// a schema, the location is relative to the directory of the main schema.
+type Loader func(location string) ([]byte, error)
+
+// Schema is a compiled XML Schema. It supports the commonly used subset of
+// XML Schema 1.0: global and local elements and attributes, named and
+// anonymous types, sequence, choice and all groups, wildcards, simple and
+// complex content derivation, the built-in types and the facets. Identity
+// constraints, xsi:type and substitution groups are not supported.
+type Schema struct {
+	elements   map[xml.Name]*elementDecl
+	attributes map[xml.Name]*attributeDecl
+}
+
+type elementDecl struct {
+	name     xml.Name
+	simple   *simpleType
+	complex  *complexType
+	nillable bool
+	fixed    *string
+	def      *string
+}
+
+type attributeDecl struct {
+	name     xml.Name
+	typ      *simpleType
+	required bool
+	fixed    *string
+}
+
+type complexType struct {
+	mixed bool
+	// content is nil for empty content
+	content *particle
+	// simple is the type of the value of types with simple content
+	simple       *simpleType
+	attributes   map[xml.Name]*attributeDecl
+	anyAttribute *wildcard
+	// elements holds the declarations of the elements of the content model,
+	// XML Schema requires the elements with the same name to be consistent.
+	elements  map[xml.Name]*elementDecl
+	wildcards []*wildcard
+	// prohibited holds the attributes of the base type removed by a
+	// restriction
+	prohibited map[xml.Name]bool
+	compiling  bool
+	// pending counts the merges with base types not yet done
+	pending int
+}
+
+// anyType is the type of the elements declared without type
+var anyType = func() *complexType {
+	w := &wildcard{any: true, process: processLax}
+	return &complexType{
+		mixed:        true,
+		content:      &particle{kind: particleWildcard, min: 0, max: unbounded, wildcard: w},
+		attributes:   map[xml.Name]*attributeDecl{},
+		anyAttribute: w,
+		elements:     map[xml.Name]*elementDecl{},
+		wildcards:    []*wildcard{w},
+	}
+}()
+
+// NewSchema compiles the XML Schema document data. load is used to read the
+// included and imported documents, it can be nil if there are none.
+func NewSchema(data []byte, load Loader) (*Schema, error) {
+	c := &compiler{
+		load:            load,
+		loaded:          map[string]bool{},
+		elements:        map[xml.Name]component{},
+		types:           map[xml.Name]component{},
+		attributes:      map[xml.Name]component{},
+		groups:          map[xml.Name]component{},
+		attributeGroups: map[xml.Name]component{},
+		elementDecls:    map[xml.Name]*elementDecl{},
+		attributeDecls:  map[xml.Name]*attributeDecl{},
+		simpleTypes:     map[xml.Name]*simpleType{},
+		complexTypes:    map[xml.Name]*complexType{},
+	}
+	if err := c.addDocument(data, "", nil); err != nil {
+		return nil, err
+	}
+
+	s := &Schema{
+		elements:   map[xml.Name]*elementDecl{},
+		attributes: map[xml.Name]*attributeDecl{},
+	}
+	// every component is compiled so that errors are reported early
+	for name := range c.types {
+		if _, _, err := c.resolveType(name); err != nil {
+			return nil, err
+		}
+	}
+	for name := range c.attributes {
+		decl, err := c.globalAttribute(name)
+		if err != nil {
+			return nil, err
+		}
+		s.attributes[name] = decl
+	}
+	for name := range c.elements {
+		decl, err := c.globalElement(name)
+		if err != nil {
+			return nil, err
+		}
+		s.elements[name] = decl
+	}
+	if err := c.runDeferred(); err != nil {
+		return nil, err
+	}
+	if len(s.elements) == 0 {
+		return nil, errors.New("the schema does not declare any global element")
+	}
+	return s, nil
+}
+
+// Validate validates the document read from r against the schema
+func (s *Schema) Validate(r io.Reader) error {
+	root, err := parseDocument(r, parseOptions{})
+	if err != nil {
+		return err
+	}
+	decl, ok := s.elements[root.name]
+	if !ok {
+		return errorf(root, "no declaration found for the root element %s", formatName(root.name))
+	}
+	return s.validateElement(root, decl)
+}
+
+func isNil(e *element) bool {
+	v, ok := e.attr(xsiNamespace, "nil")
+	v = strings.TrimSpace(v)
+	return ok && (v == "true" || v == "1")
+}
+
+func (s *Schema) validateElement(e *element, decl *elementDecl) error {
+	if isNil(e) {
+		if !decl.nillable {
+			return errorf(e, "element %s is not nillable", formatName(e.name))
+		}
+		if len(e.children) > 0 || e.hasText() {
+			return errorf(e, "element %s is nil but has content", formatName(e.name))
+		}
+		if decl.complex != nil {
+			return s.validateAttributes(e, decl.complex)
+		}
+		return nil
+	}
+
+	if decl.simple != nil {
+		if len(e.children) > 0 {
+			return errorf(e.children[0], "element %s cannot have child elements", formatName(e.name))
+		}
+		for _, a := range e.attrs {
+			if !isNamespaceDeclaration(a.Name) && a.Name.Space != xsiNamespace {
+				return errorf(e, "attribute %s is not allowed in element %s", formatName(a.Name), formatName(e.name))
+			}
+		}
+		return s.validateElementValue(e, decl, decl.simple)
+	}
+
+	ct := decl.complex
+	if err := s.validateAttributes(e, ct); err != nil {
+		return err
+	}
+
+	if ct.simple != nil {
+		if len(e.children) > 0 {
+			return errorf(e.children[0], "element %s cannot have child elements", formatName(e.name))
+		}
+		return s.validateElementValue(e, decl, ct.simple)
+	}
+
+	if !ct.mixed && e.hasText() {
+		return errorf(e, "element %s cannot have character content", formatName(e.name))
+	}
+
+	if ct.content == nil {
+		if len(e.children) > 0 {
+			return errorf(e.children[0], "element %s must be empty", formatName(e.name))
+		}
+		return nil
+	}
+
+	names := make([]xml.Name, len(e.children))
+	for i, c := range e.children {
+		names[i] = c.name
+	}
+	if err := matchContent(ct.content, names); err != nil {
+		var cerr *contentError
+		if errors.As(err, &cerr) && cerr.unexpected != nil {
+			return errorf(e.children[cerr.index], "element %s: %s", formatName(e.name), err.Error())
+		}
+		return errorf(e, "element %s: %s", formatName(e.name), err.Error())
+	}
+
+	for _, c := range e.children {
+		if d, ok := ct.elements[c.name]; ok {
+			if err := s.validateElement(c, d); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the element was matched by a wildcard
+		process := processSkip
+		for _, w := range ct.wildcards {
+			if w.allows(c.name.Space) {
+				process = w.process
+				break
+			}
+		}
+		if process == processSkip {
+			continue
+		}
+		d, ok := s.elements[c.name]
+		if !ok {
+			if process == processStrict {
+				return errorf(c, "no declaration found for element %s", formatName(c.name))
+			}
+			continue
+		}
+		if err := s.validateElement(c, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateElementValue(e *element, decl *elementDecl, t *simpleType) error {
+	v := e.text
+	if v == "" && decl.def != nil {
+		v = *decl.def
+	}
+	if err := t.validate(v); err != nil {
+		return errorf(e, "element %s: %s", formatName(e.name), err.Error())
+	}
+	if decl.fixed != nil && normalize(v, t.whitespace) != normalize(*decl.fixed, t.whitespace) {
+		return errorf(e, "element %s: value must be %q", formatName(e.name), *decl.fixed)
+	}
+	return nil
+}
+
+func (s *Schema) validateAttributes(e *element, ct *complexType) error {
+	seen := map[xml.Name]bool{}
+	for _, a := range e.attrs {
+		if isNamespaceDeclaration(a.Name) || a.Name.Space == xsiNamespace {
+			continue
+		}
+		decl, ok := ct.attributes[a.Name]
+		if !ok {
+			if ct.anyAttribute == nil || !ct.anyAttribute.allows(a.Name.Space) {
+				return errorf(e, "attribute %s is not allowed in element %s", formatName(a.Name), formatName(e.name))
+			}
+			if ct.anyAttribute.process == processSkip {
+				continue
+			}
+			if decl, ok = s.attributes[a.Name]; !ok {
+				if ct.anyAttribute.process == processStrict {
+					return errorf(e, "no declaration found for attribute %s", formatName(a.Name))
+				}
+				continue
+			}
+		}
+		seen[a.Name] = true
+		if err := decl.typ.validate(a.Value); err != nil {
+			return errorf(e, "attribute %s of element %s: %s", formatName(a.Name), formatName(e.name), err.Error())
+		}
+		if decl.fixed != nil && normalize(a.Value, decl.typ.whitespace) != normalize(*decl.fixed, decl.typ.whitespace) {
+			return errorf(e, "attribute %s of element %s: value must be %q", formatName(a.Name), formatName(e.name), *decl.fixed)
+		}
+	}
+
+	var missing []string
+	for name, decl := range ct.attributes {
+		if decl.required && !seen[name] {
+			missing = append(missing, formatName(name))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return errorf(e, "missing required attribute %s in element %s", missing[0], formatName(e.name))
+	}
+	return nil
+}
+
+// schemaDocument holds the properties of a schema document that affect its
+// components.
+type schemaDocument struct {
+	targetNamespace     string
+	qualifiedElements   bool
+	qualifiedAttributes bool
+	// chameleon is set for the documents without target namespace included
+	// by documents with one, their unqualified references are resolved in the
+	// namespace of the including document.
+	chameleon bool
+}
+
+type component struct {
+	node *element
+	doc  *schemaDocument
+}
+
+type compiler struct {
+	load   Loader
+	loaded map[string]bool
+
+	elements        map[xml.Name]component
+	types           map[xml.Name]component
+	attributes      map[xml.Name]component
+	groups          map[xml.Name]component
+	attributeGroups map[xml.Name]component
+
+	elementDecls   map[xml.Name]*elementDecl
+	attributeDecls map[xml.Name]*attributeDecl
+	simpleTypes    map[xml.Name]*simpleType
+	complexTypes   map[xml.Name]*complexType
+	// deriving tracks the named simple types being compiled to detect cycles
+	deriving   map[xml.Name]bool
+	deferred   []deferredMerge
+	groupDepth int
+}
+
+// children returns the children of an XML Schema element but annotations
+func children(e *element) []*element {
+	var c []*element
+	for _, ch := range e.children {
+		if ch.name.Space == xsdNamespace && ch.name.Local != "annotation" {
+			c = append(c, ch)
+		}
+	}
+	return c
+}
+
+func (c *compiler) addDocument(data []byte, location string, namespace *string) error {
+	root, err := parseDocument(bytes.NewReader(data), parseOptions{})
+	if err != nil {
+		return fmt.Errorf("invalid schema document %q: %s", location, err.Error())
+	}
+	if root.name != (xml.Name{Space: xsdNamespace, Local: "schema"}) {
+		return fmt.Errorf("invalid schema document %q: unexpected root element %s", location, formatName(root.name))
+	}
+
+	doc := &schemaDocument{}
+	doc.targetNamespace, _ = root.attr("", "targetNamespace")
+	if namespace != nil && *namespace != doc.targetNamespace {
+		if doc.targetNamespace != "" {
+			return fmt.Errorf("included schema document %q has a different target namespace", location)
+		}
+		doc.targetNamespace = *namespace
+		doc.chameleon = true
+	}
+	v, _ := root.attr("", "elementFormDefault")
+	doc.qualifiedElements = v == "qualified"
+	v, _ = root.attr("", "attributeFormDefault")
+	doc.qualifiedAttributes = v == "qualified"
+
+	for _, e := range children(root) {
+		var m map[xml.Name]component
+		switch e.name.Local {
+		case "include", "import":
+			loc, ok := e.attr("", "schemaLocation")
+			if !ok {
+				// the components of the namespace must be available otherwise
+				continue
+			}
+			var ns *string
+			if e.name.Local == "include" {
+				ns = &doc.targetNamespace
+			}
+			if err := c.include(location, loc, ns); err != nil {
+				return err
+			}
+			continue
+		case "element":
+			m = c.elements
+		case "simpleType", "complexType":
+			m = c.types
+		case "attribute":
+			m = c.attributes
+		case "group":
+			m = c.groups
+		case "attributeGroup":
+			m = c.attributeGroups
+		case "notation":
+			continue
+		default:
+			return errorf(e, "%s is not supported", e.name.Local)
+		}
+		n, ok := e.attr("", "name")
+		if !ok {
+			return errorf(e, "global %s without name", e.name.Local)
+		}
+		name := xml.Name{Space: doc.targetNamespace, Local: n}
+		if _, ok := m[name]; ok {
+			return errorf(e, "duplicated %s %s", e.name.Local, formatName(name))
+		}
+		m[name] = component{node: e, doc: doc}
+	}
+	return nil
+}
+
+func (c *compiler) include(base string, location string, namespace *string) error {
+	if strings.Contains(location, "://") {
+		return fmt.Errorf("remote schema location %q is not supported", location)
+	}
+	if !path.IsAbs(location) {
+		location = path.Join(path.Dir(base), location)
+	}
+	if c.loaded[location] {
+		return nil
+	}
+	c.loaded[location] = true
+	if c.load == nil {
+		return fmt.Errorf("cannot load schema location %q", location)
+	}
+	data, err := c.load(location)
+	if err != nil {
+		return err
+	}
+	return c.addDocument(data, location, namespace)
+}
+
+// resolveQName resolves a QName value of a schema document
+func resolveQName(e *element, doc *schemaDocument, v string) (xml.Name, error) {
+	v = strings.TrimSpace(v)
+	prefix, local, found := strings.Cut(v, ":")
+	if !found {
+		ns := e.namespaces[""]
+		if ns == "" && doc.chameleon {
+			ns = doc.targetNamespace
+		}
+		return xml.Name{Space: ns, Local: v}, nil
+	}
+	if prefix == "xml" {
+		return xml.Name{Space: xmlNamespace, Local: local}, nil
+	}
+	ns, ok := e.namespaces[prefix]
+	if !ok {
+		return xml.Name{}, errorf(e, "undeclared namespace prefix %q", prefix)
+	}
+	return xml.Name{Space: ns, Local: local}, nil
+}
+
+func (c *compiler) globalElement(name xml.Name) (*elementDecl, error) {
+	if decl, ok := c.elementDecls[name]; ok {
+		return decl, nil
+	}
+	comp, ok := c.elements[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown element %s", formatName(name))
+	}
+	decl := &elementDecl{name: name}
+	c.elementDecls[name] = decl
+	if err := c.fillElement(decl, comp.node, comp.doc); err != nil {
+		return nil, err
+	}
+	return decl, nil
+}
+
+func (c *compiler) fillElement(decl *elementDecl, e *element, doc *schemaDocument) error {
+	if _, ok := e.attr("", "substitutionGroup"); ok {
+		return errorf(e, "substitution groups are not supported")
+	}
+	if v, ok := e.attr("", "nillable"); ok {
+		decl.nillable = v == "true" || v == "1"
+	}
+	if v, ok := e.attr("", "fixed"); ok {
+		decl.fixed = &v
+	}
+	if v, ok := e.attr("", "default"); ok {
+		decl.def = &v
+	}
+
+	if t, ok := e.attr("", "type"); ok {
+		name, err := resolveQName(e, doc, t)
+		if err != nil {
+			return err
+		}
+		decl.simple, decl.complex, err = c.resolveType(name)
+		return err
+	}
+
+	for _, ch := range children(e) {
+		var err error
+		switch ch.name.Local {
+		case "simpleType":
+			decl.simple, err = c.compileSimpleType(ch, doc, xml.Name{})
+			return err
+		case "complexType":
+			decl.complex, err = c.compileComplexType(ch, doc, &complexType{})
+			return err
+		}
+	}
+	decl.complex = anyType
+	return nil
+}
+
+// resolveType resolves a named type, either simple or complex
+func (c *compiler) resolveType(name xml.Name) (*simpleType, *complexType, error) {
+	if name.Space == xsdNamespace {
+		if name.Local == "anyType" {
+			return nil, anyType, nil
+		}
+		if t, ok := builtinTypes[name.Local]; ok {
+			return t, nil, nil
+		}
+		return nil, nil, fmt.Errorf("unknown type %s", formatName(name))
+	}
+	if t, ok := c.simpleTypes[name]; ok {
+		return t, nil, nil
+	}
+	if t, ok := c.complexTypes[name]; ok {
+		return nil, t, nil
+	}
+
+	comp, ok := c.types[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown type %s", formatName(name))
+	}
+	if comp.node.name.Local == "simpleType" {
+		if c.deriving == nil {
+			c.deriving = map[xml.Name]bool{}
+		}
+		if c.deriving[name] {
+			return nil, nil, errorf(comp.node, "circular definition of type %s", formatName(name))
+		}
+		c.deriving[name] = true
+		t, err := c.compileSimpleType(comp.node, comp.doc, name)
+		delete(c.deriving, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		c.simpleTypes[name] = t
+		return t, nil, nil
+	}
+
+	// complex types are registered before being compiled, as their content
+	// can refer to them.
+	t := &complexType{}
+	c.complexTypes[name] = t
+	_, err := c.compileComplexType(comp.node, comp.doc, t)
+	return nil, t, err
+}
+
+func (c *compiler) resolveSimpleType(e *element, doc *schemaDocument, attr string) (*simpleType, error) {
+	v, ok := e.attr("", attr)
+	if !ok {
+		for _, ch := range children(e) {
+			if ch.name.Local == "simpleType" {
+				return c.compileSimpleType(ch, doc, xml.Name{})
+			}
+		}
+		return nil, errorf(e, "missing %s", attr)
+	}
+	name, err := resolveQName(e, doc, v)
+	if err != nil {
+		return nil, err
+	}
+	t, _, err := c.resolveType(name)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, errorf(e, "type %s is not a simple type", formatName(name))
+	}
+	return t, nil
+}
+
+func (c *compiler) compileSimpleType(e *element, doc *schemaDocument, name xml.Name) (*simpleType, error) {
+	for _, ch := range children(e) {
+		switch ch.name.Local {
+		case "restriction":
+			base, err := c.resolveSimpleType(ch, doc, "base")
+			if err != nil {
+				return nil, err
+			}
+			t := base.derive(name)
+			if err := addFacets(t, ch); err != nil {
+				return nil, err
+			}
+			return t, nil
+		case "list":
+			item, err := c.resolveSimpleType(ch, doc, "itemType")
+			if err != nil {
+				return nil, err
+			}
+			return &simpleType{name: name, item: item, whitespace: whitespaceCollapse}, nil
+		case "union":
+			t := &simpleType{name: name}
+			if v, ok := ch.attr("", "memberTypes"); ok {
+				for _, m := range strings.Fields(v) {
+					n, err := resolveQName(ch, doc, m)
+					if err != nil {
+						return nil, err
+					}
+					mt, _, err := c.resolveType(n)
+					if err != nil {
+						return nil, err
+					}
+					if mt == nil {
+						return nil, errorf(ch, "type %s is not a simple type", formatName(n))
+					}
+					t.members = append(t.members, mt)
+				}
+			}
+			for _, m := range children(ch) {
+				if m.name.Local != "simpleType" {
+					continue
+				}
+				mt, err := c.compileSimpleType(m, doc, xml.Name{})
+				if err != nil {
+					return nil, err
+				}
+				t.members = append(t.members, mt)
+			}
+			if len(t.members) == 0 {
+				return nil, errorf(ch, "union without member types")
+			}
+			return t, nil
+		}
+	}
+	return nil, errorf(e, "simple type without restriction, list or union")
+}
+
+// addFacets adds the facets of a restriction to t
+func addFacets(t *simpleType, restriction *element) error {
+	for _, f := range children(restriction) {
+		v, _ := f.attr("", "value")
+		switch f.name.Local {
+		case "simpleType", "attribute", "attributeGroup", "anyAttribute":
+			// the base type and the attributes of simple content restrictions
+		case "enumeration":
+			t.enumeration = append(t.enumeration, normalize(v, t.whitespace))
+		case "pattern":
+			re, err := compilePattern(v)
+			if err != nil {
+				return errorf(f, "invalid pattern %q: %s", v, err.Error())
+			}
+			t.patterns = append(t.patterns, re)
+		case "length", "minLength", "maxLength", "totalDigits", "fractionDigits":
+			n, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil || n < 0 {
+				return errorf(f, "invalid %s %q", f.name.Local, v)
+			}
+			switch f.name.Local {
+			case "length":
+				t.minLength, t.maxLength = &n, &n
+			case "minLength":
+				t.minLength = &n
+			case "maxLength":
+				t.maxLength = &n
+			case "totalDigits":
+				t.totalDigits = &n
+			case "fractionDigits":
+				t.fractionDigits = &n
+			}
+		case "minInclusive", "maxInclusive", "minExclusive", "maxExclusive":
+			v = strings.TrimSpace(v)
+			if err := t.validate(v); err != nil && t.comparer() != nil {
+				return errorf(f, "invalid %s: %s", f.name.Local, err.Error())
+			}
+			switch f.name.Local {
+			case "minInclusive":
+				t.minInclusive = &v
+			case "maxInclusive":
+				t.maxInclusive = &v
+			case "minExclusive":
+				t.minExclusive = &v
+			case "maxExclusive":
+				t.maxExclusive = &v
+			}
+		case "whiteSpace":
+			switch v {
+			case "preserve":
+				t.whitespace = whitespacePreserve
+			case "replace":
+				t.whitespace = whitespaceReplace
+			case "collapse":
+				t.whitespace = whitespaceCollapse
+			default:
+				return errorf(f, "invalid whiteSpace %q", v)
+			}
+		default:
+			return errorf(f, "facet %s is not supported", f.name.Local)
+		}
+	}
+	return nil
+}
+
+func (c *compiler) compileComplexType(e *element, doc *schemaDocument, ct *complexType) (*complexType, error) {
+	ct.compiling = true
+	defer func() { ct.compiling = false }()
+	ct.attributes = map[xml.Name]*attributeDecl{}
+	ct.elements = map[xml.Name]*elementDecl{}
+	if v, ok := e.attr("", "mixed"); ok {
+		ct.mixed = v == "true" || v == "1"
+	}
+
+	for _, ch := range children(e) {
+		switch ch.name.Local {
+		case "simpleContent", "complexContent":
+			if err := c.compileDerivation(ch, doc, ct); err != nil {
+				return nil, err
+			}
+		case "sequence", "choice", "all", "group":
+			p, err := c.compileParticle(ch, doc, ct)
+			if err != nil {
+				return nil, err
+			}
+			ct.content = p
+		case "attribute", "attributeGroup", "anyAttribute":
+			if err := c.addAttribute(ch, doc, ct); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errorf(ch, "%s is not supported in complex types", ch.name.Local)
+		}
+	}
+	return ct, nil
+}
+
+func (c *compiler) compileDerivation(e *element, doc *schemaDocument, ct *complexType) error {
+	if v, ok := e.attr("", "mixed"); ok {
+		ct.mixed = v == "true" || v == "1"
+	}
+	var d *element
+	for _, ch := range children(e) {
+		if ch.name.Local == "extension" || ch.name.Local == "restriction" {
+			d = ch
+		}
+	}
+	if d == nil {
+		return errorf(e, "%s without extension or restriction", e.name.Local)
+	}
+	v, ok := d.attr("", "base")
+	if !ok {
+		return errorf(d, "missing base")
+	}
+	baseName, err := resolveQName(d, doc, v)
+	if err != nil {
+		return err
+	}
+	baseSimple, base, err := c.resolveType(baseName)
+	if err != nil {
+		return err
+	}
+	simpleContent := e.name.Local == "simpleContent"
+	if !simpleContent && base == nil {
+		return errorf(d, "type %s is not a complex type", formatName(baseName))
+	}
+
+	var own *particle
+	for _, ch := range children(d) {
+		switch ch.name.Local {
+		case "sequence", "choice", "all", "group":
+			if simpleContent {
+				return errorf(ch, "%s is not allowed in simple content", ch.name.Local)
+			}
+			if own, err = c.compileParticle(ch, doc, ct); err != nil {
+				return err
+			}
+		case "attribute", "attributeGroup", "anyAttribute":
+			if err := c.addAttribute(ch, doc, ct); err != nil {
+				return err
+			}
+		}
+	}
+	ct.content = own
+
+	// the base type can still be being compiled when the derived type is
+	// used by its content, the inherited properties are merged once it is
+	// complete.
+	merge := func() error {
+		if base != nil {
+			for n, a := range base.attributes {
+				if _, ok := ct.attributes[n]; !ok && !ct.prohibited[n] {
+					ct.attributes[n] = a
+				}
+			}
+			if ct.anyAttribute == nil {
+				ct.anyAttribute = base.anyAttribute
+			}
+		}
+
+		switch {
+		case simpleContent:
+			if base != nil {
+				if base.simple == nil {
+					return errorf(d, "type %s does not have simple content", formatName(baseName))
+				}
+				baseSimple = base.simple
+			}
+			ct.simple = baseSimple
+			if d.name.Local == "restriction" {
+				ct.simple = baseSimple.derive(xml.Name{})
+				if err := addFacets(ct.simple, d); err != nil {
+					return err
+				}
+			}
+		case d.name.Local == "extension":
+			for n, decl := range base.elements {
+				if _, ok := ct.elements[n]; !ok {
+					ct.elements[n] = decl
+				}
+			}
+			ct.wildcards = append(ct.wildcards, base.wildcards...)
+			switch {
+			case base.content == nil:
+			case ct.content == nil:
+				ct.content = base.content
+			default:
+				ct.content = &particle{kind: particleSequence, min: 1, max: 1, children: []*particle{base.content, ct.content}}
+			}
+		}
+		return nil
+	}
+
+	if base != nil && (base.compiling || base.pending > 0) {
+		ct.pending++
+		c.deferred = append(c.deferred, deferredMerge{ct: ct, base: base, merge: merge, node: d})
+		return nil
+	}
+	return merge()
+}
+
+// deferredMerge is the merge of a derived type with its base type
+type deferredMerge struct {
+	ct, base *complexType
+	merge    func() error
+	node     *element
+}
+
+// runDeferred merges the derived types whose base types were incomplete
+func (c *compiler) runDeferred() error {
+	for len(c.deferred) > 0 {
+		var next []deferredMerge
+		for _, d := range c.deferred {
+			if d.base.compiling || d.base.pending > 0 {
+				next = append(next, d)
+				continue
+			}
+			if err := d.merge(); err != nil {
+				return err
+			}
+			d.ct.pending--
+		}
+		if len(next) == len(c.deferred) {
+			return errorf(next[0].node, "circular type derivation")
+		}
+		c.deferred = next
+	}
+	return nil
+}
+
+func occurrences(e *element) (int, int, error) {
+	min, max := 1, 1
+	if v, ok := e.attr("", "minOccurs"); ok {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || n < 0 {
+			return 0, 0, errorf(e, "invalid minOccurs %q", v)
+		}
+		min = n
+	}
+	if v, ok := e.attr("", "maxOccurs"); ok {
+		v = strings.TrimSpace(v)
+		if v == "unbounded" {
+			return min, unbounded, nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, errorf(e, "invalid maxOccurs %q", v)
+		}
+		max = n
+	}
+	if max < min {
+		return 0, 0, errorf(e, "maxOccurs is lower than minOccurs")
+	}
+	return min, max, nil
+}
+
+// compileParticle compiles a particle, registering its elements and
+// wildcards in ct. It returns nil for particles that cannot occur.
+func (c *compiler) compileParticle(e *element, doc *schemaDocument, ct *complexType) (*particle, error) {
+	min, max, err := occurrences(e)
+	if err != nil {
+		return nil, err
+	}
+	if max == 0 {
+		return nil, nil
+	}
+	p := &particle{min: min, max: max}
+
+	switch e.name.Local {
+	case "element":
+		decl, err := c.particleElement(e, doc)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ct.elements[decl.name]; !ok {
+			ct.elements[decl.name] = decl
+		}
+		p.kind = particleElement
+		p.name = decl.name
+	case "any":
+		p.kind = particleWildcard
+		p.wildcard, err = parseWildcard(e, doc)
+		if err != nil {
+			return nil, err
+		}
+		ct.wildcards = append(ct.wildcards, p.wildcard)
+	case "sequence", "choice", "all":
+		p.kind = map[string]particleKind{
+			"sequence": particleSequence,
+			"choice":   particleChoice,
+			"all":      particleAll,
+		}[e.name.Local]
+		for _, ch := range children(e) {
+			cp, err := c.compileParticle(ch, doc, ct)
+			if err != nil {
+				return nil, err
+			}
+			if cp != nil {
+				p.children = append(p.children, cp)
+			}
+		}
+	case "group":
+		name, g, err := c.reference(e, doc, c.groups)
+		if err != nil {
+			return nil, err
+		}
+		if c.groupDepth == maxGroupDepth {
+			return nil, errorf(e, "group %s is too deeply nested", formatName(name))
+		}
+		c.groupDepth++
+		defer func() { c.groupDepth-- }()
+		for _, ch := range children(g.node) {
+			gp, err := c.compileParticle(ch, g.doc, ct)
+			if err != nil || gp == nil {
+				return nil, err
+			}
+			gp.min, gp.max = min, max
+			return gp, nil
+		}
+		return nil, errorf(g.node, "empty group %s", formatName(name))
+	default:
+		return nil, errorf(e, "%s is not supported in content models", e.name.Local)
+	}
+	return p, nil
+}
+
+func (c *compiler) particleElement(e *element, doc *schemaDocument) (*elementDecl, error) {
+	if ref, ok := e.attr("", "ref"); ok {
+		name, err := resolveQName(e, doc, ref)
+		if err != nil {
+			return nil, err
+		}
+		return c.globalElement(name)
+	}
+
+	n, ok := e.attr("", "name")
+	if !ok {
+		return nil, errorf(e, "element without name or ref")
+	}
+	qualified := doc.qualifiedElements
+	if form, ok := e.attr("", "form"); ok {
+		qualified = form == "qualified"
+	}
+	decl := &elementDecl{name: xml.Name{Local: n}}
+	if qualified {
+		decl.name.Space = doc.targetNamespace
+	}
+	if err := c.fillElement(decl, e, doc); err != nil {
+		return nil, err
+	}
+	return decl, nil
+}
+
+// reference resolves the ref attribute of e among the components m
+func (c *compiler) reference(e *element, doc *schemaDocument, m map[xml.Name]component) (xml.Name, component, error) {
+	ref, ok := e.attr("", "ref")
+	if !ok {
+		return xml.Name{}, component{}, errorf(e, "%s without ref", e.name.Local)
+	}
+	name, err := resolveQName(e, doc, ref)
+	if err != nil {
+		return xml.Name{}, component{}, err
+	}
+	comp, ok := m[name]
+	if !ok {
+		return xml.Name{}, component{}, errorf(e, "unknown %s %s", e.name.Local, formatName(name))
+	}
+	return name, comp, nil
+}
+
+func parseWildcard(e *element, doc *schemaDocument) (*wildcard, error) {
+	w := &wildcard{}
+	switch v, _ := e.attr("", "processContents"); v {
+	case "", "strict":
+	case "lax":
+		w.process = processLax
+	case "skip":
+		w.process = processSkip
+	default:
+		return nil, errorf(e, "invalid processContents %q", v)
+	}
+
+	ns, ok := e.attr("", "namespace")
+	switch ns = strings.TrimSpace(ns); {
+	case !ok || ns == "##any":
+		w.any = true
+	case ns == "##other":
+		w.not = &doc.targetNamespace
+	default:
+		w.namespaces = map[string]bool{}
+		for _, n := range strings.Fields(ns) {
+			switch n {
+			case "##targetNamespace":
+				n = doc.targetNamespace
+			case "##local":
+				n = ""
+			}
+			w.namespaces[n] = true
+		}
+	}
+	return w, nil
+}
+
+func (c *compiler) addAttribute(e *element, doc *schemaDocument, ct *complexType) error {
+	switch e.name.Local {
+	case "anyAttribute":
+		w, err := parseWildcard(e, doc)
+		if err != nil {
+			return err
+		}
+		ct.anyAttribute = w
+		return nil
+	case "attributeGroup":
+		name, g, err := c.reference(e, doc, c.attributeGroups)
+		if err != nil {
+			return err
+		}
+		if c.groupDepth == maxGroupDepth {
+			return errorf(e, "attribute group %s is too deeply nested", formatName(name))
+		}
+		c.groupDepth++
+		defer func() { c.groupDepth-- }()
+		for _, ch := range children(g.node) {
+			if err := c.addAttribute(ch, g.doc, ct); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "attribute":
+	default:
+		return errorf(e, "%s is not supported", e.name.Local)
+	}
+
+	var decl *attributeDecl
+	if ref, ok := e.attr("", "ref"); ok {
+		name, err := resolveQName(e, doc, ref)
+		if err != nil {
+			return err
+		}
+		global, err := c.globalAttribute(name)
+		if err != nil {
+			return err
+		}
+		// the use belongs to the reference, so the global declaration is
+		// copied
+		decl = &attributeDecl{name: global.name, typ: global.typ, fixed: global.fixed}
+	} else {
+		n, ok := e.attr("", "name")
+		if !ok {
+			return errorf(e, "attribute without name or ref")
+		}
+		qualified := doc.qualifiedAttributes
+		if form, ok := e.attr("", "form"); ok {
+			qualified = form == "qualified"
+		}
+		decl = &attributeDecl{name: xml.Name{Local: n}}
+		if qualified {
+			decl.name.Space = doc.targetNamespace
+		}
+		if err := c.fillAttribute(decl, e, doc); err != nil {
+			return err
+		}
+	}
+
+	switch v, _ := e.attr("", "use"); v {
+	case "required":
+		decl.required = true
+	case "prohibited":
+		if ct.prohibited == nil {
+			ct.prohibited = map[xml.Name]bool{}
+		}
+		ct.prohibited[decl.name] = true
+		delete(ct.attributes, decl.name)
+		return nil
+	}
+	if v, ok := e.attr("", "fixed"); ok {
+		decl.fixed = &v
+	}
+	ct.attributes[decl.name] = decl
+	return nil
+}
+
+func (c *compiler) globalAttribute(name xml.Name) (*attributeDecl, error) {
+	if decl, ok := c.attributeDecls[name]; ok {
+		return decl, nil
+	}
+	comp, ok := c.attributes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown attribute %s", formatName(name))
+	}
+	decl := &attributeDecl{name: name}
+	if err := c.fillAttribute(decl, comp.node, comp.doc); err != nil {
+		return nil, err
+	}
+	c.attributeDecls[name] = decl
+	return decl, nil
+}
+
+func (c *compiler) fillAttribute(decl *attributeDecl, e *element, doc *schemaDocument) error {
+	if v, ok := e.attr("", "fixed"); ok {
+		decl.fixed = &v
+	}
+	decl.typ = anySimpleType
+	_, hasType := e.attr("", "type")
+	for _, ch := range children(e) {
+		if ch.name.Local == "simpleType" {
+			hasType = true
+		}
+	}
+	if !hasType {
+		return nil
+	}
+	t, err := c.resolveSimpleType(e, doc, "type")
+	if err != nil {
+		return err
+	}
+	decl.typ = t
+	return nil
+}
+
+// compilePattern translates an XML Schema regular expression, which is
+// implicitly anchored, to the RE2 syntax.
+func compilePattern(p string) (*regexp.Regexp, error) {
+	var (
+		b       strings.Builder
+		inClass bool
+	)
+	b.WriteString(`^(?:`)
+	rs := []rune(p)
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		switch {
+		case r == '\\' && i+1 < len(rs):
+			i++
+			class, ok := map[rune]string{
+				'i': `_:A-Za-z\x{C0}-\x{D6}\x{D8}-\x{F6}\x{F8}-\x{2FF}\x{370}-\x{37D}\x{37F}-\x{1FFF}`,
+				'c': `\-._:A-Za-z0-9\x{B7}\x{C0}-\x{D6}\x{D8}-\x{F6}\x{F8}-\x{37D}\x{37F}-\x{1FFF}`,
+			}[unicodeLower(rs[i])]
+			if !ok {
+				b.WriteRune('\\')
+				b.WriteRune(rs[i])
+				continue
+			}
+			negated := rs[i] == 'I' || rs[i] == 'C'
+			switch {
+			case inClass && negated:
+				return nil, fmt.Errorf("\\%c is not supported in character classes", rs[i])
+			case inClass:
+				b.WriteString(class)
+			case negated:
+				b.WriteString(`[^` + class + `]`)
+			default:
+				b.WriteString(`[` + class + `]`)
+			}
+		case r == '[' && inClass:
+			return nil, errors.New("character class subtraction is not supported")
+		case r == '[':
+			inClass = true
+			b.WriteRune(r)
+			if i+1 < len(rs) && rs[i+1] == '^' {
+				i++
+				b.WriteRune('^')
+			}
+		case r == ']' && inClass:
+			inClass = false
+			b.WriteRune(r)
+		case (r == '^' || r == '$') && !inClass:
+			// anchors do not exist in XML Schema regular expressions
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '-' && inClass && i+1 < len(rs) && rs[i+1] == '[':
+			return nil, errors.New("character class subtraction is not supported")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString(`)$`)
+	return regexp.Compile(b.String())
+}
+
+func unicodeLower(r rune) rune {
+	if r >= 'A' && r <= 'Z' {
+		return r + 'a' - 'A'
+	}
+	return r
+}
@@ -0,0 +1,418 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package xmlvalidate
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const orderSchema = `<?xml version="1.0"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"
+           xmlns:tns="urn:shop" targetNamespace="urn:shop" elementFormDefault="qualified">
+  <xs:annotation><xs:documentation>Orders</xs:documentation></xs:annotation>
+  <xs:element name="order" type="tns:Order"/>
+  <xs:complexType name="Order">
+    <xs:sequence>
+      <xs:element name="customer" type="tns:Name"/>
+      <xs:element name="email" minOccurs="0">
+        <xs:simpleType>
+          <xs:restriction base="xs:string">
+            <xs:pattern value="[^@]+@[^@]+"/>
+          </xs:restriction>
+        </xs:simpleType>
+      </xs:element>
+      <xs:element name="item" type="tns:Item" maxOccurs="unbounded"/>
+      <xs:choice minOccurs="0">
+        <xs:element name="card" type="xs:string"/>
+        <xs:element name="voucher" type="xs:string"/>
+      </xs:choice>
+      <xs:element name="note" type="xs:string" nillable="true" minOccurs="0"/>
+      <xs:any namespace="##other" processContents="skip" minOccurs="0"/>
+    </xs:sequence>
+    <xs:attribute name="id" type="xs:positiveInteger" use="required"/>
+    <xs:attribute name="status" type="tns:Status" default="new"/>
+  </xs:complexType>
+  <xs:complexType name="Item">
+    <xs:simpleContent>
+      <xs:extension base="tns:Quantity">
+        <xs:attribute name="sku" use="required">
+          <xs:simpleType>
+            <xs:restriction base="xs:token">
+              <xs:length value="6"/>
+            </xs:restriction>
+          </xs:simpleType>
+        </xs:attribute>
+      </xs:extension>
+    </xs:simpleContent>
+  </xs:complexType>
+  <xs:simpleType name="Quantity">
+    <xs:restriction base="xs:int">
+      <xs:minInclusive value="1"/>
+      <xs:maxExclusive value="100"/>
+    </xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="Name">
+    <xs:restriction base="xs:string">
+      <xs:minLength value="1"/>
+      <xs:maxLength value="10"/>
+    </xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="Status">
+    <xs:restriction base="xs:string">
+      <xs:enumeration value="new"/>
+      <xs:enumeration value="paid"/>
+    </xs:restriction>
+  </xs:simpleType>
+</xs:schema>`
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := NewSchema([]byte(orderSchema), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{
+			name: "valid",
+			doc: `<order xmlns="urn:shop" id="1" status="paid">
+  <customer>Alice</customer>
+  <email>alice@example.com</email>
+  <item sku="ABC123">2</item>
+  <item sku=" XYZ789 ">99</item>
+  <card>4111</card>
+  <note xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"/>
+  <ext:anything xmlns:ext="urn:other"><whatever/></ext:anything>
+</order>`,
+		},
+		{
+			name: "valid prefixed",
+			doc:  `<s:order xmlns:s="urn:shop" id="1"><s:customer>Bob</s:customer><s:item sku="ABC123">1</s:item></s:order>`,
+		},
+		{
+			name: "unknown root",
+			doc:  `<order id="1"><customer>Bob</customer></order>`,
+			err:  "no declaration found for the root element order",
+		},
+		{
+			name: "missing required element",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer></order>`,
+			err:  "missing child elements",
+		},
+		{
+			name: "unexpected element",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer><item sku="ABC123">1</item><admin/></order>`,
+			err:  "unexpected element {urn:shop}admin",
+		},
+		{
+			name: "both choices",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer><item sku="ABC123">1</item><card>1</card><voucher>2</voucher></order>`,
+			err:  "unexpected element {urn:shop}voucher",
+		},
+		{
+			name: "wrong order",
+			doc:  `<order xmlns="urn:shop" id="1"><item sku="ABC123">1</item><customer>Bob</customer></order>`,
+			err:  "unexpected element {urn:shop}item",
+		},
+		{
+			name: "wildcard in target namespace",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer><item sku="ABC123">1</item><other/></order>`,
+			err:  "unexpected element {urn:shop}other",
+		},
+		{
+			name: "missing required attribute",
+			doc:  `<order xmlns="urn:shop"><customer>Bob</customer><item sku="ABC123">1</item></order>`,
+			err:  "missing required attribute id",
+		},
+		{
+			name: "undeclared attribute",
+			doc:  `<order xmlns="urn:shop" id="1" admin="true"><customer>Bob</customer><item sku="ABC123">1</item></order>`,
+			err:  "attribute admin is not allowed",
+		},
+		{
+			name: "invalid attribute type",
+			doc:  `<order xmlns="urn:shop" id="0"><customer>Bob</customer><item sku="ABC123">1</item></order>`,
+			err:  `"0" is less than the minimum 1`,
+		},
+		{
+			name: "invalid enumeration",
+			doc:  `<order xmlns="urn:shop" id="1" status="free"><customer>Bob</customer><item sku="ABC123">1</item></order>`,
+			err:  `"free" is not an allowed value`,
+		},
+		{
+			name: "too long",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob Bobbington</customer><item sku="ABC123">1</item></order>`,
+			err:  "longer than the maximum length 10",
+		},
+		{
+			name: "pattern",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer><email>bob</email><item sku="ABC123">1</item></order>`,
+			err:  "does not match the pattern",
+		},
+		{
+			name: "simple content out of range",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer><item sku="ABC123">100</item></order>`,
+			err:  `"100" is not less than 100`,
+		},
+		{
+			name: "simple content not a number",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer><item sku="ABC123">1 OR 1=1</item></order>`,
+			err:  "is not a valid value of {http://www.w3.org/2001/XMLSchema}decimal",
+		},
+		{
+			name: "simple content with children",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</customer><item sku="ABC123"><x/></item></order>`,
+			err:  "cannot have child elements",
+		},
+		{
+			name: "text in element only content",
+			doc:  `<order xmlns="urn:shop" id="1">text<customer>Bob</customer><item sku="ABC123">1</item></order>`,
+			err:  "cannot have character content",
+		},
+		{
+			name: "not nillable",
+			doc:  `<order xmlns="urn:shop" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" id="1"><customer xsi:nil="true"/><item sku="ABC123">1</item></order>`,
+			err:  "is not nillable",
+		},
+		{
+			name: "malformed",
+			doc:  `<order xmlns="urn:shop" id="1"><customer>Bob</order>`,
+			err:  "syntax error",
+		},
+		{
+			name: "undefined entity",
+			doc:  `<!DOCTYPE order [<!ENTITY xxe SYSTEM "file:///etc/passwd">]><order xmlns="urn:shop" id="1"><customer>&xxe;</customer><item sku="ABC123">1</item></order>`,
+			err:  "invalid character entity &xxe;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(strings.NewReader(tt.doc))
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q", tt.err)
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("unexpected error %q, want %q", err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestSchemaErrorLine(t *testing.T) {
+	schema, err := NewSchema([]byte(orderSchema), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = schema.Validate(strings.NewReader("<order xmlns=\"urn:shop\" id=\"1\">\n<customer>Bob</customer>\n<item sku=\"ABC123\">1</item>\n<oops/>\n</order>"))
+	var verr *validationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if verr.line != 4 {
+		t.Errorf("unexpected line %d", verr.line)
+	}
+}
+
+func TestSchemaDerivation(t *testing.T) {
+	schema, err := NewSchema([]byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:element name="node" type="Node"/>
+  <xs:complexType name="Base">
+    <xs:sequence>
+      <xs:element name="id" type="xs:unsignedShort"/>
+      <xs:element name="child" type="Child" minOccurs="0" maxOccurs="unbounded"/>
+    </xs:sequence>
+    <xs:attribute name="lang" type="xs:language"/>
+    <xs:attribute name="debug" type="xs:boolean"/>
+  </xs:complexType>
+  <xs:complexType name="Node">
+    <xs:complexContent>
+      <xs:restriction base="Base">
+        <xs:sequence>
+          <xs:element name="id" type="xs:unsignedShort"/>
+          <xs:element name="child" type="Child" minOccurs="0" maxOccurs="unbounded"/>
+        </xs:sequence>
+        <xs:attribute name="debug" use="prohibited"/>
+      </xs:restriction>
+    </xs:complexContent>
+  </xs:complexType>
+  <xs:complexType name="Child">
+    <xs:complexContent>
+      <xs:extension base="Base">
+        <xs:all>
+          <xs:element name="a" type="xs:date"/>
+          <xs:element name="b" type="xs:decimal" minOccurs="0"/>
+        </xs:all>
+      </xs:extension>
+    </xs:complexContent>
+  </xs:complexType>
+</xs:schema>`), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := `<node lang="en-GB"><id>1</id><child><id>2</id><b>1.5</b><a>2024-02-29</a></child></node>`
+	if err := schema.Validate(strings.NewReader(valid)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	invalid := map[string]string{
+		`<node debug="true"><id>1</id></node>`:                                               "attribute debug is not allowed",
+		`<node><id>70000</id></node>`:                                                        "greater than the maximum 65535",
+		`<node><id>1</id><child><id>2</id><b>1</b></child></node>`:                           "missing child elements",
+		`<node><id>1</id><child><id>2</id><a>2023-02-29</a></child></node>`:                  "not a valid value of {http://www.w3.org/2001/XMLSchema}date",
+		`<node><id>1</id><child><id>2</id><a>2024-01-01</a><a>2024-01-01</a></child></node>`: "unexpected element a",
+	}
+	for doc, want := range invalid {
+		err := schema.Validate(strings.NewReader(doc))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("unexpected error %v for %s, want %q", err, doc, want)
+		}
+	}
+}
+
+func TestSchemaInclude(t *testing.T) {
+	fsys := fstest.MapFS{
+		"schemas/main.xsd": {Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:c="urn:common" targetNamespace="urn:main" xmlns:m="urn:main">
+  <xs:import namespace="urn:common" schemaLocation="common/common.xsd"/>
+  <xs:include schemaLocation="types.xsd"/>
+  <xs:element name="msg">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element ref="c:header"/>
+        <xs:element name="body" type="m:Body"/>
+      </xs:sequence>
+      <xs:attributeGroup ref="c:versioned"/>
+    </xs:complexType>
+  </xs:element>
+</xs:schema>`)},
+		"schemas/types.xsd": {Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:simpleType name="Body">
+    <xs:list itemType="xs:int"/>
+  </xs:simpleType>
+</xs:schema>`)},
+		"schemas/common/common.xsd": {Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="urn:common">
+  <xs:element name="header" type="xs:string"/>
+  <xs:attributeGroup name="versioned">
+    <xs:attribute name="version" type="xs:decimal" use="required"/>
+  </xs:attributeGroup>
+</xs:schema>`)},
+	}
+	data, err := fs.ReadFile(fsys, "schemas/main.xsd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := NewSchema(data, func(location string) ([]byte, error) {
+		return fs.ReadFile(fsys, "schemas/"+location)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := schema.Validate(strings.NewReader(`<m:msg xmlns:m="urn:main" xmlns:c="urn:common" version="1.0"><c:header>h</c:header><body>1 2 3</body></m:msg>`)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err := schema.Validate(strings.NewReader(`<m:msg xmlns:m="urn:main" xmlns:c="urn:common" version="1.0"><c:header>h</c:header><body>1 two 3</body></m:msg>`)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSchemaErrors(t *testing.T) {
+	tests := map[string]string{
+		`<schema/>`: "unexpected root element schema",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="a" type="Missing"/></xs:schema>`:                                                                                                            "unknown type Missing",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"></xs:schema>`:                                                                                                                                                 "does not declare any global element",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:include schemaLocation="http://example.com/a.xsd"/></xs:schema>`:                                                                                          "remote schema location",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:redefine schemaLocation="a.xsd"/></xs:schema>`:                                                                                                            "redefine is not supported",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="a" type="p:T"/></xs:schema>`:                                                                                                                "undeclared namespace prefix",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="a"><xs:simpleType><xs:restriction base="xs:string"><xs:pattern value="[a-[b]]"/></xs:restriction></xs:simpleType></xs:element></xs:schema>`: "subtraction is not supported",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:complexType name="A"><xs:complexContent><xs:extension base="B"/></xs:complexContent></xs:complexType><xs:complexType name="B"><xs:complexContent><xs:extension base="A"/></xs:complexContent></xs:complexType><xs:element name="a" type="A"/></xs:schema>`: "circular",
+	}
+	for schema, want := range tests {
+		_, err := NewSchema([]byte(schema), nil)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("unexpected error %v, want %q", err, want)
+		}
+	}
+}
+
+func TestCompilePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		match   []string
+		noMatch []string
+	}{
+		{`[0-9]{3}`, []string{"123"}, []string{"1234", "a123"}},
+		{`\i\c*`, []string{"name", "_a-b.c"}, []string{"1abc", "a b"}},
+		{`a$b^`, []string{"a$b^"}, []string{"ab"}},
+		{`[^$]+`, []string{"abc"}, []string{"a$"}},
+		{`\d+|x`, []string{"12", "x"}, []string{"12x"}},
+	}
+	for _, tt := range tests {
+		re, err := compilePattern(tt.pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range tt.match {
+			if !re.MatchString(m) {
+				t.Errorf("%q should match %q", tt.pattern, m)
+			}
+		}
+		for _, m := range tt.noMatch {
+			if re.MatchString(m) {
+				t.Errorf("%q should not match %q", tt.pattern, m)
+			}
+		}
+	}
+}
+
+func TestBuiltinTypes(t *testing.T) {
+	tests := []struct {
+		typ     string
+		valid   []string
+		invalid []string
+	}{
+		{"boolean", []string{"true", "0", " false "}, []string{"yes", ""}},
+		{"byte", []string{"-128", "127", "+5"}, []string{"128", "1.0"}},
+		{"unsignedLong", []string{"18446744073709551615"}, []string{"18446744073709551616", "-1"}},
+		{"decimal", []string{"1.5", "-.5", "10"}, []string{"1e3", "."}},
+		{"double", []string{"1e3", "INF", "NaN", "-1.5E-3"}, []string{"inf", "1e"}},
+		{"dateTime", []string{"2024-01-31T23:59:59Z", "2024-01-31T00:00:00.5+02:00"}, []string{"2024-01-32T00:00:00", "2024-01-31"}},
+		{"time", []string{"24:00:00", "12:30:00Z"}, []string{"24:00:01", "12:60:00"}},
+		{"duration", []string{"P1Y2M", "PT1.5S", "-P1D"}, []string{"P", "P1DT", "1Y"}},
+		{"hexBinary", []string{"0fA0", ""}, []string{"0f0"}},
+		{"base64Binary", []string{"aGVsbG8=", "aGVs bG8="}, []string{"aGVsbG8"}},
+		{"NCName", []string{"a-b", "_x"}, []string{"a:b", "1a"}},
+		{"QName", []string{"p:a", "a"}, []string{"p:", ":a"}},
+		{"NMTOKENS", []string{"a b  1"}, []string{"", "a ;"}},
+		{"language", []string{"en", "en-GB"}, []string{"toolongtag-x"}},
+		{"gYearMonth", []string{"2024-12"}, []string{"2024-13"}},
+	}
+	for _, tt := range tests {
+		typ := builtinTypes[tt.typ]
+		for _, v := range tt.valid {
+			if err := typ.validate(v); err != nil {
+				t.Errorf("%s: unexpected error for %q: %s", tt.typ, v, err.Error())
+			}
+		}
+		for _, v := range tt.invalid {
+			if err := typ.validate(v); err == nil {
+				t.Errorf("%s: expected error for %q", tt.typ, v)
+			}
+		}
+	}
+}
@@ -57,6 +57,7 @@ func BenchmarkCRSCompilation(b *testing.B) {
 func BenchmarkCRSSimpleGET(b *testing.B) {
 	waf := crsWAF(b)
 
+	b.ReportAllocs()
 	b.ResetTimer() // only benchmark execution, not compilation
 	for i := 0; i < b.N; i++ {
 		tx := waf.NewTransaction()
@@ -147,6 +148,54 @@ func BenchmarkCRSLargePOST(b *testing.B) {
 	}
 }
 
+// BenchmarkCRSEncodedPOST sends arguments and a body with the encodings the
+// CRS transformation chains undo, e.g. URL, HTML entity and path encodings.
+func BenchmarkCRSEncodedPOST(b *testing.B) {
+	waf := crsWAF(b)
+
+	query := url.Values{
+		"q":        {"caf%C3%A9 &amp; cr&egrave;me &#x3c;b&#x3e;br&ucirc;l&eacute;e&#x3c;/b&#x3e;"},
+		"redirect": {"/account/./settings/../profile/%2e%2e/home/"},
+		"file":     {"reports\\2024\\..\\summary.pdf"},
+	}
+	form := url.Values{
+		"comment":  {"Prices &lt; 10&euro; &amp;&amp; &quot;free&quot; shipping &#8211; see https://example.com/a/./b/../c?x=%2520y"},
+		"title":    {"&Agrave; la carte &ndash; %u00e9t%u00e9 menu"},
+		"path":     {"./static//img/../css/site.css"},
+		"tags":     {"news,%20events,%20caf&eacute;"},
+		"callback": {"render%28%27list%27%29"},
+	}
+	postPayload := []byte(form.Encode())
+
+	b.ReportAllocs()
+	b.ResetTimer() // only benchmark execution, not compilation
+	for i := 0; i < b.N; i++ {
+		tx := waf.NewTransaction()
+		tx.ProcessConnection("127.0.0.1", 8080, "127.0.0.1", 8080)
+		tx.ProcessURI("POST", "/some_path/with?"+query.Encode(), "HTTP/1.1")
+		tx.AddRequestHeader("Host", "localhost")
+		tx.AddRequestHeader("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_14_5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/75.0.3770.100 Safari/537.36")
+		tx.AddRequestHeader("Accept", "application/json")
+		tx.AddRequestHeader("Content-Type", "application/x-www-form-urlencoded")
+		tx.ProcessRequestHeaders()
+		if _, _, err := tx.WriteRequestBody(postPayload); err != nil {
+			b.Error(err)
+		}
+		if _, err := tx.ProcessRequestBody(); err != nil {
+			b.Error(err)
+		}
+		tx.AddResponseHeader("Content-Type", "application/json")
+		tx.ProcessResponseHeaders(200, "OK")
+		if _, err := tx.ProcessResponseBody(); err != nil {
+			b.Error(err)
+		}
+		tx.ProcessLogging()
+		if err := tx.Close(); err != nil {
+			b.Error(err)
+		}
+	}
+}
+
 func TestFTW(t *testing.T) {
 	conf := coraza.NewWAFConfig()
 
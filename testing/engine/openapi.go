@@ -0,0 +1,99 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !coraza.disabled_operators.validateOpenAPI
+
+package engine
+
+import (
+	"github.com/corazawaf/coraza/v3/testing/profile"
+)
+
+var _ = profile.RegisterProfile(profile.Profile{
+	Meta: profile.Meta{
+		Author:      "coraza",
+		Description: "Test if the validateOpenAPI operator works",
+		Enabled:     true,
+		Name:        "openapi.yaml",
+	},
+	Tests: []profile.Test{
+		{
+			Title: "validateOpenAPI",
+			Stages: []profile.Stage{
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/users/42",
+							Method: "GET",
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules:    []int{101},
+							NonTriggeredRules: []int{100},
+						},
+					},
+				},
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/users?limit=100",
+							Method: "GET",
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules: []int{100},
+							LogContains:    `query parameter \"limit\": 100 must be at most 50`,
+						},
+					},
+				},
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/admin",
+							Method: "GET",
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules: []int{100},
+							LogContains:    `no path of the specification matches`,
+						},
+					},
+				},
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/users",
+							Method: "POST",
+							Headers: map[string]string{
+								"content-type": "application/json",
+							},
+							Data: `{"name": "alice", "email": "alice@example.com"}`,
+						},
+						Output: profile.ExpectedOutput{
+							NonTriggeredRules: []int{100},
+						},
+					},
+				},
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/users",
+							Method: "POST",
+							Headers: map[string]string{
+								"content-type": "application/json",
+							},
+							Data: `{"name": "alice", "role": "admin"}`,
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules: []int{100},
+							LogContains:    `property \"role\" is not allowed`,
+						},
+					},
+				},
+			},
+		},
+	},
+	Rules: `
+SecRequestBodyAccess On
+SecOpenAPISpec openapi.yaml
+SecRule REQUEST_FILENAME "@validateOpenAPI" "id:100, phase:2, deny, log, capture, msg:'%{TX.0}'"
+SecRule ARGS_PATH:id "@eq 42" "id:101, phase:2, pass, log"
+`,
+})
@@ -51,3 +51,97 @@ SecRule XML:/* "test123" "id:500, log"
 SecRule XML://@* "attribute_value" "id:501, log"
 `,
 })
+
+var _ = profile.RegisterProfile(profile.Profile{
+	Meta: profile.Meta{
+		Author:      "coraza",
+		Description: "Test if XML bodies are validated against schemas and DTDs",
+		Enabled:     true,
+		Name:        "xml_validation.yaml",
+	},
+	Tests: []profile.Test{
+		{
+			Title: "validateSchema",
+			Stages: []profile.Stage{
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/soap",
+							Method: "POST",
+							Headers: map[string]string{
+								"content-type": "text/xml",
+							},
+							Data: `<?xml version="1.0"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><soap:GetQuote><soap:symbol>ACME</soap:symbol></soap:GetQuote></soap:Body></soap:Envelope>`,
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules:    []int{101},
+							NonTriggeredRules: []int{102, 103},
+						},
+					},
+				},
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/soap",
+							Method: "POST",
+							Headers: map[string]string{
+								"content-type": "text/xml",
+							},
+							Data: `<?xml version="1.0"?><soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><soap:GetQuote><soap:symbol>' OR 1=1</soap:symbol></soap:GetQuote></soap:Body></soap:Envelope>`,
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules: []int{101, 102},
+							LogContains:    "XML: schema validation failed",
+						},
+					},
+				},
+			},
+		},
+		{
+			Title: "validateDTD",
+			Stages: []profile.Stage{
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/quote",
+							Method: "POST",
+							Headers: map[string]string{
+								"content-type": "application/xml",
+							},
+							Data: `<quote currency="EUR"><symbol>ACME</symbol><price>1.5</price></quote>`,
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules:    []int{101},
+							NonTriggeredRules: []int{102, 103},
+						},
+					},
+				},
+				{
+					Stage: profile.SubStage{
+						Input: profile.StageInput{
+							URI:    "/quote",
+							Method: "POST",
+							Headers: map[string]string{
+								"content-type": "application/xml",
+							},
+							Data: `<quote currency="GBP"><symbol>ACME</symbol></quote>`,
+						},
+						Output: profile.ExpectedOutput{
+							TriggeredRules: []int{101, 103},
+							LogContains:    "XML: DTD validation failed",
+						},
+					},
+				},
+			},
+		},
+	},
+	Rules: `
+SecRequestBodyAccess On
+SecRule REQUEST_HEADERS:content-type "xml" "id:100, phase:1, pass, nolog, ctl:requestBodyProcessor=XML"
+SecRule REQBODY_PROCESSOR "XML" "id:101, phase:2, pass, log"
+SecRule REQUEST_URI "@streq /soap" "id:102, phase:2, deny, log, msg:'%{REQBODY_ERROR_MSG}', chain"
+	SecRule REQUEST_BODY "@validateSchema soap.xsd"
+SecRule REQUEST_URI "@streq /quote" "id:103, phase:2, deny, log, msg:'%{REQBODY_ERROR_MSG}', chain"
+	SecRule REQUEST_BODY "@validateDTD quote.dtd"
+`,
+})
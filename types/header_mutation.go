@@ -0,0 +1,28 @@
+// Copyright 2024 Juan Pablo Tosso and the OWASP Coraza contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// HeaderMutationType represents the kind of change a HeaderMutation
+// applies to a set of headers.
+type HeaderMutationType int
+
+const (
+	// HeaderMutationSet replaces every value of the header with the mutation value
+	HeaderMutationSet HeaderMutationType = iota
+	// HeaderMutationRemove removes the header
+	HeaderMutationRemove HeaderMutationType = iota
+)
+
+// HeaderMutation is a header change queued by a rule (e.g. through the
+// setRequestHeader, setResponseHeader or removeHeader actions) that the
+// connector is expected to apply, in order, to the request forwarded
+// upstream or to the response sent to the client.
+type HeaderMutation struct {
+	// Type is the kind of change to apply
+	Type HeaderMutationType
+	// Name is the header name
+	Name string
+	// Value is the header value, it is empty for HeaderMutationRemove
+	Value string
+}
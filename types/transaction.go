@@ -120,6 +120,8 @@ type Transaction interface {
 	// ResponseBodyReader returns a reader for content that has been written by
 	// response body buffer. This can be useful for buffering the response body
 	// within the Transaction while also passing it further in an HTTP framework.
+	// The reader includes the content injected by the prepend and append actions,
+	// hence its length might differ from the length of the written body.
 	ResponseBodyReader() (io.Reader, error)
 
 	// ProcessResponseBody Perform the analysis of the response body (if any)
@@ -190,6 +192,16 @@ type Transaction interface {
 	// MatchedRules returns the rules that have matched the requests with associated information.
 	MatchedRules() []MatchedRule
 
+	// RequestHeaderMutations returns the header changes queued by rules that the
+	// connector should apply, in order, to the request before forwarding it upstream.
+	// Mutations are only queued, Coraza variables like REQUEST_HEADERS are not modified.
+	RequestHeaderMutations() []HeaderMutation
+
+	// ResponseHeaderMutations returns the header changes queued by rules and by the
+	// SecServerSignature directive that the connector should apply, in order, to the
+	// response headers right before sending them to the client.
+	ResponseHeaderMutations() []HeaderMutation
+
 	// DebugLogger returns the debug logger for this transaction.
 	DebugLogger() debuglog.Logger
 
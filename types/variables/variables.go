@@ -44,7 +44,8 @@ const (
 	QueryString = variables.QueryString
 	// RemoteAddr is the remote address of the connection
 	RemoteAddr = variables.RemoteAddr
-	// RemoteHost is the remote host of the connection, not implemented
+	// RemoteHost is the host name of the client, resolved with reverse DNS
+	// when SecHostnameLookups is enabled
 	RemoteHost = variables.RemoteHost
 	// RemotePort is the remote port of the connection
 	RemotePort = variables.RemotePort
@@ -180,6 +180,14 @@ type Interruption struct {
 
 	// Parameters used by proxy and redirect
 	Data string
+
+	// Headers to be sent to the client along with the interruption,
+	// e.g. the redirect location or the content type of the body
+	Headers map[string]string
+
+	// Body to be sent to the client, rendered from the error document
+	// configured with SecErrorDocument or the errorDocument action
+	Body string
 }
 
 // BodyBufferOptions is used to feed a coraza.BodyBuffer with parameters
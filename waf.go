@@ -67,7 +67,9 @@ func NewWAF(config WAFConfig) (WAF, error) {
 		}
 	}
 
-	populateAuditLog(waf, c)
+	if err := populateAuditLog(waf, c); err != nil {
+		return nil, fmt.Errorf("invalid WAF config from audit log: %w", err)
+	}
 
 	if err := waf.InitAuditLogWriter(); err != nil {
 		return nil, fmt.Errorf("invalid WAF config from audit log: %w", err)
@@ -108,9 +110,9 @@ func NewWAF(config WAFConfig) (WAF, error) {
 	return wafWrapper{waf: waf}, nil
 }
 
-func populateAuditLog(waf *corazawaf.WAF, c *wafConfig) {
+func populateAuditLog(waf *corazawaf.WAF, c *wafConfig) error {
 	if c.auditLog == nil {
-		return
+		return nil
 	}
 
 	if c.auditLog.relevantOnly {
@@ -126,6 +128,14 @@ func populateAuditLog(waf *corazawaf.WAF, c *wafConfig) {
 	if c.auditLog.writer != nil {
 		waf.SetAuditLogWriter(c.auditLog.writer)
 	}
+
+	for _, target := range c.auditLog.redactions {
+		if err := waf.AuditLogRedaction.Add(target); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 type wafWrapper struct {
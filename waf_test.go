@@ -109,6 +109,16 @@ func TestResponseBodyLimit(t *testing.T) {
 	}
 }
 
+func TestPopulateAuditLogInvalidRedaction(t *testing.T) {
+	waf := &corazawaf.WAF{}
+	err := populateAuditLog(waf, &wafConfig{
+		auditLog: &auditLogConfig{redactions: []string{"TX:secret"}},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 type testAuditLogWriter struct {
 	plugintypes.AuditLogWriter
 }
@@ -168,12 +178,26 @@ func TestPopulateAuditLog(t *testing.T) {
 				}
 			},
 		},
+		"with redaction": {
+			config: &wafConfig{
+				auditLog: &auditLogConfig{
+					redactions: []string{"REQUEST_HEADERS:Authorization", "ARGS:/^pass/"},
+				},
+			},
+			check: func(t *testing.T, waf *corazawaf.WAF) {
+				if waf.AuditLogRedaction.IsEmpty() {
+					t.Fatal("expected AuditLogRedaction to be set")
+				}
+			},
+		},
 	}
 
 	for name, tCase := range testCases {
 		t.Run(name, func(t *testing.T) {
 			waf := &corazawaf.WAF{}
-			populateAuditLog(waf, tCase.config)
+			if err := populateAuditLog(waf, tCase.config); err != nil {
+				t.Fatal(err)
+			}
 			tCase.check(t, waf)
 		})
 	}